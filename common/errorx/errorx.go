@@ -36,6 +36,11 @@ func NewCodeError(code int, msg string) *CodeError {
 	}
 }
 
+// WithMsg returns a copy of the error with a more specific message, the code stays the same
+func (e *CodeError) WithMsg(msg string) *CodeError {
+	return NewCodeError(e.Code, msg)
+}
+
 // ========================================
 // Default Errors
 // ========================================
@@ -54,11 +59,33 @@ var (
 	ErrTokenExpired      = NewCodeError(2004, "Token expired")
 	ErrPermissionDenied  = NewCodeError(2005, "Permission denied")
 
-	ErrProductNotFound   = NewCodeError(3000, "Product not found")
-	ErrProductOutOfStock = NewCodeError(3001, "Product out of stock")
+	ErrProductNotFound        = NewCodeError(3000, "Product not found")
+	ErrProductOutOfStock      = NewCodeError(3001, "Product out of stock")
+	ErrProductSkuExists       = NewCodeError(3003, "Product SKU already exists")
+	ErrPriceScheduleNotFound  = NewCodeError(3004, "Price schedule not found")
+	ErrPriceScheduleOverlap   = NewCodeError(3005, "Price schedule overlaps an existing schedule")
+	ErrPriceScheduleEnded     = NewCodeError(3006, "Price schedule already ended")
+	ErrFlashSaleNotFound      = NewCodeError(3007, "Flash sale not found")
+	ErrFlashSaleNotActive     = NewCodeError(3008, "Flash sale is not in progress")
+	ErrFlashSaleSoldOut       = NewCodeError(3009, "Flash sale sold out")
+	ErrFlashSaleLimitExceeded = NewCodeError(3010, "Flash sale purchase limit exceeded")
+	ErrFlashSaleOverlap       = NewCodeError(3011, "Flash sale overlaps an existing sale")
+	ErrWarehouseNotFound      = NewCodeError(3012, "Warehouse not found")
+	ErrWarehouseCodeExists    = NewCodeError(3013, "Warehouse code already exists")
+	ErrProductInStock         = NewCodeError(3014, "Product is in stock")
+	ErrProductVersionConflict = NewCodeError(3015, "Product was modified by someone else, reload and retry")
+	ErrProductIsBundle        = NewCodeError(3016, "Bundle stock is derived from its components")
+	ErrProductNotBundle       = NewCodeError(3017, "Product is not a bundle")
+	ErrOrderLimitExceeded     = NewCodeError(3018, "Purchase limit per order exceeded")
+	ErrUserLimitExceeded      = NewCodeError(3019, "Purchase limit per customer exceeded")
 
-	ErrCartEmpty         = NewCodeError(4000, "Cart is empty")
-	ErrCartItemNotFound  = NewCodeError(4001, "Cart item not found")
+	ErrCartEmpty            = NewCodeError(4000, "Cart is empty")
+	ErrCartItemNotFound     = NewCodeError(4001, "Cart item not found")
+	ErrWishlistNotFound     = NewCodeError(4004, "Wishlist not found")
+	ErrWishlistItemNotFound = NewCodeError(4005, "Wishlist item not found")
+	ErrWishlistNameExists   = NewCodeError(4006, "Wishlist name already exists")
+	ErrQuoteNotFound        = NewCodeError(4008, "Quote expired or already used, price the cart again")
+	ErrSharedCartNotFound   = NewCodeError(4009, "Shared cart not found or expired")
 
 	ErrOrderNotFound     = NewCodeError(5000, "Order not found")
 	ErrOrderCannotCancel = NewCodeError(5002, "Cannot cancel order")
@@ -68,14 +68,40 @@ const (
 	ERROR_PERMISSION_DENIED    = 2005 // Permission denied
 
 	// Product errors (3000-3999)
-	ERROR_PRODUCT_NOT_FOUND    = 3000 // Product not found
-	ERROR_PRODUCT_OUT_OF_STOCK = 3001 // Product out of stock
-	ERROR_PRODUCT_INVALID      = 3002 // Invalid product
+	ERROR_PRODUCT_NOT_FOUND         = 3000 // Product not found
+	ERROR_PRODUCT_OUT_OF_STOCK      = 3001 // Product out of stock
+	ERROR_PRODUCT_INVALID           = 3002 // Invalid product
+	ERROR_PRODUCT_SKU_EXISTS        = 3003 // Product SKU already exists
+	ERROR_PRICE_SCHEDULE_NOT_FOUND  = 3004 // Price schedule not found
+	ERROR_PRICE_SCHEDULE_OVERLAP    = 3005 // Price schedule overlaps an existing schedule
+	ERROR_PRICE_SCHEDULE_ENDED      = 3006 // Price schedule already ended
+	ERROR_FLASH_SALE_NOT_FOUND      = 3007 // Flash sale not found
+	ERROR_FLASH_SALE_NOT_ACTIVE     = 3008 // Flash sale is not in progress
+	ERROR_FLASH_SALE_SOLD_OUT       = 3009 // Flash sale sold out
+	ERROR_FLASH_SALE_LIMIT_EXCEEDED = 3010 // Flash sale purchase limit exceeded
+	ERROR_FLASH_SALE_OVERLAP        = 3011 // Flash sale overlaps an existing sale
+	ERROR_WAREHOUSE_NOT_FOUND       = 3012 // Warehouse not found
+	ERROR_WAREHOUSE_CODE_EXISTS     = 3013 // Warehouse code already exists
+	ERROR_PRODUCT_IN_STOCK          = 3014 // Product is in stock
+	ERROR_PRODUCT_VERSION_CONFLICT  = 3015 // Product was modified by someone else
+	ERROR_PRODUCT_IS_BUNDLE         = 3016 // Bundle stock is derived from its components
+	ERROR_PRODUCT_NOT_BUNDLE        = 3017 // Product is not a bundle
+	ERROR_ORDER_LIMIT_EXCEEDED      = 3018 // More units than the product allows per order
+	ERROR_USER_LIMIT_EXCEEDED       = 3019 // More units than the product allows per user
+	ERROR_PRODUCT_ALREADY_DELETED   = 3020 // Product is already deleted
+	ERROR_PRODUCT_NOT_DELETED       = 3021 // Only deleted products can be restored
+	ERROR_FLASH_SALE_CLOSED         = 3022 // Flash sale already closed
 
 	// Cart errors (4000-4999)
-	ERROR_CART_EMPTY           = 4000 // Cart is empty
-	ERROR_CART_ITEM_NOT_FOUND  = 4001 // Cart item not found
-	ERROR_CART_EXCEED_LIMIT    = 4002 // Cart exceeds limit
+	ERROR_CART_EMPTY              = 4000 // Cart is empty
+	ERROR_CART_ITEM_NOT_FOUND     = 4001 // Cart item not found
+	ERROR_CART_EXCEED_LIMIT       = 4002 // Cart exceeds limit
+	ERROR_WISHLIST_NOT_FOUND      = 4004 // Wishlist not found
+	ERROR_WISHLIST_ITEM_NOT_FOUND = 4005 // Wishlist item not found
+	ERROR_WISHLIST_NAME_EXISTS    = 4006 // Wishlist name already exists
+	ERROR_WISHLIST_EXCEED_LIMIT   = 4007 // Wishlist exceeds limit
+	ERROR_QUOTE_NOT_FOUND         = 4008 // Quote expired or already used
+	ERROR_SHARED_CART_NOT_FOUND   = 4009 // Shared cart not found or expired
 
 	// Order errors (5000-5999)
 	ERROR_ORDER_NOT_FOUND      = 5000 // Order not found
@@ -109,13 +135,36 @@ func GetErrorMsg(code int) string {
 		ERROR_TOKEN_EXPIRED:       "Token expired",
 		ERROR_PERMISSION_DENIED:   "Permission denied",
 
-		ERROR_PRODUCT_NOT_FOUND:    "Product not found",
-		ERROR_PRODUCT_OUT_OF_STOCK: "Product out of stock",
-		ERROR_PRODUCT_INVALID:      "Invalid product",
-
-		ERROR_CART_EMPTY:          "Cart is empty",
-		ERROR_CART_ITEM_NOT_FOUND: "Cart item not found",
-		ERROR_CART_EXCEED_LIMIT:   "Cart exceeds limit",
+		ERROR_PRODUCT_NOT_FOUND:         "Product not found",
+		ERROR_PRODUCT_OUT_OF_STOCK:      "Product out of stock",
+		ERROR_PRODUCT_INVALID:           "Invalid product",
+		ERROR_PRODUCT_SKU_EXISTS:        "Product SKU already exists",
+		ERROR_PRICE_SCHEDULE_NOT_FOUND:  "Price schedule not found",
+		ERROR_PRICE_SCHEDULE_OVERLAP:    "Price schedule overlaps an existing schedule",
+		ERROR_PRICE_SCHEDULE_ENDED:      "Price schedule already ended",
+		ERROR_FLASH_SALE_NOT_FOUND:      "Flash sale not found",
+		ERROR_FLASH_SALE_NOT_ACTIVE:     "Flash sale is not in progress",
+		ERROR_FLASH_SALE_SOLD_OUT:       "Flash sale sold out",
+		ERROR_FLASH_SALE_LIMIT_EXCEEDED: "Flash sale purchase limit exceeded",
+		ERROR_FLASH_SALE_OVERLAP:        "Flash sale overlaps an existing sale",
+		ERROR_WAREHOUSE_NOT_FOUND:       "Warehouse not found",
+		ERROR_WAREHOUSE_CODE_EXISTS:     "Warehouse code already exists",
+		ERROR_PRODUCT_IN_STOCK:          "Product is in stock",
+		ERROR_PRODUCT_VERSION_CONFLICT:  "Product was modified by someone else, reload and retry",
+		ERROR_PRODUCT_IS_BUNDLE:         "Bundle stock is derived from its components",
+		ERROR_PRODUCT_NOT_BUNDLE:        "Product is not a bundle",
+		ERROR_ORDER_LIMIT_EXCEEDED:      "Purchase limit per order exceeded",
+		ERROR_USER_LIMIT_EXCEEDED:       "Purchase limit per customer exceeded",
+
+		ERROR_CART_EMPTY:              "Cart is empty",
+		ERROR_CART_ITEM_NOT_FOUND:     "Cart item not found",
+		ERROR_CART_EXCEED_LIMIT:       "Cart exceeds limit",
+		ERROR_WISHLIST_NOT_FOUND:      "Wishlist not found",
+		ERROR_WISHLIST_ITEM_NOT_FOUND: "Wishlist item not found",
+		ERROR_WISHLIST_NAME_EXISTS:    "Wishlist name already exists",
+		ERROR_WISHLIST_EXCEED_LIMIT:   "Wishlist exceeds limit",
+		ERROR_QUOTE_NOT_FOUND:         "Quote expired or already used, price the cart again",
+		ERROR_SHARED_CART_NOT_FOUND:   "Shared cart not found or expired",
 
 		ERROR_ORDER_NOT_FOUND:      "Order not found",
 		ERROR_ORDER_STATUS_INVALID: "Invalid order status",
@@ -13,6 +13,7 @@ CREATE DATABASE letsgo_user;
 CREATE DATABASE letsgo_product;
 CREATE DATABASE letsgo_order;
 CREATE DATABASE letsgo_payment;
+CREATE DATABASE letsgo_cart;
 
 -- ========================================
 -- User Service Database Schema
@@ -152,6 +153,52 @@ COMMENT ON TABLE payments IS 'Payment transactions';
 COMMENT ON COLUMN payments.payment_type IS '1:Alipay, 2:WeChat Pay, 3:Credit Card';
 COMMENT ON COLUMN payments.status IS '1:pending, 2:success, 3:failed, 4:cancelled';
 
+-- ========================================
+-- Cart Service Database Schema
+-- ========================================
+-- Carts live in Redis, user carts are copied here in the background
+
+\c letsgo_cart;
+
+-- Wishlists table (named lists and the "Saved for later" list)
+CREATE TABLE IF NOT EXISTS wishlists (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE, -- The "Saved for later" list
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name)
+);
+
+-- Wishlist items table
+CREATE TABLE IF NOT EXISTS wishlist_items (
+    wishlist_id BIGINT NOT NULL REFERENCES wishlists(id) ON DELETE CASCADE,
+    product_id BIGINT NOT NULL,
+    quantity BIGINT NOT NULL DEFAULT 1 CHECK (quantity > 0),
+    saved_price DECIMAL(10, 2) NOT NULL,   -- Price when the item was saved
+    added_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (wishlist_id, product_id)
+);
+
+-- Carts table (durable copy of Redis user carts)
+CREATE TABLE IF NOT EXISTS carts (
+    user_id BIGINT PRIMARY KEY,
+    items JSONB NOT NULL,                  -- Redis hash field -> cart line
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP, -- Last change of the cart
+    ordered_at TIMESTAMP,                  -- User's last order
+    abandoned_at TIMESTAMP,                -- updated_at of the state last checked for abandonment
+    abandoned_hash VARCHAR(64) NOT NULL DEFAULT '' -- Contents of the state last reported as abandoned
+);
+
+-- Create indexes
+CREATE UNIQUE INDEX idx_wishlists_default ON wishlists(user_id) WHERE is_default;
+CREATE INDEX idx_carts_updated_at ON carts(updated_at);
+
+COMMENT ON TABLE wishlists IS 'Named wishlists and the saved-for-later list of each user';
+COMMENT ON TABLE wishlist_items IS 'Products parked in a wishlist';
+COMMENT ON TABLE carts IS 'Write-behind copy of Redis user carts';
+
 -- ========================================
 -- Insert Sample Data (Optional)
 -- ========================================
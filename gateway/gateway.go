@@ -9,6 +9,7 @@ import (
 
 	"letsgo/gateway/internal/config"
 	"letsgo/gateway/internal/handler"
+	"letsgo/gateway/internal/storage"
 	"letsgo/gateway/internal/svc"
 
 	"github.com/zeromicro/go-zero/core/conf"
@@ -23,10 +24,17 @@ func main() {
 	var c config.Config
 	conf.MustLoad(*configFile, &c)
 
-	server := rest.MustNewServer(c.RestConf)
+	ctx := svc.NewServiceContext(c)
+
+	// Uploaded images on the local filesystem are served by the gateway itself
+	var opts []rest.RunOption
+	if localStorage, ok := ctx.Storage.(*storage.LocalStorage); ok {
+		opts = append(opts, rest.WithFileServer(localStorage.BaseURL(), localStorage.FileSystem()))
+	}
+
+	server := rest.MustNewServer(c.RestConf, opts...)
 	defer server.Stop()
 
-	ctx := svc.NewServiceContext(c)
 	handler.RegisterHandlers(server, ctx)
 
 	fmt.Printf("Starting server at %s:%d...\n", c.Host, c.Port)
@@ -4,6 +4,10 @@
 package config
 
 import (
+	"fmt"
+
+	"letsgo/gateway/internal/storage"
+
 	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/zrpc"
 )
@@ -24,4 +28,40 @@ type Config struct {
 
 	// Request Timeout (in milliseconds)
 	RequestTimeout int64 `json:",default=30000"`
+
+	// Guest cart cookie lifetime in seconds, matches Cart.GuestExpire of the cart service
+	GuestCartExpire int `json:",default=259200"`
+
+	// Product image storage (local filesystem or S3-compatible)
+	Storage storage.Conf
+
+	// Image upload settings
+	Upload struct {
+		MaxSize        int64  `json:",default=5242880"`       // Max file size in bytes (5MB), must stay under UploadMaxBytes
+		MaxPixels      int64  `json:",default=25000000"`      // Max width*height, rejects decompression bombs
+		ThumbnailSizes []int  `json:",default=[128,256,512]"` // Thumbnail bounding boxes in pixels
+		KeyPrefix      string `json:",default=products"`      // Storage key prefix
+		ImportMaxSize  int64  `json:",default=3670016"`       // Max product import file in bytes (3.5MB, stays under the 4MB gRPC message limit), must stay under ImportMaxBytes
+	}
+}
+
+// Request body limits of the upload routes, set with maxBytes in gateway.api.
+// The route limit is generated into routes.go, so it can't follow the config, keep both in sync
+const (
+	UploadMaxBytes = 10485760 // Image upload route
+	ImportMaxBytes = 4194304  // Product import route
+)
+
+// Validate is called when the config is loaded, a file limit above the route body limit
+// would be cut off by the route with a generic error before the handler checks it
+func (c Config) Validate() error {
+	if c.Upload.MaxSize >= UploadMaxBytes {
+		return fmt.Errorf("Upload.MaxSize %d must be below the upload route body limit %d, raise maxBytes in gateway.api and UploadMaxBytes first",
+			c.Upload.MaxSize, UploadMaxBytes)
+	}
+	if c.Upload.ImportMaxSize >= ImportMaxBytes {
+		return fmt.Errorf("Upload.ImportMaxSize %d must be below the import route body limit %d, raise maxBytes in gateway.api and ImportMaxBytes first",
+			c.Upload.ImportMaxSize, ImportMaxBytes)
+	}
+	return nil
 }
@@ -0,0 +1,32 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"letsgo/gateway/internal/logic/cart"
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+)
+
+// Accept cart prices - Save the current prices of lines whose price changed
+func AcceptCartPricesHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AcceptCartPricesReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := cart.NewAcceptCartPricesLogic(r.Context(), svcCtx)
+		resp, err := l.AcceptCartPrices(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
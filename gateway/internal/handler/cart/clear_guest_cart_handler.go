@@ -0,0 +1,25 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"letsgo/gateway/internal/logic/cart"
+	"letsgo/gateway/internal/svc"
+)
+
+// Clear guest cart - Remove all items from the guest cart
+func ClearGuestCartHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := cart.NewClearGuestCartLogic(r.Context(), svcCtx)
+		resp, err := l.ClearGuestCart()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
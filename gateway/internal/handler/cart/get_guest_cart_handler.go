@@ -0,0 +1,25 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"letsgo/gateway/internal/logic/cart"
+	"letsgo/gateway/internal/svc"
+)
+
+// Get guest cart - Retrieve the shopping cart of an anonymous shopper
+func GetGuestCartHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := cart.NewGetGuestCartLogic(r.Context(), svcCtx)
+		resp, err := l.GetGuestCart()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"letsgo/gateway/internal/logic/product"
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+)
+
+// Export products - Admin downloads the catalog as a CSV or JSON Lines file (admin only)
+func ExportProductsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ExportProductsReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := product.NewExportProductsLogic(r.Context(), svcCtx)
+		// The file is written to w directly, errors are only returned before the first chunk
+		err := l.ExportProducts(&req, w)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		}
+	}
+}
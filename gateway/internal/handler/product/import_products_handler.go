@@ -0,0 +1,60 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"letsgo/common/errorx"
+	"letsgo/gateway/internal/logic/product"
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+)
+
+// Import products - Admin creates or updates products by SKU from a CSV or JSON Lines file (admin only)
+func ImportProductsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ImportProductsReq
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// Read uploaded file (multipart form field "file")
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, errorx.NewCodeError(1001, "Import file is required"))
+			return
+		}
+		defer file.Close()
+
+		// The whole file is sent to the product service as one gRPC message
+		maxSize := svcCtx.Config.Upload.ImportMaxSize
+		if header.Size > maxSize {
+			httpx.ErrorCtx(r.Context(), w, errorx.NewCodeError(1001, fmt.Sprintf("Import file must be smaller than %d bytes, split it into several files", maxSize)))
+			return
+		}
+
+		data, err := io.ReadAll(io.LimitReader(file, maxSize+1))
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, errorx.NewCodeError(1001, "Failed to read import file"))
+			return
+		}
+		if int64(len(data)) > maxSize {
+			httpx.ErrorCtx(r.Context(), w, errorx.NewCodeError(1001, fmt.Sprintf("Import file must be smaller than %d bytes, split it into several files", maxSize)))
+			return
+		}
+
+		l := product.NewImportProductsLogic(r.Context(), svcCtx)
+		resp, err := l.ImportProducts(&req, data)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
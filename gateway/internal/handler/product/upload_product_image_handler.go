@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"letsgo/common/errorx"
+	"letsgo/gateway/internal/logic/product"
+	"letsgo/gateway/internal/svc"
+)
+
+// Upload product image - Admin uploads a JPEG/PNG/GIF image and gets URLs of the original and thumbnails (admin only)
+func UploadProductImageHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Read uploaded file (multipart form field "file")
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, errorx.NewCodeError(1001, "Image file is required"))
+			return
+		}
+		defer file.Close()
+
+		maxSize := svcCtx.Config.Upload.MaxSize
+		if header.Size > maxSize {
+			httpx.ErrorCtx(r.Context(), w, errorx.NewCodeError(1001, fmt.Sprintf("Image must be smaller than %d bytes", maxSize)))
+			return
+		}
+
+		// Never trust header.Size alone, read at most one byte past the limit
+		data, err := io.ReadAll(io.LimitReader(file, maxSize+1))
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, errorx.NewCodeError(1001, "Failed to read image file"))
+			return
+		}
+		if int64(len(data)) > maxSize {
+			httpx.ErrorCtx(r.Context(), w, errorx.NewCodeError(1001, fmt.Sprintf("Image must be smaller than %d bytes", maxSize)))
+			return
+		}
+
+		l := product.NewUploadProductImageLogic(r.Context(), svcCtx)
+		resp, err := l.UploadProductImage(data)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -5,12 +5,14 @@ package handler
 
 import (
 	"net/http"
+	"time"
 
 	cart "letsgo/gateway/internal/handler/cart"
 	order "letsgo/gateway/internal/handler/order"
 	payment "letsgo/gateway/internal/handler/payment"
 	product "letsgo/gateway/internal/handler/product"
 	user "letsgo/gateway/internal/handler/user"
+	wishlist "letsgo/gateway/internal/handler/wishlist"
 	"letsgo/gateway/internal/svc"
 
 	"github.com/zeromicro/go-zero/rest"
@@ -27,6 +29,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/",
 					Handler: cart.GetCartHandler(serverCtx),
 				},
+				{
+					// Accept cart prices - Save the current prices of lines whose price changed
+					Method:  http.MethodPost,
+					Path:    "/accept-prices",
+					Handler: cart.AcceptCartPricesHandler(serverCtx),
+				},
 				{
 					// Add to cart - Add product to shopping cart
 					Method:  http.MethodPost,
@@ -39,12 +47,42 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/clear",
 					Handler: cart.ClearCartHandler(serverCtx),
 				},
+				{
+					// Import shared cart - Merge the items of a shared cart into the cart with the limits of add to cart
+					Method:  http.MethodPost,
+					Path:    "/import/:token",
+					Handler: cart.ImportSharedCartHandler(serverCtx),
+				},
+				{
+					// Price cart - Price the selected items like checkout and list blocking issues, a valid cart gets a quote for create order
+					Method:  http.MethodPost,
+					Path:    "/price",
+					Handler: cart.PriceCartHandler(serverCtx),
+				},
 				{
 					// Remove from cart - Delete item from cart
 					Method:  http.MethodDelete,
 					Path:    "/remove/:productId",
 					Handler: cart.RemoveFromCartHandler(serverCtx),
 				},
+				{
+					// Select cart items - Select or deselect lines for checkout
+					Method:  http.MethodPut,
+					Path:    "/select",
+					Handler: cart.SelectCartItemsHandler(serverCtx),
+				},
+				{
+					// Select all cart items - Select or deselect every line for checkout
+					Method:  http.MethodPut,
+					Path:    "/select-all",
+					Handler: cart.SelectAllCartItemsHandler(serverCtx),
+				},
+				{
+					// Share cart - Snapshot the cart into a share link that expires, later changes are not shared
+					Method:  http.MethodPost,
+					Path:    "/share",
+					Handler: cart.ShareCartHandler(serverCtx),
+				},
 				{
 					// Update cart item - Change quantity of item in cart
 					Method:  http.MethodPut,
@@ -56,6 +94,96 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/api/v1/cart"),
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.GuestCart, serverCtx.Timeout},
+			[]rest.Route{
+				{
+					// Get guest cart - Retrieve the shopping cart of an anonymous shopper
+					Method:  http.MethodGet,
+					Path:    "/",
+					Handler: cart.GetGuestCartHandler(serverCtx),
+				},
+				{
+					// Accept guest cart prices - Save the current prices of lines whose price changed
+					Method:  http.MethodPost,
+					Path:    "/accept-prices",
+					Handler: cart.AcceptGuestCartPricesHandler(serverCtx),
+				},
+				{
+					// Add to guest cart - Add product to the shopping cart of an anonymous shopper
+					Method:  http.MethodPost,
+					Path:    "/add",
+					Handler: cart.AddToGuestCartHandler(serverCtx),
+				},
+				{
+					// Clear guest cart - Remove all items from the guest cart
+					Method:  http.MethodDelete,
+					Path:    "/clear",
+					Handler: cart.ClearGuestCartHandler(serverCtx),
+				},
+				{
+					// Import shared cart into guest cart - Merge the items of a shared cart into the guest cart
+					Method:  http.MethodPost,
+					Path:    "/import/:token",
+					Handler: cart.ImportSharedGuestCartHandler(serverCtx),
+				},
+				{
+					// Price guest cart - Price the selected items of the guest cart and list blocking issues
+					Method:  http.MethodPost,
+					Path:    "/price",
+					Handler: cart.PriceGuestCartHandler(serverCtx),
+				},
+				{
+					// Remove from guest cart - Delete item from the guest cart
+					Method:  http.MethodDelete,
+					Path:    "/remove/:productId",
+					Handler: cart.RemoveFromGuestCartHandler(serverCtx),
+				},
+				{
+					// Select guest cart items - Select or deselect lines of the guest cart
+					Method:  http.MethodPut,
+					Path:    "/select",
+					Handler: cart.SelectGuestCartItemsHandler(serverCtx),
+				},
+				{
+					// Select all guest cart items - Select or deselect every line of the guest cart
+					Method:  http.MethodPut,
+					Path:    "/select-all",
+					Handler: cart.SelectAllGuestCartItemsHandler(serverCtx),
+				},
+				{
+					// Share guest cart - Snapshot the guest cart into a share link that expires
+					Method:  http.MethodPost,
+					Path:    "/share",
+					Handler: cart.ShareGuestCartHandler(serverCtx),
+				},
+				{
+					// Update guest cart item - Change quantity of item in the guest cart
+					Method:  http.MethodPut,
+					Path:    "/update",
+					Handler: cart.UpdateGuestCartHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/cart/guest"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Timeout},
+			[]rest.Route{
+				{
+					// Get shared cart - View a shared cart with current prices and stock
+					Method:  http.MethodGet,
+					Path:    "/:token",
+					Handler: cart.GetSharedCartHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/cart/shared"),
+	)
+
 	server.AddRoutes(
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.Auth, serverCtx.Timeout},
@@ -135,12 +263,30 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.Timeout},
 			[]rest.Route{
+				{
+					// Get bundle - Components of a bundle product and how many bundles are available
+					Method:  http.MethodGet,
+					Path:    "/:id/bundle",
+					Handler: product.GetBundleHandler(serverCtx),
+				},
+				{
+					// Get related products - Products frequently bought together, topped up with best sellers of the same category
+					Method:  http.MethodGet,
+					Path:    "/:id/related",
+					Handler: product.GetRelatedProductsHandler(serverCtx),
+				},
 				{
 					// Get product detail - View single product information
 					Method:  http.MethodGet,
 					Path:    "/detail/:id",
 					Handler: product.GetProductDetailHandler(serverCtx),
 				},
+				{
+					// Get flash sale - View flash sale price, window and remaining quantity
+					Method:  http.MethodGet,
+					Path:    "/flashsale/:id",
+					Handler: product.GetFlashSaleHandler(serverCtx),
+				},
 				{
 					// List products - Get paginated list of products
 					Method:  http.MethodGet,
@@ -168,12 +314,144 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/add",
 					Handler: product.AddProductHandler(serverCtx),
 				},
+				{
+					// Set bundle items - Admin sets the components of a bundle product, no items makes it standalone again (admin only)
+					Method:  http.MethodPut,
+					Path:    "/bundle",
+					Handler: product.SetBundleItemsHandler(serverCtx),
+				},
+				{
+					// Delete product - Admin soft deletes product (admin only)
+					Method:  http.MethodDelete,
+					Path:    "/delete/:id",
+					Handler: product.DeleteProductHandler(serverCtx),
+				},
+				{
+					// Create flash sale - Admin reserves stock for a time-limited sale price (admin only)
+					Method:  http.MethodPost,
+					Path:    "/flashsale",
+					Handler: product.CreateFlashSaleHandler(serverCtx),
+				},
+				{
+					// Cancel flash sale - Admin stops an open flash sale, unsold quantity goes back to stock (admin only)
+					Method:  http.MethodDelete,
+					Path:    "/flashsale/:id",
+					Handler: product.CancelFlashSaleHandler(serverCtx),
+				},
+				{
+					// List flash sales - Admin views flash sales, optionally by product and status (admin only)
+					Method:  http.MethodGet,
+					Path:    "/flashsales",
+					Handler: product.ListFlashSalesHandler(serverCtx),
+				},
+				{
+					// Check inventory consistency - Admin recomputes stock from the inventory ledger (admin only)
+					Method:  http.MethodGet,
+					Path:    "/inventory/check",
+					Handler: product.CheckInventoryHandler(serverCtx),
+				},
+				{
+					// List low-stock products - Admin views products at or below their low-stock threshold (admin only)
+					Method:  http.MethodGet,
+					Path:    "/inventory/low",
+					Handler: product.ListLowStockHandler(serverCtx),
+				},
+				{
+					// List inventory movements - Admin views the stock changes of a product (admin only)
+					Method:  http.MethodGet,
+					Path:    "/inventory/movements",
+					Handler: product.ListInventoryMovementsHandler(serverCtx),
+				},
+				{
+					// Set stock threshold - Admin sets the low-stock alert level of a product, 0 disables alerts (admin only)
+					Method:  http.MethodPut,
+					Path:    "/inventory/threshold",
+					Handler: product.SetStockThresholdHandler(serverCtx),
+				},
+				{
+					// Set purchase limits - Admin sets the max units of a product per order and per customer within a window, 0 = unlimited (admin only)
+					Method:  http.MethodPut,
+					Path:    "/limits",
+					Handler: product.SetPurchaseLimitsHandler(serverCtx),
+				},
+				{
+					// Get price history - Admin views all price changes of a product (admin only)
+					Method:  http.MethodGet,
+					Path:    "/price/history",
+					Handler: product.GetPriceHistoryHandler(serverCtx),
+				},
+				{
+					// Schedule price change - Admin schedules a future price, optionally reverted at endAt (admin only)
+					Method:  http.MethodPost,
+					Path:    "/price/schedule",
+					Handler: product.SchedulePriceChangeHandler(serverCtx),
+				},
+				{
+					// Cancel price schedule - Admin cancels a pending schedule or ends an active one early (admin only)
+					Method:  http.MethodDelete,
+					Path:    "/price/schedule/:id",
+					Handler: product.CancelPriceScheduleHandler(serverCtx),
+				},
+				{
+					// List price schedules - Admin views scheduled price changes of a product (admin only)
+					Method:  http.MethodGet,
+					Path:    "/price/schedules",
+					Handler: product.ListPriceSchedulesHandler(serverCtx),
+				},
+				{
+					// Restore product - Admin restores deleted product as unpublished (admin only)
+					Method:  http.MethodPut,
+					Path:    "/restore/:id",
+					Handler: product.RestoreProductHandler(serverCtx),
+				},
+				{
+					// Set product status - Admin publishes, unpublishes or drafts product, optionally scheduled (admin only)
+					Method:  http.MethodPut,
+					Path:    "/status",
+					Handler: product.SetProductStatusHandler(serverCtx),
+				},
+				{
+					// Adjust stock - Admin adds or removes stock, recorded in the inventory ledger (admin only)
+					Method:  http.MethodPut,
+					Path:    "/stock",
+					Handler: product.AdjustStockHandler(serverCtx),
+				},
+				{
+					// Get stock detail - Admin views the stock of a product per warehouse (admin only)
+					Method:  http.MethodGet,
+					Path:    "/stock/:productId",
+					Handler: product.GetStockDetailHandler(serverCtx),
+				},
+				{
+					// Transfer stock - Admin moves stock of a product between warehouses (admin only)
+					Method:  http.MethodPost,
+					Path:    "/stock/transfer",
+					Handler: product.TransferStockHandler(serverCtx),
+				},
 				{
 					// Update product - Admin modifies product (admin only)
 					Method:  http.MethodPut,
 					Path:    "/update",
 					Handler: product.UpdateProductHandler(serverCtx),
 				},
+				{
+					// Create warehouse - Admin adds a stock location (admin only)
+					Method:  http.MethodPost,
+					Path:    "/warehouse",
+					Handler: product.CreateWarehouseHandler(serverCtx),
+				},
+				{
+					// Update warehouse - Admin changes name, region and priority of a warehouse (admin only)
+					Method:  http.MethodPut,
+					Path:    "/warehouse",
+					Handler: product.UpdateWarehouseHandler(serverCtx),
+				},
+				{
+					// List warehouses - Admin views all stock locations, the default warehouse first (admin only)
+					Method:  http.MethodGet,
+					Path:    "/warehouses",
+					Handler: product.ListWarehousesHandler(serverCtx),
+				},
 			}...,
 		),
 		rest.WithPrefix("/api/v1/product"),
@@ -181,14 +459,74 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{serverCtx.Timeout},
+			[]rest.Middleware{serverCtx.Auth, serverCtx.Timeout},
 			[]rest.Route{
 				{
-					// User login - Authenticates user and returns JWT token
+					// Buy flash sale - Reserve flash sale stock, the order is created asynchronously, poll it by orderNo
 					Method:  http.MethodPost,
-					Path:    "/login",
-					Handler: user.LoginHandler(serverCtx),
+					Path:    "/flashsale/buy",
+					Handler: product.FlashSaleBuyHandler(serverCtx),
+				},
+				{
+					// Subscribe restock - Get notified once when an out-of-stock product is back in stock
+					Method:  http.MethodPost,
+					Path:    "/restock/subscribe",
+					Handler: product.SubscribeRestockHandler(serverCtx),
+				},
+				{
+					// Unsubscribe restock - Cancel a pending back-in-stock notification
+					Method:  http.MethodDelete,
+					Path:    "/restock/subscribe/:productId",
+					Handler: product.UnsubscribeRestockHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/product"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AdminAuth},
+			[]rest.Route{
+				{
+					// Export products - Admin downloads the catalog as a CSV or JSON Lines file (admin only)
+					Method:  http.MethodGet,
+					Path:    "/export",
+					Handler: product.ExportProductsHandler(serverCtx),
+				},
+				{
+					// Import products - Admin creates or updates products by SKU from a CSV or JSON Lines file (admin only)
+					Method:  http.MethodPost,
+					Path:    "/import",
+					Handler: product.ImportProductsHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/product"),
+		rest.WithTimeout(120000*time.Millisecond),
+		rest.WithMaxBytes(4194304),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.AdminAuth, serverCtx.Timeout},
+			[]rest.Route{
+				{
+					// Upload product image - Admin uploads a JPEG/PNG/GIF image and gets URLs of the original and thumbnails (admin only)
+					Method:  http.MethodPost,
+					Path:    "/image/upload",
+					Handler: product.UploadProductImageHandler(serverCtx),
 				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/product"),
+		rest.WithMaxBytes(10485760),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Timeout},
+			[]rest.Route{
 				{
 					// User registration - Creates a new user account
 					Method:  http.MethodPost,
@@ -200,6 +538,21 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/api/v1/user"),
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.GuestCart, serverCtx.Timeout},
+			[]rest.Route{
+				{
+					// User login - Authenticates user and returns JWT token, the guest cart is merged into the user's cart
+					Method:  http.MethodPost,
+					Path:    "/login",
+					Handler: user.LoginHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/user"),
+	)
+
 	server.AddRoutes(
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.Auth, serverCtx.Timeout},
@@ -220,4 +573,67 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		),
 		rest.WithPrefix("/api/v1/user"),
 	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Auth, serverCtx.Timeout},
+			[]rest.Route{
+				{
+					// List wishlists - The user's wishlists, Saved for later first
+					Method:  http.MethodGet,
+					Path:    "/",
+					Handler: wishlist.ListWishlistsHandler(serverCtx),
+				},
+				{
+					// Create wishlist - Create a named wishlist
+					Method:  http.MethodPost,
+					Path:    "/",
+					Handler: wishlist.CreateWishlistHandler(serverCtx),
+				},
+				{
+					// Get wishlist - Wishlist items with current product data
+					Method:  http.MethodGet,
+					Path:    "/:id",
+					Handler: wishlist.GetWishlistHandler(serverCtx),
+				},
+				{
+					// Rename wishlist - Change the name of a named wishlist
+					Method:  http.MethodPut,
+					Path:    "/:id",
+					Handler: wishlist.RenameWishlistHandler(serverCtx),
+				},
+				{
+					// Delete wishlist - Delete a wishlist and its items
+					Method:  http.MethodDelete,
+					Path:    "/:id",
+					Handler: wishlist.DeleteWishlistHandler(serverCtx),
+				},
+				{
+					// Add to wishlist - Save a product to a wishlist
+					Method:  http.MethodPost,
+					Path:    "/:id/items",
+					Handler: wishlist.AddToWishlistHandler(serverCtx),
+				},
+				{
+					// Remove from wishlist - Delete a product from a wishlist
+					Method:  http.MethodDelete,
+					Path:    "/:id/items/:productId",
+					Handler: wishlist.RemoveFromWishlistHandler(serverCtx),
+				},
+				{
+					// Move to cart - Move a wishlist item to the cart
+					Method:  http.MethodPost,
+					Path:    "/:id/items/:productId/move-to-cart",
+					Handler: wishlist.MoveToCartHandler(serverCtx),
+				},
+				{
+					// Move to wishlist - Move a cart item to a wishlist (Saved for later by default)
+					Method:  http.MethodPost,
+					Path:    "/move-from-cart",
+					Handler: wishlist.MoveToWishlistHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/wishlist"),
+	)
 }
@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AcceptCartPricesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Accept cart prices - Save the current prices of lines whose price changed
+func NewAcceptCartPricesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AcceptCartPricesLogic {
+	return &AcceptCartPricesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AcceptCartPricesLogic) AcceptCartPrices(req *types.AcceptCartPricesReq) (resp *types.AcceptCartPricesResp, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.AcceptCartPrices(l.ctx, &cart.AcceptCartPricesRequest{
+		UserId:     userId,
+		ProductIds: req.ProductIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AcceptCartPricesResp{
+		Updated: cartResp.Updated,
+	}, nil
+}
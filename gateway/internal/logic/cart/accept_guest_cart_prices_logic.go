@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AcceptGuestCartPricesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Accept guest cart prices - Save the current prices of lines whose price changed
+func NewAcceptGuestCartPricesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AcceptGuestCartPricesLogic {
+	return &AcceptGuestCartPricesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AcceptGuestCartPricesLogic) AcceptGuestCartPrices(req *types.AcceptCartPricesReq) (resp *types.AcceptCartPricesResp, err error) {
+	// Get guest cart ID from context
+	cartId := l.ctx.Value("cartId").(string)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.AcceptCartPrices(l.ctx, &cart.AcceptCartPricesRequest{
+		TempCartId: cartId,
+		ProductIds: req.ProductIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AcceptCartPricesResp{
+		Updated: cartResp.Updated,
+	}, nil
+}
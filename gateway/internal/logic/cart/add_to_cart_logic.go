@@ -43,4 +43,3 @@ func (l *AddToCartLogic) AddToCart(req *types.AddToCartReq) (resp *types.AddToCa
 		Success: true,
 	}, nil
 }
-
@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AddToGuestCartLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Add to guest cart - Add product to the shopping cart of an anonymous shopper
+func NewAddToGuestCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddToGuestCartLogic {
+	return &AddToGuestCartLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AddToGuestCartLogic) AddToGuestCart(req *types.AddToCartReq) (resp *types.AddToCartResp, err error) {
+	// Get guest cart ID from context (set by GuestCart middleware)
+	cartId := l.ctx.Value("cartId").(string)
+
+	// Call Cart RPC service
+	_, err = l.svcCtx.CartRpc.AddToCart(l.ctx, &cart.AddToCartRequest{
+		TempCartId: cartId,
+		ProductId:  req.ProductId,
+		Quantity:   req.Quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AddToCartResp{
+		Success: true,
+	}, nil
+}
@@ -41,4 +41,3 @@ func (l *ClearCartLogic) ClearCart() (resp *types.ClearCartResp, err error) {
 		Success: true,
 	}, nil
 }
-
@@ -0,0 +1,46 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ClearGuestCartLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Clear guest cart - Remove all items from the guest cart
+func NewClearGuestCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ClearGuestCartLogic {
+	return &ClearGuestCartLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ClearGuestCartLogic) ClearGuestCart() (resp *types.ClearCartResp, err error) {
+	// Get guest cart ID from context
+	cartId := l.ctx.Value("cartId").(string)
+
+	// Call Cart RPC service
+	_, err = l.svcCtx.CartRpc.ClearCart(l.ctx, &cart.ClearCartRequest{
+		TempCartId: cartId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ClearCartResp{
+		Success: true,
+	}, nil
+}
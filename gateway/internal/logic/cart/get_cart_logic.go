@@ -41,13 +41,18 @@ func (l *GetCartLogic) GetCart() (resp *types.CartResp, err error) {
 	items := make([]types.CartItem, 0, len(cartResp.Items))
 	for _, item := range cartResp.Items {
 		items = append(items, types.CartItem{
-			ProductId: item.ProductId,
-			Name:      item.Name,
-			Price:     item.Price,
-			Quantity:  item.Quantity,
-			Image:     item.Image,
-			Stock:     item.Stock,
-			Available: item.Available,
+			ProductId:      item.ProductId,
+			Name:           item.Name,
+			Price:          item.Price,
+			Quantity:       item.Quantity,
+			Image:          item.Image,
+			Stock:          item.Stock,
+			Available:      item.Available,
+			SavedPrice:     item.SavedPrice,
+			PriceIncreased: item.PriceIncreased,
+			PriceDecreased: item.PriceDecreased,
+			ExceedsStock:   item.ExceedsStock,
+			Selected:       item.Selected,
 		})
 	}
 
@@ -55,6 +60,6 @@ func (l *GetCartLogic) GetCart() (resp *types.CartResp, err error) {
 		Items:      items,
 		TotalPrice: cartResp.TotalPrice,
 		TotalCount: cartResp.TotalCount,
+		HasChanges: cartResp.HasChanges,
 	}, nil
 }
-
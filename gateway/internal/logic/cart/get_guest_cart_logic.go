@@ -0,0 +1,68 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetGuestCartLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get guest cart - Retrieve the shopping cart of an anonymous shopper
+func NewGetGuestCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetGuestCartLogic {
+	return &GetGuestCartLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetGuestCartLogic) GetGuestCart() (resp *types.CartResp, err error) {
+	// Get guest cart ID from context (set by GuestCart middleware)
+	cartId := l.ctx.Value("cartId").(string)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.GetCart(l.ctx, &cart.GetCartRequest{
+		TempCartId: cartId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert RPC response to API response
+	items := make([]types.CartItem, 0, len(cartResp.Items))
+	for _, item := range cartResp.Items {
+		items = append(items, types.CartItem{
+			ProductId:      item.ProductId,
+			Name:           item.Name,
+			Price:          item.Price,
+			Quantity:       item.Quantity,
+			Image:          item.Image,
+			Stock:          item.Stock,
+			Available:      item.Available,
+			SavedPrice:     item.SavedPrice,
+			PriceIncreased: item.PriceIncreased,
+			PriceDecreased: item.PriceDecreased,
+			ExceedsStock:   item.ExceedsStock,
+			Selected:       item.Selected,
+		})
+	}
+
+	return &types.CartResp{
+		Items:      items,
+		TotalPrice: cartResp.TotalPrice,
+		TotalCount: cartResp.TotalCount,
+		HasChanges: cartResp.HasChanges,
+	}, nil
+}
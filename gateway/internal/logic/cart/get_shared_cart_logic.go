@@ -0,0 +1,63 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSharedCartLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get shared cart - View a shared cart with current prices and stock
+func NewGetSharedCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSharedCartLogic {
+	return &GetSharedCartLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetSharedCartLogic) GetSharedCart(req *types.SharedCartReq) (resp *types.SharedCartResp, err error) {
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.GetSharedCart(l.ctx, &cart.GetSharedCartRequest{
+		Token: req.Token,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert RPC response to API response
+	items := make([]types.CartItem, 0, len(cartResp.Items))
+	for _, item := range cartResp.Items {
+		items = append(items, types.CartItem{
+			ProductId:      item.ProductId,
+			Name:           item.Name,
+			Price:          item.Price,
+			Quantity:       item.Quantity,
+			Image:          item.Image,
+			Stock:          item.Stock,
+			Available:      item.Available,
+			SavedPrice:     item.SavedPrice,
+			PriceIncreased: item.PriceIncreased,
+			PriceDecreased: item.PriceDecreased,
+			ExceedsStock:   item.ExceedsStock,
+			Selected:       item.Selected,
+		})
+	}
+
+	return &types.SharedCartResp{
+		Items:     items,
+		ExpiresAt: cartResp.ExpiresAt,
+	}, nil
+}
@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ImportSharedCartLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Import shared cart - Merge the items of a shared cart into the cart with the limits of add to cart
+func NewImportSharedCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportSharedCartLogic {
+	return &ImportSharedCartLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ImportSharedCartLogic) ImportSharedCart(req *types.ImportSharedCartReq) (resp *types.ImportSharedCartResp, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.ImportSharedCart(l.ctx, &cart.ImportSharedCartRequest{
+		UserId: userId,
+		Token:  req.Token,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ImportSharedCartResp{
+		Imported: cartResp.Imported,
+		Skipped:  cartResp.Skipped,
+	}, nil
+}
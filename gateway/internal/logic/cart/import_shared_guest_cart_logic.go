@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ImportSharedGuestCartLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Import shared cart into guest cart - Merge the items of a shared cart into the guest cart
+func NewImportSharedGuestCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportSharedGuestCartLogic {
+	return &ImportSharedGuestCartLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ImportSharedGuestCartLogic) ImportSharedGuestCart(req *types.ImportSharedCartReq) (resp *types.ImportSharedCartResp, err error) {
+	// Get guest cart ID from context
+	cartId := l.ctx.Value("cartId").(string)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.ImportSharedCart(l.ctx, &cart.ImportSharedCartRequest{
+		TempCartId: cartId,
+		Token:      req.Token,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ImportSharedCartResp{
+		Imported: cartResp.Imported,
+		Skipped:  cartResp.Skipped,
+	}, nil
+}
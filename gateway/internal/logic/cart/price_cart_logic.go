@@ -0,0 +1,85 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PriceCartLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Price cart - Price the selected items like checkout and list blocking issues, a valid cart gets a quote for create order
+func NewPriceCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PriceCartLogic {
+	return &PriceCartLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PriceCartLogic) PriceCart() (resp *types.PriceCartResp, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.PriceCart(l.ctx, &cart.PriceCartRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toPriceCartResp(cartResp), nil
+}
+
+// toPriceCartResp converts a cart price breakdown to the API type
+func toPriceCartResp(cartResp *cart.PriceCartResponse) *types.PriceCartResp {
+	lines := make([]types.PricedLine, 0, len(cartResp.Lines))
+	for _, line := range cartResp.Lines {
+		lines = append(lines, types.PricedLine{
+			ProductId:  line.ProductId,
+			Name:       line.Name,
+			Image:      line.Image,
+			Quantity:   line.Quantity,
+			UnitPrice:  line.UnitPrice,
+			SavedPrice: line.SavedPrice,
+			Subtotal:   line.Subtotal,
+			Discount:   line.Discount,
+			Total:      line.Total,
+			Stock:      line.Stock,
+		})
+	}
+
+	issues := make([]types.CartIssue, 0, len(cartResp.Issues))
+	for _, issue := range cartResp.Issues {
+		issues = append(issues, types.CartIssue{
+			ProductId: issue.ProductId,
+			Code:      issue.Code,
+			Message:   issue.Message,
+		})
+	}
+
+	return &types.PriceCartResp{
+		Lines:          lines,
+		Subtotal:       cartResp.Subtotal,
+		Discount:       cartResp.Discount,
+		Shipping:       cartResp.Shipping,
+		Tax:            cartResp.Tax,
+		Total:          cartResp.Total,
+		Issues:         issues,
+		Valid:          cartResp.Valid,
+		QuoteId:        cartResp.QuoteId,
+		QuoteExpiresAt: cartResp.QuoteExpiresAt,
+	}
+}
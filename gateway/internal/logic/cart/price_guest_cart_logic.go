@@ -0,0 +1,44 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PriceGuestCartLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Price guest cart - Price the selected items of the guest cart and list blocking issues
+func NewPriceGuestCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PriceGuestCartLogic {
+	return &PriceGuestCartLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PriceGuestCartLogic) PriceGuestCart() (resp *types.PriceCartResp, err error) {
+	// Get guest cart ID from context
+	cartId := l.ctx.Value("cartId").(string)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.PriceCart(l.ctx, &cart.PriceCartRequest{
+		TempCartId: cartId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toPriceCartResp(cartResp), nil
+}
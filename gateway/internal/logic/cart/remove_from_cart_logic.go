@@ -42,4 +42,3 @@ func (l *RemoveFromCartLogic) RemoveFromCart(req *types.RemoveCartReq) (resp *ty
 		Success: true,
 	}, nil
 }
-
@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RemoveFromGuestCartLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Remove from guest cart - Delete item from the guest cart
+func NewRemoveFromGuestCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveFromGuestCartLogic {
+	return &RemoveFromGuestCartLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RemoveFromGuestCartLogic) RemoveFromGuestCart(req *types.RemoveCartReq) (resp *types.RemoveCartResp, err error) {
+	// Get guest cart ID from context
+	cartId := l.ctx.Value("cartId").(string)
+
+	// Call Cart RPC service
+	_, err = l.svcCtx.CartRpc.RemoveCartItem(l.ctx, &cart.RemoveCartItemRequest{
+		TempCartId: cartId,
+		ProductId:  req.ProductId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RemoveCartResp{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SelectAllCartItemsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Select all cart items - Select or deselect every line for checkout
+func NewSelectAllCartItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SelectAllCartItemsLogic {
+	return &SelectAllCartItemsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SelectAllCartItemsLogic) SelectAllCartItems(req *types.SelectAllCartItemsReq) (resp *types.SelectCartItemsResp, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.SelectAllCartItems(l.ctx, &cart.SelectAllCartItemsRequest{
+		UserId:   userId,
+		Selected: req.Selected,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SelectCartItemsResp{
+		Updated: cartResp.Updated,
+	}, nil
+}
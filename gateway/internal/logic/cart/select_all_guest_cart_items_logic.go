@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SelectAllGuestCartItemsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Select all guest cart items - Select or deselect every line of the guest cart
+func NewSelectAllGuestCartItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SelectAllGuestCartItemsLogic {
+	return &SelectAllGuestCartItemsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SelectAllGuestCartItemsLogic) SelectAllGuestCartItems(req *types.SelectAllCartItemsReq) (resp *types.SelectCartItemsResp, err error) {
+	// Get guest cart ID from context
+	cartId := l.ctx.Value("cartId").(string)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.SelectAllCartItems(l.ctx, &cart.SelectAllCartItemsRequest{
+		TempCartId: cartId,
+		Selected:   req.Selected,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SelectCartItemsResp{
+		Updated: cartResp.Updated,
+	}, nil
+}
@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SelectCartItemsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Select cart items - Select or deselect lines for checkout
+func NewSelectCartItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SelectCartItemsLogic {
+	return &SelectCartItemsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SelectCartItemsLogic) SelectCartItems(req *types.SelectCartItemsReq) (resp *types.SelectCartItemsResp, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.SelectCartItems(l.ctx, &cart.SelectCartItemsRequest{
+		UserId:     userId,
+		ProductIds: req.ProductIds,
+		Selected:   req.Selected,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SelectCartItemsResp{
+		Updated: cartResp.Updated,
+	}, nil
+}
@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SelectGuestCartItemsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Select guest cart items - Select or deselect lines of the guest cart
+func NewSelectGuestCartItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SelectGuestCartItemsLogic {
+	return &SelectGuestCartItemsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SelectGuestCartItemsLogic) SelectGuestCartItems(req *types.SelectCartItemsReq) (resp *types.SelectCartItemsResp, err error) {
+	// Get guest cart ID from context
+	cartId := l.ctx.Value("cartId").(string)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.SelectCartItems(l.ctx, &cart.SelectCartItemsRequest{
+		TempCartId: cartId,
+		ProductIds: req.ProductIds,
+		Selected:   req.Selected,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SelectCartItemsResp{
+		Updated: cartResp.Updated,
+	}, nil
+}
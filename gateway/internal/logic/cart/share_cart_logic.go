@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ShareCartLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Share cart - Snapshot the cart into a share link that expires, later changes are not shared
+func NewShareCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShareCartLogic {
+	return &ShareCartLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ShareCartLogic) ShareCart() (resp *types.ShareCartResp, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.ShareCart(l.ctx, &cart.ShareCartRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ShareCartResp{
+		Token:     cartResp.Token,
+		ExpiresAt: cartResp.ExpiresAt,
+	}, nil
+}
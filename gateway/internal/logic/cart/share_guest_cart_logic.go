@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ShareGuestCartLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Share guest cart - Snapshot the guest cart into a share link that expires
+func NewShareGuestCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShareGuestCartLogic {
+	return &ShareGuestCartLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ShareGuestCartLogic) ShareGuestCart() (resp *types.ShareCartResp, err error) {
+	// Get guest cart ID from context
+	cartId := l.ctx.Value("cartId").(string)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.ShareCart(l.ctx, &cart.ShareCartRequest{
+		TempCartId: cartId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ShareCartResp{
+		Token:     cartResp.Token,
+		ExpiresAt: cartResp.ExpiresAt,
+	}, nil
+}
@@ -43,4 +43,3 @@ func (l *UpdateCartLogic) UpdateCart(req *types.UpdateCartReq) (resp *types.Upda
 		Success: true,
 	}, nil
 }
-
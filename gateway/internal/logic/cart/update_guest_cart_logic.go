@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package cart
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateGuestCartLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Update guest cart item - Change quantity of item in the guest cart
+func NewUpdateGuestCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateGuestCartLogic {
+	return &UpdateGuestCartLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateGuestCartLogic) UpdateGuestCart(req *types.UpdateCartReq) (resp *types.UpdateCartResp, err error) {
+	// Get guest cart ID from context
+	cartId := l.ctx.Value("cartId").(string)
+
+	// Call Cart RPC service
+	_, err = l.svcCtx.CartRpc.UpdateCartItem(l.ctx, &cart.UpdateCartItemRequest{
+		TempCartId: cartId,
+		ProductId:  req.ProductId,
+		Quantity:   req.Quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.UpdateCartResp{
+		Success: true,
+	}, nil
+}
@@ -40,11 +40,14 @@ func (l *CreateOrderLogic) CreateOrder(req *types.CreateOrderReq) (resp *types.C
 
 	// Call Order RPC service
 	rpcResp, err := l.svcCtx.OrderRpc.CreateOrder(l.ctx, &order.CreateOrderRequest{
-		UserId:  userId,
-		Items:   items,
-		Address: req.Address,
-		Phone:   req.Phone,
-		Remark:  req.Remark,
+		UserId:   userId,
+		Items:    items,
+		Address:  req.Address,
+		Phone:    req.Phone,
+		Remark:   req.Remark,
+		Region:   req.Region,
+		FromCart: req.FromCart,
+		QuoteId:  req.QuoteId,
 	})
 	if err != nil {
 		l.Logger.Errorf("failed to create order: %v", err)
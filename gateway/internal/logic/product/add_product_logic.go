@@ -30,6 +30,7 @@ func NewAddProductLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddPro
 
 func (l *AddProductLogic) AddProduct(req *types.AddProductReq) (resp *types.AddProductResp, err error) {
 	ProductResp, err := l.svcCtx.ProductRpc.AddProduct(l.ctx, &product_client.AddProductRequest{
+		Sku:         req.Sku,
 		Name:        req.Name,
 		Description: req.Description,
 		Price:       req.Price,
@@ -37,6 +38,9 @@ func (l *AddProductLogic) AddProduct(req *types.AddProductReq) (resp *types.AddP
 		Category:    req.Category,
 		Images:      req.Images,
 		Attributes:  req.Attributes,
+		Status:      req.Status,
+		PublishAt:   req.PublishAt,
+		OperatorId:  l.ctx.Value("userId").(int64),
 	})
 	if err != nil {
 		return nil, err
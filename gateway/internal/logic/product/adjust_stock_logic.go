@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdjustStockLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Adjust stock - Admin adds or removes stock, recorded in the inventory ledger (admin only)
+func NewAdjustStockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdjustStockLogic {
+	return &AdjustStockLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdjustStockLogic) AdjustStock(req *types.AdjustStockReq) (resp *types.AdjustStockResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.UpdateStock(l.ctx, &product_client.UpdateStockRequest{
+		ProductId:   req.ProductId,
+		Quantity:    req.Quantity,
+		Reason:      "admin_adjust",
+		ReferenceId: req.ReferenceId,
+		OperatorId:  l.ctx.Value("userId").(int64),
+		WarehouseId: req.WarehouseId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AdjustStockResp{
+		NewStock: ProductResp.NewStock,
+	}, nil
+}
@@ -0,0 +1,44 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelFlashSaleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Cancel flash sale - Admin stops an open flash sale, unsold quantity goes back to stock (admin only)
+func NewCancelFlashSaleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelFlashSaleLogic {
+	return &CancelFlashSaleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CancelFlashSaleLogic) CancelFlashSale(req *types.CancelFlashSaleReq) (resp *types.CancelFlashSaleResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.CancelFlashSale(l.ctx, &product_client.CancelFlashSaleRequest{
+		SaleId:     req.Id,
+		OperatorId: l.ctx.Value("userId").(int64),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CancelFlashSaleResp{
+		Success:  ProductResp.Success,
+		Returned: ProductResp.Returned,
+	}, nil
+}
@@ -0,0 +1,43 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelPriceScheduleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Cancel price schedule - Admin cancels a pending schedule or ends an active one early (admin only)
+func NewCancelPriceScheduleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelPriceScheduleLogic {
+	return &CancelPriceScheduleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CancelPriceScheduleLogic) CancelPriceSchedule(req *types.CancelPriceScheduleReq) (resp *types.CancelPriceScheduleResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.CancelPriceSchedule(l.ctx, &product_client.CancelPriceScheduleRequest{
+		ScheduleId: req.Id,
+		OperatorId: l.ctx.Value("userId").(int64),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CancelPriceScheduleResp{
+		Success: ProductResp.Success,
+	}, nil
+}
@@ -0,0 +1,55 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckInventoryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Check inventory consistency - Admin recomputes stock from the inventory ledger (admin only)
+func NewCheckInventoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckInventoryLogic {
+	return &CheckInventoryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CheckInventoryLogic) CheckInventory(req *types.InventoryCheckReq) (resp *types.InventoryCheckResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.CheckInventoryConsistency(l.ctx, &product_client.CheckInventoryConsistencyRequest{
+		ProductId: req.ProductId,
+		Limit:     int32(req.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	Discrepancies := make([]types.StockDiscrepancy, 0, len(ProductResp.Discrepancies))
+	for _, d := range ProductResp.Discrepancies {
+		Discrepancies = append(Discrepancies, types.StockDiscrepancy{
+			ProductId:      d.ProductId,
+			Stock:          d.Stock,
+			LedgerStock:    d.LedgerStock,
+			Difference:     d.Difference,
+			WarehouseStock: d.WarehouseStock,
+		})
+	}
+
+	return &types.InventoryCheckResp{
+		Consistent:    ProductResp.Consistent,
+		Discrepancies: Discrepancies,
+	}, nil
+}
@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateFlashSaleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Create flash sale - Admin reserves stock for a time-limited sale price (admin only)
+func NewCreateFlashSaleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateFlashSaleLogic {
+	return &CreateFlashSaleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateFlashSaleLogic) CreateFlashSale(req *types.CreateFlashSaleReq) (resp *types.CreateFlashSaleResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.CreateFlashSale(l.ctx, &product_client.CreateFlashSaleRequest{
+		ProductId:    req.ProductId,
+		Price:        req.Price,
+		Quantity:     req.Quantity,
+		PerUserLimit: req.PerUserLimit,
+		StartAt:      req.StartAt,
+		EndAt:        req.EndAt,
+		OperatorId:   l.ctx.Value("userId").(int64),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CreateFlashSaleResp{
+		SaleId: ProductResp.SaleId,
+	}, nil
+}
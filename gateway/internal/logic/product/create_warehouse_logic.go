@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateWarehouseLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Create warehouse - Admin adds a stock location (admin only)
+func NewCreateWarehouseLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateWarehouseLogic {
+	return &CreateWarehouseLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateWarehouseLogic) CreateWarehouse(req *types.CreateWarehouseReq) (resp *types.CreateWarehouseResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.CreateWarehouse(l.ctx, &product_client.CreateWarehouseRequest{
+		Code:     req.Code,
+		Name:     req.Name,
+		Region:   req.Region,
+		Priority: req.Priority,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CreateWarehouseResp{
+		WarehouseId: ProductResp.WarehouseId,
+	}, nil
+}
@@ -0,0 +1,79 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportProductsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Export products - Admin downloads the catalog as a CSV or JSON Lines file (admin only)
+func NewExportProductsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportProductsLogic {
+	return &ExportProductsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ExportProductsLogic) ExportProducts(req *types.ExportProductsReq, w http.ResponseWriter) error {
+	stream, err := l.svcCtx.ProductRpc.ExportProducts(l.ctx, &product_client.ExportProductsRequest{
+		Format: req.Format,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Wait for the first chunk so that errors (e.g. database down) still become a JSON error response
+	chunk, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if req.Format == "jsonl" {
+		contentType = "application/x-ndjson"
+	}
+	filename := fmt.Sprintf("products-%s.%s", time.Now().Format("20060102150405"), req.Format)
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	for err == nil {
+		if _, err := w.Write(chunk.Data); err != nil {
+			// Client went away, nothing more we can do
+			l.Logger.Errorf("Failed to write export chunk: %v", err)
+			return nil
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		chunk, err = stream.Recv()
+	}
+
+	if err != io.EOF {
+		// Headers are already sent, the download ends truncated
+		l.Logger.Errorf("Export stream broken: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FlashSaleBuyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Buy flash sale - Reserve flash sale stock, the order is created asynchronously, poll it by orderNo
+func NewFlashSaleBuyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FlashSaleBuyLogic {
+	return &FlashSaleBuyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *FlashSaleBuyLogic) FlashSaleBuy(req *types.FlashSaleBuyReq) (resp *types.FlashSaleBuyResp, err error) {
+	userId := l.ctx.Value("userId").(int64)
+
+	ProductResp, err := l.svcCtx.ProductRpc.FlashSaleBuy(l.ctx, &product_client.FlashSaleBuyRequest{
+		SaleId:   req.SaleId,
+		UserId:   userId,
+		Quantity: req.Quantity,
+		Address:  req.Address,
+		Phone:    req.Phone,
+		Remark:   req.Remark,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.FlashSaleBuyResp{
+		OrderNo:     ProductResp.OrderNo,
+		TotalAmount: ProductResp.TotalAmount,
+	}, nil
+}
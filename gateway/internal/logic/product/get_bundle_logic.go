@@ -0,0 +1,74 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetBundleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get bundle - Components of a bundle product and how many bundles are available
+func NewGetBundleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetBundleLogic {
+	return &GetBundleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetBundleLogic) GetBundle(req *types.BundleReq) (resp *types.BundleResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.GetBundle(l.ctx, &product_client.GetBundleRequest{
+		BundleId: req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	Components := make([]types.BundleComponent, 0, len(ProductResp.Components))
+	for _, component := range ProductResp.Components {
+		Components = append(Components, types.BundleComponent{
+			Product:  toProduct(component.Product),
+			Quantity: component.Quantity,
+		})
+	}
+
+	return &types.BundleResp{
+		Bundle:     toProduct(ProductResp.Bundle),
+		Components: Components,
+		Available:  ProductResp.Available,
+	}, nil
+}
+
+// toProduct converts a product from the product rpc to its gateway representation
+func toProduct(productInfo *product_client.ProductInfo) types.Product {
+	return types.Product{
+		Id:          productInfo.Id,
+		Sku:         productInfo.Sku,
+		Name:        productInfo.Name,
+		Description: productInfo.Description,
+		Price:       productInfo.Price,
+		Stock:       productInfo.Stock,
+		Category:    productInfo.Category,
+		Images:      productInfo.Images,
+		Attributes:  productInfo.Attributes,
+		Sales:       productInfo.Sales,
+		CreatedAt:   productInfo.CreatedAt,
+		UpdatedAt:   productInfo.UpdatedAt,
+		Version:     productInfo.Version,
+		MaxPerOrder: productInfo.MaxPerOrder,
+		MaxPerUser:  productInfo.MaxPerUser,
+		LimitWindow: productInfo.LimitWindow,
+	}
+}
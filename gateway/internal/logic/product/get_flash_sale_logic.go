@@ -0,0 +1,57 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetFlashSaleLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get flash sale - View flash sale price, window and remaining quantity
+func NewGetFlashSaleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetFlashSaleLogic {
+	return &GetFlashSaleLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetFlashSaleLogic) GetFlashSale(req *types.FlashSaleDetailReq) (resp *types.FlashSaleDetailResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.GetFlashSale(l.ctx, &product_client.GetFlashSaleRequest{
+		SaleId: req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sale := ProductResp.Sale
+	return &types.FlashSaleDetailResp{
+		Sale: types.FlashSale{
+			Id:           sale.Id,
+			ProductId:    sale.ProductId,
+			Price:        sale.Price,
+			Quantity:     sale.Quantity,
+			Sold:         sale.Sold,
+			Remaining:    sale.Remaining,
+			PerUserLimit: sale.PerUserLimit,
+			StartAt:      sale.StartAt,
+			EndAt:        sale.EndAt,
+			Status:       sale.Status,
+			OperatorId:   sale.OperatorId,
+			CreatedAt:    sale.CreatedAt,
+			UpdatedAt:    sale.UpdatedAt,
+		},
+	}, nil
+}
@@ -0,0 +1,59 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPriceHistoryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get price history - Admin views all price changes of a product (admin only)
+func NewGetPriceHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPriceHistoryLogic {
+	return &GetPriceHistoryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPriceHistoryLogic) GetPriceHistory(req *types.PriceHistoryReq) (resp *types.PriceHistoryResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.GetPriceHistory(l.ctx, &product_client.GetPriceHistoryRequest{
+		ProductId: req.ProductId,
+		Page:      int32(req.Page),
+		PageSize:  int32(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	History := make([]types.PriceHistory, 0, len(ProductResp.History))
+	for _, h := range ProductResp.History {
+		History = append(History, types.PriceHistory{
+			Id:         h.Id,
+			ProductId:  h.ProductId,
+			OldPrice:   h.OldPrice,
+			NewPrice:   h.NewPrice,
+			OperatorId: h.OperatorId,
+			Source:     h.Source,
+			ScheduleId: h.ScheduleId,
+			CreatedAt:  h.CreatedAt,
+		})
+	}
+
+	return &types.PriceHistoryResp{
+		Total:   ProductResp.Total,
+		History: History,
+	}, nil
+}
@@ -40,6 +40,7 @@ func (l *GetProductDetailLogic) GetProductDetail(req *types.ProductDetailReq) (r
 	return &types.ProductDetailResp{
 		Product: types.Product{
 			Id:          ProductInfo.Id,
+			Sku:         ProductInfo.Sku,
 			Name:        ProductInfo.Name,
 			Description: ProductInfo.Description,
 			Price:       ProductInfo.Price,
@@ -50,6 +51,10 @@ func (l *GetProductDetailLogic) GetProductDetail(req *types.ProductDetailReq) (r
 			Sales:       ProductInfo.Sales,
 			CreatedAt:   ProductInfo.CreatedAt,
 			UpdatedAt:   ProductInfo.UpdatedAt,
+			Version:     ProductInfo.Version,
+			MaxPerOrder: ProductInfo.MaxPerOrder,
+			MaxPerUser:  ProductInfo.MaxPerUser,
+			LimitWindow: ProductInfo.LimitWindow,
 		},
 	}, nil
 }
@@ -0,0 +1,66 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRelatedProductsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get related products - Products frequently bought together, topped up with best sellers of the same category
+func NewGetRelatedProductsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRelatedProductsLogic {
+	return &GetRelatedProductsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetRelatedProductsLogic) GetRelatedProducts(req *types.RelatedProductsReq) (resp *types.RelatedProductsResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.GetRelatedProducts(l.ctx, &product_client.GetRelatedProductsRequest{
+		ProductId: req.Id,
+		Limit:     int32(req.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	Products := make([]types.Product, 0, len(ProductResp.Products))
+	for _, productInfo := range ProductResp.Products {
+		Products = append(Products, types.Product{
+			Id:          productInfo.Id,
+			Sku:         productInfo.Sku,
+			Name:        productInfo.Name,
+			Description: productInfo.Description,
+			Price:       productInfo.Price,
+			Stock:       productInfo.Stock,
+			Category:    productInfo.Category,
+			Images:      productInfo.Images,
+			Attributes:  productInfo.Attributes,
+			Sales:       productInfo.Sales,
+			CreatedAt:   productInfo.CreatedAt,
+			UpdatedAt:   productInfo.UpdatedAt,
+			Version:     productInfo.Version,
+			MaxPerOrder: productInfo.MaxPerOrder,
+			MaxPerUser:  productInfo.MaxPerUser,
+			LimitWindow: productInfo.LimitWindow,
+		})
+	}
+
+	return &types.RelatedProductsResp{
+		Products:       Products,
+		BoughtTogether: int(ProductResp.BoughtTogether),
+	}, nil
+}
@@ -0,0 +1,59 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetStockDetailLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get stock detail - Admin views the stock of a product per warehouse (admin only)
+func NewGetStockDetailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetStockDetailLogic {
+	return &GetStockDetailLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetStockDetailLogic) GetStockDetail(req *types.StockDetailReq) (resp *types.StockDetailResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.CheckStock(l.ctx, &product_client.CheckStockRequest{
+		Items: []*product_client.StockItem{{
+			ProductId:        req.ProductId,
+			RequiredQuantity: 1,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.StockDetailResp{
+		ProductId:  req.ProductId,
+		Warehouses: []types.WarehouseStock{},
+	}
+	for _, item := range ProductResp.Items {
+		resp.Stock = item.AvailableStock
+		for _, ws := range item.Warehouses {
+			resp.Warehouses = append(resp.Warehouses, types.WarehouseStock{
+				WarehouseId:   ws.WarehouseId,
+				WarehouseCode: ws.WarehouseCode,
+				WarehouseName: ws.WarehouseName,
+				Stock:         ws.Stock,
+			})
+		}
+	}
+
+	return resp, nil
+}
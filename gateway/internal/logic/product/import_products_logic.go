@@ -0,0 +1,60 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+	"time"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/zrpc"
+)
+
+type ImportProductsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Import products - Admin creates or updates products by SKU from a CSV or JSON Lines file (admin only)
+func NewImportProductsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportProductsLogic {
+	return &ImportProductsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ImportProductsLogic) ImportProducts(req *types.ImportProductsReq, data []byte) (resp *types.ImportProductsResp, err error) {
+	// Import runs far longer than a normal call, raise the client side timeout
+	ProductResp, err := l.svcCtx.ProductRpc.ImportProducts(l.ctx, &product_client.ImportProductsRequest{
+		Format:     req.Format,
+		Data:       data,
+		OperatorId: l.ctx.Value("userId").(int64),
+	}, zrpc.WithCallTimeout(110*time.Second))
+	if err != nil {
+		return nil, err
+	}
+
+	RowErrors := make([]types.ImportRowError, 0, len(ProductResp.Errors))
+	for _, rowError := range ProductResp.Errors {
+		RowErrors = append(RowErrors, types.ImportRowError{
+			Row:     rowError.Row,
+			Sku:     rowError.Sku,
+			Message: rowError.Message,
+		})
+	}
+
+	return &types.ImportProductsResp{
+		Total:   ProductResp.Total,
+		Created: ProductResp.Created,
+		Updated: ProductResp.Updated,
+		Failed:  ProductResp.Failed,
+		Errors:  RowErrors,
+	}, nil
+}
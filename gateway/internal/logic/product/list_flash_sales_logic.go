@@ -0,0 +1,65 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFlashSalesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List flash sales - Admin views flash sales, optionally by product and status (admin only)
+func NewListFlashSalesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFlashSalesLogic {
+	return &ListFlashSalesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListFlashSalesLogic) ListFlashSales(req *types.ListFlashSalesReq) (resp *types.ListFlashSalesResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.ListFlashSales(l.ctx, &product_client.ListFlashSalesRequest{
+		ProductId: req.ProductId,
+		Status:    req.Status,
+		Page:      int32(req.Page),
+		PageSize:  int32(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	Sales := make([]types.FlashSale, 0, len(ProductResp.Sales))
+	for _, sale := range ProductResp.Sales {
+		Sales = append(Sales, types.FlashSale{
+			Id:           sale.Id,
+			ProductId:    sale.ProductId,
+			Price:        sale.Price,
+			Quantity:     sale.Quantity,
+			Sold:         sale.Sold,
+			Remaining:    sale.Remaining,
+			PerUserLimit: sale.PerUserLimit,
+			StartAt:      sale.StartAt,
+			EndAt:        sale.EndAt,
+			Status:       sale.Status,
+			OperatorId:   sale.OperatorId,
+			CreatedAt:    sale.CreatedAt,
+			UpdatedAt:    sale.UpdatedAt,
+		})
+	}
+
+	return &types.ListFlashSalesResp{
+		Total: ProductResp.Total,
+		Sales: Sales,
+	}, nil
+}
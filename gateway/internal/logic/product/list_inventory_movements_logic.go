@@ -0,0 +1,61 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListInventoryMovementsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List inventory movements - Admin views the stock changes of a product (admin only)
+func NewListInventoryMovementsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListInventoryMovementsLogic {
+	return &ListInventoryMovementsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListInventoryMovementsLogic) ListInventoryMovements(req *types.InventoryMovementsReq) (resp *types.InventoryMovementsResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.ListInventoryMovements(l.ctx, &product_client.ListInventoryMovementsRequest{
+		ProductId: req.ProductId,
+		Reason:    req.Reason,
+		Page:      int32(req.Page),
+		PageSize:  int32(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	Movements := make([]types.InventoryMovement, 0, len(ProductResp.Movements))
+	for _, m := range ProductResp.Movements {
+		Movements = append(Movements, types.InventoryMovement{
+			Id:          m.Id,
+			ProductId:   m.ProductId,
+			WarehouseId: m.WarehouseId,
+			Quantity:    m.Quantity,
+			StockAfter:  m.StockAfter,
+			Reason:      m.Reason,
+			ReferenceId: m.ReferenceId,
+			OperatorId:  m.OperatorId,
+			CreatedAt:   m.CreatedAt,
+		})
+	}
+
+	return &types.InventoryMovementsResp{
+		Total:     ProductResp.Total,
+		Movements: Movements,
+	}, nil
+}
@@ -0,0 +1,55 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListLowStockLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List low-stock products - Admin views products at or below their low-stock threshold (admin only)
+func NewListLowStockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListLowStockLogic {
+	return &ListLowStockLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListLowStockLogic) ListLowStock(req *types.LowStockReq) (resp *types.LowStockResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.ListLowStockProducts(l.ctx, &product_client.ListLowStockProductsRequest{
+		Page:     int32(req.Page),
+		PageSize: int32(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	Products := make([]types.LowStockProduct, 0, len(ProductResp.Products))
+	for _, p := range ProductResp.Products {
+		Products = append(Products, types.LowStockProduct{
+			ProductId: p.ProductId,
+			Sku:       p.Sku,
+			Name:      p.Name,
+			Stock:     p.Stock,
+			Threshold: p.Threshold,
+		})
+	}
+
+	return &types.LowStockResp{
+		Total:    ProductResp.Total,
+		Products: Products,
+	}, nil
+}
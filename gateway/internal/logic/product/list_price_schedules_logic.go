@@ -0,0 +1,59 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListPriceSchedulesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List price schedules - Admin views scheduled price changes of a product (admin only)
+func NewListPriceSchedulesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPriceSchedulesLogic {
+	return &ListPriceSchedulesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListPriceSchedulesLogic) ListPriceSchedules(req *types.ListPriceSchedulesReq) (resp *types.ListPriceSchedulesResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.ListPriceSchedules(l.ctx, &product_client.ListPriceSchedulesRequest{
+		ProductId:       req.ProductId,
+		IncludeFinished: req.IncludeFinished,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	Schedules := make([]types.PriceSchedule, 0, len(ProductResp.Schedules))
+	for _, schedule := range ProductResp.Schedules {
+		Schedules = append(Schedules, types.PriceSchedule{
+			Id:            schedule.Id,
+			ProductId:     schedule.ProductId,
+			Price:         schedule.Price,
+			OriginalPrice: schedule.OriginalPrice,
+			StartAt:       schedule.StartAt,
+			EndAt:         schedule.EndAt,
+			Status:        schedule.Status,
+			OperatorId:    schedule.OperatorId,
+			CreatedAt:     schedule.CreatedAt,
+			UpdatedAt:     schedule.UpdatedAt,
+		})
+	}
+
+	return &types.ListPriceSchedulesResp{
+		Schedules: Schedules,
+	}, nil
+}
@@ -44,6 +44,7 @@ func (l *ListProductsLogic) ListProducts(req *types.ProductListReq) (resp *types
 	for _, productInfo := range ProductResp.Products {
 		newProduct := types.Product{
 			Id:          productInfo.Id,
+			Sku:         productInfo.Sku,
 			Name:        productInfo.Name,
 			Description: productInfo.Description,
 			Price:       productInfo.Price,
@@ -54,6 +55,10 @@ func (l *ListProductsLogic) ListProducts(req *types.ProductListReq) (resp *types
 			Sales:       productInfo.Sales,
 			CreatedAt:   productInfo.CreatedAt,
 			UpdatedAt:   productInfo.UpdatedAt,
+			Version:     productInfo.Version,
+			MaxPerOrder: productInfo.MaxPerOrder,
+			MaxPerUser:  productInfo.MaxPerUser,
+			LimitWindow: productInfo.LimitWindow,
 		}
 		Products = append(Products, newProduct)
 	}
@@ -0,0 +1,53 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListWarehousesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List warehouses - Admin views all stock locations, the default warehouse first (admin only)
+func NewListWarehousesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListWarehousesLogic {
+	return &ListWarehousesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListWarehousesLogic) ListWarehouses() (resp *types.ListWarehousesResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.ListWarehouses(l.ctx, &product_client.ListWarehousesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	Warehouses := make([]types.Warehouse, 0, len(ProductResp.Warehouses))
+	for _, w := range ProductResp.Warehouses {
+		Warehouses = append(Warehouses, types.Warehouse{
+			Id:        w.Id,
+			Code:      w.Code,
+			Name:      w.Name,
+			Region:    w.Region,
+			Priority:  w.Priority,
+			CreatedAt: w.CreatedAt,
+			UpdatedAt: w.UpdatedAt,
+		})
+	}
+
+	return &types.ListWarehousesResp{
+		Warehouses: Warehouses,
+	}, nil
+}
@@ -0,0 +1,42 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RestoreProductLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Restore product - Admin restores deleted product as unpublished (admin only)
+func NewRestoreProductLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RestoreProductLogic {
+	return &RestoreProductLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RestoreProductLogic) RestoreProduct(req *types.RestoreProductReq) (resp *types.RestoreProductResp, err error) {
+	productResp, err := l.svcCtx.ProductRpc.RestoreProduct(l.ctx, &product_client.RestoreProductRequest{
+		Id: req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RestoreProductResp{
+		Success: productResp.Success,
+	}, nil
+}
@@ -0,0 +1,46 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SchedulePriceChangeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Schedule price change - Admin schedules a future price, optionally reverted at endAt (admin only)
+func NewSchedulePriceChangeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SchedulePriceChangeLogic {
+	return &SchedulePriceChangeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SchedulePriceChangeLogic) SchedulePriceChange(req *types.SchedulePriceChangeReq) (resp *types.SchedulePriceChangeResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.SchedulePriceChange(l.ctx, &product_client.SchedulePriceChangeRequest{
+		ProductId:  req.ProductId,
+		Price:      req.Price,
+		StartAt:    req.StartAt,
+		EndAt:      req.EndAt,
+		OperatorId: l.ctx.Value("userId").(int64),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SchedulePriceChangeResp{
+		ScheduleId: ProductResp.ScheduleId,
+	}, nil
+}
@@ -42,6 +42,7 @@ func (l *SearchProductsLogic) SearchProducts(req *types.ProductSearchReq) (resp
 	for _, productInfo := range ProductResp.Products {
 		newProduct := types.Product{
 			Id:          productInfo.Id,
+			Sku:         productInfo.Sku,
 			Name:        productInfo.Name,
 			Description: productInfo.Description,
 			Price:       productInfo.Price,
@@ -52,6 +53,10 @@ func (l *SearchProductsLogic) SearchProducts(req *types.ProductSearchReq) (resp
 			Sales:       productInfo.Sales,
 			CreatedAt:   productInfo.CreatedAt,
 			UpdatedAt:   productInfo.UpdatedAt,
+			Version:     productInfo.Version,
+			MaxPerOrder: productInfo.MaxPerOrder,
+			MaxPerUser:  productInfo.MaxPerUser,
+			LimitWindow: productInfo.LimitWindow,
 		}
 		Products = append(Products, newProduct)
 	}
@@ -0,0 +1,51 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetBundleItemsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Set bundle items - Admin sets the components of a bundle product, no items makes it standalone again (admin only)
+func NewSetBundleItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetBundleItemsLogic {
+	return &SetBundleItemsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetBundleItemsLogic) SetBundleItems(req *types.SetBundleItemsReq) (resp *types.SetBundleItemsResp, err error) {
+	Items := make([]*product_client.BundleItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		Items = append(Items, &product_client.BundleItem{
+			ComponentId: item.ComponentId,
+			Quantity:    item.Quantity,
+		})
+	}
+
+	ProductResp, err := l.svcCtx.ProductRpc.SetBundleItems(l.ctx, &product_client.SetBundleItemsRequest{
+		BundleId: req.BundleId,
+		Items:    Items,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SetBundleItemsResp{
+		Success: ProductResp.Success,
+	}, nil
+}
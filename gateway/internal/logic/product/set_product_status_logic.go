@@ -0,0 +1,44 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetProductStatusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Set product status - Admin publishes, unpublishes or drafts product, optionally scheduled (admin only)
+func NewSetProductStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetProductStatusLogic {
+	return &SetProductStatusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetProductStatusLogic) SetProductStatus(req *types.SetProductStatusReq) (resp *types.SetProductStatusResp, err error) {
+	productResp, err := l.svcCtx.ProductRpc.SetProductStatus(l.ctx, &product_client.SetProductStatusRequest{
+		Id:        req.Id,
+		Status:    req.Status,
+		PublishAt: req.PublishAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SetProductStatusResp{
+		Success: productResp.Success,
+	}, nil
+}
@@ -0,0 +1,46 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetPurchaseLimitsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Set purchase limits - Admin sets the max units of a product per order and per customer within a window, 0 = unlimited (admin only)
+func NewSetPurchaseLimitsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetPurchaseLimitsLogic {
+	return &SetPurchaseLimitsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetPurchaseLimitsLogic) SetPurchaseLimits(req *types.SetPurchaseLimitsReq) (resp *types.SetPurchaseLimitsResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.SetPurchaseLimits(l.ctx, &product_client.SetPurchaseLimitsRequest{
+		ProductId:   req.ProductId,
+		MaxPerOrder: req.MaxPerOrder,
+		MaxPerUser:  req.MaxPerUser,
+		LimitWindow: req.LimitWindow,
+		OperatorId:  l.ctx.Value("userId").(int64),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SetPurchaseLimitsResp{
+		Success: ProductResp.Success,
+	}, nil
+}
@@ -0,0 +1,43 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetStockThresholdLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Set stock threshold - Admin sets the low-stock alert level of a product, 0 disables alerts (admin only)
+func NewSetStockThresholdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetStockThresholdLogic {
+	return &SetStockThresholdLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetStockThresholdLogic) SetStockThreshold(req *types.SetStockThresholdReq) (resp *types.SetStockThresholdResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.SetStockThreshold(l.ctx, &product_client.SetStockThresholdRequest{
+		ProductId: req.ProductId,
+		Threshold: req.Threshold,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SetStockThresholdResp{
+		Success: ProductResp.Success,
+	}, nil
+}
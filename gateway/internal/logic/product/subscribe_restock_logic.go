@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SubscribeRestockLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Subscribe restock - Get notified once when an out-of-stock product is back in stock
+func NewSubscribeRestockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubscribeRestockLogic {
+	return &SubscribeRestockLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SubscribeRestockLogic) SubscribeRestock(req *types.SubscribeRestockReq) (resp *types.SubscribeRestockResp, err error) {
+	userId := l.ctx.Value("userId").(int64)
+
+	ProductResp, err := l.svcCtx.ProductRpc.SubscribeRestock(l.ctx, &product_client.SubscribeRestockRequest{
+		UserId:    userId,
+		ProductId: req.ProductId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SubscribeRestockResp{
+		Success: ProductResp.Success,
+	}, nil
+}
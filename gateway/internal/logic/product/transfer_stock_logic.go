@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TransferStockLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Transfer stock - Admin moves stock of a product between warehouses (admin only)
+func NewTransferStockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TransferStockLogic {
+	return &TransferStockLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *TransferStockLogic) TransferStock(req *types.TransferStockReq) (resp *types.TransferStockResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.TransferStock(l.ctx, &product_client.TransferStockRequest{
+		ProductId:       req.ProductId,
+		FromWarehouseId: req.FromWarehouseId,
+		ToWarehouseId:   req.ToWarehouseId,
+		Quantity:        req.Quantity,
+		ReferenceId:     req.ReferenceId,
+		OperatorId:      l.ctx.Value("userId").(int64),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TransferStockResp{
+		Success: ProductResp.Success,
+	}, nil
+}
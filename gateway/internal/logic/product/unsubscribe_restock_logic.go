@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnsubscribeRestockLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Unsubscribe restock - Cancel a pending back-in-stock notification
+func NewUnsubscribeRestockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnsubscribeRestockLogic {
+	return &UnsubscribeRestockLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UnsubscribeRestockLogic) UnsubscribeRestock(req *types.UnsubscribeRestockReq) (resp *types.UnsubscribeRestockResp, err error) {
+	userId := l.ctx.Value("userId").(int64)
+
+	ProductResp, err := l.svcCtx.ProductRpc.UnsubscribeRestock(l.ctx, &product_client.UnsubscribeRestockRequest{
+		UserId:    userId,
+		ProductId: req.ProductId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.UnsubscribeRestockResp{
+		Success: ProductResp.Success,
+	}, nil
+}
@@ -37,6 +37,8 @@ func (l *UpdateProductLogic) UpdateProduct(req *types.UpdateProductReq) (resp *t
 		Category:    req.Category,
 		Images:      req.Images,
 		Attributes:  req.Attributes,
+		OperatorId:  l.ctx.Value("userId").(int64),
+		Version:     req.Version,
 	})
 	if err != nil {
 		return nil, err
@@ -44,5 +46,6 @@ func (l *UpdateProductLogic) UpdateProduct(req *types.UpdateProductReq) (resp *t
 
 	return &types.UpdateProductResp{
 		Success: ProductResp.Success,
+		Version: ProductResp.Version,
 	}, nil
 }
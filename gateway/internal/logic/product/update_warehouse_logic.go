@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/product/rpc/product_client"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateWarehouseLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Update warehouse - Admin changes name, region and priority of a warehouse (admin only)
+func NewUpdateWarehouseLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateWarehouseLogic {
+	return &UpdateWarehouseLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateWarehouseLogic) UpdateWarehouse(req *types.UpdateWarehouseReq) (resp *types.UpdateWarehouseResp, err error) {
+	ProductResp, err := l.svcCtx.ProductRpc.UpdateWarehouse(l.ctx, &product_client.UpdateWarehouseRequest{
+		Id:       req.Id,
+		Name:     req.Name,
+		Region:   req.Region,
+		Priority: req.Priority,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.UpdateWarehouseResp{
+		Success: ProductResp.Success,
+	}, nil
+}
@@ -0,0 +1,116 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package product
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/gateway/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UploadProductImageLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Upload product image - Admin uploads a JPEG/PNG/GIF image and gets URLs of the original and thumbnails (admin only)
+func NewUploadProductImageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UploadProductImageLogic {
+	return &UploadProductImageLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UploadProductImageLogic) UploadProductImage(data []byte) (resp *types.UploadProductImageResp, err error) {
+	uploadConf := l.svcCtx.Config.Upload
+
+	// 1. Sniff the real type from content and decode (validates the whole file)
+	contentType, ext, ok := utils.SniffImageType(data)
+	if !ok {
+		return nil, errorx.NewCodeError(1001, "Unsupported image type, only JPEG, PNG and GIF are allowed")
+	}
+
+	img, err := utils.DecodeImage(data, uploadConf.MaxPixels)
+	if err != nil {
+		l.Logger.Infof("Rejected image upload: %v", err)
+		return nil, errorx.NewCodeError(1001, "Invalid or too large image")
+	}
+
+	// 2. Store original, e.g. products/2026/10/18/<uuid>.jpg
+	baseKey := fmt.Sprintf("%s/%s/%s", uploadConf.KeyPrefix, time.Now().Format("2006/01/02"), uuid.NewString())
+	var storedKeys []string
+	defer func() {
+		// Do not leave half an upload behind
+		if err != nil {
+			for _, key := range storedKeys {
+				if delErr := l.svcCtx.Storage.Delete(context.Background(), key); delErr != nil {
+					l.Logger.Errorf("Failed to clean up uploaded image %s: %v", key, delErr)
+				}
+			}
+		}
+	}()
+
+	originalKey := baseKey + ext
+	originalURL, err := l.svcCtx.Storage.Put(l.ctx, originalKey, contentType, data)
+	if err != nil {
+		l.Logger.Errorf("Failed to store image %s: %v", originalKey, err)
+		return nil, errorx.ErrSystem
+	}
+	storedKeys = append(storedKeys, originalKey)
+
+	// 3. Generate and store thumbnails, e.g. products/2026/10/18/<uuid>_256.jpg
+	thumbnails := utils.Thumbnails(img, uploadConf.ThumbnailSizes)
+	sizes := make([]int, 0, len(thumbnails))
+	for size := range thumbnails {
+		sizes = append(sizes, size)
+	}
+	sort.Ints(sizes)
+
+	ImageThumbnails := make([]types.ImageThumbnail, 0, len(sizes))
+	for _, size := range sizes {
+		thumbnail := thumbnails[size]
+		thumbData, thumbType, thumbExt, err := utils.EncodeThumbnail(thumbnail, contentType)
+		if err != nil {
+			l.Logger.Errorf("Failed to encode %d thumbnail of %s: %v", size, originalKey, err)
+			return nil, errorx.ErrSystem
+		}
+
+		thumbKey := fmt.Sprintf("%s_%d%s", baseKey, size, thumbExt)
+		thumbURL, err := l.svcCtx.Storage.Put(l.ctx, thumbKey, thumbType, thumbData)
+		if err != nil {
+			l.Logger.Errorf("Failed to store thumbnail %s: %v", thumbKey, err)
+			return nil, errorx.ErrSystem
+		}
+		storedKeys = append(storedKeys, thumbKey)
+
+		ImageThumbnails = append(ImageThumbnails, types.ImageThumbnail{
+			Size:   size,
+			Url:    thumbURL,
+			Width:  thumbnail.Bounds().Dx(),
+			Height: thumbnail.Bounds().Dy(),
+		})
+	}
+
+	l.Logger.Infof("Product image uploaded: key=%s, type=%s, size=%d, thumbnails=%d", originalKey, contentType, len(data), len(ImageThumbnails))
+
+	return &types.UploadProductImageResp{
+		Url:         originalURL,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		Width:       img.Bounds().Dx(),
+		Height:      img.Bounds().Dy(),
+		Thumbnails:  ImageThumbnails,
+	}, nil
+}
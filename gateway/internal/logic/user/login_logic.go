@@ -8,6 +8,7 @@ import (
 
 	"letsgo/gateway/internal/svc"
 	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
 	"letsgo/services/user/rpc/user_client"
 
 	"github.com/zeromicro/go-zero/core/logx"
@@ -37,6 +38,19 @@ func (l *LoginLogic) Login(req *types.LoginReq) (resp *types.LoginResp, err erro
 		return nil, err
 	}
 
+	// Merge the guest cart into the user's cart, a failed merge does not fail the login
+	cartId := l.ctx.Value("cartId").(string)
+	mergeResp, err := l.svcCtx.CartRpc.MergeCart(l.ctx, &cart.MergeCartRequest{
+		UserId:     userResp.UserId,
+		TempCartId: cartId,
+	})
+	if err != nil {
+		l.Logger.Errorf("Failed to merge guest cart: user_id=%d, cart_id=%s, err=%v", userResp.UserId, cartId, err)
+	} else if mergeResp.Skipped > 0 {
+		l.Logger.Infof("Guest cart merged partially: user_id=%d, merged=%d, skipped=%d",
+			userResp.UserId, mergeResp.Merged, mergeResp.Skipped)
+	}
+
 	return &types.LoginResp{
 		UserId: userResp.UserId,
 		Token:  userResp.Token,
@@ -0,0 +1,49 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package wishlist
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AddToWishlistLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Add to wishlist - Save a product to a wishlist
+func NewAddToWishlistLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddToWishlistLogic {
+	return &AddToWishlistLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AddToWishlistLogic) AddToWishlist(req *types.AddToWishlistReq) (resp *types.AddToWishlistResp, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.AddToWishlist(l.ctx, &cart.AddToWishlistRequest{
+		UserId:     userId,
+		WishlistId: req.Id,
+		ProductId:  req.ProductId,
+		Quantity:   req.Quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.AddToWishlistResp{
+		Success: cartResp.Success,
+	}, nil
+}
@@ -0,0 +1,46 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package wishlist
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateWishlistLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Create wishlist - Create a named wishlist
+func NewCreateWishlistLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateWishlistLogic {
+	return &CreateWishlistLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateWishlistLogic) CreateWishlist(req *types.CreateWishlistReq) (resp *types.Wishlist, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.CreateWishlist(l.ctx, &cart.CreateWishlistRequest{
+		UserId: userId,
+		Name:   req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	wishlist := toWishlist(cartResp.Wishlist)
+	return &wishlist, nil
+}
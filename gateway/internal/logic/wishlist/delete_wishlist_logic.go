@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package wishlist
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteWishlistLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Delete wishlist - Delete a wishlist and its items
+func NewDeleteWishlistLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteWishlistLogic {
+	return &DeleteWishlistLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteWishlistLogic) DeleteWishlist(req *types.DeleteWishlistReq) (resp *types.DeleteWishlistResp, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.DeleteWishlist(l.ctx, &cart.DeleteWishlistRequest{
+		UserId:     userId,
+		WishlistId: req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.DeleteWishlistResp{
+		Success: cartResp.Success,
+	}, nil
+}
@@ -0,0 +1,63 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package wishlist
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetWishlistLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get wishlist - Wishlist items with current product data
+func NewGetWishlistLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetWishlistLogic {
+	return &GetWishlistLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetWishlistLogic) GetWishlist(req *types.GetWishlistReq) (resp *types.WishlistResp, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.GetWishlist(l.ctx, &cart.GetWishlistRequest{
+		UserId:     userId,
+		WishlistId: req.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]types.WishlistItem, 0, len(cartResp.Items))
+	for _, item := range cartResp.Items {
+		items = append(items, types.WishlistItem{
+			ProductId:  item.ProductId,
+			Name:       item.Name,
+			Price:      item.Price,
+			SavedPrice: item.SavedPrice,
+			Quantity:   item.Quantity,
+			Image:      item.Image,
+			Stock:      item.Stock,
+			Available:  item.Available,
+			AddedAt:    item.AddedAt,
+		})
+	}
+
+	return &types.WishlistResp{
+		Wishlist: toWishlist(cartResp.Wishlist),
+		Items:    items,
+	}, nil
+}
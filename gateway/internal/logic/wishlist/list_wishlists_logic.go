@@ -0,0 +1,63 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package wishlist
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListWishlistsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List wishlists - The user's wishlists, Saved for later first
+func NewListWishlistsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListWishlistsLogic {
+	return &ListWishlistsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListWishlistsLogic) ListWishlists() (resp *types.ListWishlistsResp, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.ListWishlists(l.ctx, &cart.ListWishlistsRequest{
+		UserId: userId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	wishlists := make([]types.Wishlist, 0, len(cartResp.Wishlists))
+	for _, wishlist := range cartResp.Wishlists {
+		wishlists = append(wishlists, toWishlist(wishlist))
+	}
+
+	return &types.ListWishlistsResp{
+		Wishlists: wishlists,
+	}, nil
+}
+
+// toWishlist converts a wishlist RPC message to the API type
+func toWishlist(wishlist *cart.Wishlist) types.Wishlist {
+	return types.Wishlist{
+		Id:        wishlist.Id,
+		Name:      wishlist.Name,
+		IsDefault: wishlist.IsDefault,
+		ItemCount: wishlist.ItemCount,
+		CreatedAt: wishlist.CreatedAt,
+		UpdatedAt: wishlist.UpdatedAt,
+	}
+}
@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package wishlist
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MoveToCartLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Move to cart - Move a wishlist item to the cart
+func NewMoveToCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MoveToCartLogic {
+	return &MoveToCartLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *MoveToCartLogic) MoveToCart(req *types.WishlistItemReq) (resp *types.MoveToCartResp, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.MoveToCart(l.ctx, &cart.MoveToCartRequest{
+		UserId:     userId,
+		WishlistId: req.Id,
+		ProductId:  req.ProductId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MoveToCartResp{
+		Success: cartResp.Success,
+	}, nil
+}
@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package wishlist
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MoveToWishlistLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Move to wishlist - Move a cart item to a wishlist (Saved for later by default)
+func NewMoveToWishlistLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MoveToWishlistLogic {
+	return &MoveToWishlistLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *MoveToWishlistLogic) MoveToWishlist(req *types.MoveToWishlistReq) (resp *types.MoveToWishlistResp, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.MoveToWishlist(l.ctx, &cart.MoveToWishlistRequest{
+		UserId:     userId,
+		ProductId:  req.ProductId,
+		WishlistId: req.WishlistId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MoveToWishlistResp{
+		Success: cartResp.Success,
+	}, nil
+}
@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package wishlist
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RemoveFromWishlistLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Remove from wishlist - Delete a product from a wishlist
+func NewRemoveFromWishlistLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveFromWishlistLogic {
+	return &RemoveFromWishlistLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RemoveFromWishlistLogic) RemoveFromWishlist(req *types.WishlistItemReq) (resp *types.RemoveFromWishlistResp, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.RemoveFromWishlist(l.ctx, &cart.RemoveFromWishlistRequest{
+		UserId:     userId,
+		WishlistId: req.Id,
+		ProductId:  req.ProductId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RemoveFromWishlistResp{
+		Success: cartResp.Success,
+	}, nil
+}
@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package wishlist
+
+import (
+	"context"
+
+	"letsgo/gateway/internal/svc"
+	"letsgo/gateway/internal/types"
+	"letsgo/services/cart/rpc/cart"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RenameWishlistLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Rename wishlist - Change the name of a named wishlist
+func NewRenameWishlistLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RenameWishlistLogic {
+	return &RenameWishlistLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RenameWishlistLogic) RenameWishlist(req *types.RenameWishlistReq) (resp *types.RenameWishlistResp, err error) {
+	// Get user ID from context
+	userId := l.ctx.Value("userId").(int64)
+
+	// Call Cart RPC service
+	cartResp, err := l.svcCtx.CartRpc.RenameWishlist(l.ctx, &cart.RenameWishlistRequest{
+		UserId:     userId,
+		WishlistId: req.Id,
+		Name:       req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.RenameWishlistResp{
+		Success: cartResp.Success,
+	}, nil
+}
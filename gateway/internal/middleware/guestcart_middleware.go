@@ -0,0 +1,61 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+var cartIdKey string = "cartId"
+
+const (
+	cartIdHeader = "X-Cart-Id"
+	cartIdCookie = "cart_id"
+)
+
+// GuestCartMiddleware identifies the cart of an anonymous shopper by the X-Cart-Id header or
+// the cart_id cookie, a new ID is issued when neither carries a valid one
+type GuestCartMiddleware struct {
+	maxAge int
+}
+
+func NewGuestCartMiddleware(maxAge int) *GuestCartMiddleware {
+	return &GuestCartMiddleware{
+		maxAge: maxAge,
+	}
+}
+
+func (m *GuestCartMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cartId := r.Header.Get(cartIdHeader)
+		if cartId == "" {
+			if cookie, err := r.Cookie(cartIdCookie); err == nil {
+				cartId = cookie.Value
+			}
+		}
+
+		if id, err := uuid.Parse(cartId); err == nil {
+			cartId = id.String()
+		} else {
+			cartId = uuid.NewString()
+		}
+
+		// Sent back on every response, so the cookie lives as long as the cart is used
+		http.SetCookie(w, &http.Cookie{
+			Name:     cartIdCookie,
+			Value:    cartId,
+			Path:     "/",
+			MaxAge:   m.maxAge,
+			HttpOnly: true,
+			SameSite: http.SameSiteLaxMode,
+		})
+		w.Header().Set(cartIdHeader, cartId)
+
+		ctx := context.WithValue(r.Context(), cartIdKey, cartId)
+		next(w, r.WithContext(ctx))
+	}
+}
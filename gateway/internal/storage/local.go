@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalConf configures the local filesystem backend
+type LocalConf struct {
+	Dir     string `json:",default=uploads"`  // Directory files are written to
+	BaseURL string `json:",default=/uploads"` // URL prefix the gateway serves Dir under
+}
+
+// LocalStorage stores files on the local filesystem, the gateway serves them itself
+type LocalStorage struct {
+	dir     string
+	baseURL string
+}
+
+// NewLocalStorage creates a local storage, the directory is created if missing
+func NewLocalStorage(c LocalConf) (*LocalStorage, error) {
+	if err := os.MkdirAll(c.Dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create upload dir: %w", err)
+	}
+
+	return &LocalStorage{
+		dir:     c.Dir,
+		baseURL: c.BaseURL,
+	}, nil
+}
+
+// Put writes data to a temp file first and renames it, so readers never see partial files
+func (s *LocalStorage) Put(ctx context.Context, key string, contentType string, data []byte) (string, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return "", err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return "", err
+	}
+
+	return joinURL(s.baseURL, key), nil
+}
+
+// Delete removes the file stored under key
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// BaseURL returns the URL prefix files are served under
+func (s *LocalStorage) BaseURL() string {
+	return s.baseURL
+}
+
+// FileSystem returns the upload directory for serving, directory listings are disabled
+func (s *LocalStorage) FileSystem() http.FileSystem {
+	return noListFileSystem{http.Dir(s.dir)}
+}
+
+// path maps a key to a file path and rejects keys escaping the upload directory
+func (s *LocalStorage) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if clean == "/" || strings.Contains(key, "..") {
+		return "", fmt.Errorf("invalid storage key: %s", key)
+	}
+	return filepath.Join(s.dir, clean), nil
+}
+
+// noListFileSystem hides directories so http.FileServer cannot list them
+type noListFileSystem struct {
+	fs http.FileSystem
+}
+
+func (n noListFileSystem) Open(name string) (http.File, error) {
+	f, err := n.fs.Open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	stat, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	if stat.IsDir() {
+		f.Close()
+		return nil, os.ErrNotExist
+	}
+
+	return f, nil
+}
@@ -0,0 +1,205 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// S3Conf configures an S3-compatible backend (AWS S3, MinIO, Ceph RGW, ...)
+type S3Conf struct {
+	Endpoint  string // e.g. https://s3.us-east-1.amazonaws.com or http://127.0.0.1:9000 for MinIO
+	Region    string `json:",default=us-east-1"`
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	PathStyle bool   `json:",default=true"` // endpoint/bucket/key instead of bucket.endpoint/key (MinIO needs true)
+	PublicURL string `json:",optional"`     // URL prefix returned to clients (CDN etc.), defaults to the bucket URL
+	ACL       string `json:",optional"`     // Canned ACL sent with uploads, e.g. public-read
+	TimeoutMs int64  `json:",default=10000"`
+}
+
+// S3Storage stores files in an S3-compatible bucket using plain HTTP requests
+// signed with AWS Signature Version 4, so no SDK is needed
+type S3Storage struct {
+	conf     S3Conf
+	endpoint *url.URL
+	client   *http.Client
+}
+
+// NewS3Storage creates an S3 storage
+func NewS3Storage(c S3Conf) (*S3Storage, error) {
+	if c.Endpoint == "" || c.Bucket == "" {
+		return nil, fmt.Errorf("s3 endpoint and bucket are required")
+	}
+	if c.AccessKey == "" || c.SecretKey == "" {
+		return nil, fmt.Errorf("s3 access key and secret key are required")
+	}
+
+	endpoint, err := url.Parse(c.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint: %s", c.Endpoint)
+	}
+
+	return &S3Storage{
+		conf:     c,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: time.Duration(c.TimeoutMs) * time.Millisecond},
+	}, nil
+}
+
+// Put uploads data with a single PUT Object request
+func (s *S3Storage) Put(ctx context.Context, key string, contentType string, data []byte) (string, error) {
+	headers := map[string]string{
+		"Content-Type": contentType,
+	}
+	if s.conf.ACL != "" {
+		headers["X-Amz-Acl"] = s.conf.ACL
+	}
+
+	if err := s.do(ctx, http.MethodPut, key, headers, data); err != nil {
+		return "", err
+	}
+
+	publicURL := s.conf.PublicURL
+	if publicURL == "" {
+		publicURL = s.bucketURL()
+	}
+	return joinURL(publicURL, key), nil
+}
+
+// Delete removes the object, S3 returns 204 for missing objects as well
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	return s.do(ctx, http.MethodDelete, key, nil, nil)
+}
+
+// bucketURL returns the base URL of the bucket
+func (s *S3Storage) bucketURL() string {
+	if s.conf.PathStyle {
+		return joinURL(s.endpoint.Scheme+"://"+s.endpoint.Host, s.conf.Bucket)
+	}
+	return s.endpoint.Scheme + "://" + s.conf.Bucket + "." + s.endpoint.Host
+}
+
+// do sends a signed request for the given object key
+func (s *S3Storage) do(ctx context.Context, method, key string, headers map[string]string, body []byte) error {
+	objectPath := "/" + encodePath(strings.TrimLeft(key, "/"))
+	host := s.endpoint.Host
+	if s.conf.PathStyle {
+		objectPath = "/" + s.conf.Bucket + objectPath
+	} else {
+		host = s.conf.Bucket + "." + host
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.endpoint.Scheme+"://"+host+objectPath, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Host = host
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	s.sign(req, objectPath, body, time.Now().UTC())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("s3 %s %s failed: %w", method, key, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("s3 %s %s failed: status=%d, body=%s", method, key, resp.StatusCode, msg)
+	}
+	return nil
+}
+
+// sign adds AWS Signature Version 4 headers to the request
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func (s *S3Storage) sign(req *http.Request, canonicalURI string, body []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	// Canonical headers: lower case names, sorted, host included
+	signed := map[string]string{"host": req.Host}
+	for name, values := range req.Header {
+		signed[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(signed))
+	for name := range signed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + signed[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalURI,
+		"", // No query string
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.conf.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.conf.SecretKey), date)
+	signingKey = hmacSHA256(signingKey, s.conf.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.conf.AccessKey, scope, signedHeaders, signature))
+}
+
+// encodePath URI-encodes the key as required by SigV4: everything except
+// RFC 3986 unreserved characters and '/' is percent-encoded
+func encodePath(path string) string {
+	var b strings.Builder
+	for i := 0; i < len(path); i++ {
+		c := path[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || c == '/' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeS3 is a minimal S3 stand-in keeping objects in memory
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	headers map[string]http.Header
+}
+
+func newFakeS3(t *testing.T) (*fakeS3, *httptest.Server) {
+	f := &fakeS3{
+		objects: make(map[string][]byte),
+		headers: make(map[string]http.Header),
+	}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	sum := sha256.Sum256(body)
+
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=test-key/") ||
+		!strings.Contains(auth, "SignedHeaders=") || !strings.Contains(auth, "Signature=") ||
+		r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(sum[:]) ||
+		r.Header.Get("X-Amz-Date") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		io.WriteString(w, "<Error><Code>SignatureDoesNotMatch</Code></Error>")
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		f.objects[r.URL.EscapedPath()] = body
+		f.headers[r.URL.EscapedPath()] = r.Header.Clone()
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.EscapedPath())
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func newTestS3(t *testing.T, endpoint string, c S3Conf) *S3Storage {
+	c.Endpoint = endpoint
+	c.Bucket = "images"
+	c.Region = "us-east-1"
+	if c.AccessKey == "" {
+		c.AccessKey = "test-key"
+	}
+	c.SecretKey = "test-secret"
+	c.PathStyle = true
+	c.TimeoutMs = 1000
+
+	s, err := NewS3Storage(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestS3PutAndDelete(t *testing.T) {
+	fake, srv := newFakeS3(t)
+	s := newTestS3(t, srv.URL, S3Conf{ACL: "public-read"})
+	ctx := context.Background()
+
+	url, err := s.Put(ctx, "products/2026/10/18/a b.jpg", "image/jpeg", []byte("jpeg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := srv.URL + "/images/products/2026/10/18/a b.jpg"; url != want {
+		t.Fatalf("url = %s, want %s", url, want)
+	}
+	object := "/images/products/2026/10/18/a%20b.jpg"
+	if string(fake.objects[object]) != "jpeg" {
+		t.Fatalf("stored objects = %v", fake.objects)
+	}
+	if h := fake.headers[object]; h.Get("Content-Type") != "image/jpeg" || h.Get("X-Amz-Acl") != "public-read" {
+		t.Fatalf("stored headers = %v", h)
+	}
+
+	if err := s.Delete(ctx, "products/2026/10/18/a b.jpg"); err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.objects) != 0 {
+		t.Fatalf("object not deleted: %v", fake.objects)
+	}
+}
+
+func TestS3PublicURL(t *testing.T) {
+	_, srv := newFakeS3(t)
+	s := newTestS3(t, srv.URL, S3Conf{PublicURL: "https://cdn.example.com/"})
+
+	url, err := s.Put(context.Background(), "/products/a.png", "image/png", []byte("png"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if url != "https://cdn.example.com/products/a.png" {
+		t.Fatalf("url = %s", url)
+	}
+}
+
+func TestS3ErrorStatus(t *testing.T) {
+	_, srv := newFakeS3(t)
+	s := newTestS3(t, srv.URL, S3Conf{AccessKey: "wrong-key"})
+
+	_, err := s.Put(context.Background(), "products/a.png", "image/png", []byte("png"))
+	if err == nil || !strings.Contains(err.Error(), "status=403") || !strings.Contains(err.Error(), "SignatureDoesNotMatch") {
+		t.Fatalf("err = %v, want the rejected status and body", err)
+	}
+}
+
+func TestNewS3StorageValidatesConf(t *testing.T) {
+	tests := []struct {
+		name string
+		conf S3Conf
+	}{
+		{name: "no bucket", conf: S3Conf{Endpoint: "http://127.0.0.1:9000", AccessKey: "k", SecretKey: "s"}},
+		{name: "no keys", conf: S3Conf{Endpoint: "http://127.0.0.1:9000", Bucket: "images"}},
+		{name: "no host", conf: S3Conf{Endpoint: "127.0.0.1", Bucket: "images", AccessKey: "k", SecretKey: "s"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewS3Storage(tt.conf); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Supported storage backends
+const (
+	TypeLocal = "local"
+	TypeS3    = "s3"
+)
+
+// Storage stores uploaded files and returns URLs that can be used directly
+// in product images
+type Storage interface {
+	// Put stores data under key (e.g. "products/2026/10/18/xxx.jpg") and returns its public URL
+	Put(ctx context.Context, key string, contentType string, data []byte) (string, error)
+	// Delete removes the object stored under key, missing objects are not an error
+	Delete(ctx context.Context, key string) error
+}
+
+// Conf is the storage configuration, only the section matching Type is used
+type Conf struct {
+	Type  string `json:",default=local,options=local|s3"`
+	Local LocalConf
+	S3    S3Conf `json:",optional"`
+}
+
+// NewStorage creates the storage backend selected by c.Type
+func NewStorage(c Conf) (Storage, error) {
+	switch c.Type {
+	case TypeLocal:
+		return NewLocalStorage(c.Local)
+	case TypeS3:
+		return NewS3Storage(c.S3)
+	default:
+		return nil, fmt.Errorf("unsupported storage type: %s", c.Type)
+	}
+}
+
+// joinURL joins a base URL and an object key with exactly one slash
+func joinURL(base, key string) string {
+	return strings.TrimRight(base, "/") + "/" + strings.TrimLeft(key, "/")
+}
@@ -1,8 +1,10 @@
 package svc
 
 import (
+	"fmt"
 	"letsgo/gateway/internal/config"
 	"letsgo/gateway/internal/middleware"
+	"letsgo/gateway/internal/storage"
 	"letsgo/services/cart/rpc/cart_client"
 	"letsgo/services/order/rpc/order_client"
 	"letsgo/services/payment/rpc/payment_client"
@@ -18,24 +20,33 @@ type ServiceContext struct {
 	Config     config.Config
 	Auth       rest.Middleware
 	AdminAuth  rest.Middleware
+	GuestCart  rest.Middleware
 	Timeout    rest.Middleware
 	UserRpc    user_client.User
 	ProductRpc product_client.Product
 	CartRpc    cart_client.Cart
 	OrderRpc   order_client.Order
 	PaymentRpc payment_client.Payment
+	Storage    storage.Storage
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
+	imageStorage, err := storage.NewStorage(c.Storage)
+	if err != nil {
+		panic(fmt.Sprintf("failed to init image storage: %v", err))
+	}
+
 	return &ServiceContext{
 		Config:     c,
 		Auth:       middleware.NewAuthMiddleware(c.Auth.AccessSecret).Handle,
 		AdminAuth:  middleware.NewAdminAuthMiddleware(c.Auth.AccessSecret).Handle,
+		GuestCart:  middleware.NewGuestCartMiddleware(c.GuestCartExpire).Handle,
 		Timeout:    middleware.NewTimeoutMiddleware(time.Duration(c.RequestTimeout) * time.Millisecond).Handle,
 		UserRpc:    user_client.NewUser(zrpc.MustNewClient(c.UserRpc)),
 		ProductRpc: product_client.NewProduct(zrpc.MustNewClient(c.ProductRpc)),
 		CartRpc:    cart_client.NewCart(zrpc.MustNewClient(c.CartRpc)),
 		OrderRpc:   order_client.NewOrder(zrpc.MustNewClient(c.OrderRpc)),
 		PaymentRpc: payment_client.NewPayment(zrpc.MustNewClient(c.PaymentRpc)),
+		Storage:    imageStorage,
 	}
 }
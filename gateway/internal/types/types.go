@@ -3,14 +3,25 @@
 
 package types
 
+type AcceptCartPricesReq struct {
+	ProductIds []int64 `json:"productIds,optional"` // Empty = all items
+}
+
+type AcceptCartPricesResp struct {
+	Updated int64 `json:"updated"` // Items whose saved price was updated
+}
+
 type AddProductReq struct {
+	Sku         string   `json:"sku,optional" validate:"omitempty,max=64"` // External SKU code, unique when set
 	Name        string   `json:"name" validate:"required,min=1,max=200"`
 	Description string   `json:"description" validate:"required"`
 	Price       float64  `json:"price" validate:"required,gt=0"`  // Must be > 0
 	Stock       int64    `json:"stock" validate:"required,gte=0"` // Must be >= 0
 	Category    string   `json:"category" validate:"required"`
-	Images      []string `json:"images" validate:"required,min=1"` // At least 1 image
-	Attributes  string   `json:"attributes,optional"`              // JSON string of attributes
+	Images      []string `json:"images" validate:"required,min=1"`                 // At least 1 image
+	Attributes  string   `json:"attributes,optional"`                              // JSON string of attributes
+	Status      int32    `json:"status,optional" validate:"omitempty,oneof=1 2 4"` // 1:published (default), 2:unpublished, 4:draft
+	PublishAt   int64    `json:"publishAt,optional"`                               // Scheduled publish Unix timestamp (draft/unpublished only)
 }
 
 type AddProductResp struct {
@@ -26,6 +37,56 @@ type AddToCartResp struct {
 	Success bool `json:"success"`
 }
 
+type AddToWishlistReq struct {
+	Id        int64 `path:"id" validate:"min=0"` // 0 = Saved for later
+	ProductId int64 `json:"productId" validate:"required,min=1"`
+	Quantity  int64 `json:"quantity,default=1" validate:"min=1,max=999"`
+}
+
+type AddToWishlistResp struct {
+	Success bool `json:"success"`
+}
+
+type AdjustStockReq struct {
+	ProductId   int64  `json:"productId" validate:"required,min=1"`
+	Quantity    int64  `json:"quantity" validate:"required"`                     // Positive = add, negative = remove
+	ReferenceId string `json:"referenceId,optional" validate:"omitempty,max=64"` // Stocktake number, supplier invoice...
+	WarehouseId int64  `json:"warehouseId,optional"`                             // 0 = default warehouse when adding, allocated when removing
+}
+
+type AdjustStockResp struct {
+	NewStock int64 `json:"newStock"`
+}
+
+type BundleComponent struct {
+	Product  Product `json:"product"`
+	Quantity int64   `json:"quantity"` // Units of the component in one bundle
+}
+
+type BundleItem struct {
+	ComponentId int64 `json:"componentId" validate:"required,min=1"`
+	Quantity    int64 `json:"quantity" validate:"required,min=1"` // Units of the component in one bundle
+}
+
+type BundleReq struct {
+	Id int64 `path:"id" validate:"required,min=1"`
+}
+
+type BundleResp struct {
+	Bundle     Product           `json:"bundle"`
+	Components []BundleComponent `json:"components"`
+	Available  int64             `json:"available"` // Bundles the component stock can make
+}
+
+type CancelFlashSaleReq struct {
+	Id int64 `path:"id" validate:"required,min=1"`
+}
+
+type CancelFlashSaleResp struct {
+	Success  bool  `json:"success"`
+	Returned int64 `json:"returned"` // Unsold units returned to product stock
+}
+
 type CancelOrderReq struct {
 	Id int64 `path:"id" validate:"required,min=1"`
 }
@@ -34,31 +95,67 @@ type CancelOrderResp struct {
 	Success bool `json:"success"`
 }
 
+type CancelPriceScheduleReq struct {
+	Id int64 `path:"id" validate:"required,min=1"`
+}
+
+type CancelPriceScheduleResp struct {
+	Success bool `json:"success"`
+}
+
+type CartIssue struct {
+	ProductId int64  `json:"productId"` // 0 = the whole cart
+	Code      string `json:"code"`      // empty, too_many_items, delisted, out_of_stock, insufficient_stock, quantity_limit, purchase_limit
+	Message   string `json:"message"`
+}
+
 type CartItem struct {
-	ProductId int64   `json:"productId"` // Product reference
-	Name      string  `json:"name"`
-	Price     float64 `json:"price"` // Price at time of adding
-	Quantity  int64   `json:"quantity"`
-	Image     string  `json:"image"`     // First product image
-	Stock     int64   `json:"stock"`     // Current available stock
-	Available bool    `json:"available"` // Is product still available
+	ProductId      int64   `json:"productId"` // Product reference
+	Name           string  `json:"name"`
+	Price          float64 `json:"price"` // Current price
+	Quantity       int64   `json:"quantity"`
+	Image          string  `json:"image"`      // First product image
+	Stock          int64   `json:"stock"`      // Current available stock
+	Available      bool    `json:"available"`  // Is product still available
+	SavedPrice     float64 `json:"savedPrice"` // Price when added or last accepted
+	PriceIncreased bool    `json:"priceIncreased"`
+	PriceDecreased bool    `json:"priceDecreased"`
+	ExceedsStock   bool    `json:"exceedsStock"` // Quantity is more than the available stock
+	Selected       bool    `json:"selected"`     // Selected for checkout
 }
 
 type CartResp struct {
 	Items      []CartItem `json:"items"`
-	TotalPrice float64    `json:"totalPrice"` // Sum of all items
-	TotalCount int64      `json:"totalCount"` // Total number of items
+	TotalPrice float64    `json:"totalPrice"` // Current prices of selected available items
+	TotalCount int64      `json:"totalCount"` // Number of selected available items
+	HasChanges bool       `json:"hasChanges"` // Some item changed price, became unavailable or exceeds stock
 }
 
 type ClearCartResp struct {
 	Success bool `json:"success"`
 }
 
+type CreateFlashSaleReq struct {
+	ProductId    int64   `json:"productId" validate:"required,min=1"`
+	Price        float64 `json:"price" validate:"required,gt=0"`         // Flash sale price
+	Quantity     int64   `json:"quantity" validate:"required,min=1"`     // Units reserved from product stock
+	PerUserLimit int64   `json:"perUserLimit,optional" validate:"min=0"` // Max units per user (0 = unlimited)
+	StartAt      int64   `json:"startAt" validate:"required"`            // Unix timestamp, must be in the future
+	EndAt        int64   `json:"endAt" validate:"required"`              // Unix timestamp, unsold units are returned after it
+}
+
+type CreateFlashSaleResp struct {
+	SaleId int64 `json:"saleId"`
+}
+
 type CreateOrderReq struct {
-	Items   []OrderItemReq `json:"items" validate:"required,min=1,dive"` // At least 1 item
-	Address string         `json:"address" validate:"required,min=10"`   // Delivery address
-	Phone   string         `json:"phone" validate:"required,len=11"`     // Contact phone
-	Remark  string         `json:"remark,optional"`                      // Order notes
+	Items    []OrderItemReq `json:"items,optional" validate:"required_without_all=FromCart QuoteId,dive"` // Items to order, or use fromCart or quoteId
+	FromCart bool           `json:"fromCart,optional"`                                                    // Order the selected cart items instead of items
+	QuoteId  string         `json:"quoteId,optional"`                                                     // Order a cart price quote at the quoted total instead of items
+	Address  string         `json:"address" validate:"required,min=10"`                                   // Delivery address
+	Phone    string         `json:"phone" validate:"required,len=11"`                                     // Contact phone
+	Remark   string         `json:"remark,optional"`                                                      // Order notes
+	Region   string         `json:"region,optional"`                                                      // Delivery region, picks the nearest warehouse
 }
 
 type CreateOrderResp struct {
@@ -80,6 +177,179 @@ type CreatePaymentResp struct {
 	QrCode    string `json:"qrCode,optional"` // QR code for scan payment
 }
 
+type CreateWarehouseReq struct {
+	Code     string `json:"code" validate:"required,max=32"` // Unique short code, stored upper case
+	Name     string `json:"name" validate:"required,max=100"`
+	Region   string `json:"region,optional" validate:"omitempty,max=64"` // Matched against the order region by nearest allocation
+	Priority int64  `json:"priority,optional"`                           // Lower ships first
+}
+
+type CreateWarehouseResp struct {
+	WarehouseId int64 `json:"warehouseId"`
+}
+
+type CreateWishlistReq struct {
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+type DeleteProductReq struct {
+	Id int64 `path:"id" validate:"required,min=1"`
+}
+
+type DeleteProductResp struct {
+	Success bool `json:"success"`
+}
+
+type DeleteWishlistReq struct {
+	Id int64 `path:"id" validate:"min=0"` // 0 = Saved for later
+}
+
+type DeleteWishlistResp struct {
+	Success bool `json:"success"`
+}
+
+type ExportProductsReq struct {
+	Format string `form:"format,default=csv,options=csv|jsonl"` // File format
+}
+
+type FlashSale struct {
+	Id           int64   `json:"id"`
+	ProductId    int64   `json:"productId"`
+	Price        float64 `json:"price"`
+	Quantity     int64   `json:"quantity"`
+	Sold         int64   `json:"sold"`         // Settled when the sale closes
+	Remaining    int64   `json:"remaining"`    // Units left while the sale is open
+	PerUserLimit int64   `json:"perUserLimit"` // 0 = unlimited
+	StartAt      int64   `json:"startAt"`
+	EndAt        int64   `json:"endAt"`
+	Status       int32   `json:"status"` // 1:pending, 2:active, 3:finished, 4:cancelled
+	OperatorId   int64   `json:"operatorId"`
+	CreatedAt    int64   `json:"createdAt"`
+	UpdatedAt    int64   `json:"updatedAt"`
+}
+
+type FlashSaleBuyReq struct {
+	SaleId   int64  `json:"saleId" validate:"required,min=1"`
+	Quantity int64  `json:"quantity" validate:"required,min=1"`
+	Address  string `json:"address" validate:"required,min=10"` // Delivery address
+	Phone    string `json:"phone" validate:"required,len=11"`   // Contact phone
+	Remark   string `json:"remark,optional"`                    // Order notes
+}
+
+type FlashSaleBuyResp struct {
+	OrderNo     string  `json:"orderNo"` // Query with /api/v1/order/query/:orderNo once created
+	TotalAmount float64 `json:"totalAmount"`
+}
+
+type FlashSaleDetailReq struct {
+	Id int64 `path:"id" validate:"required,min=1"`
+}
+
+type FlashSaleDetailResp struct {
+	Sale FlashSale `json:"sale"`
+}
+
+type GetWishlistReq struct {
+	Id int64 `path:"id" validate:"min=0"` // 0 = Saved for later
+}
+
+type ImageThumbnail struct {
+	Size   int    `json:"size"` // Bounding box, e.g. 256 = fits in 256x256
+	Url    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+type ImportProductsReq struct {
+	Format string `form:"format,options=csv|jsonl"` // File format
+}
+
+type ImportProductsResp struct {
+	Total   int64            `json:"total"` // Rows in file
+	Created int64            `json:"created"`
+	Updated int64            `json:"updated"`
+	Failed  int64            `json:"failed"`
+	Errors  []ImportRowError `json:"errors"` // Per-row errors
+}
+
+type ImportRowError struct {
+	Row     int64  `json:"row"` // Data row number in file, CSV header excluded
+	Sku     string `json:"sku"`
+	Message string `json:"message"`
+}
+
+type ImportSharedCartReq struct {
+	Token string `path:"token" validate:"required,uuid"`
+}
+
+type ImportSharedCartResp struct {
+	Imported int64 `json:"imported"` // Items added or whose quantity was increased
+	Skipped  int64 `json:"skipped"`  // Items left out: unavailable, cart full or quantity limit
+}
+
+type InventoryCheckReq struct {
+	ProductId int64 `form:"productId,optional"` // 0 = all products
+	Limit     int   `form:"limit,default=100"`
+}
+
+type InventoryCheckResp struct {
+	Consistent    bool               `json:"consistent"`
+	Discrepancies []StockDiscrepancy `json:"discrepancies"`
+}
+
+type InventoryMovement struct {
+	Id          int64  `json:"id"`
+	ProductId   int64  `json:"productId"`
+	WarehouseId int64  `json:"warehouseId"`
+	Quantity    int64  `json:"quantity"` // Positive = in, negative = out
+	StockAfter  int64  `json:"stockAfter"`
+	Reason      string `json:"reason"`
+	ReferenceId string `json:"referenceId"` // Order number, flash sale ID... (empty = none)
+	OperatorId  int64  `json:"operatorId"`  // 0 = system
+	CreatedAt   int64  `json:"createdAt"`
+}
+
+type InventoryMovementsReq struct {
+	ProductId int64  `form:"productId" validate:"required,min=1"`
+	Reason    string `form:"reason,optional"` // Empty = all, initial, import, order, cancel, compensation, admin_adjust, flash_sale, transfer
+	Page      int    `form:"page,default=1"`
+	PageSize  int    `form:"pageSize,default=20"`
+}
+
+type InventoryMovementsResp struct {
+	Total     int64               `json:"total"`
+	Movements []InventoryMovement `json:"movements"` // Newest first
+}
+
+type ListFlashSalesReq struct {
+	ProductId int64 `form:"productId,optional"` // 0 = all products
+	Status    int32 `form:"status,optional"`    // 0 = all, 1:pending, 2:active, 3:finished, 4:cancelled
+	Page      int   `form:"page,default=1"`
+	PageSize  int   `form:"pageSize,default=20"`
+}
+
+type ListFlashSalesResp struct {
+	Total int64       `json:"total"`
+	Sales []FlashSale `json:"sales"`
+}
+
+type ListPriceSchedulesReq struct {
+	ProductId       int64 `form:"productId" validate:"required,min=1"`
+	IncludeFinished bool  `form:"includeFinished,optional"` // Also return finished and cancelled schedules
+}
+
+type ListPriceSchedulesResp struct {
+	Schedules []PriceSchedule `json:"schedules"`
+}
+
+type ListWarehousesResp struct {
+	Warehouses []Warehouse `json:"warehouses"`
+}
+
+type ListWishlistsResp struct {
+	Wishlists []Wishlist `json:"wishlists"`
+}
+
 type LoginReq struct {
 	Username string `json:"username" validate:"required"`
 	Password string `json:"password" validate:"required"`
@@ -90,6 +360,37 @@ type LoginResp struct {
 	Token  string `json:"token"`
 }
 
+type LowStockProduct struct {
+	ProductId int64  `json:"productId"`
+	Sku       string `json:"sku"`
+	Name      string `json:"name"`
+	Stock     int64  `json:"stock"`
+	Threshold int64  `json:"threshold"`
+}
+
+type LowStockReq struct {
+	Page     int `form:"page,default=1"`
+	PageSize int `form:"pageSize,default=20"`
+}
+
+type LowStockResp struct {
+	Total    int64             `json:"total"`
+	Products []LowStockProduct `json:"products"` // Lowest stock first
+}
+
+type MoveToCartResp struct {
+	Success bool `json:"success"`
+}
+
+type MoveToWishlistReq struct {
+	ProductId  int64 `json:"productId" validate:"required,min=1"`
+	WishlistId int64 `json:"wishlistId,optional" validate:"min=0"` // 0 = Saved for later
+}
+
+type MoveToWishlistResp struct {
+	Success bool `json:"success"`
+}
+
 type Order struct {
 	Id          int64       `json:"id"`
 	UserId      int64       `json:"userId"`
@@ -153,8 +454,70 @@ type PaymentCallbackResp struct {
 	Message string `json:"message"`
 }
 
+type PriceCartResp struct {
+	Lines          []PricedLine `json:"lines"` // Selected items, oldest first
+	Subtotal       float64      `json:"subtotal"`
+	Discount       float64      `json:"discount"` // Promotions, 0 until promotions exist
+	Shipping       float64      `json:"shipping"` // 0 until shipping rates exist
+	Tax            float64      `json:"tax"`      // 0 until tax rules exist
+	Total          float64      `json:"total"`
+	Issues         []CartIssue  `json:"issues"` // Blocking issues, empty when the cart can be ordered
+	Valid          bool         `json:"valid"`
+	QuoteId        string       `json:"quoteId"` // Valid user carts only, pass to create order
+	QuoteExpiresAt int64        `json:"quoteExpiresAt"`
+}
+
+type PriceHistory struct {
+	Id         int64   `json:"id"`
+	ProductId  int64   `json:"productId"`
+	OldPrice   float64 `json:"oldPrice"`
+	NewPrice   float64 `json:"newPrice"`
+	OperatorId int64   `json:"operatorId"` // 0 = system
+	Source     string  `json:"source"`     // manual, import, schedule_start, schedule_end, schedule_cancel
+	ScheduleId int64   `json:"scheduleId"` // Related price schedule (0 = none)
+	CreatedAt  int64   `json:"createdAt"`
+}
+
+type PriceHistoryReq struct {
+	ProductId int64 `form:"productId" validate:"required,min=1"`
+	Page      int   `form:"page,default=1"`
+	PageSize  int   `form:"pageSize,default=20"`
+}
+
+type PriceHistoryResp struct {
+	Total   int64          `json:"total"`
+	History []PriceHistory `json:"history"` // Newest first
+}
+
+type PriceSchedule struct {
+	Id            int64   `json:"id"`
+	ProductId     int64   `json:"productId"`
+	Price         float64 `json:"price"`
+	OriginalPrice float64 `json:"originalPrice"` // Price before start (0 = not started)
+	StartAt       int64   `json:"startAt"`
+	EndAt         int64   `json:"endAt"`  // 0 = permanent change
+	Status        int32   `json:"status"` // 1:pending, 2:active, 3:finished, 4:cancelled
+	OperatorId    int64   `json:"operatorId"`
+	CreatedAt     int64   `json:"createdAt"`
+	UpdatedAt     int64   `json:"updatedAt"`
+}
+
+type PricedLine struct {
+	ProductId  int64   `json:"productId"`
+	Name       string  `json:"name"`
+	Image      string  `json:"image"`
+	Quantity   int64   `json:"quantity"`
+	UnitPrice  float64 `json:"unitPrice"`  // Current price
+	SavedPrice float64 `json:"savedPrice"` // Price when added or last accepted
+	Subtotal   float64 `json:"subtotal"`
+	Discount   float64 `json:"discount"`
+	Total      float64 `json:"total"`
+	Stock      int64   `json:"stock"` // Current available stock
+}
+
 type Product struct {
 	Id          int64    `json:"id"`
+	Sku         string   `json:"sku"`
 	Name        string   `json:"name"`
 	Description string   `json:"description"`
 	Price       float64  `json:"price"`
@@ -165,6 +528,10 @@ type Product struct {
 	Sales       int64    `json:"sales"`      // Total sales count
 	CreatedAt   int64    `json:"createdAt"`
 	UpdatedAt   int64    `json:"updatedAt"`
+	Version     int64    `json:"version"`     // Changes with every edit, required by update product
+	MaxPerOrder int64    `json:"maxPerOrder"` // Max units in one order (0 = unlimited)
+	MaxPerUser  int64    `json:"maxPerUser"`  // Max units per customer within limitWindow (0 = unlimited)
+	LimitWindow int64    `json:"limitWindow"` // Seconds of order history counted for maxPerUser (0 = all time)
 }
 
 type ProductDetailReq struct {
@@ -233,6 +600,16 @@ type RegisterResp struct {
 	Token  string `json:"token"`  // JWT authentication token
 }
 
+type RelatedProductsReq struct {
+	Id    int64 `path:"id" validate:"required,min=1"`
+	Limit int   `form:"limit,default=10"` // Max 20
+}
+
+type RelatedProductsResp struct {
+	Products       []Product `json:"products"`
+	BoughtTogether int       `json:"boughtTogether"` // The first boughtTogether products come from orders, the rest are category best sellers
+}
+
 type RemoveCartReq struct {
 	ProductId int64 `path:"productId" validate:"required,min=1"`
 }
@@ -241,6 +618,150 @@ type RemoveCartResp struct {
 	Success bool `json:"success"`
 }
 
+type RemoveFromWishlistResp struct {
+	Success bool `json:"success"`
+}
+
+type RenameWishlistReq struct {
+	Id   int64  `path:"id" validate:"required,min=1"`
+	Name string `json:"name" validate:"required,max=100"`
+}
+
+type RenameWishlistResp struct {
+	Success bool `json:"success"`
+}
+
+type RestoreProductReq struct {
+	Id int64 `path:"id" validate:"required,min=1"`
+}
+
+type RestoreProductResp struct {
+	Success bool `json:"success"`
+}
+
+type SchedulePriceChangeReq struct {
+	ProductId int64   `json:"productId" validate:"required,min=1"`
+	Price     float64 `json:"price" validate:"required,gt=0"`
+	StartAt   int64   `json:"startAt" validate:"required"` // Unix timestamp, must be in the future
+	EndAt     int64   `json:"endAt,optional"`              // Unix timestamp to revert the price (0 = permanent change)
+}
+
+type SchedulePriceChangeResp struct {
+	ScheduleId int64 `json:"scheduleId"`
+}
+
+type SelectAllCartItemsReq struct {
+	Selected bool `json:"selected"`
+}
+
+type SelectCartItemsReq struct {
+	ProductIds []int64 `json:"productIds" validate:"required,min=1"`
+	Selected   bool    `json:"selected"`
+}
+
+type SelectCartItemsResp struct {
+	Updated int64 `json:"updated"` // Items whose selection changed
+}
+
+type SetBundleItemsReq struct {
+	BundleId int64        `json:"bundleId" validate:"required,min=1"`
+	Items    []BundleItem `json:"items,optional" validate:"max=20,dive"` // Empty = standalone product again
+}
+
+type SetBundleItemsResp struct {
+	Success bool `json:"success"`
+}
+
+type SetProductStatusReq struct {
+	Id        int64 `json:"id" validate:"required,min=1"`
+	Status    int32 `json:"status" validate:"required,oneof=1 2 4"` // 1:published, 2:unpublished, 4:draft
+	PublishAt int64 `json:"publishAt,optional"`                     // Publish automatically at this Unix timestamp (draft/unpublished only)
+}
+
+type SetProductStatusResp struct {
+	Success bool `json:"success"`
+}
+
+type SetPurchaseLimitsReq struct {
+	ProductId   int64 `json:"productId" validate:"required,min=1"`
+	MaxPerOrder int64 `json:"maxPerOrder" validate:"min=0"` // Max units in one order, 0 = unlimited
+	MaxPerUser  int64 `json:"maxPerUser" validate:"min=0"`  // Max units per customer within limitWindow, 0 = unlimited
+	LimitWindow int64 `json:"limitWindow" validate:"min=0"` // Seconds of order history counted for maxPerUser, 0 = all time
+}
+
+type SetPurchaseLimitsResp struct {
+	Success bool `json:"success"`
+}
+
+type SetStockThresholdReq struct {
+	ProductId int64 `json:"productId" validate:"required,min=1"`
+	Threshold int64 `json:"threshold" validate:"min=0"` // Alert when stock drops to this level, 0 = no alerts
+}
+
+type SetStockThresholdResp struct {
+	Success bool `json:"success"`
+}
+
+type ShareCartResp struct {
+	Token     string `json:"token"` // Pass to get shared cart and import shared cart
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+type SharedCartReq struct {
+	Token string `path:"token" validate:"required,uuid"`
+}
+
+type SharedCartResp struct {
+	Items     []CartItem `json:"items"` // Shared quantities with current prices and stock
+	ExpiresAt int64      `json:"expiresAt"`
+}
+
+type StockDetailReq struct {
+	ProductId int64 `path:"productId" validate:"required,min=1"`
+}
+
+type StockDetailResp struct {
+	ProductId  int64            `json:"productId"`
+	Stock      int64            `json:"stock"` // Total over all warehouses
+	Warehouses []WarehouseStock `json:"warehouses"`
+}
+
+type StockDiscrepancy struct {
+	ProductId      int64 `json:"productId"`
+	Stock          int64 `json:"stock"`
+	LedgerStock    int64 `json:"ledgerStock"`    // Sum of inventory movements
+	Difference     int64 `json:"difference"`     // stock - ledgerStock
+	WarehouseStock int64 `json:"warehouseStock"` // Sum of per-warehouse stock
+}
+
+type SubscribeRestockReq struct {
+	ProductId int64 `json:"productId" validate:"required,min=1"`
+}
+
+type SubscribeRestockResp struct {
+	Success bool `json:"success"`
+}
+
+type TransferStockReq struct {
+	ProductId       int64  `json:"productId" validate:"required,min=1"`
+	FromWarehouseId int64  `json:"fromWarehouseId" validate:"required,min=1"`
+	ToWarehouseId   int64  `json:"toWarehouseId" validate:"required,min=1,nefield=FromWarehouseId"`
+	Quantity        int64  `json:"quantity" validate:"required,min=1"`
+	ReferenceId     string `json:"referenceId,optional" validate:"omitempty,max=64"` // Transfer note number
+}
+
+type TransferStockResp struct {
+	Success bool `json:"success"`
+}
+
+type UnsubscribeRestockReq struct {
+	ProductId int64 `path:"productId" validate:"required,min=1"`
+}
+
+type UnsubscribeRestockResp struct {
+	Success bool `json:"success"`
+}
+
 type UpdateCartReq struct {
 	ProductId int64 `json:"productId" validate:"required,min=1"`
 	Quantity  int64 `json:"quantity" validate:"required,min=1,max=999"`
@@ -258,10 +779,12 @@ type UpdateProductReq struct {
 	Category    string   `json:"category,optional"`
 	Images      []string `json:"images,optional"`
 	Attributes  string   `json:"attributes,optional"`
+	Version     int64    `json:"version" validate:"required,min=1"` // Version of the product being edited, from product detail
 }
 
 type UpdateProductResp struct {
-	Success bool `json:"success"`
+	Success bool  `json:"success"`
+	Version int64 `json:"version"` // New version, use it for the next edit
 }
 
 type UpdateProfileReq struct {
@@ -274,6 +797,26 @@ type UpdateProfileResp struct {
 	Success bool `json:"success"`
 }
 
+type UpdateWarehouseReq struct {
+	Id       int64  `json:"id" validate:"required,min=1"`
+	Name     string `json:"name,optional" validate:"omitempty,max=100"`  // Empty = no change
+	Region   string `json:"region,optional" validate:"omitempty,max=64"` // Empty = no change
+	Priority int64  `json:"priority"`                                    // Always applied
+}
+
+type UpdateWarehouseResp struct {
+	Success bool `json:"success"`
+}
+
+type UploadProductImageResp struct {
+	Url         string           `json:"url"`         // Original image URL
+	ContentType string           `json:"contentType"` // Sniffed from file content
+	Size        int64            `json:"size"`        // File size in bytes
+	Width       int              `json:"width"`
+	Height      int              `json:"height"`
+	Thumbnails  []ImageThumbnail `json:"thumbnails"`
+}
+
 type UserProfileResp struct {
 	UserId    int64  `json:"userId"`
 	Username  string `json:"username"`
@@ -282,3 +825,51 @@ type UserProfileResp struct {
 	Avatar    string `json:"avatar"`
 	CreatedAt int64  `json:"createdAt"` // Unix timestamp
 }
+
+type Warehouse struct {
+	Id        int64  `json:"id"`
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	Region    string `json:"region"`
+	Priority  int64  `json:"priority"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+type WarehouseStock struct {
+	WarehouseId   int64  `json:"warehouseId"`
+	WarehouseCode string `json:"warehouseCode"`
+	WarehouseName string `json:"warehouseName"`
+	Stock         int64  `json:"stock"`
+}
+
+type Wishlist struct {
+	Id        int64  `json:"id"`
+	Name      string `json:"name"`
+	IsDefault bool   `json:"isDefault"` // The Saved for later list
+	ItemCount int64  `json:"itemCount"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+type WishlistItem struct {
+	ProductId  int64   `json:"productId"`
+	Name       string  `json:"name"`
+	Price      float64 `json:"price"`      // Current price
+	SavedPrice float64 `json:"savedPrice"` // Price when saved
+	Quantity   int64   `json:"quantity"`
+	Image      string  `json:"image"`     // First product image
+	Stock      int64   `json:"stock"`     // Current available stock
+	Available  bool    `json:"available"` // Is product still available
+	AddedAt    int64   `json:"addedAt"`
+}
+
+type WishlistItemReq struct {
+	Id        int64 `path:"id" validate:"min=0"` // 0 = Saved for later
+	ProductId int64 `path:"productId" validate:"required,min=1"`
+}
+
+type WishlistResp struct {
+	Wishlist Wishlist       `json:"wishlist"`
+	Items    []WishlistItem `json:"items"` // Most recently saved first
+}
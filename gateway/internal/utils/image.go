@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // Register GIF decoder
+	"image/jpeg"
+	"image/png"
+	"net/http"
+	"sort"
+)
+
+// Supported upload image types: content type -> file extension
+// WebP is sniffed but rejected because the standard library cannot decode it for thumbnails
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+// SniffImageType detects the image type from the file content, the client supplied
+// Content-Type and file name are never trusted
+func SniffImageType(data []byte) (contentType string, ext string, ok bool) {
+	contentType = http.DetectContentType(data)
+	ext, ok = imageExtensions[contentType]
+	return contentType, ext, ok
+}
+
+// DecodeImage decodes an image after checking its dimensions from the header,
+// so oversized images (decompression bombs) are rejected before allocating pixels
+func DecodeImage(data []byte, maxPixels int64) (image.Image, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid image: %w", err)
+	}
+	if config.Width <= 0 || config.Height <= 0 {
+		return nil, fmt.Errorf("invalid image size: %dx%d", config.Width, config.Height)
+	}
+	if int64(config.Width)*int64(config.Height) > maxPixels {
+		return nil, fmt.Errorf("image too large: %dx%d", config.Width, config.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid image: %w", err)
+	}
+	return img, nil
+}
+
+// Thumbnails scales src to fit inside each size x size box, keeping the aspect ratio.
+// Images are never upscaled. Larger thumbnails are generated first and each smaller one
+// is scaled from the previous result, which keeps the work on big originals small.
+func Thumbnails(src image.Image, sizes []int) map[int]image.Image {
+	ordered := append([]int(nil), sizes...)
+	sort.Sort(sort.Reverse(sort.IntSlice(ordered)))
+
+	result := make(map[int]image.Image, len(ordered))
+	current := src
+	for _, size := range ordered {
+		bounds := current.Bounds()
+		width, height := fitSize(bounds.Dx(), bounds.Dy(), size)
+		if width != bounds.Dx() || height != bounds.Dy() {
+			current = resizeBox(current, width, height)
+		}
+		result[size] = current
+	}
+
+	return result
+}
+
+// EncodeThumbnail encodes a thumbnail, PNG and GIF sources become PNG to keep
+// transparency, everything else becomes JPEG
+func EncodeThumbnail(img image.Image, sourceType string) (data []byte, contentType string, ext string, err error) {
+	var buf bytes.Buffer
+	switch sourceType {
+	case "image/png", "image/gif":
+		err = png.Encode(&buf, img)
+		contentType, ext = "image/png", ".png"
+	default:
+		err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 85})
+		contentType, ext = "image/jpeg", ".jpg"
+	}
+	if err != nil {
+		return nil, "", "", err
+	}
+	return buf.Bytes(), contentType, ext, nil
+}
+
+// fitSize returns the size of a width x height image scaled down to fit inside box x box
+func fitSize(width, height, box int) (int, int) {
+	if width <= box && height <= box {
+		return width, height
+	}
+	if width >= height {
+		return box, max(1, height*box/width)
+	}
+	return max(1, width*box/height), box
+}
+
+// resizeBox downscales src with a box filter (average of all covered source pixels)
+func resizeBox(src image.Image, width, height int) *image.RGBA {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := 0; y < height; y++ {
+		y0 := y * srcH / height
+		y1 := max((y+1)*srcH/height, y0+1)
+		for x := 0; x < width; x++ {
+			x0 := x * srcW / width
+			x1 := max((x+1)*srcW/width, x0+1)
+
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := src.At(bounds.Min.X+sx, bounds.Min.Y+sy).RGBA()
+					r += uint64(pr)
+					g += uint64(pg)
+					b += uint64(pb)
+					a += uint64(pa)
+					n++
+				}
+			}
+
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n >> 8),
+				G: uint8(g / n >> 8),
+				B: uint8(b / n >> 8),
+				A: uint8(a / n >> 8),
+			})
+		}
+	}
+
+	return dst
+}
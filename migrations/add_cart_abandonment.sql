@@ -0,0 +1,14 @@
+-- Migration: Add abandoned cart tracking
+-- Date: 2026-10-18
+-- Description: Saved user carts record the user's last order and the cart state a
+--              cart.abandoned event was last considered for, so an idle cart is
+--              reported once per state and not at all after the user ordered
+
+ALTER TABLE carts ADD COLUMN IF NOT EXISTS ordered_at TIMESTAMP;
+ALTER TABLE carts ADD COLUMN IF NOT EXISTS abandoned_at TIMESTAMP;
+ALTER TABLE carts ADD COLUMN IF NOT EXISTS abandoned_hash VARCHAR(64) NOT NULL DEFAULT '';
+
+COMMENT ON COLUMN carts.updated_at IS 'Last change of the cart';
+COMMENT ON COLUMN carts.ordered_at IS 'Creation time of the user''s last order';
+COMMENT ON COLUMN carts.abandoned_at IS 'updated_at of the cart state last checked for abandonment';
+COMMENT ON COLUMN carts.abandoned_hash IS 'Contents hash of the cart state last reported as abandoned';
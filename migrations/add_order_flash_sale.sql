@@ -0,0 +1,8 @@
+-- Migration: Link flash sale orders to their sale
+-- Date: 2026-10-19
+-- Description: Cancelling a flash sale order gives the user's purchase count back to the sale,
+--              so the order keeps the ID of the sale it was bought from
+
+ALTER TABLE orders ADD COLUMN IF NOT EXISTS flash_sale_id BIGINT NOT NULL DEFAULT 0;
+
+COMMENT ON COLUMN orders.flash_sale_id IS 'Flash sale the order was bought from, 0 = regular order';
@@ -0,0 +1,32 @@
+-- Migration: Add draft/scheduled publish support to products table
+-- Date: 2026-10-18
+-- Description: Product status now distinguishes published, unpublished, deleted and draft,
+--              and products can be scheduled to publish automatically
+
+-- Before this migration status 2 was only ever written by Delete, so every
+-- existing status 2 row is a deleted product. Move them to the new deleted
+-- status so they cannot be published and show up in Restore and the deleted listing.
+-- The check on publish_at keeps the migration safe to run again: once the column
+-- exists, status 2 means unpublished and must not be touched.
+DO $$
+BEGIN
+    IF NOT EXISTS (
+        SELECT 1 FROM information_schema.columns
+        WHERE table_name = 'products' AND column_name = 'publish_at'
+    ) THEN
+        UPDATE products SET status = 3 WHERE status = 2;
+    END IF;
+END $$;
+
+-- Add scheduled publish time (0 = not scheduled)
+ALTER TABLE products
+ADD COLUMN IF NOT EXISTS publish_at BIGINT NOT NULL DEFAULT 0;
+
+-- Partial index for the scheduled publish job
+CREATE INDEX IF NOT EXISTS idx_products_publish_at ON products(publish_at) WHERE publish_at > 0;
+
+-- Status values:
+--   1 = published, 2 = unpublished (previously used for deleted, migrated to 3 above)
+--   3 = deleted (soft delete), 4 = draft
+COMMENT ON COLUMN products.status IS '1=published (available for sale), 2=unpublished (hidden), 3=deleted (soft delete), 4=draft';
+COMMENT ON COLUMN products.publish_at IS 'Scheduled publish timestamp (Unix epoch), 0 = not scheduled';
@@ -0,0 +1,15 @@
+-- Migration: Add per-product purchase limits
+-- Date: 2026-10-18
+-- Description: Maximum units of a product per order and per user within a time window.
+--              The cart rejects quantities above either limit and CreateOrder checks them
+--              again against the user's orders, so limited products cannot be bought around the cart.
+--              Cached product details carry no limits until they expire, run the product cache
+--              warm-up command after deploying
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS max_per_order BIGINT NOT NULL DEFAULT 0 CHECK (max_per_order >= 0);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS max_per_user BIGINT NOT NULL DEFAULT 0 CHECK (max_per_user >= 0);
+ALTER TABLE products ADD COLUMN IF NOT EXISTS limit_window BIGINT NOT NULL DEFAULT 0 CHECK (limit_window >= 0);
+
+COMMENT ON COLUMN products.max_per_order IS 'Max units in one order, 0 = unlimited';
+COMMENT ON COLUMN products.max_per_user IS 'Max units one user can order within limit_window, 0 = unlimited';
+COMMENT ON COLUMN products.limit_window IS 'Seconds of order history counted for max_per_user, 0 = all time';
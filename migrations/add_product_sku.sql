@@ -0,0 +1,13 @@
+-- Migration: Add SKU code to products table
+-- Date: 2026-10-18
+-- Description: Products get an optional external SKU code, bulk import uses it
+--              to decide whether a row creates a new product or updates an existing one
+
+-- Add SKU column ('' = none, existing products keep no SKU)
+ALTER TABLE products
+ADD COLUMN IF NOT EXISTS sku VARCHAR(64) NOT NULL DEFAULT '';
+
+-- SKU must be unique when set (also the conflict target of the import upsert)
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_sku ON products(sku) WHERE sku <> '';
+
+COMMENT ON COLUMN products.sku IS 'External SKU code, unique when set, used as the key for bulk import';
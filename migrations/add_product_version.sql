@@ -0,0 +1,9 @@
+-- Migration: Add optimistic locking to products
+-- Date: 2026-10-18
+-- Description: UpdateProduct only succeeds with the current version, so concurrent
+--              admin edits cannot overwrite each other. Cached product details carry
+--              no version until they expire, run the product cache warm-up command after deploying
+
+ALTER TABLE products ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN products.version IS 'Optimistic lock version of the editable fields, stock and sales changes do not bump it';
@@ -0,0 +1,28 @@
+-- Migration: Add low-stock alerts and back-in-stock subscriptions
+-- Date: 2026-10-18
+-- Description: Per-product low-stock thresholds and a table of users waiting
+--              for out-of-stock products to be restocked
+
+-- Low-stock threshold (0 = no alerts) and whether the alert was already sent
+ALTER TABLE products ADD COLUMN IF NOT EXISTS low_stock_threshold BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE products ADD COLUMN IF NOT EXISTS low_stock_alerted BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX IF NOT EXISTS idx_products_low_stock ON products(stock) WHERE low_stock_threshold > 0;
+
+COMMENT ON COLUMN products.low_stock_threshold IS 'Low-stock alert threshold, 0 = no alerts';
+COMMENT ON COLUMN products.low_stock_alerted IS 'Whether the low-stock alert was sent since stock last went above the threshold';
+
+-- Back-in-stock subscriptions (users notified once when an out-of-stock product is restocked)
+CREATE TABLE IF NOT EXISTS stock_subscriptions (
+    id          BIGSERIAL PRIMARY KEY,
+    product_id  BIGINT NOT NULL REFERENCES products(id),
+    user_id     BIGINT NOT NULL,
+    created_at  BIGINT NOT NULL,           -- Unix timestamp
+    notified_at BIGINT NOT NULL DEFAULT 0, -- Unix timestamp (0 = pending)
+
+    UNIQUE (product_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_subscriptions_pending ON stock_subscriptions(product_id) WHERE notified_at = 0;
+
+COMMENT ON TABLE stock_subscriptions IS 'Users waiting for a product to be back in stock';
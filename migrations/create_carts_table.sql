@@ -0,0 +1,16 @@
+-- Migration: Create carts table
+-- Date: 2026-10-18
+-- Description: Durable copy of user carts (letsgo_cart). Redis stays the primary
+--              store, changed carts are written here in the background and loaded
+--              back into Redis when the Redis copy is missing
+
+CREATE TABLE IF NOT EXISTS carts (
+    user_id    BIGINT PRIMARY KEY,
+    items      JSONB NOT NULL,                      -- Redis hash field -> cart line
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Used by the retention cleanup
+CREATE INDEX IF NOT EXISTS idx_carts_updated_at ON carts(updated_at);
+
+COMMENT ON TABLE carts IS 'Write-behind copy of Redis user carts, removed after Cart.Retention of inactivity';
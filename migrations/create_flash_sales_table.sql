@@ -0,0 +1,28 @@
+-- Migration: Add flash sales
+-- Date: 2026-10-18
+-- Description: Flash sales reserve product stock, sell it from Redis during the sale window
+--              and return the unsold quantity to products.stock when the sale closes
+
+-- Flash sales (quantity is reserved from products.stock when the sale is created,
+-- sold in Redis during the window and the unsold part is returned when it closes)
+CREATE TABLE IF NOT EXISTS flash_sales (
+    id             BIGSERIAL PRIMARY KEY,
+    product_id     BIGINT NOT NULL REFERENCES products(id),
+    price          DECIMAL(10,2) NOT NULL CHECK (price > 0),
+    quantity       INT NOT NULL CHECK (quantity > 0),  -- Units reserved from products.stock
+    sold           INT NOT NULL DEFAULT 0,             -- Units sold, settled when the sale closes
+    per_user_limit INT NOT NULL DEFAULT 0,             -- Max units per user (0 = unlimited)
+    start_at       BIGINT NOT NULL,
+    end_at         BIGINT NOT NULL,
+    status         INT NOT NULL DEFAULT 1,             -- 1:pending, 2:active, 3:finished, 4:cancelled
+    operator_id    BIGINT NOT NULL DEFAULT 0,
+    created_at     BIGINT NOT NULL,
+    updated_at     BIGINT NOT NULL,
+
+    CONSTRAINT flash_sales_end_after_start CHECK (end_at > start_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_flash_sales_product ON flash_sales(product_id, start_at);
+CREATE INDEX IF NOT EXISTS idx_flash_sales_open ON flash_sales(end_at) WHERE status IN (1, 2);
+
+COMMENT ON TABLE flash_sales IS 'Flash sales with stock pre-deducted into Redis';
@@ -0,0 +1,27 @@
+-- Migration: Add inventory movement ledger
+-- Date: 2026-10-18
+-- Description: Every product stock change is recorded in inventory_movements
+--              with its reason, reference and operator
+
+-- Inventory movements (one row per change of products.stock, written in the same transaction,
+-- so the quantities of a product add up to its stock)
+CREATE TABLE IF NOT EXISTS inventory_movements (
+    id           BIGSERIAL PRIMARY KEY,
+    product_id   BIGINT NOT NULL REFERENCES products(id),
+    quantity     BIGINT NOT NULL,                 -- Positive = in, negative = out
+    stock_after  BIGINT NOT NULL,                 -- products.stock after the change
+    reason       VARCHAR(20) NOT NULL,            -- initial, import, order, cancel, compensation, admin_adjust, flash_sale
+    reference_id VARCHAR(64) NOT NULL DEFAULT '', -- Order number, flash sale ID... ('' = none)
+    operator_id  BIGINT NOT NULL DEFAULT 0,       -- Admin user ID (0 = system)
+    created_at   BIGINT NOT NULL                  -- Unix timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_inventory_movements_product ON inventory_movements(product_id, id DESC);
+
+COMMENT ON TABLE inventory_movements IS 'Ledger of product stock changes';
+
+-- Open the ledger of existing products with their current stock
+INSERT INTO inventory_movements (product_id, quantity, stock_after, reason, created_at)
+SELECT id, stock, stock, 'initial', EXTRACT(EPOCH FROM NOW())::BIGINT
+FROM products
+WHERE stock > 0 AND NOT EXISTS (SELECT 1 FROM inventory_movements im WHERE im.product_id = products.id);
@@ -0,0 +1,18 @@
+-- Migration: Create product bundle items table
+-- Date: 2026-10-18
+-- Description: Bundles (kits) are products sold as a single SKU made of component
+--              products. A bundle has no stock of its own, its availability is derived
+--              from the stock of its components
+
+CREATE TABLE IF NOT EXISTS product_bundle_items (
+    bundle_id    BIGINT NOT NULL REFERENCES products(id),
+    component_id BIGINT NOT NULL REFERENCES products(id),
+    quantity     BIGINT NOT NULL CHECK (quantity > 0), -- Units of the component in one bundle
+
+    PRIMARY KEY (bundle_id, component_id),
+    CHECK (bundle_id <> component_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bundle_items_component ON product_bundle_items(component_id);
+
+COMMENT ON TABLE product_bundle_items IS 'Components of bundle products, stock is deducted from the components when a bundle is ordered';
@@ -0,0 +1,42 @@
+-- Migration: Add price history and scheduled price changes
+-- Date: 2026-10-18
+-- Description: Every product price change is recorded in product_price_history,
+--              admins can schedule price changes that the product service applies and reverts
+
+-- Price history (one row per change of products.price)
+CREATE TABLE IF NOT EXISTS product_price_history (
+    id          BIGSERIAL PRIMARY KEY,
+    product_id  BIGINT NOT NULL REFERENCES products(id),
+    old_price   DECIMAL(10,2) NOT NULL,
+    new_price   DECIMAL(10,2) NOT NULL,
+    operator_id BIGINT NOT NULL DEFAULT 0,       -- Admin user ID (0 = system)
+    source      VARCHAR(20) NOT NULL,            -- manual, import, schedule_start, schedule_end, schedule_cancel
+    schedule_id BIGINT NOT NULL DEFAULT 0,       -- Related price schedule (0 = none)
+    created_at  BIGINT NOT NULL                  -- Unix timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_price_history_product ON product_price_history(product_id, created_at DESC);
+
+COMMENT ON TABLE product_price_history IS 'Audit log of product price changes';
+
+-- Scheduled price changes
+CREATE TABLE IF NOT EXISTS product_price_schedules (
+    id             BIGSERIAL PRIMARY KEY,
+    product_id     BIGINT NOT NULL REFERENCES products(id),
+    price          DECIMAL(10,2) NOT NULL CHECK (price > 0),
+    original_price DECIMAL(10,2) NOT NULL DEFAULT 0, -- Price before start, restored at end_at
+    start_at       BIGINT NOT NULL,
+    end_at         BIGINT NOT NULL DEFAULT 0,        -- 0 = permanent change
+    status         INT NOT NULL DEFAULT 1,           -- 1:pending, 2:active, 3:finished, 4:cancelled
+    operator_id    BIGINT NOT NULL DEFAULT 0,
+    created_at     BIGINT NOT NULL,
+    updated_at     BIGINT NOT NULL,
+
+    CONSTRAINT price_schedules_end_after_start CHECK (end_at = 0 OR end_at > start_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_price_schedules_product ON product_price_schedules(product_id, start_at);
+CREATE INDEX IF NOT EXISTS idx_price_schedules_pending ON product_price_schedules(start_at) WHERE status = 1;
+CREATE INDEX IF NOT EXISTS idx_price_schedules_active ON product_price_schedules(end_at) WHERE status = 2;
+
+COMMENT ON TABLE product_price_schedules IS 'Scheduled product price changes (sales, permanent price changes)';
@@ -0,0 +1,34 @@
+-- Migration: Add frequently bought together tables
+-- Date: 2026-10-18
+-- Description: Co-purchase pairs for product recommendations, filled by the
+--              related products rebuild command and order.created events
+
+-- Products bought together, counted from orders (every pair is stored in both directions)
+CREATE TABLE IF NOT EXISTS product_co_purchases (
+    product_id BIGINT NOT NULL,
+    related_id BIGINT NOT NULL,
+    orders     BIGINT NOT NULL DEFAULT 0, -- Orders containing both products
+    updated_at BIGINT NOT NULL,           -- Unix timestamp
+
+    PRIMARY KEY (product_id, related_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_co_purchases_ranking ON product_co_purchases(product_id, orders DESC);
+
+COMMENT ON TABLE product_co_purchases IS 'Frequently bought together pairs, rebuilt offline and updated from order.created events';
+
+-- Highest order ID counted by the last rebuild, events of older orders are skipped
+CREATE TABLE IF NOT EXISTS co_purchase_watermark (
+    id         SMALLINT PRIMARY KEY DEFAULT 1 CHECK (id = 1), -- Single row
+    order_id   BIGINT NOT NULL DEFAULT 0,
+    updated_at BIGINT NOT NULL DEFAULT 0 -- Unix timestamp
+);
+
+INSERT INTO co_purchase_watermark (id, order_id, updated_at) VALUES (1, 0, 0) ON CONFLICT (id) DO NOTHING;
+
+-- Orders above the watermark already counted in product_co_purchases, makes redelivered events a no-op
+-- Rows at or below the watermark are removed by the rebuild
+CREATE TABLE IF NOT EXISTS co_purchase_orders (
+    order_id   BIGINT PRIMARY KEY,
+    created_at BIGINT NOT NULL -- Unix timestamp
+);
@@ -0,0 +1,48 @@
+-- Migration: Add warehouses and per-warehouse stock
+-- Date: 2026-10-18
+-- Description: Stock is kept per warehouse, products.stock stays the total.
+--              Existing stock is moved into a default warehouse
+
+-- Warehouses (stock is kept per warehouse, products.stock is the sum over all of them)
+CREATE TABLE IF NOT EXISTS warehouses (
+    id         BIGSERIAL PRIMARY KEY,
+    code       VARCHAR(32) NOT NULL UNIQUE,     -- Short code, e.g. SH01
+    name       VARCHAR(100) NOT NULL,
+    region     VARCHAR(64) NOT NULL DEFAULT '', -- Matched against the customer region by nearest allocation
+    priority   INT NOT NULL DEFAULT 0,          -- Lower ships first, the first warehouse is the default one
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT NOT NULL
+);
+
+COMMENT ON TABLE warehouses IS 'Stock locations';
+
+-- Per-warehouse stock levels
+CREATE TABLE IF NOT EXISTS warehouse_stock (
+    warehouse_id BIGINT NOT NULL REFERENCES warehouses(id),
+    product_id   BIGINT NOT NULL REFERENCES products(id),
+    stock        BIGINT NOT NULL DEFAULT 0 CHECK (stock >= 0),
+    updated_at   BIGINT NOT NULL,
+
+    PRIMARY KEY (warehouse_id, product_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_warehouse_stock_product ON warehouse_stock(product_id);
+
+COMMENT ON TABLE warehouse_stock IS 'Stock of each product per warehouse';
+
+-- Inventory movements record the warehouse whose stock changed
+ALTER TABLE inventory_movements ADD COLUMN IF NOT EXISTS warehouse_id BIGINT NOT NULL DEFAULT 0;
+
+-- Default warehouse holding all existing stock
+INSERT INTO warehouses (code, name, region, priority, created_at, updated_at)
+VALUES ('DEFAULT', 'Default warehouse', '', 0, EXTRACT(EPOCH FROM NOW())::BIGINT, EXTRACT(EPOCH FROM NOW())::BIGINT)
+ON CONFLICT (code) DO NOTHING;
+
+INSERT INTO warehouse_stock (warehouse_id, product_id, stock, updated_at)
+SELECT w.id, p.id, p.stock, EXTRACT(EPOCH FROM NOW())::BIGINT
+FROM products p, warehouses w
+WHERE w.code = 'DEFAULT' AND p.stock > 0
+ON CONFLICT (warehouse_id, product_id) DO NOTHING;
+
+UPDATE inventory_movements SET warehouse_id = (SELECT id FROM warehouses WHERE code = 'DEFAULT')
+WHERE warehouse_id = 0;
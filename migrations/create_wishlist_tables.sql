@@ -0,0 +1,32 @@
+-- Migration: Create wishlist tables
+-- Date: 2026-10-18
+-- Description: Wishlists and the "Saved for later" list live in Postgres (letsgo_cart),
+--              so parked items survive the Redis cart expiration. A user has one
+--              default list, created on first use, and any number of named lists
+
+CREATE TABLE IF NOT EXISTS wishlists (
+    id         BIGSERIAL PRIMARY KEY,
+    user_id    BIGINT NOT NULL,
+    name       VARCHAR(100) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE, -- The "Saved for later" list
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    UNIQUE (user_id, name)
+);
+
+-- At most one default list per user
+CREATE UNIQUE INDEX IF NOT EXISTS idx_wishlists_default ON wishlists(user_id) WHERE is_default;
+
+CREATE TABLE IF NOT EXISTS wishlist_items (
+    wishlist_id BIGINT NOT NULL REFERENCES wishlists(id) ON DELETE CASCADE,
+    product_id  BIGINT NOT NULL,
+    quantity    BIGINT NOT NULL DEFAULT 1 CHECK (quantity > 0),
+    saved_price DECIMAL(10,2) NOT NULL, -- Price when the item was saved
+    added_at    TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (wishlist_id, product_id)
+);
+
+COMMENT ON TABLE wishlists IS 'Named wishlists and the saved-for-later list of each user';
+COMMENT ON TABLE wishlist_items IS 'Products parked in a wishlist, moved to the Redis cart on demand';
@@ -0,0 +1,140 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ CartModel = (*customCartModel)(nil)
+
+type (
+	// CartModel is an interface for the durable copy of user carts kept behind Redis
+	CartModel interface {
+		// Upsert saves the lines of a user cart, keyed by their Redis hash field
+		Upsert(ctx context.Context, userId int64, items map[string]string) error
+
+		// FindOne returns the lines of a user cart saved at or after since
+		FindOne(ctx context.Context, userId int64, since time.Time) (map[string]string, error)
+
+		// Delete removes the saved copy of a user cart
+		Delete(ctx context.Context, userId int64) error
+
+		// DeleteBefore removes carts last saved before the given time
+		DeleteBefore(ctx context.Context, before time.Time) (int64, error)
+
+		// MarkOrdered records that the user created an order
+		MarkOrdered(ctx context.Context, userId int64, orderedAt time.Time) error
+
+		// FindAbandoned returns carts not changed since idleBefore and not followed by an order,
+		// leaving out carts whose current state was already checked
+		FindAbandoned(ctx context.Context, idleBefore time.Time, limit int) ([]*Cart, error)
+
+		// MarkAbandoned records that the cart state saved at updatedAt was checked, hash is the reported contents
+		MarkAbandoned(ctx context.Context, userId int64, updatedAt time.Time, hash string) error
+	}
+
+	customCartModel struct {
+		conn sqlx.SqlConn
+	}
+)
+
+// NewCartModel returns a CartModel instance
+func NewCartModel(conn sqlx.SqlConn) CartModel {
+	return &customCartModel{
+		conn: conn,
+	}
+}
+
+// Upsert saves the lines of a user cart, the line JSON is stored as is
+func (m *customCartModel) Upsert(ctx context.Context, userId int64, items map[string]string) error {
+	lines := make(map[string]json.RawMessage, len(items))
+	for field, item := range items {
+		lines[field] = json.RawMessage(item)
+	}
+
+	data, err := json.Marshal(lines)
+	if err != nil {
+		return err
+	}
+
+	_, err = m.conn.ExecCtx(ctx, `INSERT INTO carts (user_id, items, updated_at) VALUES ($1, $2, NOW())
+		ON CONFLICT (user_id) DO UPDATE SET items = EXCLUDED.items, updated_at = EXCLUDED.updated_at`,
+		userId, string(data))
+	return err
+}
+
+// FindOne returns the lines of a user cart, carts older than since are treated as gone
+func (m *customCartModel) FindOne(ctx context.Context, userId int64, since time.Time) (map[string]string, error) {
+	var data string
+	err := m.conn.QueryRowCtx(ctx, &data, `SELECT items FROM carts WHERE user_id = $1 AND updated_at >= $2`, userId, since)
+	switch err {
+	case nil:
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+
+	var lines map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(data), &lines); err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]string, len(lines))
+	for field, line := range lines {
+		items[field] = string(line)
+	}
+
+	return items, nil
+}
+
+// Delete removes the saved copy of a user cart
+func (m *customCartModel) Delete(ctx context.Context, userId int64) error {
+	_, err := m.conn.ExecCtx(ctx, `DELETE FROM carts WHERE user_id = $1`, userId)
+	return err
+}
+
+// DeleteBefore removes carts last saved before the given time and returns how many were removed
+func (m *customCartModel) DeleteBefore(ctx context.Context, before time.Time) (int64, error) {
+	result, err := m.conn.ExecCtx(ctx, `DELETE FROM carts WHERE updated_at < $1`, before)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// MarkOrdered records the user's latest order time, users without a saved cart are ignored
+func (m *customCartModel) MarkOrdered(ctx context.Context, userId int64, orderedAt time.Time) error {
+	_, err := m.conn.ExecCtx(ctx, `UPDATE carts SET ordered_at = GREATEST(ordered_at, $2) WHERE user_id = $1`,
+		userId, orderedAt)
+	return err
+}
+
+// FindAbandoned returns idle carts, oldest first
+// A cart is checked again only after it changed, abandoned_at holds the updated_at it was checked at
+func (m *customCartModel) FindAbandoned(ctx context.Context, idleBefore time.Time, limit int) ([]*Cart, error) {
+	query := `SELECT user_id, items, updated_at, abandoned_hash FROM carts
+		WHERE updated_at < $1
+		  AND (ordered_at IS NULL OR ordered_at < updated_at)
+		  AND abandoned_at IS DISTINCT FROM updated_at
+		ORDER BY updated_at
+		LIMIT $2`
+
+	var carts []*Cart
+	if err := m.conn.QueryRowsCtx(ctx, &carts, query, idleBefore, limit); err != nil {
+		return nil, err
+	}
+
+	return carts, nil
+}
+
+// MarkAbandoned records the checked cart state, nothing is recorded if the cart changed in the meantime
+func (m *customCartModel) MarkAbandoned(ctx context.Context, userId int64, updatedAt time.Time, hash string) error {
+	_, err := m.conn.ExecCtx(ctx, `UPDATE carts SET abandoned_at = updated_at, abandoned_hash = $3
+		WHERE user_id = $1 AND updated_at = $2`, userId, updatedAt, hash)
+	return err
+}
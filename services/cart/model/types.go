@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// Wishlist represents a named list of products parked by a user
+type Wishlist struct {
+	Id        int64     `db:"id"`
+	UserId    int64     `db:"user_id"`
+	Name      string    `db:"name"`
+	IsDefault bool      `db:"is_default"` // The "Saved for later" list
+	ItemCount int64     `db:"item_count"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// WishlistItem represents a product in a wishlist
+type WishlistItem struct {
+	WishlistId int64     `db:"wishlist_id"`
+	ProductId  int64     `db:"product_id"`
+	Quantity   int64     `db:"quantity"`
+	SavedPrice float64   `db:"saved_price"` // Price when the item was saved
+	AddedAt    time.Time `db:"added_at"`
+}
+
+// Cart represents the saved copy of a user cart
+type Cart struct {
+	UserId        int64     `db:"user_id"`
+	Items         string    `db:"items"`      // JSON object, Redis hash field -> cart line
+	UpdatedAt     time.Time `db:"updated_at"` // Last change of the cart
+	AbandonedHash string    `db:"abandoned_hash"`
+}
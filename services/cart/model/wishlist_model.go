@@ -0,0 +1,295 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ WishlistModel = (*customWishlistModel)(nil)
+
+type (
+	// WishlistModel is an interface for wishlists and their items
+	WishlistModel interface {
+		// Insert creates a named wishlist, a user has at most maxLists lists
+		Insert(ctx context.Context, userId int64, name string, maxLists int) (*Wishlist, error)
+
+		// FindOrCreateDefault returns the "Saved for later" list of a user, creating it on first use
+		FindOrCreateDefault(ctx context.Context, userId int64, name string) (*Wishlist, error)
+
+		// FindOne finds a wishlist owned by a user
+		FindOne(ctx context.Context, id, userId int64) (*Wishlist, error)
+
+		// FindByUserId returns all wishlists of a user, the default list first
+		FindByUserId(ctx context.Context, userId int64) ([]*Wishlist, error)
+
+		// Rename changes the name of a named wishlist
+		Rename(ctx context.Context, id, userId int64, name string) error
+
+		// Delete removes a wishlist together with its items
+		Delete(ctx context.Context, id, userId int64) error
+
+		// FindItems returns the items of a wishlist, most recently saved first
+		FindItems(ctx context.Context, wishlistId int64) ([]*WishlistItem, error)
+
+		// FindItem finds a product in a wishlist
+		FindItem(ctx context.Context, wishlistId, productId int64) (*WishlistItem, error)
+
+		// AddItem saves a product to a wishlist, quantities of a product saved twice are added up
+		AddItem(ctx context.Context, item *WishlistItem, maxItems, maxQuantity int) error
+
+		// RemoveItem removes a product from a wishlist
+		RemoveItem(ctx context.Context, wishlistId, productId int64) error
+	}
+
+	customWishlistModel struct {
+		conn sqlx.SqlConn
+	}
+)
+
+// NewWishlistModel returns a WishlistModel instance
+func NewWishlistModel(conn sqlx.SqlConn) WishlistModel {
+	return &customWishlistModel{
+		conn: conn,
+	}
+}
+
+const wishlistFields = `w.id, w.user_id, w.name, w.is_default, w.created_at, w.updated_at,
+	(SELECT COUNT(*) FROM wishlist_items i WHERE i.wishlist_id = w.id) AS item_count`
+
+const wishlistItemFields = `wishlist_id, product_id, quantity, saved_price, added_at`
+
+// Insert creates a named wishlist unless the user already has maxLists lists
+func (m *customWishlistModel) Insert(ctx context.Context, userId int64, name string, maxLists int) (*Wishlist, error) {
+	query := `INSERT INTO wishlists (user_id, name)
+		SELECT $1, $2
+		WHERE (SELECT COUNT(*) FROM wishlists WHERE user_id = $1 AND NOT is_default) < $3
+		RETURNING id`
+
+	var id int64
+	err := m.conn.QueryRowCtx(ctx, &id, query, userId, name, maxLists)
+	switch {
+	case err == sqlx.ErrNotFound:
+		return nil, ErrWishlistLimit
+	case err != nil:
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return nil, ErrDuplicateWishlistName
+		}
+		return nil, err
+	}
+
+	return m.FindOne(ctx, id, userId)
+}
+
+// FindOrCreateDefault returns the default list of a user
+// The default list does not count against the list limit
+func (m *customWishlistModel) FindOrCreateDefault(ctx context.Context, userId int64, name string) (*Wishlist, error) {
+	_, err := m.conn.ExecCtx(ctx, `INSERT INTO wishlists (user_id, name, is_default) VALUES ($1, $2, TRUE)
+		ON CONFLICT DO NOTHING`, userId, name)
+	if err != nil {
+		return nil, err
+	}
+
+	query := `SELECT ` + wishlistFields + ` FROM wishlists w WHERE w.user_id = $1 AND w.is_default`
+
+	var wishlist Wishlist
+	err = m.conn.QueryRowCtx(ctx, &wishlist, query, userId)
+	switch err {
+	case nil:
+		return &wishlist, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindOne finds a wishlist by ID, lists of other users are not found
+func (m *customWishlistModel) FindOne(ctx context.Context, id, userId int64) (*Wishlist, error) {
+	query := `SELECT ` + wishlistFields + ` FROM wishlists w WHERE w.id = $1 AND w.user_id = $2`
+
+	var wishlist Wishlist
+	err := m.conn.QueryRowCtx(ctx, &wishlist, query, id, userId)
+	switch err {
+	case nil:
+		return &wishlist, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// FindByUserId returns all wishlists of a user, the default list first and then by creation time
+func (m *customWishlistModel) FindByUserId(ctx context.Context, userId int64) ([]*Wishlist, error) {
+	query := `SELECT ` + wishlistFields + ` FROM wishlists w WHERE w.user_id = $1 ORDER BY w.is_default DESC, w.id`
+
+	var wishlists []*Wishlist
+	if err := m.conn.QueryRowsCtx(ctx, &wishlists, query, userId); err != nil {
+		return nil, err
+	}
+
+	return wishlists, nil
+}
+
+// Rename changes the name of a wishlist, the default list keeps its name
+func (m *customWishlistModel) Rename(ctx context.Context, id, userId int64, name string) error {
+	result, err := m.conn.ExecCtx(ctx, `UPDATE wishlists SET name = $1, updated_at = NOW()
+		WHERE id = $2 AND user_id = $3 AND NOT is_default`, name, id, userId)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return ErrDuplicateWishlistName
+		}
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Delete removes a wishlist, its items are deleted by the foreign key cascade
+func (m *customWishlistModel) Delete(ctx context.Context, id, userId int64) error {
+	result, err := m.conn.ExecCtx(ctx, `DELETE FROM wishlists WHERE id = $1 AND user_id = $2`, id, userId)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// FindItems returns the items of a wishlist, most recently saved first
+func (m *customWishlistModel) FindItems(ctx context.Context, wishlistId int64) ([]*WishlistItem, error) {
+	query := `SELECT ` + wishlistItemFields + ` FROM wishlist_items WHERE wishlist_id = $1 ORDER BY added_at DESC, product_id`
+
+	var items []*WishlistItem
+	if err := m.conn.QueryRowsCtx(ctx, &items, query, wishlistId); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// FindItem finds a product in a wishlist
+func (m *customWishlistModel) FindItem(ctx context.Context, wishlistId, productId int64) (*WishlistItem, error) {
+	query := `SELECT ` + wishlistItemFields + ` FROM wishlist_items WHERE wishlist_id = $1 AND product_id = $2`
+
+	var item WishlistItem
+	err := m.conn.QueryRowCtx(ctx, &item, query, wishlistId, productId)
+	switch err {
+	case nil:
+		return &item, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// AddItem saves a product to a wishlist in a transaction
+// The wishlist row is locked so concurrent saves cannot push it past maxItems
+func (m *customWishlistModel) AddItem(ctx context.Context, item *WishlistItem, maxItems, maxQuantity int) error {
+	db, err := m.conn.RawDB()
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var id int64
+	err = tx.QueryRowContext(ctx, `SELECT id FROM wishlists WHERE id = $1 FOR UPDATE`, item.WishlistId).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = ErrNotFound
+		}
+		return err
+	}
+
+	// Only a new product takes up a slot, saving a product again just adds to its quantity
+	var count, existing int64
+	err = tx.QueryRowContext(ctx, `SELECT COUNT(*), COUNT(*) FILTER (WHERE product_id = $2)
+		FROM wishlist_items WHERE wishlist_id = $1`, item.WishlistId, item.ProductId).Scan(&count, &existing)
+	if err != nil {
+		return err
+	}
+	if existing == 0 && count >= int64(maxItems) {
+		err = ErrWishlistFull
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO wishlist_items (wishlist_id, product_id, quantity, saved_price)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (wishlist_id, product_id) DO UPDATE
+		SET quantity = LEAST(wishlist_items.quantity + EXCLUDED.quantity, $5),
+			saved_price = EXCLUDED.saved_price,
+			added_at = NOW()`,
+		item.WishlistId, item.ProductId, item.Quantity, item.SavedPrice, maxQuantity)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE wishlists SET updated_at = NOW() WHERE id = $1`, item.WishlistId)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	return err
+}
+
+// RemoveItem removes a product from a wishlist
+func (m *customWishlistModel) RemoveItem(ctx context.Context, wishlistId, productId int64) error {
+	result, err := m.conn.ExecCtx(ctx, `DELETE FROM wishlist_items WHERE wishlist_id = $1 AND product_id = $2`,
+		wishlistId, productId)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ErrNotFound is returned when a wishlist or wishlist item does not exist
+var ErrNotFound = sqlx.ErrNotFound
+
+// ErrWishlistLimit is returned when a user already has the maximum number of wishlists
+var ErrWishlistLimit = fmt.Errorf("too many wishlists")
+
+// ErrWishlistFull is returned when a wishlist already has the maximum number of items
+var ErrWishlistFull = fmt.Errorf("wishlist is full")
+
+// ErrDuplicateWishlistName is returned when a user already has a wishlist with the name
+var ErrDuplicateWishlistName = fmt.Errorf("duplicate wishlist name")
@@ -6,6 +6,8 @@ import (
 
 	"letsgo/services/cart/rpc/cart"
 	"letsgo/services/cart/rpc/internal/config"
+	"letsgo/services/cart/rpc/internal/consumer"
+	"letsgo/services/cart/rpc/internal/job"
 	"letsgo/services/cart/rpc/internal/server"
 	"letsgo/services/cart/rpc/internal/svc"
 
@@ -24,6 +26,8 @@ func main() {
 	var c config.Config
 	conf.MustLoad(*configFile, &c)
 	ctx := svc.NewServiceContext(c)
+	defer ctx.KafkaProducer.Close()
+	defer ctx.CartEvents.Close()
 
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		cart.RegisterCartServer(grpcServer, server.NewCartServer(ctx))
@@ -34,6 +38,21 @@ func main() {
 	})
 	defer s.Stop()
 
+	// Write changed user carts to the database behind Redis
+	persistJob := job.NewPersistJob(ctx)
+	persistJob.Start()
+	defer persistJob.Stop()
+
+	// Publish cart.abandoned events for idle user carts
+	abandonJob := job.NewAbandonJob(ctx)
+	abandonJob.Start()
+	defer abandonJob.Stop()
+
+	// Record orders, carts of users who ordered since their last change are not abandoned
+	orderCreatedConsumer := consumer.NewOrderCreatedConsumer(ctx)
+	orderCreatedConsumer.Start()
+	defer orderCreatedConsumer.Stop()
+
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
 	s.Start()
 }
@@ -23,9 +23,10 @@ const (
 
 type AddToCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 0 = guest cart
 	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	TempCartId    string                 `protobuf:"bytes,4,opt,name=temp_cart_id,json=tempCartId,proto3" json:"temp_cart_id,omitempty"` // Guest cart identifier, used when user_id is 0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -81,6 +82,13 @@ func (x *AddToCartRequest) GetQuantity() int64 {
 	return 0
 }
 
+func (x *AddToCartRequest) GetTempCartId() string {
+	if x != nil {
+		return x.TempCartId
+	}
+	return ""
+}
+
 type AddToCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -127,7 +135,8 @@ func (x *AddToCartResponse) GetSuccess() bool {
 
 type GetCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // 0 = guest cart
+	TempCartId    string                 `protobuf:"bytes,2,opt,name=temp_cart_id,json=tempCartId,proto3" json:"temp_cart_id,omitempty"` // Guest cart identifier, used when user_id is 0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -169,11 +178,19 @@ func (x *GetCartRequest) GetUserId() int64 {
 	return 0
 }
 
+func (x *GetCartRequest) GetTempCartId() string {
+	if x != nil {
+		return x.TempCartId
+	}
+	return ""
+}
+
 type GetCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*CartItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalPrice    float64                `protobuf:"fixed64,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
-	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	TotalPrice    float64                `protobuf:"fixed64,2,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"` // Current prices of selected available lines
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`  // Units of selected available lines
+	HasChanges    bool                   `protobuf:"varint,4,opt,name=has_changes,json=hasChanges,proto3" json:"has_changes,omitempty"`  // Some line changed price, became unavailable or exceeds stock
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -229,11 +246,19 @@ func (x *GetCartResponse) GetTotalCount() int64 {
 	return 0
 }
 
+func (x *GetCartResponse) GetHasChanges() bool {
+	if x != nil {
+		return x.HasChanges
+	}
+	return false
+}
+
 type UpdateCartItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 0 = guest cart
 	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"` // New quantity
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                        // New quantity
+	TempCartId    string                 `protobuf:"bytes,4,opt,name=temp_cart_id,json=tempCartId,proto3" json:"temp_cart_id,omitempty"` // Guest cart identifier, used when user_id is 0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -289,6 +314,13 @@ func (x *UpdateCartItemRequest) GetQuantity() int64 {
 	return 0
 }
 
+func (x *UpdateCartItemRequest) GetTempCartId() string {
+	if x != nil {
+		return x.TempCartId
+	}
+	return ""
+}
+
 type UpdateCartItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -335,8 +367,9 @@ func (x *UpdateCartItemResponse) GetSuccess() bool {
 
 type RemoveCartItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 0 = guest cart
 	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	TempCartId    string                 `protobuf:"bytes,3,opt,name=temp_cart_id,json=tempCartId,proto3" json:"temp_cart_id,omitempty"` // Guest cart identifier, used when user_id is 0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -385,6 +418,13 @@ func (x *RemoveCartItemRequest) GetProductId() int64 {
 	return 0
 }
 
+func (x *RemoveCartItemRequest) GetTempCartId() string {
+	if x != nil {
+		return x.TempCartId
+	}
+	return ""
+}
+
 type RemoveCartItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -431,7 +471,8 @@ func (x *RemoveCartItemResponse) GetSuccess() bool {
 
 type ClearCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // 0 = guest cart
+	TempCartId    string                 `protobuf:"bytes,2,opt,name=temp_cart_id,json=tempCartId,proto3" json:"temp_cart_id,omitempty"` // Guest cart identifier, used when user_id is 0
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -473,6 +514,13 @@ func (x *ClearCartRequest) GetUserId() int64 {
 	return 0
 }
 
+func (x *ClearCartRequest) GetTempCartId() string {
+	if x != nil {
+		return x.TempCartId
+	}
+	return ""
+}
+
 type ClearCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -573,6 +621,8 @@ func (x *MergeCartRequest) GetTempCartId() string {
 type MergeCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Merged        int64                  `protobuf:"varint,2,opt,name=merged,proto3" json:"merged,omitempty"`   // Guest lines merged into the user cart
+	Skipped       int64                  `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"` // Guest lines dropped because the user cart was full
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -614,34 +664,43 @@ func (x *MergeCartResponse) GetSuccess() bool {
 	return false
 }
 
-// Cart item model
-type CartItem struct {
+func (x *MergeCartResponse) GetMerged() int64 {
+	if x != nil {
+		return x.Merged
+	}
+	return 0
+}
+
+func (x *MergeCartResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type AcceptCartPricesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Image         string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
-	Stock         int64                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`         // Current available stock
-	Available     bool                   `protobuf:"varint,7,opt,name=available,proto3" json:"available,omitempty"` // Is product still available?
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                    // 0 = guest cart
+	TempCartId    string                 `protobuf:"bytes,2,opt,name=temp_cart_id,json=tempCartId,proto3" json:"temp_cart_id,omitempty"`       // Guest cart identifier, used when user_id is 0
+	ProductIds    []int64                `protobuf:"varint,3,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // Lines to accept, empty = all lines
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CartItem) Reset() {
-	*x = CartItem{}
+func (x *AcceptCartPricesRequest) Reset() {
+	*x = AcceptCartPricesRequest{}
 	mi := &file_cart_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CartItem) String() string {
+func (x *AcceptCartPricesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CartItem) ProtoMessage() {}
+func (*AcceptCartPricesRequest) ProtoMessage() {}
 
-func (x *CartItem) ProtoReflect() protoreflect.Message {
+func (x *AcceptCartPricesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cart_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -653,104 +712,2976 @@ func (x *CartItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
-func (*CartItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use AcceptCartPricesRequest.ProtoReflect.Descriptor instead.
+func (*AcceptCartPricesRequest) Descriptor() ([]byte, []int) {
 	return file_cart_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *CartItem) GetProductId() int64 {
+func (x *AcceptCartPricesRequest) GetUserId() int64 {
 	if x != nil {
-		return x.ProductId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *CartItem) GetName() string {
+func (x *AcceptCartPricesRequest) GetTempCartId() string {
 	if x != nil {
-		return x.Name
+		return x.TempCartId
 	}
 	return ""
 }
 
-func (x *CartItem) GetPrice() float64 {
+func (x *AcceptCartPricesRequest) GetProductIds() []int64 {
 	if x != nil {
-		return x.Price
+		return x.ProductIds
+	}
+	return nil
+}
+
+type AcceptCartPricesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Updated       int64                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"` // Lines whose saved price was updated
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptCartPricesResponse) Reset() {
+	*x = AcceptCartPricesResponse{}
+	mi := &file_cart_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptCartPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptCartPricesResponse) ProtoMessage() {}
+
+func (x *AcceptCartPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptCartPricesResponse.ProtoReflect.Descriptor instead.
+func (*AcceptCartPricesResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AcceptCartPricesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *AcceptCartPricesResponse) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
 	}
 	return 0
 }
 
-func (x *CartItem) GetQuantity() int64 {
+type SelectCartItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // 0 = guest cart
+	TempCartId    string                 `protobuf:"bytes,2,opt,name=temp_cart_id,json=tempCartId,proto3" json:"temp_cart_id,omitempty"` // Guest cart identifier, used when user_id is 0
+	ProductIds    []int64                `protobuf:"varint,3,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Selected      bool                   `protobuf:"varint,4,opt,name=selected,proto3" json:"selected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectCartItemsRequest) Reset() {
+	*x = SelectCartItemsRequest{}
+	mi := &file_cart_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectCartItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectCartItemsRequest) ProtoMessage() {}
+
+func (x *SelectCartItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[14]
 	if x != nil {
-		return x.Quantity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectCartItemsRequest.ProtoReflect.Descriptor instead.
+func (*SelectCartItemsRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SelectCartItemsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *CartItem) GetImage() string {
+func (x *SelectCartItemsRequest) GetTempCartId() string {
 	if x != nil {
-		return x.Image
+		return x.TempCartId
 	}
 	return ""
 }
 
-func (x *CartItem) GetStock() int64 {
+func (x *SelectCartItemsRequest) GetProductIds() []int64 {
 	if x != nil {
-		return x.Stock
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *SelectCartItemsRequest) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+type SelectCartItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Updated       int64                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"` // Lines whose selection changed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectCartItemsResponse) Reset() {
+	*x = SelectCartItemsResponse{}
+	mi := &file_cart_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectCartItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectCartItemsResponse) ProtoMessage() {}
+
+func (x *SelectCartItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectCartItemsResponse.ProtoReflect.Descriptor instead.
+func (*SelectCartItemsResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SelectCartItemsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SelectCartItemsResponse) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
 	}
 	return 0
 }
 
-func (x *CartItem) GetAvailable() bool {
+type SelectAllCartItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // 0 = guest cart
+	TempCartId    string                 `protobuf:"bytes,2,opt,name=temp_cart_id,json=tempCartId,proto3" json:"temp_cart_id,omitempty"` // Guest cart identifier, used when user_id is 0
+	Selected      bool                   `protobuf:"varint,3,opt,name=selected,proto3" json:"selected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectAllCartItemsRequest) Reset() {
+	*x = SelectAllCartItemsRequest{}
+	mi := &file_cart_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectAllCartItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectAllCartItemsRequest) ProtoMessage() {}
+
+func (x *SelectAllCartItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[16]
 	if x != nil {
-		return x.Available
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectAllCartItemsRequest.ProtoReflect.Descriptor instead.
+func (*SelectAllCartItemsRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SelectAllCartItemsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SelectAllCartItemsRequest) GetTempCartId() string {
+	if x != nil {
+		return x.TempCartId
+	}
+	return ""
+}
+
+func (x *SelectAllCartItemsRequest) GetSelected() bool {
+	if x != nil {
+		return x.Selected
 	}
 	return false
 }
 
-var File_cart_proto protoreflect.FileDescriptor
+type SelectAllCartItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Updated       int64                  `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"` // Lines whose selection changed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_cart_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"cart.proto\x12\x04cart\"f\n" +
-	"\x10AddToCartRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x03R\bquantity\"-\n" +
-	"\x11AddToCartResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\")\n" +
-	"\x0eGetCartRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"y\n" +
-	"\x0fGetCartResponse\x12$\n" +
-	"\x05items\x18\x01 \x03(\v2\x0e.cart.CartItemR\x05items\x12\x1f\n" +
-	"\vtotal_price\x18\x02 \x01(\x01R\n" +
-	"totalPrice\x12\x1f\n" +
-	"\vtotal_count\x18\x03 \x01(\x03R\n" +
-	"totalCount\"k\n" +
-	"\x15UpdateCartItemRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x03R\bquantity\"2\n" +
-	"\x16UpdateCartItemResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"O\n" +
-	"\x15RemoveCartItemRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\x03R\tproductId\"2\n" +
-	"\x16RemoveCartItemResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"+\n" +
-	"\x10ClearCartRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"-\n" +
-	"\x11ClearCartResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"M\n" +
-	"\x10MergeCartRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
-	"\ftemp_cart_id\x18\x02 \x01(\tR\n" +
+func (x *SelectAllCartItemsResponse) Reset() {
+	*x = SelectAllCartItemsResponse{}
+	mi := &file_cart_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectAllCartItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectAllCartItemsResponse) ProtoMessage() {}
+
+func (x *SelectAllCartItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectAllCartItemsResponse.ProtoReflect.Descriptor instead.
+func (*SelectAllCartItemsResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SelectAllCartItemsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SelectAllCartItemsResponse) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type GetCheckoutItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCheckoutItemsRequest) Reset() {
+	*x = GetCheckoutItemsRequest{}
+	mi := &file_cart_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCheckoutItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCheckoutItemsRequest) ProtoMessage() {}
+
+func (x *GetCheckoutItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCheckoutItemsRequest.ProtoReflect.Descriptor instead.
+func (*GetCheckoutItemsRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetCheckoutItemsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetCheckoutItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CheckoutItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Selected lines, oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCheckoutItemsResponse) Reset() {
+	*x = GetCheckoutItemsResponse{}
+	mi := &file_cart_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCheckoutItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCheckoutItemsResponse) ProtoMessage() {}
+
+func (x *GetCheckoutItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCheckoutItemsResponse.ProtoReflect.Descriptor instead.
+func (*GetCheckoutItemsResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetCheckoutItemsResponse) GetItems() []*CheckoutItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CheckoutItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckoutItem) Reset() {
+	*x = CheckoutItem{}
+	mi := &file_cart_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckoutItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckoutItem) ProtoMessage() {}
+
+func (x *CheckoutItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckoutItem.ProtoReflect.Descriptor instead.
+func (*CheckoutItem) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CheckoutItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CheckoutItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RemoveCartItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductIds    []int64                `protobuf:"varint,2,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCartItemsRequest) Reset() {
+	*x = RemoveCartItemsRequest{}
+	mi := &file_cart_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCartItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCartItemsRequest) ProtoMessage() {}
+
+func (x *RemoveCartItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCartItemsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCartItemsRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RemoveCartItemsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveCartItemsRequest) GetProductIds() []int64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type RemoveCartItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCartItemsResponse) Reset() {
+	*x = RemoveCartItemsResponse{}
+	mi := &file_cart_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCartItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCartItemsResponse) ProtoMessage() {}
+
+func (x *RemoveCartItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCartItemsResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCartItemsResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RemoveCartItemsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreateWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWishlistRequest) Reset() {
+	*x = CreateWishlistRequest{}
+	mi := &file_cart_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWishlistRequest) ProtoMessage() {}
+
+func (x *CreateWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWishlistRequest.ProtoReflect.Descriptor instead.
+func (*CreateWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateWishlistRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateWishlistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wishlist      *Wishlist              `protobuf:"bytes,1,opt,name=wishlist,proto3" json:"wishlist,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWishlistResponse) Reset() {
+	*x = CreateWishlistResponse{}
+	mi := &file_cart_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWishlistResponse) ProtoMessage() {}
+
+func (x *CreateWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWishlistResponse.ProtoReflect.Descriptor instead.
+func (*CreateWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateWishlistResponse) GetWishlist() *Wishlist {
+	if x != nil {
+		return x.Wishlist
+	}
+	return nil
+}
+
+type ListWishlistsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistsRequest) Reset() {
+	*x = ListWishlistsRequest{}
+	mi := &file_cart_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistsRequest) ProtoMessage() {}
+
+func (x *ListWishlistsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistsRequest.ProtoReflect.Descriptor instead.
+func (*ListWishlistsRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListWishlistsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListWishlistsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wishlists     []*Wishlist            `protobuf:"bytes,1,rep,name=wishlists,proto3" json:"wishlists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWishlistsResponse) Reset() {
+	*x = ListWishlistsResponse{}
+	mi := &file_cart_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWishlistsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWishlistsResponse) ProtoMessage() {}
+
+func (x *ListWishlistsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWishlistsResponse.ProtoReflect.Descriptor instead.
+func (*ListWishlistsResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListWishlistsResponse) GetWishlists() []*Wishlist {
+	if x != nil {
+		return x.Wishlists
+	}
+	return nil
+}
+
+type RenameWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WishlistId    int64                  `protobuf:"varint,2,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameWishlistRequest) Reset() {
+	*x = RenameWishlistRequest{}
+	mi := &file_cart_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameWishlistRequest) ProtoMessage() {}
+
+func (x *RenameWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameWishlistRequest.ProtoReflect.Descriptor instead.
+func (*RenameWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RenameWishlistRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RenameWishlistRequest) GetWishlistId() int64 {
+	if x != nil {
+		return x.WishlistId
+	}
+	return 0
+}
+
+func (x *RenameWishlistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RenameWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenameWishlistResponse) Reset() {
+	*x = RenameWishlistResponse{}
+	mi := &file_cart_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenameWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameWishlistResponse) ProtoMessage() {}
+
+func (x *RenameWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameWishlistResponse.ProtoReflect.Descriptor instead.
+func (*RenameWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RenameWishlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DeleteWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WishlistId    int64                  `protobuf:"varint,2,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"` // 0 = Saved for later
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWishlistRequest) Reset() {
+	*x = DeleteWishlistRequest{}
+	mi := &file_cart_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWishlistRequest) ProtoMessage() {}
+
+func (x *DeleteWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWishlistRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteWishlistRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteWishlistRequest) GetWishlistId() int64 {
+	if x != nil {
+		return x.WishlistId
+	}
+	return 0
+}
+
+type DeleteWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWishlistResponse) Reset() {
+	*x = DeleteWishlistResponse{}
+	mi := &file_cart_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWishlistResponse) ProtoMessage() {}
+
+func (x *DeleteWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWishlistResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeleteWishlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WishlistId    int64                  `protobuf:"varint,2,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"` // 0 = Saved for later
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWishlistRequest) Reset() {
+	*x = GetWishlistRequest{}
+	mi := &file_cart_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWishlistRequest) ProtoMessage() {}
+
+func (x *GetWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWishlistRequest.ProtoReflect.Descriptor instead.
+func (*GetWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetWishlistRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetWishlistRequest) GetWishlistId() int64 {
+	if x != nil {
+		return x.WishlistId
+	}
+	return 0
+}
+
+type GetWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wishlist      *Wishlist              `protobuf:"bytes,1,opt,name=wishlist,proto3" json:"wishlist,omitempty"`
+	Items         []*WishlistItem        `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // Most recently saved first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWishlistResponse) Reset() {
+	*x = GetWishlistResponse{}
+	mi := &file_cart_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWishlistResponse) ProtoMessage() {}
+
+func (x *GetWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWishlistResponse.ProtoReflect.Descriptor instead.
+func (*GetWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetWishlistResponse) GetWishlist() *Wishlist {
+	if x != nil {
+		return x.Wishlist
+	}
+	return nil
+}
+
+func (x *GetWishlistResponse) GetItems() []*WishlistItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AddToWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WishlistId    int64                  `protobuf:"varint,2,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"` // 0 = Saved for later
+	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToWishlistRequest) Reset() {
+	*x = AddToWishlistRequest{}
+	mi := &file_cart_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWishlistRequest) ProtoMessage() {}
+
+func (x *AddToWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWishlistRequest.ProtoReflect.Descriptor instead.
+func (*AddToWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AddToWishlistRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddToWishlistRequest) GetWishlistId() int64 {
+	if x != nil {
+		return x.WishlistId
+	}
+	return 0
+}
+
+func (x *AddToWishlistRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *AddToWishlistRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type AddToWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddToWishlistResponse) Reset() {
+	*x = AddToWishlistResponse{}
+	mi := &file_cart_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddToWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddToWishlistResponse) ProtoMessage() {}
+
+func (x *AddToWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddToWishlistResponse.ProtoReflect.Descriptor instead.
+func (*AddToWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *AddToWishlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RemoveFromWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WishlistId    int64                  `protobuf:"varint,2,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"` // 0 = Saved for later
+	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWishlistRequest) Reset() {
+	*x = RemoveFromWishlistRequest{}
+	mi := &file_cart_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWishlistRequest) ProtoMessage() {}
+
+func (x *RemoveFromWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWishlistRequest.ProtoReflect.Descriptor instead.
+func (*RemoveFromWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RemoveFromWishlistRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RemoveFromWishlistRequest) GetWishlistId() int64 {
+	if x != nil {
+		return x.WishlistId
+	}
+	return 0
+}
+
+func (x *RemoveFromWishlistRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type RemoveFromWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveFromWishlistResponse) Reset() {
+	*x = RemoveFromWishlistResponse{}
+	mi := &file_cart_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveFromWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveFromWishlistResponse) ProtoMessage() {}
+
+func (x *RemoveFromWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveFromWishlistResponse.ProtoReflect.Descriptor instead.
+func (*RemoveFromWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RemoveFromWishlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type MoveToWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	WishlistId    int64                  `protobuf:"varint,3,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"` // 0 = Saved for later
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveToWishlistRequest) Reset() {
+	*x = MoveToWishlistRequest{}
+	mi := &file_cart_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveToWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveToWishlistRequest) ProtoMessage() {}
+
+func (x *MoveToWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveToWishlistRequest.ProtoReflect.Descriptor instead.
+func (*MoveToWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MoveToWishlistRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MoveToWishlistRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *MoveToWishlistRequest) GetWishlistId() int64 {
+	if x != nil {
+		return x.WishlistId
+	}
+	return 0
+}
+
+type MoveToWishlistResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveToWishlistResponse) Reset() {
+	*x = MoveToWishlistResponse{}
+	mi := &file_cart_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveToWishlistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveToWishlistResponse) ProtoMessage() {}
+
+func (x *MoveToWishlistResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveToWishlistResponse.ProtoReflect.Descriptor instead.
+func (*MoveToWishlistResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MoveToWishlistResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type MoveToCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WishlistId    int64                  `protobuf:"varint,2,opt,name=wishlist_id,json=wishlistId,proto3" json:"wishlist_id,omitempty"` // 0 = Saved for later
+	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveToCartRequest) Reset() {
+	*x = MoveToCartRequest{}
+	mi := &file_cart_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveToCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveToCartRequest) ProtoMessage() {}
+
+func (x *MoveToCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveToCartRequest.ProtoReflect.Descriptor instead.
+func (*MoveToCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MoveToCartRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MoveToCartRequest) GetWishlistId() int64 {
+	if x != nil {
+		return x.WishlistId
+	}
+	return 0
+}
+
+func (x *MoveToCartRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type MoveToCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveToCartResponse) Reset() {
+	*x = MoveToCartResponse{}
+	mi := &file_cart_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveToCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveToCartResponse) ProtoMessage() {}
+
+func (x *MoveToCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveToCartResponse.ProtoReflect.Descriptor instead.
+func (*MoveToCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *MoveToCartResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type PriceCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // 0 = guest cart
+	TempCartId    string                 `protobuf:"bytes,2,opt,name=temp_cart_id,json=tempCartId,proto3" json:"temp_cart_id,omitempty"` // Guest cart identifier, used when user_id is 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceCartRequest) Reset() {
+	*x = PriceCartRequest{}
+	mi := &file_cart_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceCartRequest) ProtoMessage() {}
+
+func (x *PriceCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceCartRequest.ProtoReflect.Descriptor instead.
+func (*PriceCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PriceCartRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PriceCartRequest) GetTempCartId() string {
+	if x != nil {
+		return x.TempCartId
+	}
+	return ""
+}
+
+type PriceCartResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Lines          []*PricedLine          `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`                    // Selected lines, oldest first
+	Subtotal       float64                `protobuf:"fixed64,2,opt,name=subtotal,proto3" json:"subtotal,omitempty"`            // Sum of line subtotals
+	Discount       float64                `protobuf:"fixed64,3,opt,name=discount,proto3" json:"discount,omitempty"`            // Promotions, 0 until promotions exist
+	Shipping       float64                `protobuf:"fixed64,4,opt,name=shipping,proto3" json:"shipping,omitempty"`            // 0 until shipping rates exist
+	Tax            float64                `protobuf:"fixed64,5,opt,name=tax,proto3" json:"tax,omitempty"`                      // 0 until tax rules exist
+	Total          float64                `protobuf:"fixed64,6,opt,name=total,proto3" json:"total,omitempty"`                  // subtotal - discount + shipping + tax
+	Issues         []*CartIssue           `protobuf:"bytes,7,rep,name=issues,proto3" json:"issues,omitempty"`                  // Blocking issues, empty when the cart can be ordered
+	Valid          bool                   `protobuf:"varint,8,opt,name=valid,proto3" json:"valid,omitempty"`                   // No blocking issues
+	QuoteId        string                 `protobuf:"bytes,9,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"` // Valid user carts only, pass to CreateOrder
+	QuoteExpiresAt int64                  `protobuf:"varint,10,opt,name=quote_expires_at,json=quoteExpiresAt,proto3" json:"quote_expires_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PriceCartResponse) Reset() {
+	*x = PriceCartResponse{}
+	mi := &file_cart_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceCartResponse) ProtoMessage() {}
+
+func (x *PriceCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceCartResponse.ProtoReflect.Descriptor instead.
+func (*PriceCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PriceCartResponse) GetLines() []*PricedLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PriceCartResponse) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *PriceCartResponse) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *PriceCartResponse) GetShipping() float64 {
+	if x != nil {
+		return x.Shipping
+	}
+	return 0
+}
+
+func (x *PriceCartResponse) GetTax() float64 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+func (x *PriceCartResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PriceCartResponse) GetIssues() []*CartIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *PriceCartResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *PriceCartResponse) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *PriceCartResponse) GetQuoteExpiresAt() int64 {
+	if x != nil {
+		return x.QuoteExpiresAt
+	}
+	return 0
+}
+
+type PricedLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Image         string                 `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     float64                `protobuf:"fixed64,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`    // Current price
+	SavedPrice    float64                `protobuf:"fixed64,6,opt,name=saved_price,json=savedPrice,proto3" json:"saved_price,omitempty"` // Price when added or last accepted
+	Subtotal      float64                `protobuf:"fixed64,7,opt,name=subtotal,proto3" json:"subtotal,omitempty"`                       // unit_price * quantity
+	Discount      float64                `protobuf:"fixed64,8,opt,name=discount,proto3" json:"discount,omitempty"`                       // Promotions, 0 until promotions exist
+	Total         float64                `protobuf:"fixed64,9,opt,name=total,proto3" json:"total,omitempty"`                             // subtotal - discount
+	Stock         int64                  `protobuf:"varint,10,opt,name=stock,proto3" json:"stock,omitempty"`                             // Current available stock
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricedLine) Reset() {
+	*x = PricedLine{}
+	mi := &file_cart_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricedLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricedLine) ProtoMessage() {}
+
+func (x *PricedLine) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricedLine.ProtoReflect.Descriptor instead.
+func (*PricedLine) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PricedLine) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *PricedLine) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PricedLine) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *PricedLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PricedLine) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *PricedLine) GetSavedPrice() float64 {
+	if x != nil {
+		return x.SavedPrice
+	}
+	return 0
+}
+
+func (x *PricedLine) GetSubtotal() float64 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *PricedLine) GetDiscount() float64 {
+	if x != nil {
+		return x.Discount
+	}
+	return 0
+}
+
+func (x *PricedLine) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PricedLine) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+type CartIssue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // 0 = the whole cart
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`                             // empty, too_many_items, delisted, out_of_stock, insufficient_stock, quantity_limit, purchase_limit
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartIssue) Reset() {
+	*x = CartIssue{}
+	mi := &file_cart_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartIssue) ProtoMessage() {}
+
+func (x *CartIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartIssue.ProtoReflect.Descriptor instead.
+func (*CartIssue) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CartIssue) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CartIssue) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CartIssue) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type TakeQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	QuoteId       string                 `protobuf:"bytes,2,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakeQuoteRequest) Reset() {
+	*x = TakeQuoteRequest{}
+	mi := &file_cart_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeQuoteRequest) ProtoMessage() {}
+
+func (x *TakeQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeQuoteRequest.ProtoReflect.Descriptor instead.
+func (*TakeQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TakeQuoteRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TakeQuoteRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+type TakeQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*QuoteLine           `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	Total         float64                `protobuf:"fixed64,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TakeQuoteResponse) Reset() {
+	*x = TakeQuoteResponse{}
+	mi := &file_cart_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TakeQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TakeQuoteResponse) ProtoMessage() {}
+
+func (x *TakeQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TakeQuoteResponse.ProtoReflect.Descriptor instead.
+func (*TakeQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TakeQuoteResponse) GetLines() []*QuoteLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *TakeQuoteResponse) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ReleaseQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	QuoteId       string                 `protobuf:"bytes,2,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseQuoteRequest) Reset() {
+	*x = ReleaseQuoteRequest{}
+	mi := &file_cart_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseQuoteRequest) ProtoMessage() {}
+
+func (x *ReleaseQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseQuoteRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ReleaseQuoteRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReleaseQuoteRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+type ReleaseQuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // false when the quote expired meanwhile
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseQuoteResponse) Reset() {
+	*x = ReleaseQuoteResponse{}
+	mi := &file_cart_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseQuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseQuoteResponse) ProtoMessage() {}
+
+func (x *ReleaseQuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseQuoteResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseQuoteResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ReleaseQuoteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type QuoteLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     float64                `protobuf:"fixed64,3,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"` // Quoted price, charged even if the product price changed since
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteLine) Reset() {
+	*x = QuoteLine{}
+	mi := &file_cart_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteLine) ProtoMessage() {}
+
+func (x *QuoteLine) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteLine.ProtoReflect.Descriptor instead.
+func (*QuoteLine) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *QuoteLine) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *QuoteLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *QuoteLine) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+type ShareCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // 0 = guest cart
+	TempCartId    string                 `protobuf:"bytes,2,opt,name=temp_cart_id,json=tempCartId,proto3" json:"temp_cart_id,omitempty"` // Guest cart identifier, used when user_id is 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareCartRequest) Reset() {
+	*x = ShareCartRequest{}
+	mi := &file_cart_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareCartRequest) ProtoMessage() {}
+
+func (x *ShareCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareCartRequest.ProtoReflect.Descriptor instead.
+func (*ShareCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ShareCartRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ShareCartRequest) GetTempCartId() string {
+	if x != nil {
+		return x.TempCartId
+	}
+	return ""
+}
+
+type ShareCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareCartResponse) Reset() {
+	*x = ShareCartResponse{}
+	mi := &file_cart_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareCartResponse) ProtoMessage() {}
+
+func (x *ShareCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareCartResponse.ProtoReflect.Descriptor instead.
+func (*ShareCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ShareCartResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ShareCartResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type GetSharedCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedCartRequest) Reset() {
+	*x = GetSharedCartRequest{}
+	mi := &file_cart_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedCartRequest) ProtoMessage() {}
+
+func (x *GetSharedCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedCartRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetSharedCartRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetSharedCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*CartItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"` // Shared quantities with current product data, oldest first
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedCartResponse) Reset() {
+	*x = GetSharedCartResponse{}
+	mi := &file_cart_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedCartResponse) ProtoMessage() {}
+
+func (x *GetSharedCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedCartResponse.ProtoReflect.Descriptor instead.
+func (*GetSharedCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetSharedCartResponse) GetItems() []*CartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetSharedCartResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ImportSharedCartRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // 0 = guest cart
+	TempCartId    string                 `protobuf:"bytes,2,opt,name=temp_cart_id,json=tempCartId,proto3" json:"temp_cart_id,omitempty"` // Guest cart identifier, used when user_id is 0
+	Token         string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSharedCartRequest) Reset() {
+	*x = ImportSharedCartRequest{}
+	mi := &file_cart_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSharedCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSharedCartRequest) ProtoMessage() {}
+
+func (x *ImportSharedCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSharedCartRequest.ProtoReflect.Descriptor instead.
+func (*ImportSharedCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ImportSharedCartRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ImportSharedCartRequest) GetTempCartId() string {
+	if x != nil {
+		return x.TempCartId
+	}
+	return ""
+}
+
+func (x *ImportSharedCartRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ImportSharedCartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Imported      int64                  `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"` // Lines added or whose quantity was increased
+	Skipped       int64                  `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`   // Lines left out: product unavailable, cart full or quantity limit
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSharedCartResponse) Reset() {
+	*x = ImportSharedCartResponse{}
+	mi := &file_cart_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSharedCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSharedCartResponse) ProtoMessage() {}
+
+func (x *ImportSharedCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSharedCartResponse.ProtoReflect.Descriptor instead.
+func (*ImportSharedCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ImportSharedCartResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ImportSharedCartResponse) GetImported() int64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportSharedCartResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+// Wishlist model
+type Wishlist struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsDefault     bool                   `protobuf:"varint,3,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"` // The "Saved for later" list
+	ItemCount     int64                  `protobuf:"varint,4,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Wishlist) Reset() {
+	*x = Wishlist{}
+	mi := &file_cart_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Wishlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Wishlist) ProtoMessage() {}
+
+func (x *Wishlist) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Wishlist.ProtoReflect.Descriptor instead.
+func (*Wishlist) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Wishlist) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Wishlist) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Wishlist) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *Wishlist) GetItemCount() int64 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *Wishlist) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Wishlist) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// Wishlist item model
+type WishlistItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`                             // Current price
+	SavedPrice    float64                `protobuf:"fixed64,4,opt,name=saved_price,json=savedPrice,proto3" json:"saved_price,omitempty"` // Price when saved
+	Quantity      int64                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Image         string                 `protobuf:"bytes,6,opt,name=image,proto3" json:"image,omitempty"`
+	Stock         int64                  `protobuf:"varint,7,opt,name=stock,proto3" json:"stock,omitempty"`         // Current available stock
+	Available     bool                   `protobuf:"varint,8,opt,name=available,proto3" json:"available,omitempty"` // Is product still available?
+	AddedAt       int64                  `protobuf:"varint,9,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WishlistItem) Reset() {
+	*x = WishlistItem{}
+	mi := &file_cart_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WishlistItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WishlistItem) ProtoMessage() {}
+
+func (x *WishlistItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WishlistItem.ProtoReflect.Descriptor instead.
+func (*WishlistItem) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *WishlistItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetSavedPrice() float64 {
+	if x != nil {
+		return x.SavedPrice
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *WishlistItem) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *WishlistItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *WishlistItem) GetAddedAt() int64 {
+	if x != nil {
+		return x.AddedAt
+	}
+	return 0
+}
+
+// Cart item model
+type CartItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Price          float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"` // Current price
+	Quantity       int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Image          string                 `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	Stock          int64                  `protobuf:"varint,6,opt,name=stock,proto3" json:"stock,omitempty"`                                          // Current available stock
+	Available      bool                   `protobuf:"varint,7,opt,name=available,proto3" json:"available,omitempty"`                                  // Is product still available?
+	SavedPrice     float64                `protobuf:"fixed64,8,opt,name=saved_price,json=savedPrice,proto3" json:"saved_price,omitempty"`             // Price when added or last accepted
+	PriceIncreased bool                   `protobuf:"varint,9,opt,name=price_increased,json=priceIncreased,proto3" json:"price_increased,omitempty"`  // Current price is higher than saved_price
+	PriceDecreased bool                   `protobuf:"varint,10,opt,name=price_decreased,json=priceDecreased,proto3" json:"price_decreased,omitempty"` // Current price is lower than saved_price
+	ExceedsStock   bool                   `protobuf:"varint,11,opt,name=exceeds_stock,json=exceedsStock,proto3" json:"exceeds_stock,omitempty"`       // Quantity is more than the available stock
+	Selected       bool                   `protobuf:"varint,12,opt,name=selected,proto3" json:"selected,omitempty"`                                   // Selected for checkout
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CartItem) Reset() {
+	*x = CartItem{}
+	mi := &file_cart_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartItem) ProtoMessage() {}
+
+func (x *CartItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
+func (*CartItem) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CartItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CartItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CartItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CartItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CartItem) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *CartItem) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *CartItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *CartItem) GetSavedPrice() float64 {
+	if x != nil {
+		return x.SavedPrice
+	}
+	return 0
+}
+
+func (x *CartItem) GetPriceIncreased() bool {
+	if x != nil {
+		return x.PriceIncreased
+	}
+	return false
+}
+
+func (x *CartItem) GetPriceDecreased() bool {
+	if x != nil {
+		return x.PriceDecreased
+	}
+	return false
+}
+
+func (x *CartItem) GetExceedsStock() bool {
+	if x != nil {
+		return x.ExceedsStock
+	}
+	return false
+}
+
+func (x *CartItem) GetSelected() bool {
+	if x != nil {
+		return x.Selected
+	}
+	return false
+}
+
+var File_cart_proto protoreflect.FileDescriptor
+
+const file_cart_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"cart.proto\x12\x04cart\"\x88\x01\n" +
+	"\x10AddToCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12 \n" +
+	"\ftemp_cart_id\x18\x04 \x01(\tR\n" +
+	"tempCartId\"-\n" +
+	"\x11AddToCartResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"K\n" +
+	"\x0eGetCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
+	"\ftemp_cart_id\x18\x02 \x01(\tR\n" +
+	"tempCartId\"\x9a\x01\n" +
+	"\x0fGetCartResponse\x12$\n" +
+	"\x05items\x18\x01 \x03(\v2\x0e.cart.CartItemR\x05items\x12\x1f\n" +
+	"\vtotal_price\x18\x02 \x01(\x01R\n" +
+	"totalPrice\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x03R\n" +
+	"totalCount\x12\x1f\n" +
+	"\vhas_changes\x18\x04 \x01(\bR\n" +
+	"hasChanges\"\x8d\x01\n" +
+	"\x15UpdateCartItemRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12 \n" +
+	"\ftemp_cart_id\x18\x04 \x01(\tR\n" +
+	"tempCartId\"2\n" +
+	"\x16UpdateCartItemResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"q\n" +
+	"\x15RemoveCartItemRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12 \n" +
+	"\ftemp_cart_id\x18\x03 \x01(\tR\n" +
+	"tempCartId\"2\n" +
+	"\x16RemoveCartItemResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"M\n" +
+	"\x10ClearCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
+	"\ftemp_cart_id\x18\x02 \x01(\tR\n" +
 	"tempCartId\"-\n" +
+	"\x11ClearCartResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"M\n" +
+	"\x10MergeCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
+	"\ftemp_cart_id\x18\x02 \x01(\tR\n" +
+	"tempCartId\"_\n" +
 	"\x11MergeCartResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb9\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x16\n" +
+	"\x06merged\x18\x02 \x01(\x03R\x06merged\x12\x18\n" +
+	"\askipped\x18\x03 \x01(\x03R\askipped\"u\n" +
+	"\x17AcceptCartPricesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
+	"\ftemp_cart_id\x18\x02 \x01(\tR\n" +
+	"tempCartId\x12\x1f\n" +
+	"\vproduct_ids\x18\x03 \x03(\x03R\n" +
+	"productIds\"N\n" +
+	"\x18AcceptCartPricesResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x03R\aupdated\"\x90\x01\n" +
+	"\x16SelectCartItemsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
+	"\ftemp_cart_id\x18\x02 \x01(\tR\n" +
+	"tempCartId\x12\x1f\n" +
+	"\vproduct_ids\x18\x03 \x03(\x03R\n" +
+	"productIds\x12\x1a\n" +
+	"\bselected\x18\x04 \x01(\bR\bselected\"M\n" +
+	"\x17SelectCartItemsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x03R\aupdated\"r\n" +
+	"\x19SelectAllCartItemsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
+	"\ftemp_cart_id\x18\x02 \x01(\tR\n" +
+	"tempCartId\x12\x1a\n" +
+	"\bselected\x18\x03 \x01(\bR\bselected\"P\n" +
+	"\x1aSelectAllCartItemsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\aupdated\x18\x02 \x01(\x03R\aupdated\"2\n" +
+	"\x17GetCheckoutItemsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"D\n" +
+	"\x18GetCheckoutItemsResponse\x12(\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.cart.CheckoutItemR\x05items\"I\n" +
+	"\fCheckoutItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"R\n" +
+	"\x16RemoveCartItemsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vproduct_ids\x18\x02 \x03(\x03R\n" +
+	"productIds\"3\n" +
+	"\x17RemoveCartItemsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"D\n" +
+	"\x15CreateWishlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"D\n" +
+	"\x16CreateWishlistResponse\x12*\n" +
+	"\bwishlist\x18\x01 \x01(\v2\x0e.cart.WishlistR\bwishlist\"/\n" +
+	"\x14ListWishlistsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"E\n" +
+	"\x15ListWishlistsResponse\x12,\n" +
+	"\twishlists\x18\x01 \x03(\v2\x0e.cart.WishlistR\twishlists\"e\n" +
+	"\x15RenameWishlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vwishlist_id\x18\x02 \x01(\x03R\n" +
+	"wishlistId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"2\n" +
+	"\x16RenameWishlistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\x15DeleteWishlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vwishlist_id\x18\x02 \x01(\x03R\n" +
+	"wishlistId\"2\n" +
+	"\x16DeleteWishlistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"N\n" +
+	"\x12GetWishlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vwishlist_id\x18\x02 \x01(\x03R\n" +
+	"wishlistId\"k\n" +
+	"\x13GetWishlistResponse\x12*\n" +
+	"\bwishlist\x18\x01 \x01(\v2\x0e.cart.WishlistR\bwishlist\x12(\n" +
+	"\x05items\x18\x02 \x03(\v2\x12.cart.WishlistItemR\x05items\"\x8b\x01\n" +
+	"\x14AddToWishlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vwishlist_id\x18\x02 \x01(\x03R\n" +
+	"wishlistId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\"1\n" +
+	"\x15AddToWishlistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"t\n" +
+	"\x19RemoveFromWishlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vwishlist_id\x18\x02 \x01(\x03R\n" +
+	"wishlistId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03R\tproductId\"6\n" +
+	"\x1aRemoveFromWishlistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"p\n" +
+	"\x15MoveToWishlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1f\n" +
+	"\vwishlist_id\x18\x03 \x01(\x03R\n" +
+	"wishlistId\"2\n" +
+	"\x16MoveToWishlistResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"l\n" +
+	"\x11MoveToCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vwishlist_id\x18\x02 \x01(\x03R\n" +
+	"wishlistId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03R\tproductId\".\n" +
+	"\x12MoveToCartResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"M\n" +
+	"\x10PriceCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
+	"\ftemp_cart_id\x18\x02 \x01(\tR\n" +
+	"tempCartId\"\xbb\x02\n" +
+	"\x11PriceCartResponse\x12&\n" +
+	"\x05lines\x18\x01 \x03(\v2\x10.cart.PricedLineR\x05lines\x12\x1a\n" +
+	"\bsubtotal\x18\x02 \x01(\x01R\bsubtotal\x12\x1a\n" +
+	"\bdiscount\x18\x03 \x01(\x01R\bdiscount\x12\x1a\n" +
+	"\bshipping\x18\x04 \x01(\x01R\bshipping\x12\x10\n" +
+	"\x03tax\x18\x05 \x01(\x01R\x03tax\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x01R\x05total\x12'\n" +
+	"\x06issues\x18\a \x03(\v2\x0f.cart.CartIssueR\x06issues\x12\x14\n" +
+	"\x05valid\x18\b \x01(\bR\x05valid\x12\x19\n" +
+	"\bquote_id\x18\t \x01(\tR\aquoteId\x12(\n" +
+	"\x10quote_expires_at\x18\n" +
+	" \x01(\x03R\x0equoteExpiresAt\"\x95\x02\n" +
+	"\n" +
+	"PricedLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05image\x18\x03 \x01(\tR\x05image\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\x01R\tunitPrice\x12\x1f\n" +
+	"\vsaved_price\x18\x06 \x01(\x01R\n" +
+	"savedPrice\x12\x1a\n" +
+	"\bsubtotal\x18\a \x01(\x01R\bsubtotal\x12\x1a\n" +
+	"\bdiscount\x18\b \x01(\x01R\bdiscount\x12\x14\n" +
+	"\x05total\x18\t \x01(\x01R\x05total\x12\x14\n" +
+	"\x05stock\x18\n" +
+	" \x01(\x03R\x05stock\"X\n" +
+	"\tCartIssue\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"F\n" +
+	"\x10TakeQuoteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bquote_id\x18\x02 \x01(\tR\aquoteId\"P\n" +
+	"\x11TakeQuoteResponse\x12%\n" +
+	"\x05lines\x18\x01 \x03(\v2\x0f.cart.QuoteLineR\x05lines\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x01R\x05total\"I\n" +
+	"\x13ReleaseQuoteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\bquote_id\x18\x02 \x01(\tR\aquoteId\"0\n" +
+	"\x14ReleaseQuoteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"e\n" +
+	"\tQuoteLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x03 \x01(\x01R\tunitPrice\"M\n" +
+	"\x10ShareCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
+	"\ftemp_cart_id\x18\x02 \x01(\tR\n" +
+	"tempCartId\"H\n" +
+	"\x11ShareCartResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\",\n" +
+	"\x14GetSharedCartRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\\\n" +
+	"\x15GetSharedCartResponse\x12$\n" +
+	"\x05items\x18\x01 \x03(\v2\x0e.cart.CartItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\"j\n" +
+	"\x17ImportSharedCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12 \n" +
+	"\ftemp_cart_id\x18\x02 \x01(\tR\n" +
+	"tempCartId\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\"j\n" +
+	"\x18ImportSharedCartResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1a\n" +
+	"\bimported\x18\x02 \x01(\x03R\bimported\x12\x18\n" +
+	"\askipped\x18\x03 \x01(\x03R\askipped\"\xaa\x01\n" +
+	"\bWishlist\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x03 \x01(\bR\tisDefault\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x04 \x01(\x03R\titemCount\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\x03R\tupdatedAt\"\xf9\x01\n" +
+	"\fWishlistItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1f\n" +
+	"\vsaved_price\x18\x04 \x01(\x01R\n" +
+	"savedPrice\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x03R\bquantity\x12\x14\n" +
+	"\x05image\x18\x06 \x01(\tR\x05image\x12\x14\n" +
+	"\x05stock\x18\a \x01(\x03R\x05stock\x12\x1c\n" +
+	"\tavailable\x18\b \x01(\bR\tavailable\x12\x19\n" +
+	"\badded_at\x18\t \x01(\x03R\aaddedAt\"\xed\x02\n" +
 	"\bCartItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x12\n" +
@@ -759,14 +3690,42 @@ const file_cart_proto_rawDesc = "" +
 	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x14\n" +
 	"\x05image\x18\x05 \x01(\tR\x05image\x12\x14\n" +
 	"\x05stock\x18\x06 \x01(\x03R\x05stock\x12\x1c\n" +
-	"\tavailable\x18\a \x01(\bR\tavailable2\x92\x03\n" +
+	"\tavailable\x18\a \x01(\bR\tavailable\x12\x1f\n" +
+	"\vsaved_price\x18\b \x01(\x01R\n" +
+	"savedPrice\x12'\n" +
+	"\x0fprice_increased\x18\t \x01(\bR\x0epriceIncreased\x12'\n" +
+	"\x0fprice_decreased\x18\n" +
+	" \x01(\bR\x0epriceDecreased\x12#\n" +
+	"\rexceeds_stock\x18\v \x01(\bR\fexceedsStock\x12\x1a\n" +
+	"\bselected\x18\f \x01(\bR\bselected2\xf5\x0e\n" +
 	"\x04Cart\x12<\n" +
 	"\tAddToCart\x12\x16.cart.AddToCartRequest\x1a\x17.cart.AddToCartResponse\x126\n" +
 	"\aGetCart\x12\x14.cart.GetCartRequest\x1a\x15.cart.GetCartResponse\x12K\n" +
 	"\x0eUpdateCartItem\x12\x1b.cart.UpdateCartItemRequest\x1a\x1c.cart.UpdateCartItemResponse\x12K\n" +
 	"\x0eRemoveCartItem\x12\x1b.cart.RemoveCartItemRequest\x1a\x1c.cart.RemoveCartItemResponse\x12<\n" +
 	"\tClearCart\x12\x16.cart.ClearCartRequest\x1a\x17.cart.ClearCartResponse\x12<\n" +
-	"\tMergeCart\x12\x16.cart.MergeCartRequest\x1a\x17.cart.MergeCartResponseB\bZ\x06./cartb\x06proto3"
+	"\tMergeCart\x12\x16.cart.MergeCartRequest\x1a\x17.cart.MergeCartResponse\x12Q\n" +
+	"\x10AcceptCartPrices\x12\x1d.cart.AcceptCartPricesRequest\x1a\x1e.cart.AcceptCartPricesResponse\x12N\n" +
+	"\x0fSelectCartItems\x12\x1c.cart.SelectCartItemsRequest\x1a\x1d.cart.SelectCartItemsResponse\x12W\n" +
+	"\x12SelectAllCartItems\x12\x1f.cart.SelectAllCartItemsRequest\x1a .cart.SelectAllCartItemsResponse\x12Q\n" +
+	"\x10GetCheckoutItems\x12\x1d.cart.GetCheckoutItemsRequest\x1a\x1e.cart.GetCheckoutItemsResponse\x12N\n" +
+	"\x0fRemoveCartItems\x12\x1c.cart.RemoveCartItemsRequest\x1a\x1d.cart.RemoveCartItemsResponse\x12<\n" +
+	"\tPriceCart\x12\x16.cart.PriceCartRequest\x1a\x17.cart.PriceCartResponse\x12<\n" +
+	"\tTakeQuote\x12\x16.cart.TakeQuoteRequest\x1a\x17.cart.TakeQuoteResponse\x12E\n" +
+	"\fReleaseQuote\x12\x19.cart.ReleaseQuoteRequest\x1a\x1a.cart.ReleaseQuoteResponse\x12<\n" +
+	"\tShareCart\x12\x16.cart.ShareCartRequest\x1a\x17.cart.ShareCartResponse\x12H\n" +
+	"\rGetSharedCart\x12\x1a.cart.GetSharedCartRequest\x1a\x1b.cart.GetSharedCartResponse\x12Q\n" +
+	"\x10ImportSharedCart\x12\x1d.cart.ImportSharedCartRequest\x1a\x1e.cart.ImportSharedCartResponse\x12K\n" +
+	"\x0eCreateWishlist\x12\x1b.cart.CreateWishlistRequest\x1a\x1c.cart.CreateWishlistResponse\x12H\n" +
+	"\rListWishlists\x12\x1a.cart.ListWishlistsRequest\x1a\x1b.cart.ListWishlistsResponse\x12K\n" +
+	"\x0eRenameWishlist\x12\x1b.cart.RenameWishlistRequest\x1a\x1c.cart.RenameWishlistResponse\x12K\n" +
+	"\x0eDeleteWishlist\x12\x1b.cart.DeleteWishlistRequest\x1a\x1c.cart.DeleteWishlistResponse\x12B\n" +
+	"\vGetWishlist\x12\x18.cart.GetWishlistRequest\x1a\x19.cart.GetWishlistResponse\x12H\n" +
+	"\rAddToWishlist\x12\x1a.cart.AddToWishlistRequest\x1a\x1b.cart.AddToWishlistResponse\x12W\n" +
+	"\x12RemoveFromWishlist\x12\x1f.cart.RemoveFromWishlistRequest\x1a .cart.RemoveFromWishlistResponse\x12K\n" +
+	"\x0eMoveToWishlist\x12\x1b.cart.MoveToWishlistRequest\x1a\x1c.cart.MoveToWishlistResponse\x12?\n" +
+	"\n" +
+	"MoveToCart\x12\x17.cart.MoveToCartRequest\x1a\x18.cart.MoveToCartResponseB\bZ\x06./cartb\x06proto3"
 
 var (
 	file_cart_proto_rawDescOnce sync.Once
@@ -780,41 +3739,136 @@ func file_cart_proto_rawDescGZIP() []byte {
 	return file_cart_proto_rawDescData
 }
 
-var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_cart_proto_goTypes = []any{
-	(*AddToCartRequest)(nil),       // 0: cart.AddToCartRequest
-	(*AddToCartResponse)(nil),      // 1: cart.AddToCartResponse
-	(*GetCartRequest)(nil),         // 2: cart.GetCartRequest
-	(*GetCartResponse)(nil),        // 3: cart.GetCartResponse
-	(*UpdateCartItemRequest)(nil),  // 4: cart.UpdateCartItemRequest
-	(*UpdateCartItemResponse)(nil), // 5: cart.UpdateCartItemResponse
-	(*RemoveCartItemRequest)(nil),  // 6: cart.RemoveCartItemRequest
-	(*RemoveCartItemResponse)(nil), // 7: cart.RemoveCartItemResponse
-	(*ClearCartRequest)(nil),       // 8: cart.ClearCartRequest
-	(*ClearCartResponse)(nil),      // 9: cart.ClearCartResponse
-	(*MergeCartRequest)(nil),       // 10: cart.MergeCartRequest
-	(*MergeCartResponse)(nil),      // 11: cart.MergeCartResponse
-	(*CartItem)(nil),               // 12: cart.CartItem
+	(*AddToCartRequest)(nil),           // 0: cart.AddToCartRequest
+	(*AddToCartResponse)(nil),          // 1: cart.AddToCartResponse
+	(*GetCartRequest)(nil),             // 2: cart.GetCartRequest
+	(*GetCartResponse)(nil),            // 3: cart.GetCartResponse
+	(*UpdateCartItemRequest)(nil),      // 4: cart.UpdateCartItemRequest
+	(*UpdateCartItemResponse)(nil),     // 5: cart.UpdateCartItemResponse
+	(*RemoveCartItemRequest)(nil),      // 6: cart.RemoveCartItemRequest
+	(*RemoveCartItemResponse)(nil),     // 7: cart.RemoveCartItemResponse
+	(*ClearCartRequest)(nil),           // 8: cart.ClearCartRequest
+	(*ClearCartResponse)(nil),          // 9: cart.ClearCartResponse
+	(*MergeCartRequest)(nil),           // 10: cart.MergeCartRequest
+	(*MergeCartResponse)(nil),          // 11: cart.MergeCartResponse
+	(*AcceptCartPricesRequest)(nil),    // 12: cart.AcceptCartPricesRequest
+	(*AcceptCartPricesResponse)(nil),   // 13: cart.AcceptCartPricesResponse
+	(*SelectCartItemsRequest)(nil),     // 14: cart.SelectCartItemsRequest
+	(*SelectCartItemsResponse)(nil),    // 15: cart.SelectCartItemsResponse
+	(*SelectAllCartItemsRequest)(nil),  // 16: cart.SelectAllCartItemsRequest
+	(*SelectAllCartItemsResponse)(nil), // 17: cart.SelectAllCartItemsResponse
+	(*GetCheckoutItemsRequest)(nil),    // 18: cart.GetCheckoutItemsRequest
+	(*GetCheckoutItemsResponse)(nil),   // 19: cart.GetCheckoutItemsResponse
+	(*CheckoutItem)(nil),               // 20: cart.CheckoutItem
+	(*RemoveCartItemsRequest)(nil),     // 21: cart.RemoveCartItemsRequest
+	(*RemoveCartItemsResponse)(nil),    // 22: cart.RemoveCartItemsResponse
+	(*CreateWishlistRequest)(nil),      // 23: cart.CreateWishlistRequest
+	(*CreateWishlistResponse)(nil),     // 24: cart.CreateWishlistResponse
+	(*ListWishlistsRequest)(nil),       // 25: cart.ListWishlistsRequest
+	(*ListWishlistsResponse)(nil),      // 26: cart.ListWishlistsResponse
+	(*RenameWishlistRequest)(nil),      // 27: cart.RenameWishlistRequest
+	(*RenameWishlistResponse)(nil),     // 28: cart.RenameWishlistResponse
+	(*DeleteWishlistRequest)(nil),      // 29: cart.DeleteWishlistRequest
+	(*DeleteWishlistResponse)(nil),     // 30: cart.DeleteWishlistResponse
+	(*GetWishlistRequest)(nil),         // 31: cart.GetWishlistRequest
+	(*GetWishlistResponse)(nil),        // 32: cart.GetWishlistResponse
+	(*AddToWishlistRequest)(nil),       // 33: cart.AddToWishlistRequest
+	(*AddToWishlistResponse)(nil),      // 34: cart.AddToWishlistResponse
+	(*RemoveFromWishlistRequest)(nil),  // 35: cart.RemoveFromWishlistRequest
+	(*RemoveFromWishlistResponse)(nil), // 36: cart.RemoveFromWishlistResponse
+	(*MoveToWishlistRequest)(nil),      // 37: cart.MoveToWishlistRequest
+	(*MoveToWishlistResponse)(nil),     // 38: cart.MoveToWishlistResponse
+	(*MoveToCartRequest)(nil),          // 39: cart.MoveToCartRequest
+	(*MoveToCartResponse)(nil),         // 40: cart.MoveToCartResponse
+	(*PriceCartRequest)(nil),           // 41: cart.PriceCartRequest
+	(*PriceCartResponse)(nil),          // 42: cart.PriceCartResponse
+	(*PricedLine)(nil),                 // 43: cart.PricedLine
+	(*CartIssue)(nil),                  // 44: cart.CartIssue
+	(*TakeQuoteRequest)(nil),           // 45: cart.TakeQuoteRequest
+	(*TakeQuoteResponse)(nil),          // 46: cart.TakeQuoteResponse
+	(*ReleaseQuoteRequest)(nil),        // 47: cart.ReleaseQuoteRequest
+	(*ReleaseQuoteResponse)(nil),       // 48: cart.ReleaseQuoteResponse
+	(*QuoteLine)(nil),                  // 49: cart.QuoteLine
+	(*ShareCartRequest)(nil),           // 50: cart.ShareCartRequest
+	(*ShareCartResponse)(nil),          // 51: cart.ShareCartResponse
+	(*GetSharedCartRequest)(nil),       // 52: cart.GetSharedCartRequest
+	(*GetSharedCartResponse)(nil),      // 53: cart.GetSharedCartResponse
+	(*ImportSharedCartRequest)(nil),    // 54: cart.ImportSharedCartRequest
+	(*ImportSharedCartResponse)(nil),   // 55: cart.ImportSharedCartResponse
+	(*Wishlist)(nil),                   // 56: cart.Wishlist
+	(*WishlistItem)(nil),               // 57: cart.WishlistItem
+	(*CartItem)(nil),                   // 58: cart.CartItem
 }
 var file_cart_proto_depIdxs = []int32{
-	12, // 0: cart.GetCartResponse.items:type_name -> cart.CartItem
-	0,  // 1: cart.Cart.AddToCart:input_type -> cart.AddToCartRequest
-	2,  // 2: cart.Cart.GetCart:input_type -> cart.GetCartRequest
-	4,  // 3: cart.Cart.UpdateCartItem:input_type -> cart.UpdateCartItemRequest
-	6,  // 4: cart.Cart.RemoveCartItem:input_type -> cart.RemoveCartItemRequest
-	8,  // 5: cart.Cart.ClearCart:input_type -> cart.ClearCartRequest
-	10, // 6: cart.Cart.MergeCart:input_type -> cart.MergeCartRequest
-	1,  // 7: cart.Cart.AddToCart:output_type -> cart.AddToCartResponse
-	3,  // 8: cart.Cart.GetCart:output_type -> cart.GetCartResponse
-	5,  // 9: cart.Cart.UpdateCartItem:output_type -> cart.UpdateCartItemResponse
-	7,  // 10: cart.Cart.RemoveCartItem:output_type -> cart.RemoveCartItemResponse
-	9,  // 11: cart.Cart.ClearCart:output_type -> cart.ClearCartResponse
-	11, // 12: cart.Cart.MergeCart:output_type -> cart.MergeCartResponse
-	7,  // [7:13] is the sub-list for method output_type
-	1,  // [1:7] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	58, // 0: cart.GetCartResponse.items:type_name -> cart.CartItem
+	20, // 1: cart.GetCheckoutItemsResponse.items:type_name -> cart.CheckoutItem
+	56, // 2: cart.CreateWishlistResponse.wishlist:type_name -> cart.Wishlist
+	56, // 3: cart.ListWishlistsResponse.wishlists:type_name -> cart.Wishlist
+	56, // 4: cart.GetWishlistResponse.wishlist:type_name -> cart.Wishlist
+	57, // 5: cart.GetWishlistResponse.items:type_name -> cart.WishlistItem
+	43, // 6: cart.PriceCartResponse.lines:type_name -> cart.PricedLine
+	44, // 7: cart.PriceCartResponse.issues:type_name -> cart.CartIssue
+	49, // 8: cart.TakeQuoteResponse.lines:type_name -> cart.QuoteLine
+	58, // 9: cart.GetSharedCartResponse.items:type_name -> cart.CartItem
+	0,  // 10: cart.Cart.AddToCart:input_type -> cart.AddToCartRequest
+	2,  // 11: cart.Cart.GetCart:input_type -> cart.GetCartRequest
+	4,  // 12: cart.Cart.UpdateCartItem:input_type -> cart.UpdateCartItemRequest
+	6,  // 13: cart.Cart.RemoveCartItem:input_type -> cart.RemoveCartItemRequest
+	8,  // 14: cart.Cart.ClearCart:input_type -> cart.ClearCartRequest
+	10, // 15: cart.Cart.MergeCart:input_type -> cart.MergeCartRequest
+	12, // 16: cart.Cart.AcceptCartPrices:input_type -> cart.AcceptCartPricesRequest
+	14, // 17: cart.Cart.SelectCartItems:input_type -> cart.SelectCartItemsRequest
+	16, // 18: cart.Cart.SelectAllCartItems:input_type -> cart.SelectAllCartItemsRequest
+	18, // 19: cart.Cart.GetCheckoutItems:input_type -> cart.GetCheckoutItemsRequest
+	21, // 20: cart.Cart.RemoveCartItems:input_type -> cart.RemoveCartItemsRequest
+	41, // 21: cart.Cart.PriceCart:input_type -> cart.PriceCartRequest
+	45, // 22: cart.Cart.TakeQuote:input_type -> cart.TakeQuoteRequest
+	47, // 23: cart.Cart.ReleaseQuote:input_type -> cart.ReleaseQuoteRequest
+	50, // 24: cart.Cart.ShareCart:input_type -> cart.ShareCartRequest
+	52, // 25: cart.Cart.GetSharedCart:input_type -> cart.GetSharedCartRequest
+	54, // 26: cart.Cart.ImportSharedCart:input_type -> cart.ImportSharedCartRequest
+	23, // 27: cart.Cart.CreateWishlist:input_type -> cart.CreateWishlistRequest
+	25, // 28: cart.Cart.ListWishlists:input_type -> cart.ListWishlistsRequest
+	27, // 29: cart.Cart.RenameWishlist:input_type -> cart.RenameWishlistRequest
+	29, // 30: cart.Cart.DeleteWishlist:input_type -> cart.DeleteWishlistRequest
+	31, // 31: cart.Cart.GetWishlist:input_type -> cart.GetWishlistRequest
+	33, // 32: cart.Cart.AddToWishlist:input_type -> cart.AddToWishlistRequest
+	35, // 33: cart.Cart.RemoveFromWishlist:input_type -> cart.RemoveFromWishlistRequest
+	37, // 34: cart.Cart.MoveToWishlist:input_type -> cart.MoveToWishlistRequest
+	39, // 35: cart.Cart.MoveToCart:input_type -> cart.MoveToCartRequest
+	1,  // 36: cart.Cart.AddToCart:output_type -> cart.AddToCartResponse
+	3,  // 37: cart.Cart.GetCart:output_type -> cart.GetCartResponse
+	5,  // 38: cart.Cart.UpdateCartItem:output_type -> cart.UpdateCartItemResponse
+	7,  // 39: cart.Cart.RemoveCartItem:output_type -> cart.RemoveCartItemResponse
+	9,  // 40: cart.Cart.ClearCart:output_type -> cart.ClearCartResponse
+	11, // 41: cart.Cart.MergeCart:output_type -> cart.MergeCartResponse
+	13, // 42: cart.Cart.AcceptCartPrices:output_type -> cart.AcceptCartPricesResponse
+	15, // 43: cart.Cart.SelectCartItems:output_type -> cart.SelectCartItemsResponse
+	17, // 44: cart.Cart.SelectAllCartItems:output_type -> cart.SelectAllCartItemsResponse
+	19, // 45: cart.Cart.GetCheckoutItems:output_type -> cart.GetCheckoutItemsResponse
+	22, // 46: cart.Cart.RemoveCartItems:output_type -> cart.RemoveCartItemsResponse
+	42, // 47: cart.Cart.PriceCart:output_type -> cart.PriceCartResponse
+	46, // 48: cart.Cart.TakeQuote:output_type -> cart.TakeQuoteResponse
+	48, // 49: cart.Cart.ReleaseQuote:output_type -> cart.ReleaseQuoteResponse
+	51, // 50: cart.Cart.ShareCart:output_type -> cart.ShareCartResponse
+	53, // 51: cart.Cart.GetSharedCart:output_type -> cart.GetSharedCartResponse
+	55, // 52: cart.Cart.ImportSharedCart:output_type -> cart.ImportSharedCartResponse
+	24, // 53: cart.Cart.CreateWishlist:output_type -> cart.CreateWishlistResponse
+	26, // 54: cart.Cart.ListWishlists:output_type -> cart.ListWishlistsResponse
+	28, // 55: cart.Cart.RenameWishlist:output_type -> cart.RenameWishlistResponse
+	30, // 56: cart.Cart.DeleteWishlist:output_type -> cart.DeleteWishlistResponse
+	32, // 57: cart.Cart.GetWishlist:output_type -> cart.GetWishlistResponse
+	34, // 58: cart.Cart.AddToWishlist:output_type -> cart.AddToWishlistResponse
+	36, // 59: cart.Cart.RemoveFromWishlist:output_type -> cart.RemoveFromWishlistResponse
+	38, // 60: cart.Cart.MoveToWishlist:output_type -> cart.MoveToWishlistResponse
+	40, // 61: cart.Cart.MoveToCart:output_type -> cart.MoveToCartResponse
+	36, // [36:62] is the sub-list for method output_type
+	10, // [10:36] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_cart_proto_init() }
@@ -828,7 +3882,7 @@ func file_cart_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cart_proto_rawDesc), len(file_cart_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
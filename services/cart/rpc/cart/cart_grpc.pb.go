@@ -19,19 +19,39 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Cart_AddToCart_FullMethodName      = "/cart.Cart/AddToCart"
-	Cart_GetCart_FullMethodName        = "/cart.Cart/GetCart"
-	Cart_UpdateCartItem_FullMethodName = "/cart.Cart/UpdateCartItem"
-	Cart_RemoveCartItem_FullMethodName = "/cart.Cart/RemoveCartItem"
-	Cart_ClearCart_FullMethodName      = "/cart.Cart/ClearCart"
-	Cart_MergeCart_FullMethodName      = "/cart.Cart/MergeCart"
+	Cart_AddToCart_FullMethodName          = "/cart.Cart/AddToCart"
+	Cart_GetCart_FullMethodName            = "/cart.Cart/GetCart"
+	Cart_UpdateCartItem_FullMethodName     = "/cart.Cart/UpdateCartItem"
+	Cart_RemoveCartItem_FullMethodName     = "/cart.Cart/RemoveCartItem"
+	Cart_ClearCart_FullMethodName          = "/cart.Cart/ClearCart"
+	Cart_MergeCart_FullMethodName          = "/cart.Cart/MergeCart"
+	Cart_AcceptCartPrices_FullMethodName   = "/cart.Cart/AcceptCartPrices"
+	Cart_SelectCartItems_FullMethodName    = "/cart.Cart/SelectCartItems"
+	Cart_SelectAllCartItems_FullMethodName = "/cart.Cart/SelectAllCartItems"
+	Cart_GetCheckoutItems_FullMethodName   = "/cart.Cart/GetCheckoutItems"
+	Cart_RemoveCartItems_FullMethodName    = "/cart.Cart/RemoveCartItems"
+	Cart_PriceCart_FullMethodName          = "/cart.Cart/PriceCart"
+	Cart_TakeQuote_FullMethodName          = "/cart.Cart/TakeQuote"
+	Cart_ReleaseQuote_FullMethodName       = "/cart.Cart/ReleaseQuote"
+	Cart_ShareCart_FullMethodName          = "/cart.Cart/ShareCart"
+	Cart_GetSharedCart_FullMethodName      = "/cart.Cart/GetSharedCart"
+	Cart_ImportSharedCart_FullMethodName   = "/cart.Cart/ImportSharedCart"
+	Cart_CreateWishlist_FullMethodName     = "/cart.Cart/CreateWishlist"
+	Cart_ListWishlists_FullMethodName      = "/cart.Cart/ListWishlists"
+	Cart_RenameWishlist_FullMethodName     = "/cart.Cart/RenameWishlist"
+	Cart_DeleteWishlist_FullMethodName     = "/cart.Cart/DeleteWishlist"
+	Cart_GetWishlist_FullMethodName        = "/cart.Cart/GetWishlist"
+	Cart_AddToWishlist_FullMethodName      = "/cart.Cart/AddToWishlist"
+	Cart_RemoveFromWishlist_FullMethodName = "/cart.Cart/RemoveFromWishlist"
+	Cart_MoveToWishlist_FullMethodName     = "/cart.Cart/MoveToWishlist"
+	Cart_MoveToCart_FullMethodName         = "/cart.Cart/MoveToCart"
 )
 
 // CartClient is the client API for Cart service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type CartClient interface {
-	// Add product to cart
+	// Add product to cart, requests with user_id 0 use the guest cart temp_cart_id instead
 	AddToCart(ctx context.Context, in *AddToCartRequest, opts ...grpc.CallOption) (*AddToCartResponse, error)
 	// Get user's cart
 	GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
@@ -41,8 +61,49 @@ type CartClient interface {
 	RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, opts ...grpc.CallOption) (*RemoveCartItemResponse, error)
 	// Clear entire cart
 	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error)
-	// Merge guest cart with user cart (after login)
+	// Merge guest cart into user cart (after login), the guest cart is deleted
 	MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*MergeCartResponse, error)
+	// Accept the current prices of cart lines whose price changed since they were added
+	AcceptCartPrices(ctx context.Context, in *AcceptCartPricesRequest, opts ...grpc.CallOption) (*AcceptCartPricesResponse, error)
+	// Select or deselect cart lines for checkout, new lines are selected
+	SelectCartItems(ctx context.Context, in *SelectCartItemsRequest, opts ...grpc.CallOption) (*SelectCartItemsResponse, error)
+	// Select or deselect all cart lines
+	SelectAllCartItems(ctx context.Context, in *SelectAllCartItemsRequest, opts ...grpc.CallOption) (*SelectAllCartItemsResponse, error)
+	// Get the selected lines of a user's cart (called by order service at checkout)
+	GetCheckoutItems(ctx context.Context, in *GetCheckoutItemsRequest, opts ...grpc.CallOption) (*GetCheckoutItemsResponse, error)
+	// Remove several items from a user's cart (called by order service after checkout)
+	RemoveCartItems(ctx context.Context, in *RemoveCartItemsRequest, opts ...grpc.CallOption) (*RemoveCartItemsResponse, error)
+	// Price the selected lines like checkout will and list every issue that blocks the order
+	// A user cart without issues gets a short-lived quote that CreateOrder accepts to order at the quoted total
+	PriceCart(ctx context.Context, in *PriceCartRequest, opts ...grpc.CallOption) (*PriceCartResponse, error)
+	// Take a quote (called by order service at checkout), a quote can be used once, ReleaseQuote gives it back if the order fails
+	TakeQuote(ctx context.Context, in *TakeQuoteRequest, opts ...grpc.CallOption) (*TakeQuoteResponse, error)
+	// Give back a taken quote when the order failed, so it can be used again until it expires
+	ReleaseQuote(ctx context.Context, in *ReleaseQuoteRequest, opts ...grpc.CallOption) (*ReleaseQuoteResponse, error)
+	// Snapshot a cart into a share token that expires, later changes to the cart are not shared
+	ShareCart(ctx context.Context, in *ShareCartRequest, opts ...grpc.CallOption) (*ShareCartResponse, error)
+	// Get a shared cart by token with current product data (public)
+	GetSharedCart(ctx context.Context, in *GetSharedCartRequest, opts ...grpc.CallOption) (*GetSharedCartResponse, error)
+	// Merge the lines of a shared cart into the caller's cart with the limits of AddToCart
+	ImportSharedCart(ctx context.Context, in *ImportSharedCartRequest, opts ...grpc.CallOption) (*ImportSharedCartResponse, error)
+	// Create a named wishlist
+	CreateWishlist(ctx context.Context, in *CreateWishlistRequest, opts ...grpc.CallOption) (*CreateWishlistResponse, error)
+	// List the wishlists of a user, the "Saved for later" list first
+	ListWishlists(ctx context.Context, in *ListWishlistsRequest, opts ...grpc.CallOption) (*ListWishlistsResponse, error)
+	// Rename a named wishlist
+	RenameWishlist(ctx context.Context, in *RenameWishlistRequest, opts ...grpc.CallOption) (*RenameWishlistResponse, error)
+	// Delete a wishlist and its items
+	DeleteWishlist(ctx context.Context, in *DeleteWishlistRequest, opts ...grpc.CallOption) (*DeleteWishlistResponse, error)
+	// Get a wishlist with current product data
+	GetWishlist(ctx context.Context, in *GetWishlistRequest, opts ...grpc.CallOption) (*GetWishlistResponse, error)
+	// Save a product to a wishlist
+	AddToWishlist(ctx context.Context, in *AddToWishlistRequest, opts ...grpc.CallOption) (*AddToWishlistResponse, error)
+	// Remove a product from a wishlist
+	RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest, opts ...grpc.CallOption) (*RemoveFromWishlistResponse, error)
+	// Move a cart line to a wishlist, the line is removed from the cart
+	MoveToWishlist(ctx context.Context, in *MoveToWishlistRequest, opts ...grpc.CallOption) (*MoveToWishlistResponse, error)
+	// Move a wishlist item to the cart, the item is removed from the wishlist
+	MoveToCart(ctx context.Context, in *MoveToCartRequest, opts ...grpc.CallOption) (*MoveToCartResponse, error)
 }
 
 type cartClient struct {
@@ -113,11 +174,211 @@ func (c *cartClient) MergeCart(ctx context.Context, in *MergeCartRequest, opts .
 	return out, nil
 }
 
+func (c *cartClient) AcceptCartPrices(ctx context.Context, in *AcceptCartPricesRequest, opts ...grpc.CallOption) (*AcceptCartPricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptCartPricesResponse)
+	err := c.cc.Invoke(ctx, Cart_AcceptCartPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) SelectCartItems(ctx context.Context, in *SelectCartItemsRequest, opts ...grpc.CallOption) (*SelectCartItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelectCartItemsResponse)
+	err := c.cc.Invoke(ctx, Cart_SelectCartItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) SelectAllCartItems(ctx context.Context, in *SelectAllCartItemsRequest, opts ...grpc.CallOption) (*SelectAllCartItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelectAllCartItemsResponse)
+	err := c.cc.Invoke(ctx, Cart_SelectAllCartItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) GetCheckoutItems(ctx context.Context, in *GetCheckoutItemsRequest, opts ...grpc.CallOption) (*GetCheckoutItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCheckoutItemsResponse)
+	err := c.cc.Invoke(ctx, Cart_GetCheckoutItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) RemoveCartItems(ctx context.Context, in *RemoveCartItemsRequest, opts ...grpc.CallOption) (*RemoveCartItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCartItemsResponse)
+	err := c.cc.Invoke(ctx, Cart_RemoveCartItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) PriceCart(ctx context.Context, in *PriceCartRequest, opts ...grpc.CallOption) (*PriceCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceCartResponse)
+	err := c.cc.Invoke(ctx, Cart_PriceCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) TakeQuote(ctx context.Context, in *TakeQuoteRequest, opts ...grpc.CallOption) (*TakeQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TakeQuoteResponse)
+	err := c.cc.Invoke(ctx, Cart_TakeQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ReleaseQuote(ctx context.Context, in *ReleaseQuoteRequest, opts ...grpc.CallOption) (*ReleaseQuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseQuoteResponse)
+	err := c.cc.Invoke(ctx, Cart_ReleaseQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ShareCart(ctx context.Context, in *ShareCartRequest, opts ...grpc.CallOption) (*ShareCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareCartResponse)
+	err := c.cc.Invoke(ctx, Cart_ShareCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) GetSharedCart(ctx context.Context, in *GetSharedCartRequest, opts ...grpc.CallOption) (*GetSharedCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSharedCartResponse)
+	err := c.cc.Invoke(ctx, Cart_GetSharedCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ImportSharedCart(ctx context.Context, in *ImportSharedCartRequest, opts ...grpc.CallOption) (*ImportSharedCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportSharedCartResponse)
+	err := c.cc.Invoke(ctx, Cart_ImportSharedCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) CreateWishlist(ctx context.Context, in *CreateWishlistRequest, opts ...grpc.CallOption) (*CreateWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWishlistResponse)
+	err := c.cc.Invoke(ctx, Cart_CreateWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ListWishlists(ctx context.Context, in *ListWishlistsRequest, opts ...grpc.CallOption) (*ListWishlistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWishlistsResponse)
+	err := c.cc.Invoke(ctx, Cart_ListWishlists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) RenameWishlist(ctx context.Context, in *RenameWishlistRequest, opts ...grpc.CallOption) (*RenameWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenameWishlistResponse)
+	err := c.cc.Invoke(ctx, Cart_RenameWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) DeleteWishlist(ctx context.Context, in *DeleteWishlistRequest, opts ...grpc.CallOption) (*DeleteWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWishlistResponse)
+	err := c.cc.Invoke(ctx, Cart_DeleteWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) GetWishlist(ctx context.Context, in *GetWishlistRequest, opts ...grpc.CallOption) (*GetWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWishlistResponse)
+	err := c.cc.Invoke(ctx, Cart_GetWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) AddToWishlist(ctx context.Context, in *AddToWishlistRequest, opts ...grpc.CallOption) (*AddToWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddToWishlistResponse)
+	err := c.cc.Invoke(ctx, Cart_AddToWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest, opts ...grpc.CallOption) (*RemoveFromWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveFromWishlistResponse)
+	err := c.cc.Invoke(ctx, Cart_RemoveFromWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) MoveToWishlist(ctx context.Context, in *MoveToWishlistRequest, opts ...grpc.CallOption) (*MoveToWishlistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveToWishlistResponse)
+	err := c.cc.Invoke(ctx, Cart_MoveToWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) MoveToCart(ctx context.Context, in *MoveToCartRequest, opts ...grpc.CallOption) (*MoveToCartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveToCartResponse)
+	err := c.cc.Invoke(ctx, Cart_MoveToCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CartServer is the server API for Cart service.
 // All implementations must embed UnimplementedCartServer
 // for forward compatibility.
 type CartServer interface {
-	// Add product to cart
+	// Add product to cart, requests with user_id 0 use the guest cart temp_cart_id instead
 	AddToCart(context.Context, *AddToCartRequest) (*AddToCartResponse, error)
 	// Get user's cart
 	GetCart(context.Context, *GetCartRequest) (*GetCartResponse, error)
@@ -127,8 +388,49 @@ type CartServer interface {
 	RemoveCartItem(context.Context, *RemoveCartItemRequest) (*RemoveCartItemResponse, error)
 	// Clear entire cart
 	ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error)
-	// Merge guest cart with user cart (after login)
+	// Merge guest cart into user cart (after login), the guest cart is deleted
 	MergeCart(context.Context, *MergeCartRequest) (*MergeCartResponse, error)
+	// Accept the current prices of cart lines whose price changed since they were added
+	AcceptCartPrices(context.Context, *AcceptCartPricesRequest) (*AcceptCartPricesResponse, error)
+	// Select or deselect cart lines for checkout, new lines are selected
+	SelectCartItems(context.Context, *SelectCartItemsRequest) (*SelectCartItemsResponse, error)
+	// Select or deselect all cart lines
+	SelectAllCartItems(context.Context, *SelectAllCartItemsRequest) (*SelectAllCartItemsResponse, error)
+	// Get the selected lines of a user's cart (called by order service at checkout)
+	GetCheckoutItems(context.Context, *GetCheckoutItemsRequest) (*GetCheckoutItemsResponse, error)
+	// Remove several items from a user's cart (called by order service after checkout)
+	RemoveCartItems(context.Context, *RemoveCartItemsRequest) (*RemoveCartItemsResponse, error)
+	// Price the selected lines like checkout will and list every issue that blocks the order
+	// A user cart without issues gets a short-lived quote that CreateOrder accepts to order at the quoted total
+	PriceCart(context.Context, *PriceCartRequest) (*PriceCartResponse, error)
+	// Take a quote (called by order service at checkout), a quote can be used once, ReleaseQuote gives it back if the order fails
+	TakeQuote(context.Context, *TakeQuoteRequest) (*TakeQuoteResponse, error)
+	// Give back a taken quote when the order failed, so it can be used again until it expires
+	ReleaseQuote(context.Context, *ReleaseQuoteRequest) (*ReleaseQuoteResponse, error)
+	// Snapshot a cart into a share token that expires, later changes to the cart are not shared
+	ShareCart(context.Context, *ShareCartRequest) (*ShareCartResponse, error)
+	// Get a shared cart by token with current product data (public)
+	GetSharedCart(context.Context, *GetSharedCartRequest) (*GetSharedCartResponse, error)
+	// Merge the lines of a shared cart into the caller's cart with the limits of AddToCart
+	ImportSharedCart(context.Context, *ImportSharedCartRequest) (*ImportSharedCartResponse, error)
+	// Create a named wishlist
+	CreateWishlist(context.Context, *CreateWishlistRequest) (*CreateWishlistResponse, error)
+	// List the wishlists of a user, the "Saved for later" list first
+	ListWishlists(context.Context, *ListWishlistsRequest) (*ListWishlistsResponse, error)
+	// Rename a named wishlist
+	RenameWishlist(context.Context, *RenameWishlistRequest) (*RenameWishlistResponse, error)
+	// Delete a wishlist and its items
+	DeleteWishlist(context.Context, *DeleteWishlistRequest) (*DeleteWishlistResponse, error)
+	// Get a wishlist with current product data
+	GetWishlist(context.Context, *GetWishlistRequest) (*GetWishlistResponse, error)
+	// Save a product to a wishlist
+	AddToWishlist(context.Context, *AddToWishlistRequest) (*AddToWishlistResponse, error)
+	// Remove a product from a wishlist
+	RemoveFromWishlist(context.Context, *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error)
+	// Move a cart line to a wishlist, the line is removed from the cart
+	MoveToWishlist(context.Context, *MoveToWishlistRequest) (*MoveToWishlistResponse, error)
+	// Move a wishlist item to the cart, the item is removed from the wishlist
+	MoveToCart(context.Context, *MoveToCartRequest) (*MoveToCartResponse, error)
 	mustEmbedUnimplementedCartServer()
 }
 
@@ -157,6 +459,66 @@ func (UnimplementedCartServer) ClearCart(context.Context, *ClearCartRequest) (*C
 func (UnimplementedCartServer) MergeCart(context.Context, *MergeCartRequest) (*MergeCartResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method MergeCart not implemented")
 }
+func (UnimplementedCartServer) AcceptCartPrices(context.Context, *AcceptCartPricesRequest) (*AcceptCartPricesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptCartPrices not implemented")
+}
+func (UnimplementedCartServer) SelectCartItems(context.Context, *SelectCartItemsRequest) (*SelectCartItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SelectCartItems not implemented")
+}
+func (UnimplementedCartServer) SelectAllCartItems(context.Context, *SelectAllCartItemsRequest) (*SelectAllCartItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SelectAllCartItems not implemented")
+}
+func (UnimplementedCartServer) GetCheckoutItems(context.Context, *GetCheckoutItemsRequest) (*GetCheckoutItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCheckoutItems not implemented")
+}
+func (UnimplementedCartServer) RemoveCartItems(context.Context, *RemoveCartItemsRequest) (*RemoveCartItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveCartItems not implemented")
+}
+func (UnimplementedCartServer) PriceCart(context.Context, *PriceCartRequest) (*PriceCartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PriceCart not implemented")
+}
+func (UnimplementedCartServer) TakeQuote(context.Context, *TakeQuoteRequest) (*TakeQuoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TakeQuote not implemented")
+}
+func (UnimplementedCartServer) ReleaseQuote(context.Context, *ReleaseQuoteRequest) (*ReleaseQuoteResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseQuote not implemented")
+}
+func (UnimplementedCartServer) ShareCart(context.Context, *ShareCartRequest) (*ShareCartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ShareCart not implemented")
+}
+func (UnimplementedCartServer) GetSharedCart(context.Context, *GetSharedCartRequest) (*GetSharedCartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSharedCart not implemented")
+}
+func (UnimplementedCartServer) ImportSharedCart(context.Context, *ImportSharedCartRequest) (*ImportSharedCartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportSharedCart not implemented")
+}
+func (UnimplementedCartServer) CreateWishlist(context.Context, *CreateWishlistRequest) (*CreateWishlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateWishlist not implemented")
+}
+func (UnimplementedCartServer) ListWishlists(context.Context, *ListWishlistsRequest) (*ListWishlistsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWishlists not implemented")
+}
+func (UnimplementedCartServer) RenameWishlist(context.Context, *RenameWishlistRequest) (*RenameWishlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RenameWishlist not implemented")
+}
+func (UnimplementedCartServer) DeleteWishlist(context.Context, *DeleteWishlistRequest) (*DeleteWishlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteWishlist not implemented")
+}
+func (UnimplementedCartServer) GetWishlist(context.Context, *GetWishlistRequest) (*GetWishlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetWishlist not implemented")
+}
+func (UnimplementedCartServer) AddToWishlist(context.Context, *AddToWishlistRequest) (*AddToWishlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddToWishlist not implemented")
+}
+func (UnimplementedCartServer) RemoveFromWishlist(context.Context, *RemoveFromWishlistRequest) (*RemoveFromWishlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveFromWishlist not implemented")
+}
+func (UnimplementedCartServer) MoveToWishlist(context.Context, *MoveToWishlistRequest) (*MoveToWishlistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveToWishlist not implemented")
+}
+func (UnimplementedCartServer) MoveToCart(context.Context, *MoveToCartRequest) (*MoveToCartResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveToCart not implemented")
+}
 func (UnimplementedCartServer) mustEmbedUnimplementedCartServer() {}
 func (UnimplementedCartServer) testEmbeddedByValue()              {}
 
@@ -286,6 +648,366 @@ func _Cart_MergeCart_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cart_AcceptCartPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptCartPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).AcceptCartPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_AcceptCartPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).AcceptCartPrices(ctx, req.(*AcceptCartPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_SelectCartItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectCartItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).SelectCartItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_SelectCartItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).SelectCartItems(ctx, req.(*SelectCartItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_SelectAllCartItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectAllCartItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).SelectAllCartItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_SelectAllCartItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).SelectAllCartItems(ctx, req.(*SelectAllCartItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_GetCheckoutItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCheckoutItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).GetCheckoutItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_GetCheckoutItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).GetCheckoutItems(ctx, req.(*GetCheckoutItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_RemoveCartItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCartItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).RemoveCartItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_RemoveCartItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).RemoveCartItems(ctx, req.(*RemoveCartItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_PriceCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PriceCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).PriceCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_PriceCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).PriceCart(ctx, req.(*PriceCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_TakeQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakeQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).TakeQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_TakeQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).TakeQuote(ctx, req.(*TakeQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ReleaseQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ReleaseQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ReleaseQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ReleaseQuote(ctx, req.(*ReleaseQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ShareCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ShareCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ShareCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ShareCart(ctx, req.(*ShareCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_GetSharedCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).GetSharedCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_GetSharedCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).GetSharedCart(ctx, req.(*GetSharedCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ImportSharedCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSharedCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ImportSharedCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ImportSharedCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ImportSharedCart(ctx, req.(*ImportSharedCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_CreateWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).CreateWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_CreateWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).CreateWishlist(ctx, req.(*CreateWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ListWishlists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWishlistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ListWishlists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ListWishlists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ListWishlists(ctx, req.(*ListWishlistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_RenameWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).RenameWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_RenameWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).RenameWishlist(ctx, req.(*RenameWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_DeleteWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).DeleteWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_DeleteWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).DeleteWishlist(ctx, req.(*DeleteWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_GetWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).GetWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_GetWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).GetWishlist(ctx, req.(*GetWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_AddToWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddToWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).AddToWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_AddToWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).AddToWishlist(ctx, req.(*AddToWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_RemoveFromWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveFromWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).RemoveFromWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_RemoveFromWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).RemoveFromWishlist(ctx, req.(*RemoveFromWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_MoveToWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveToWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).MoveToWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_MoveToWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).MoveToWishlist(ctx, req.(*MoveToWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_MoveToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveToCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).MoveToCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_MoveToCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).MoveToCart(ctx, req.(*MoveToCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Cart_ServiceDesc is the grpc.ServiceDesc for Cart service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -317,6 +1039,86 @@ var Cart_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MergeCart",
 			Handler:    _Cart_MergeCart_Handler,
 		},
+		{
+			MethodName: "AcceptCartPrices",
+			Handler:    _Cart_AcceptCartPrices_Handler,
+		},
+		{
+			MethodName: "SelectCartItems",
+			Handler:    _Cart_SelectCartItems_Handler,
+		},
+		{
+			MethodName: "SelectAllCartItems",
+			Handler:    _Cart_SelectAllCartItems_Handler,
+		},
+		{
+			MethodName: "GetCheckoutItems",
+			Handler:    _Cart_GetCheckoutItems_Handler,
+		},
+		{
+			MethodName: "RemoveCartItems",
+			Handler:    _Cart_RemoveCartItems_Handler,
+		},
+		{
+			MethodName: "PriceCart",
+			Handler:    _Cart_PriceCart_Handler,
+		},
+		{
+			MethodName: "TakeQuote",
+			Handler:    _Cart_TakeQuote_Handler,
+		},
+		{
+			MethodName: "ReleaseQuote",
+			Handler:    _Cart_ReleaseQuote_Handler,
+		},
+		{
+			MethodName: "ShareCart",
+			Handler:    _Cart_ShareCart_Handler,
+		},
+		{
+			MethodName: "GetSharedCart",
+			Handler:    _Cart_GetSharedCart_Handler,
+		},
+		{
+			MethodName: "ImportSharedCart",
+			Handler:    _Cart_ImportSharedCart_Handler,
+		},
+		{
+			MethodName: "CreateWishlist",
+			Handler:    _Cart_CreateWishlist_Handler,
+		},
+		{
+			MethodName: "ListWishlists",
+			Handler:    _Cart_ListWishlists_Handler,
+		},
+		{
+			MethodName: "RenameWishlist",
+			Handler:    _Cart_RenameWishlist_Handler,
+		},
+		{
+			MethodName: "DeleteWishlist",
+			Handler:    _Cart_DeleteWishlist_Handler,
+		},
+		{
+			MethodName: "GetWishlist",
+			Handler:    _Cart_GetWishlist_Handler,
+		},
+		{
+			MethodName: "AddToWishlist",
+			Handler:    _Cart_AddToWishlist_Handler,
+		},
+		{
+			MethodName: "RemoveFromWishlist",
+			Handler:    _Cart_RemoveFromWishlist_Handler,
+		},
+		{
+			MethodName: "MoveToWishlist",
+			Handler:    _Cart_MoveToWishlist_Handler,
+		},
+		{
+			MethodName: "MoveToCart",
+			Handler:    _Cart_MoveToCart_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cart.proto",
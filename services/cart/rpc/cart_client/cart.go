@@ -14,22 +14,68 @@ import (
 )
 
 type (
-	AddToCartRequest       = cart.AddToCartRequest
-	AddToCartResponse      = cart.AddToCartResponse
-	CartItem               = cart.CartItem
-	ClearCartRequest       = cart.ClearCartRequest
-	ClearCartResponse      = cart.ClearCartResponse
-	GetCartRequest         = cart.GetCartRequest
-	GetCartResponse        = cart.GetCartResponse
-	MergeCartRequest       = cart.MergeCartRequest
-	MergeCartResponse      = cart.MergeCartResponse
-	RemoveCartItemRequest  = cart.RemoveCartItemRequest
-	RemoveCartItemResponse = cart.RemoveCartItemResponse
-	UpdateCartItemRequest  = cart.UpdateCartItemRequest
-	UpdateCartItemResponse = cart.UpdateCartItemResponse
+	AcceptCartPricesRequest    = cart.AcceptCartPricesRequest
+	AcceptCartPricesResponse   = cart.AcceptCartPricesResponse
+	AddToCartRequest           = cart.AddToCartRequest
+	AddToCartResponse          = cart.AddToCartResponse
+	AddToWishlistRequest       = cart.AddToWishlistRequest
+	AddToWishlistResponse      = cart.AddToWishlistResponse
+	CartIssue                  = cart.CartIssue
+	CartItem                   = cart.CartItem
+	CheckoutItem               = cart.CheckoutItem
+	ClearCartRequest           = cart.ClearCartRequest
+	ClearCartResponse          = cart.ClearCartResponse
+	CreateWishlistRequest      = cart.CreateWishlistRequest
+	CreateWishlistResponse     = cart.CreateWishlistResponse
+	DeleteWishlistRequest      = cart.DeleteWishlistRequest
+	DeleteWishlistResponse     = cart.DeleteWishlistResponse
+	GetCartRequest             = cart.GetCartRequest
+	GetCartResponse            = cart.GetCartResponse
+	GetCheckoutItemsRequest    = cart.GetCheckoutItemsRequest
+	GetCheckoutItemsResponse   = cart.GetCheckoutItemsResponse
+	GetSharedCartRequest       = cart.GetSharedCartRequest
+	GetSharedCartResponse      = cart.GetSharedCartResponse
+	GetWishlistRequest         = cart.GetWishlistRequest
+	GetWishlistResponse        = cart.GetWishlistResponse
+	ImportSharedCartRequest    = cart.ImportSharedCartRequest
+	ImportSharedCartResponse   = cart.ImportSharedCartResponse
+	ListWishlistsRequest       = cart.ListWishlistsRequest
+	ListWishlistsResponse      = cart.ListWishlistsResponse
+	MergeCartRequest           = cart.MergeCartRequest
+	MergeCartResponse          = cart.MergeCartResponse
+	MoveToCartRequest          = cart.MoveToCartRequest
+	MoveToCartResponse         = cart.MoveToCartResponse
+	MoveToWishlistRequest      = cart.MoveToWishlistRequest
+	MoveToWishlistResponse     = cart.MoveToWishlistResponse
+	PriceCartRequest           = cart.PriceCartRequest
+	PriceCartResponse          = cart.PriceCartResponse
+	PricedLine                 = cart.PricedLine
+	QuoteLine                  = cart.QuoteLine
+	ReleaseQuoteRequest        = cart.ReleaseQuoteRequest
+	ReleaseQuoteResponse       = cart.ReleaseQuoteResponse
+	RemoveCartItemRequest      = cart.RemoveCartItemRequest
+	RemoveCartItemResponse     = cart.RemoveCartItemResponse
+	RemoveCartItemsRequest     = cart.RemoveCartItemsRequest
+	RemoveCartItemsResponse    = cart.RemoveCartItemsResponse
+	RemoveFromWishlistRequest  = cart.RemoveFromWishlistRequest
+	RemoveFromWishlistResponse = cart.RemoveFromWishlistResponse
+	RenameWishlistRequest      = cart.RenameWishlistRequest
+	RenameWishlistResponse     = cart.RenameWishlistResponse
+	SelectAllCartItemsRequest  = cart.SelectAllCartItemsRequest
+	SelectAllCartItemsResponse = cart.SelectAllCartItemsResponse
+	SelectCartItemsRequest     = cart.SelectCartItemsRequest
+	SelectCartItemsResponse    = cart.SelectCartItemsResponse
+	ShareCartRequest           = cart.ShareCartRequest
+	ShareCartResponse          = cart.ShareCartResponse
+	TakeQuoteRequest           = cart.TakeQuoteRequest
+	TakeQuoteResponse          = cart.TakeQuoteResponse
+	UpdateCartItemRequest      = cart.UpdateCartItemRequest
+	UpdateCartItemResponse     = cart.UpdateCartItemResponse
+	Wishlist                   = cart.Wishlist
+	WishlistItem               = cart.WishlistItem
 
 	Cart interface {
-		// Add product to cart
+		// Add product to cart, requests with user_id 0 use the guest cart temp_cart_id instead
 		AddToCart(ctx context.Context, in *AddToCartRequest, opts ...grpc.CallOption) (*AddToCartResponse, error)
 		// Get user's cart
 		GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*GetCartResponse, error)
@@ -39,8 +85,48 @@ type (
 		RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, opts ...grpc.CallOption) (*RemoveCartItemResponse, error)
 		// Clear entire cart
 		ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error)
-		// Merge guest cart with user cart (after login)
+		// Merge guest cart into user cart (after login), the guest cart is deleted
 		MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*MergeCartResponse, error)
+		// Accept the current prices of cart lines whose price changed since they were added
+		AcceptCartPrices(ctx context.Context, in *AcceptCartPricesRequest, opts ...grpc.CallOption) (*AcceptCartPricesResponse, error)
+		// Select or deselect cart lines for checkout, new lines are selected
+		SelectCartItems(ctx context.Context, in *SelectCartItemsRequest, opts ...grpc.CallOption) (*SelectCartItemsResponse, error)
+		// Select or deselect all cart lines
+		SelectAllCartItems(ctx context.Context, in *SelectAllCartItemsRequest, opts ...grpc.CallOption) (*SelectAllCartItemsResponse, error)
+		// Get the selected lines of a user's cart (called by order service at checkout)
+		GetCheckoutItems(ctx context.Context, in *GetCheckoutItemsRequest, opts ...grpc.CallOption) (*GetCheckoutItemsResponse, error)
+		// Remove several items from a user's cart (called by order service after checkout)
+		RemoveCartItems(ctx context.Context, in *RemoveCartItemsRequest, opts ...grpc.CallOption) (*RemoveCartItemsResponse, error)
+		// Price the selected lines like checkout will and list every issue that blocks the order
+		PriceCart(ctx context.Context, in *PriceCartRequest, opts ...grpc.CallOption) (*PriceCartResponse, error)
+		// Take a quote (called by order service at checkout), a quote can be used once, ReleaseQuote gives it back if the order fails
+		TakeQuote(ctx context.Context, in *TakeQuoteRequest, opts ...grpc.CallOption) (*TakeQuoteResponse, error)
+		// Give back a taken quote when the order failed, so it can be used again until it expires
+		ReleaseQuote(ctx context.Context, in *ReleaseQuoteRequest, opts ...grpc.CallOption) (*ReleaseQuoteResponse, error)
+		// Snapshot a cart into a share token that expires, later changes to the cart are not shared
+		ShareCart(ctx context.Context, in *ShareCartRequest, opts ...grpc.CallOption) (*ShareCartResponse, error)
+		// Get a shared cart by token with current product data (public)
+		GetSharedCart(ctx context.Context, in *GetSharedCartRequest, opts ...grpc.CallOption) (*GetSharedCartResponse, error)
+		// Merge the lines of a shared cart into the caller's cart with the limits of AddToCart
+		ImportSharedCart(ctx context.Context, in *ImportSharedCartRequest, opts ...grpc.CallOption) (*ImportSharedCartResponse, error)
+		// Create a named wishlist
+		CreateWishlist(ctx context.Context, in *CreateWishlistRequest, opts ...grpc.CallOption) (*CreateWishlistResponse, error)
+		// List the wishlists of a user, the "Saved for later" list first
+		ListWishlists(ctx context.Context, in *ListWishlistsRequest, opts ...grpc.CallOption) (*ListWishlistsResponse, error)
+		// Rename a named wishlist
+		RenameWishlist(ctx context.Context, in *RenameWishlistRequest, opts ...grpc.CallOption) (*RenameWishlistResponse, error)
+		// Delete a wishlist and its items
+		DeleteWishlist(ctx context.Context, in *DeleteWishlistRequest, opts ...grpc.CallOption) (*DeleteWishlistResponse, error)
+		// Get a wishlist with current product data
+		GetWishlist(ctx context.Context, in *GetWishlistRequest, opts ...grpc.CallOption) (*GetWishlistResponse, error)
+		// Save a product to a wishlist
+		AddToWishlist(ctx context.Context, in *AddToWishlistRequest, opts ...grpc.CallOption) (*AddToWishlistResponse, error)
+		// Remove a product from a wishlist
+		RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest, opts ...grpc.CallOption) (*RemoveFromWishlistResponse, error)
+		// Move a cart line to a wishlist, the line is removed from the cart
+		MoveToWishlist(ctx context.Context, in *MoveToWishlistRequest, opts ...grpc.CallOption) (*MoveToWishlistResponse, error)
+		// Move a wishlist item to the cart, the item is removed from the wishlist
+		MoveToCart(ctx context.Context, in *MoveToCartRequest, opts ...grpc.CallOption) (*MoveToCartResponse, error)
 	}
 
 	defaultCart struct {
@@ -54,7 +140,7 @@ func NewCart(cli zrpc.Client) Cart {
 	}
 }
 
-// Add product to cart
+// Add product to cart, requests with user_id 0 use the guest cart temp_cart_id instead
 func (m *defaultCart) AddToCart(ctx context.Context, in *AddToCartRequest, opts ...grpc.CallOption) (*AddToCartResponse, error) {
 	client := cart.NewCartClient(m.cli.Conn())
 	return client.AddToCart(ctx, in, opts...)
@@ -84,8 +170,128 @@ func (m *defaultCart) ClearCart(ctx context.Context, in *ClearCartRequest, opts
 	return client.ClearCart(ctx, in, opts...)
 }
 
-// Merge guest cart with user cart (after login)
+// Merge guest cart into user cart (after login), the guest cart is deleted
 func (m *defaultCart) MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*MergeCartResponse, error) {
 	client := cart.NewCartClient(m.cli.Conn())
 	return client.MergeCart(ctx, in, opts...)
 }
+
+// Accept the current prices of cart lines whose price changed since they were added
+func (m *defaultCart) AcceptCartPrices(ctx context.Context, in *AcceptCartPricesRequest, opts ...grpc.CallOption) (*AcceptCartPricesResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.AcceptCartPrices(ctx, in, opts...)
+}
+
+// Select or deselect cart lines for checkout, new lines are selected
+func (m *defaultCart) SelectCartItems(ctx context.Context, in *SelectCartItemsRequest, opts ...grpc.CallOption) (*SelectCartItemsResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.SelectCartItems(ctx, in, opts...)
+}
+
+// Select or deselect all cart lines
+func (m *defaultCart) SelectAllCartItems(ctx context.Context, in *SelectAllCartItemsRequest, opts ...grpc.CallOption) (*SelectAllCartItemsResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.SelectAllCartItems(ctx, in, opts...)
+}
+
+// Get the selected lines of a user's cart (called by order service at checkout)
+func (m *defaultCart) GetCheckoutItems(ctx context.Context, in *GetCheckoutItemsRequest, opts ...grpc.CallOption) (*GetCheckoutItemsResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.GetCheckoutItems(ctx, in, opts...)
+}
+
+// Remove several items from a user's cart (called by order service after checkout)
+func (m *defaultCart) RemoveCartItems(ctx context.Context, in *RemoveCartItemsRequest, opts ...grpc.CallOption) (*RemoveCartItemsResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.RemoveCartItems(ctx, in, opts...)
+}
+
+// Price the selected lines like checkout will and list every issue that blocks the order
+func (m *defaultCart) PriceCart(ctx context.Context, in *PriceCartRequest, opts ...grpc.CallOption) (*PriceCartResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.PriceCart(ctx, in, opts...)
+}
+
+// Take a quote (called by order service at checkout), a quote can be used once, ReleaseQuote gives it back if the order fails
+func (m *defaultCart) TakeQuote(ctx context.Context, in *TakeQuoteRequest, opts ...grpc.CallOption) (*TakeQuoteResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.TakeQuote(ctx, in, opts...)
+}
+
+// Give back a taken quote when the order failed, so it can be used again until it expires
+func (m *defaultCart) ReleaseQuote(ctx context.Context, in *ReleaseQuoteRequest, opts ...grpc.CallOption) (*ReleaseQuoteResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.ReleaseQuote(ctx, in, opts...)
+}
+
+// Snapshot a cart into a share token that expires, later changes to the cart are not shared
+func (m *defaultCart) ShareCart(ctx context.Context, in *ShareCartRequest, opts ...grpc.CallOption) (*ShareCartResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.ShareCart(ctx, in, opts...)
+}
+
+// Get a shared cart by token with current product data (public)
+func (m *defaultCart) GetSharedCart(ctx context.Context, in *GetSharedCartRequest, opts ...grpc.CallOption) (*GetSharedCartResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.GetSharedCart(ctx, in, opts...)
+}
+
+// Merge the lines of a shared cart into the caller's cart with the limits of AddToCart
+func (m *defaultCart) ImportSharedCart(ctx context.Context, in *ImportSharedCartRequest, opts ...grpc.CallOption) (*ImportSharedCartResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.ImportSharedCart(ctx, in, opts...)
+}
+
+// Create a named wishlist
+func (m *defaultCart) CreateWishlist(ctx context.Context, in *CreateWishlistRequest, opts ...grpc.CallOption) (*CreateWishlistResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.CreateWishlist(ctx, in, opts...)
+}
+
+// List the wishlists of a user, the "Saved for later" list first
+func (m *defaultCart) ListWishlists(ctx context.Context, in *ListWishlistsRequest, opts ...grpc.CallOption) (*ListWishlistsResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.ListWishlists(ctx, in, opts...)
+}
+
+// Rename a named wishlist
+func (m *defaultCart) RenameWishlist(ctx context.Context, in *RenameWishlistRequest, opts ...grpc.CallOption) (*RenameWishlistResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.RenameWishlist(ctx, in, opts...)
+}
+
+// Delete a wishlist and its items
+func (m *defaultCart) DeleteWishlist(ctx context.Context, in *DeleteWishlistRequest, opts ...grpc.CallOption) (*DeleteWishlistResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.DeleteWishlist(ctx, in, opts...)
+}
+
+// Get a wishlist with current product data
+func (m *defaultCart) GetWishlist(ctx context.Context, in *GetWishlistRequest, opts ...grpc.CallOption) (*GetWishlistResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.GetWishlist(ctx, in, opts...)
+}
+
+// Save a product to a wishlist
+func (m *defaultCart) AddToWishlist(ctx context.Context, in *AddToWishlistRequest, opts ...grpc.CallOption) (*AddToWishlistResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.AddToWishlist(ctx, in, opts...)
+}
+
+// Remove a product from a wishlist
+func (m *defaultCart) RemoveFromWishlist(ctx context.Context, in *RemoveFromWishlistRequest, opts ...grpc.CallOption) (*RemoveFromWishlistResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.RemoveFromWishlist(ctx, in, opts...)
+}
+
+// Move a cart line to a wishlist, the line is removed from the cart
+func (m *defaultCart) MoveToWishlist(ctx context.Context, in *MoveToWishlistRequest, opts ...grpc.CallOption) (*MoveToWishlistResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.MoveToWishlist(ctx, in, opts...)
+}
+
+// Move a wishlist item to the cart, the item is removed from the wishlist
+func (m *defaultCart) MoveToCart(ctx context.Context, in *MoveToCartRequest, opts ...grpc.CallOption) (*MoveToCartResponse, error) {
+	client := cart.NewCartClient(m.cli.Conn())
+	return client.MoveToCart(ctx, in, opts...)
+}
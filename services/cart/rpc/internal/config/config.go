@@ -8,6 +8,17 @@ import (
 type Config struct {
 	zrpc.RpcServerConf
 
+	// Database configuration for wishlists and the durable copy of user carts
+	DB struct {
+		DataSource string
+	}
+
+	DBPool struct {
+		MaxOpenConns    int
+		MaxIdleConns    int
+		ConnMaxLifetime int
+	}
+
 	// Redis configuration for cart storage
 	RedisConf redis.RedisConf
 
@@ -16,6 +27,35 @@ type Config struct {
 		Expire             int // Cart expiration time in seconds
 		MaxItems           int // Maximum items per cart
 		MaxQuantityPerItem int // Maximum quantity per item
+		GuestExpire        int `json:",default=259200"`  // Guest cart expiration time in seconds
+		PersistInterval    int `json:",default=5"`       // Seconds between writes of changed user carts to the database
+		Retention          int `json:",default=2592000"` // Seconds a saved user cart is kept after its last change
+		AbandonAfter       int `json:",default=3600"`    // Seconds a user cart stays unchanged without an order before it is abandoned
+		AbandonInterval    int `json:",default=300"`     // Seconds between scans for abandoned carts
+		QuoteExpire        int `json:",default=900"`     // Seconds a cart price quote can be used by CreateOrder
+		ShareExpire        int `json:",default=604800"`  // Seconds a shared cart link stays valid
+	}
+
+	// Wishlist settings
+	Wishlist struct {
+		MaxLists int `json:",default=20"`  // Maximum named wishlists per user
+		MaxItems int `json:",default=200"` // Maximum items per wishlist
+	}
+
+	// Kafka configuration
+	Kafka struct {
+		Brokers       []string
+		GroupId       string `json:",default=cart.rpc"` // Consumer group for topics consumed by the cart service
+		BatchSize     int    `json:",default=100"`      // Cart change events per Kafka write
+		BatchInterval int    `json:",default=1000"`     // Milliseconds a cart change event waits for its batch at most
+		Topics        struct {
+			CartAbandoned   string `json:",default=cart.abandoned"`    // User cart idle without an order, for reminders
+			CartItemAdded   string `json:",default=cart.item.added"`   // Product added to a cart, or its quantity increased by adding
+			CartItemUpdated string `json:",default=cart.item.updated"` // Quantity of a line changed
+			CartItemRemoved string `json:",default=cart.item.removed"` // Line removed from a cart
+			CartCleared     string `json:",default=cart.cleared"`      // All lines removed from a cart
+			OrderCreated    string `json:",default=order.created"`     // Consumed: an order stops the user's cart counting as abandoned
+		}
 	}
 
 	// Product RPC client
@@ -0,0 +1,126 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"letsgo/services/cart/rpc/internal/svc"
+	"letsgo/services/cart/rpc/internal/utils"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// maxRetryBackoff caps the wait between attempts to record the same order
+const maxRetryBackoff = 30 * time.Second
+
+// OrderCreatedConsumer records the latest order of each user on the saved cart,
+// a cart last changed before the user's latest order is not abandoned.
+// Recording an order twice has no effect, so redelivered events need no deduplication
+type OrderCreatedConsumer struct {
+	svcCtx *svc.ServiceContext
+	reader *kafka.Reader
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewOrderCreatedConsumer creates an order created consumer
+func NewOrderCreatedConsumer(svcCtx *svc.ServiceContext) *OrderCreatedConsumer {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &OrderCreatedConsumer{
+		svcCtx: svcCtx,
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers:  svcCtx.Config.Kafka.Brokers,
+			GroupID:  svcCtx.Config.Kafka.GroupId,
+			Topic:    svcCtx.Config.Kafka.Topics.OrderCreated,
+			MinBytes: 1,
+			MaxBytes: 10e6,
+		}),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Start consumes in background until Stop is called
+func (c *OrderCreatedConsumer) Start() {
+	threading.GoSafe(func() {
+		for {
+			msg, err := c.reader.FetchMessage(c.ctx)
+			if err != nil {
+				if c.ctx.Err() != nil {
+					return
+				}
+				logx.Errorf("Failed to fetch order created event: %v", err)
+				time.Sleep(time.Second)
+				continue
+			}
+
+			if !c.handle(msg) {
+				// Stopped before the order was recorded, leave the offset for the next start
+				return
+			}
+
+			if err := c.reader.CommitMessages(c.ctx, msg); err != nil {
+				logx.Errorf("Failed to commit order created event: offset=%d, err=%v", msg.Offset, err)
+			}
+		}
+	})
+}
+
+// Stop stops consuming and closes the reader
+func (c *OrderCreatedConsumer) Stop() {
+	c.cancel()
+	c.reader.Close()
+}
+
+// handle records one order, retrying until it is stored.
+// Returns false if the consumer was stopped first
+func (c *OrderCreatedConsumer) handle(msg kafka.Message) bool {
+	var event utils.OrderCreatedEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		// Retrying cannot fix a malformed event, skip it
+		logx.Errorf("Invalid order created event, skipped: offset=%d, err=%v", msg.Offset, err)
+		return true
+	}
+
+	if event.Data.UserID <= 0 {
+		return true
+	}
+
+	orderedAt := time.Unix(event.Timestamp, 0)
+	if event.Timestamp <= 0 {
+		orderedAt = msg.Time
+	}
+
+	backoff := time.Second
+	for {
+		err := c.recordOrder(event.Data.UserID, orderedAt)
+		if err == nil {
+			return true
+		}
+
+		logx.Errorf("Failed to record order on cart, retrying in %s: order_no=%s, err=%v", backoff, event.Data.OrderNo, err)
+
+		select {
+		case <-time.After(backoff):
+		case <-c.ctx.Done():
+			return false
+		}
+
+		backoff *= 2
+		if backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+	}
+}
+
+// recordOrder stores the order time on the user's saved cart
+func (c *OrderCreatedConsumer) recordOrder(userId int64, orderedAt time.Time) error {
+	ctx, cancel := context.WithTimeout(c.ctx, 10*time.Second)
+	defer cancel()
+
+	return c.svcCtx.CartModel.MarkOrdered(ctx, userId, orderedAt)
+}
@@ -0,0 +1,177 @@
+package job
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"letsgo/services/cart/model"
+	"letsgo/services/cart/rpc/internal/logic"
+	"letsgo/services/cart/rpc/internal/svc"
+	"letsgo/services/cart/rpc/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// abandonBatchSize limits carts checked per scan, the rest is picked up by the next tick
+const abandonBatchSize = 200
+
+// abandonLockKey keeps instances of the cart service from scanning at the same time
+const abandonLockKey = "cart:abandon:lock"
+
+// AbandonJob periodically publishes cart.abandoned events for saved user carts
+// that stayed unchanged longer than Cart.AbandonAfter without a later order
+type AbandonJob struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+	done     chan struct{}
+}
+
+// NewAbandonJob creates an abandoned cart job
+func NewAbandonJob(svcCtx *svc.ServiceContext) *AbandonJob {
+	return &AbandonJob{
+		svcCtx:   svcCtx,
+		interval: time.Duration(svcCtx.Config.Cart.AbandonInterval) * time.Second,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the job in background until Stop is called
+func (j *AbandonJob) Start() {
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				j.runOnce()
+			case <-j.done:
+				return
+			}
+		}
+	})
+}
+
+// Stop stops the job
+func (j *AbandonJob) Stop() {
+	close(j.done)
+}
+
+// runOnce reports idle carts, every checked cart state is recorded so it is reported at most once
+func (j *AbandonJob) runOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), j.interval)
+	defer cancel()
+
+	locked, err := j.svcCtx.Redis.SetnxExCtx(ctx, abandonLockKey, "1", int(j.interval/time.Second))
+	if err != nil {
+		logx.Errorf("Failed to lock abandoned cart scan: %v", err)
+		return
+	}
+	if !locked {
+		return
+	}
+	defer j.svcCtx.Redis.DelCtx(ctx, abandonLockKey)
+
+	idleBefore := time.Now().Add(-time.Duration(j.svcCtx.Config.Cart.AbandonAfter) * time.Second)
+	carts, err := j.svcCtx.CartModel.FindAbandoned(ctx, idleBefore, abandonBatchSize)
+	if err != nil {
+		logx.Errorf("Failed to find abandoned carts: %v", err)
+		return
+	}
+
+	published := 0
+	for _, cart := range carts {
+		// Changes not saved yet are newer than the saved copy, check the cart after the next save
+		dirty, err := j.svcCtx.Redis.SismemberCtx(ctx, logic.CartDirtyKey, cart.UserId)
+		if err != nil {
+			logx.Errorf("Failed to check cart state: user_id=%d, err=%v", cart.UserId, err)
+			continue
+		}
+		if dirty {
+			continue
+		}
+
+		ok, err := j.handle(ctx, cart)
+		if err != nil {
+			logx.Errorf("Failed to report abandoned cart: user_id=%d, err=%v", cart.UserId, err)
+			continue
+		}
+		if ok {
+			published++
+		}
+	}
+
+	if published > 0 {
+		logx.Infof("Published %d abandoned carts", published)
+	}
+}
+
+// handle publishes the event of one idle cart unless its contents were already reported,
+// e.g. when only the selection of lines changed. Returns whether an event was published
+func (j *AbandonJob) handle(ctx context.Context, cart *model.Cart) (bool, error) {
+	var lines map[string]utils.CartLine
+	if err := json.Unmarshal([]byte(cart.Items), &lines); err != nil {
+		return false, err
+	}
+
+	items := make([]utils.CartLine, 0, len(lines))
+	for _, line := range lines {
+		items = append(items, line)
+	}
+	sort.Slice(items, func(a, b int) bool {
+		return items[a].ProductID < items[b].ProductID
+	})
+
+	hash := contentsHash(items)
+	if hash == cart.AbandonedHash {
+		return false, j.svcCtx.CartModel.MarkAbandoned(ctx, cart.UserId, cart.UpdatedAt, hash)
+	}
+
+	var totalPrice float64
+	for _, item := range items {
+		totalPrice += item.Price * float64(item.Quantity)
+	}
+
+	event := utils.CartAbandonedEvent{
+		EventType: "cart.abandoned",
+		EventID:   uuid.New().String(),
+		Timestamp: time.Now().Unix(),
+		Data: utils.CartAbandonedData{
+			UserID:     cart.UserId,
+			Items:      items,
+			TotalPrice: totalPrice,
+			UpdatedAt:  cart.UpdatedAt.Unix(),
+		},
+	}
+
+	key := fmt.Sprintf("%d", cart.UserId)
+	if err := j.svcCtx.KafkaProducer.PublishEvent(ctx, j.svcCtx.Config.Kafka.Topics.CartAbandoned, key, event); err != nil {
+		return false, err
+	}
+
+	// A failure here reports the same state again on the next scan
+	if err := j.svcCtx.CartModel.MarkAbandoned(ctx, cart.UserId, cart.UpdatedAt, hash); err != nil {
+		return true, err
+	}
+
+	return true, nil
+}
+
+// contentsHash identifies the products and quantities of a cart, items must be sorted by product
+func contentsHash(items []utils.CartLine) string {
+	var b strings.Builder
+	for _, item := range items {
+		fmt.Fprintf(&b, "%d:%d;", item.ProductID, item.Quantity)
+	}
+
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
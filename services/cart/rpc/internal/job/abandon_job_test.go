@@ -0,0 +1,116 @@
+package job
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"letsgo/services/cart/model"
+	"letsgo/services/cart/rpc/internal/logic"
+	"letsgo/services/cart/rpc/internal/svc"
+	"letsgo/services/cart/rpc/internal/utils"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+func TestMain(m *testing.M) {
+	logx.Disable()
+	os.Exit(m.Run())
+}
+
+// fakeCartModel serves fixed idle carts and records which states were marked as checked
+type fakeCartModel struct {
+	model.CartModel
+	idle   []*model.Cart
+	marked map[int64]string // User ID -> reported contents hash
+}
+
+func (m *fakeCartModel) FindAbandoned(ctx context.Context, idleBefore time.Time, limit int) ([]*model.Cart, error) {
+	return m.idle, nil
+}
+
+func (m *fakeCartModel) MarkAbandoned(ctx context.Context, userId int64, updatedAt time.Time, hash string) error {
+	m.marked[userId] = hash
+	return nil
+}
+
+func newAbandonTest(t *testing.T) (*AbandonJob, *fakeCartModel, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	carts := &fakeCartModel{marked: make(map[int64]string)}
+
+	svcCtx := &svc.ServiceContext{
+		Redis:     redis.New(mr.Addr()),
+		CartModel: carts,
+	}
+	svcCtx.Config.Cart.AbandonAfter = 3600
+	svcCtx.Config.Cart.AbandonInterval = 60
+
+	return NewAbandonJob(svcCtx), carts, mr
+}
+
+func TestContentsHash(t *testing.T) {
+	base := contentsHash([]utils.CartLine{{ProductID: 1, Quantity: 2}, {ProductID: 3, Quantity: 1}})
+
+	tests := []struct {
+		name  string
+		items []utils.CartLine
+		same  bool
+	}{
+		{name: "price and name ignored", items: []utils.CartLine{{ProductID: 1, Quantity: 2, Price: 9, Name: "Pen"}, {ProductID: 3, Quantity: 1}}, same: true},
+		{name: "quantity changed", items: []utils.CartLine{{ProductID: 1, Quantity: 3}, {ProductID: 3, Quantity: 1}}},
+		{name: "line removed", items: []utils.CartLine{{ProductID: 1, Quantity: 2}}},
+		{name: "line added", items: []utils.CartLine{{ProductID: 1, Quantity: 2}, {ProductID: 3, Quantity: 1}, {ProductID: 4, Quantity: 1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if same := contentsHash(tt.items) == base; same != tt.same {
+				t.Fatalf("same hash = %v, want %v", same, tt.same)
+			}
+		})
+	}
+}
+
+func TestAbandonSkipsReportedContents(t *testing.T) {
+	job, carts, _ := newAbandonTest(t)
+
+	// Only the selection changed since the cart was reported, so the contents hash is the same
+	items := `{"product:3":{"product_id":3,"quantity":1,"selected":false},"product:1":{"product_id":1,"quantity":2}}`
+	hash := contentsHash([]utils.CartLine{{ProductID: 1, Quantity: 2}, {ProductID: 3, Quantity: 1}})
+	carts.idle = []*model.Cart{{UserId: 42, Items: items, UpdatedAt: time.Unix(100, 0), AbandonedHash: hash}}
+
+	// The job has no Kafka producer, publishing would panic
+	job.runOnce()
+
+	if carts.marked[42] != hash {
+		t.Fatalf("marked = %v, want the state recorded without publishing", carts.marked)
+	}
+}
+
+func TestAbandonSkipsUnsavedCarts(t *testing.T) {
+	job, carts, mr := newAbandonTest(t)
+	carts.idle = []*model.Cart{{UserId: 42, Items: `{}`, UpdatedAt: time.Unix(100, 0)}}
+	mr.SAdd(logic.CartDirtyKey, "42")
+
+	job.runOnce()
+
+	if len(carts.marked) != 0 {
+		t.Fatalf("cart with unsaved changes was checked: %v", carts.marked)
+	}
+}
+
+func TestAbandonScanRunsOnce(t *testing.T) {
+	job, carts, mr := newAbandonTest(t)
+	carts.idle = []*model.Cart{{UserId: 42, Items: `{}`, UpdatedAt: time.Unix(100, 0), AbandonedHash: contentsHash(nil)}}
+	// Another instance is scanning
+	mr.Set(abandonLockKey, "1")
+
+	job.runOnce()
+
+	if len(carts.marked) != 0 {
+		t.Fatalf("scanned while locked: %v", carts.marked)
+	}
+}
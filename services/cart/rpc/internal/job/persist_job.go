@@ -0,0 +1,119 @@
+package job
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"letsgo/services/cart/rpc/internal/logic"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// persistBatchSize limits carts saved per tick, the rest is picked up by the next tick
+const persistBatchSize = 500
+
+// retentionInterval is how often saved carts past their retention are removed
+const retentionInterval = time.Hour
+
+// PersistJob periodically writes changed user carts from Redis to the database
+// and removes saved carts that were not changed within the retention period
+type PersistJob struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+	done     chan struct{}
+}
+
+// NewPersistJob creates a cart persistence job
+func NewPersistJob(svcCtx *svc.ServiceContext) *PersistJob {
+	return &PersistJob{
+		svcCtx:   svcCtx,
+		interval: time.Duration(svcCtx.Config.Cart.PersistInterval) * time.Second,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the job in background until Stop is called
+func (j *PersistJob) Start() {
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		cleanup := time.NewTicker(retentionInterval)
+		defer cleanup.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				j.persistOnce()
+			case <-cleanup.C:
+				j.cleanupOnce()
+			case <-j.done:
+				return
+			}
+		}
+	})
+}
+
+// Stop stops the job
+func (j *PersistJob) Stop() {
+	close(j.done)
+}
+
+// persistOnce saves carts queued in the dirty set, the set outlives restarts of the service
+// SPOP hands every cart to one instance, a cart that fails to save is queued again
+func (j *PersistJob) persistOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), j.interval)
+	defer cancel()
+
+	saved := 0
+	for i := 0; i < persistBatchSize; i++ {
+		member, err := j.svcCtx.Redis.SpopCtx(ctx, logic.CartDirtyKey)
+		if err == redis.Nil {
+			break
+		}
+		if err != nil {
+			logx.Errorf("Failed to get changed carts: %v", err)
+			break
+		}
+
+		userId, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			logx.Errorf("Invalid user ID in changed carts: %q", member)
+			continue
+		}
+
+		if err := logic.PersistCart(ctx, j.svcCtx, userId); err != nil {
+			logx.Errorf("Failed to save cart: user_id=%d, err=%v", userId, err)
+			if _, err := j.svcCtx.Redis.SaddCtx(ctx, logic.CartDirtyKey, userId); err != nil {
+				logx.Errorf("Failed to queue cart again: user_id=%d, err=%v", userId, err)
+			}
+			continue
+		}
+		saved++
+	}
+
+	if saved > 0 {
+		logx.Infof("Saved %d carts to database", saved)
+	}
+}
+
+// cleanupOnce removes saved carts not changed within the retention period
+func (j *PersistJob) cleanupOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	before := time.Now().Add(-time.Duration(j.svcCtx.Config.Cart.Retention) * time.Second)
+	removed, err := j.svcCtx.CartModel.DeleteBefore(ctx, before)
+	if err != nil {
+		logx.Errorf("Failed to remove expired saved carts: %v", err)
+		return
+	}
+
+	if removed > 0 {
+		logx.Infof("Removed %d saved carts past retention", removed)
+	}
+}
@@ -0,0 +1,136 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AcceptCartPricesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAcceptCartPricesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AcceptCartPricesLogic {
+	return &AcceptCartPricesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Accept the current prices of cart lines whose price changed since they were added
+func (l *AcceptCartPricesLogic) AcceptCartPrices(in *cart.AcceptCartPricesRequest) (*cart.AcceptCartPricesResponse, error) {
+	// 1. Validate input
+	cartKey, expire, err := loadCart(l.ctx, l.svcCtx, in.UserId, in.TempCartId)
+	if err != nil {
+		return nil, err
+	}
+	for _, productId := range in.ProductIds {
+		if productId <= 0 {
+			return nil, errorx.NewCodeError(1001, "Invalid product ID")
+		}
+	}
+
+	// 2. Lines to accept, all lines by default
+	productIds := in.ProductIds
+	if len(productIds) == 0 {
+		fields, err := l.svcCtx.Redis.HkeysCtx(l.ctx, cartKey)
+		if err != nil {
+			l.Logger.Errorf("Failed to get cart: cart=%s, err=%v", cartKey, err)
+			return nil, errorx.ErrCache
+		}
+		for _, field := range fields {
+			var productId int64
+			if _, err := fmt.Sscanf(field, "product:%d", &productId); err == nil {
+				productIds = append(productIds, productId)
+			}
+		}
+	}
+	if len(productIds) == 0 {
+		return &cart.AcceptCartPricesResponse{
+			Success: true,
+		}, nil
+	}
+
+	// 3. Get current product data, products taken off sale keep their saved price
+	productsResp, err := l.svcCtx.ProductRpc.GetProducts(l.ctx, &product.GetProductsRequest{
+		Ids: productIds,
+	})
+	if err != nil {
+		l.Logger.Errorf("Failed to get products: err=%v", err)
+		return nil, errorx.ErrProductNotFound
+	}
+
+	args := make([]interface{}, 0, 1+len(productIds)*2)
+	args = append(args, expire)
+	for _, result := range productsResp.Results {
+		if !result.Found {
+			continue
+		}
+		current := &CartItemData{
+			Name:  result.Product.Name,
+			Price: result.Product.Price,
+			Image: getFirstImage(result.Product.Images),
+		}
+		currentJSON, err := json.Marshal(current)
+		if err != nil {
+			l.Logger.Errorf("Failed to marshal cart item: %v", err)
+			return nil, errorx.ErrSystem
+		}
+		args = append(args, fmt.Sprintf("product:%d", result.Id), string(currentJSON))
+	}
+
+	// 4. Save current prices using Lua script (atomic operation)
+	// Lines removed in the meantime are not added back
+	script := `
+		local cart_key = KEYS[1]
+		local expire_time = tonumber(ARGV[1])
+		local updated = 0
+
+		for i = 2, #ARGV, 2 do
+			local current_item = redis.call('HGET', cart_key, ARGV[i])
+			if current_item then
+				local item = cjson.decode(current_item)
+				local current = cjson.decode(ARGV[i + 1])
+				if item.price ~= current.price then
+					updated = updated + 1
+				end
+				item.name = current.name
+				item.price = current.price
+				item.image = current.image
+				redis.call('HSET', cart_key, ARGV[i], cjson.encode(item))
+			end
+		end
+
+		if updated > 0 then
+			redis.call('EXPIRE', cart_key, expire_time)
+		end
+
+		return updated
+	`
+
+	result, err := l.svcCtx.Redis.EvalCtx(l.ctx, script, []string{cartKey}, args...)
+	if err != nil {
+		l.Logger.Errorf("Failed to accept cart prices: cart=%s, err=%v", cartKey, err)
+		return nil, errorx.ErrCache
+	}
+	updated, _ := result.(int64)
+
+	markCartDirty(l.ctx, l.svcCtx, in.UserId)
+
+	l.Logger.Infof("Accepted cart prices successfully: cart=%s, updated=%d", cartKey, updated)
+
+	return &cart.AcceptCartPricesResponse{
+		Success: true,
+		Updated: updated,
+	}, nil
+}
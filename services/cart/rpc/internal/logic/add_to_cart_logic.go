@@ -36,6 +36,9 @@ type CartItemData struct {
 	Quantity  int64   `json:"quantity"`
 	Image     string  `json:"image"`
 	AddedAt   int64   `json:"added_at"`
+
+	// Stored inverted, so lines saved before selection existed count as selected
+	Deselected bool `json:"deselected,omitempty"`
 }
 
 // Add product to cart
@@ -44,6 +47,10 @@ func (l *AddToCartLogic) AddToCart(in *cart.AddToCartRequest) (*cart.AddToCartRe
 	if err := l.validateParams(in); err != nil {
 		return nil, err
 	}
+	cartKey, expire, err := loadCart(l.ctx, l.svcCtx, in.UserId, in.TempCartId)
+	if err != nil {
+		return nil, err
+	}
 
 	// 2. Get product information from Product service
 	productInfo, err := l.svcCtx.ProductRpc.GetProduct(l.ctx, &product.GetProductRequest{
@@ -71,7 +78,7 @@ func (l *AddToCartLogic) AddToCart(in *cart.AddToCartRequest) (*cart.AddToCartRe
 	}
 
 	// 4. Add to cart using Lua script (atomic operation)
-	cartKey := fmt.Sprintf("cart:user:%d", in.UserId)
+	limit, limitErr := purchaseLimit(productInfo.Product)
 	productField := fmt.Sprintf("product:%d", in.ProductId)
 
 	// Lua script for atomic add to cart
@@ -83,16 +90,20 @@ func (l *AddToCartLogic) AddToCart(in *cart.AddToCartRequest) (*cart.AddToCartRe
 		local max_items = tonumber(ARGV[4])
 		local item_data = ARGV[5]
 		local expire_time = tonumber(ARGV[6])
+		local product_limit = tonumber(ARGV[7])
 
 		-- Get current item
 		local current_item = redis.call('HGET', cart_key, product_field)
 		local current_qty = 0
+		local new_item
 
 		if current_item then
-			-- Item exists, decode and get quantity
-			local item = cjson.decode(current_item)
-			current_qty = item.quantity
+			-- Item exists, only its quantity changes so the saved price and selection stay
+			new_item = cjson.decode(current_item)
+			current_qty = new_item.quantity
 		else
+			new_item = cjson.decode(item_data)
+
 			-- New item, check if cart is full
 			local count = redis.call('HLEN', cart_key)
 			if count >= max_items then
@@ -105,9 +116,11 @@ func (l *AddToCartLogic) AddToCart(in *cart.AddToCartRequest) (*cart.AddToCartRe
 		if new_qty > max_qty then
 			return redis.error_reply('QUANTITY_LIMIT_EXCEEDED')
 		end
+		if product_limit > 0 and new_qty > product_limit then
+			return redis.error_reply('PURCHASE_LIMIT_EXCEEDED')
+		end
 
 		-- Update item data with new quantity
-		local new_item = cjson.decode(item_data)
 		new_item.quantity = new_qty
 		local new_item_json = cjson.encode(new_item)
 
@@ -115,7 +128,7 @@ func (l *AddToCartLogic) AddToCart(in *cart.AddToCartRequest) (*cart.AddToCartRe
 		redis.call('HSET', cart_key, product_field, new_item_json)
 		redis.call('EXPIRE', cart_key, expire_time)
 
-		return new_qty
+		return new_item_json
 	`
 
 	result, err := l.svcCtx.Redis.EvalCtx(l.ctx, script,
@@ -125,23 +138,36 @@ func (l *AddToCartLogic) AddToCart(in *cart.AddToCartRequest) (*cart.AddToCartRe
 		l.svcCtx.Config.Cart.MaxQuantityPerItem,
 		l.svcCtx.Config.Cart.MaxItems,
 		string(itemJSON),
-		l.svcCtx.Config.Cart.Expire,
+		expire,
+		limit,
 	)
 
 	if err != nil {
-		errMsg := err.Error()
+		errMsg := scriptError(err)
 		if errMsg == "CART_FULL" {
 			return nil, errorx.NewCodeError(4002, fmt.Sprintf("Cart is full, maximum %d items allowed", l.svcCtx.Config.Cart.MaxItems))
 		}
 		if errMsg == "QUANTITY_LIMIT_EXCEEDED" {
 			return nil, errorx.NewCodeError(4003, fmt.Sprintf("Quantity limit exceeded, maximum %d per item", l.svcCtx.Config.Cart.MaxQuantityPerItem))
 		}
-		l.Logger.Errorf("Failed to add to cart: user_id=%d, product_id=%d, err=%v", in.UserId, in.ProductId, err)
+		if errMsg == "PURCHASE_LIMIT_EXCEEDED" {
+			return nil, limitErr
+		}
+		l.Logger.Errorf("Failed to add to cart: cart=%s, product_id=%d, err=%v", cartKey, in.ProductId, err)
 		return nil, errorx.ErrCache
 	}
 
-	l.Logger.Infof("Added to cart successfully: user_id=%d, product_id=%d, quantity=%d, new_total=%v",
-		in.UserId, in.ProductId, in.Quantity, result)
+	markCartDirty(l.ctx, l.svcCtx, in.UserId)
+
+	// The saved line carries the price the customer sees, which is kept for existing lines
+	var savedItem CartItemData
+	if itemStr, ok := result.(string); !ok || json.Unmarshal([]byte(itemStr), &savedItem) != nil {
+		savedItem = *cartItem
+	}
+	publishItemEvent(l.svcCtx, EventItemAdded, in.UserId, in.TempCartId, in.ProductId, savedItem.Quantity, in.Quantity, savedItem.Price)
+
+	l.Logger.Infof("Added to cart successfully: cart=%s, product_id=%d, quantity=%d, new_total=%d",
+		cartKey, in.ProductId, in.Quantity, savedItem.Quantity)
 
 	return &cart.AddToCartResponse{
 		Success: true,
@@ -150,9 +176,6 @@ func (l *AddToCartLogic) AddToCart(in *cart.AddToCartRequest) (*cart.AddToCartRe
 
 // validateParams validates input parameters
 func (l *AddToCartLogic) validateParams(in *cart.AddToCartRequest) error {
-	if in.UserId <= 0 {
-		return errorx.NewCodeError(1001, "Invalid user ID")
-	}
 	if in.ProductId <= 0 {
 		return errorx.NewCodeError(1001, "Invalid product ID")
 	}
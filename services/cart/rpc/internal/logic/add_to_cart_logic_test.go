@@ -0,0 +1,83 @@
+package logic
+
+import (
+	"testing"
+
+	"letsgo/services/cart/rpc/cart"
+)
+
+func TestAddToCartNewLine(t *testing.T) {
+	ct := newCartTest(t)
+	ct.addProduct(1, "Pen", 2.5)
+
+	_, err := NewAddToCartLogic(ct.ctx, ct.svcCtx).AddToCart(&cart.AddToCartRequest{UserId: testUserId, ProductId: 1, Quantity: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cartKey, _, _ := resolveCart(ct.svcCtx, testUserId, "")
+	if item := ct.item(t, cartKey, 1); item.Quantity != 2 || item.Price != 2.5 || item.Name != "Pen" {
+		t.Fatalf("item = %+v", item)
+	}
+	if dirty, _ := ct.redis.IsMember(CartDirtyKey, "42"); !dirty {
+		t.Fatal("cart not queued for saving")
+	}
+}
+
+func TestAddToCartExistingLineKeepsPriceAndSelection(t *testing.T) {
+	ct := newCartTest(t)
+	ct.addProduct(1, "Pen", 3)
+	cartKey, _, _ := resolveCart(ct.svcCtx, testUserId, "")
+	ct.setItem(t, cartKey, CartItemData{ProductId: 1, Name: "Pen", Price: 2.5, Quantity: 2, AddedAt: 100, Deselected: true})
+
+	_, err := NewAddToCartLogic(ct.ctx, ct.svcCtx).AddToCart(&cart.AddToCartRequest{UserId: testUserId, ProductId: 1, Quantity: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item := ct.item(t, cartKey, 1)
+	if item.Quantity != 5 {
+		t.Fatalf("quantity = %d, want 5", item.Quantity)
+	}
+	if item.Price != 2.5 || !item.Deselected || item.AddedAt != 100 {
+		t.Fatalf("existing line changed: %+v", item)
+	}
+}
+
+func TestAddToCartLimits(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxPerOrder int64
+		current     int64
+		add         int64
+		fill        int // Other lines in the cart
+		code        int
+	}{
+		{name: "cart full", fill: 3, add: 1, code: 4002},
+		{name: "quantity limit", current: 8, add: 3, code: 4003},
+		{name: "purchase limit", maxPerOrder: 4, current: 3, add: 2, code: 3018},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ct := newCartTest(t)
+			ct.addProduct(1, "Pen", 2.5).MaxPerOrder = tt.maxPerOrder
+			cartKey, _, _ := resolveCart(ct.svcCtx, testUserId, "")
+			if tt.current > 0 {
+				ct.setItem(t, cartKey, CartItemData{ProductId: 1, Quantity: tt.current})
+			}
+			for i := 0; i < tt.fill; i++ {
+				ct.setItem(t, cartKey, CartItemData{ProductId: int64(100 + i), Quantity: 1})
+			}
+
+			_, err := NewAddToCartLogic(ct.ctx, ct.svcCtx).AddToCart(&cart.AddToCartRequest{UserId: testUserId, ProductId: 1, Quantity: tt.add})
+			assertCode(t, err, tt.code)
+
+			if tt.current > 0 {
+				if item := ct.item(t, cartKey, 1); item.Quantity != tt.current {
+					t.Fatalf("quantity changed to %d", item.Quantity)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/model"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AddToWishlistLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewAddToWishlistLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AddToWishlistLogic {
+	return &AddToWishlistLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Save a product to a wishlist
+func (l *AddToWishlistLogic) AddToWishlist(in *cart.AddToWishlistRequest) (*cart.AddToWishlistResponse, error) {
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+	if in.Quantity <= 0 {
+		return nil, errorx.NewCodeError(1001, "Quantity must be greater than 0")
+	}
+	if in.Quantity > int64(l.svcCtx.Config.Cart.MaxQuantityPerItem) {
+		return nil, errorx.NewCodeError(1001, fmt.Sprintf("Quantity cannot exceed %d", l.svcCtx.Config.Cart.MaxQuantityPerItem))
+	}
+
+	wishlist, err := resolveWishlist(l.ctx, l.svcCtx, in.UserId, in.WishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	productInfo, err := l.svcCtx.ProductRpc.GetProduct(l.ctx, &product.GetProductRequest{
+		Id: in.ProductId,
+	})
+	if err != nil {
+		l.Logger.Errorf("Failed to get product info: product_id=%d, err=%v", in.ProductId, err)
+		return nil, errorx.ErrProductNotFound
+	}
+
+	err = addWishlistItem(l.ctx, l.svcCtx, &model.WishlistItem{
+		WishlistId: wishlist.Id,
+		ProductId:  in.ProductId,
+		Quantity:   in.Quantity,
+		SavedPrice: productInfo.Product.Price,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l.Logger.Infof("Added to wishlist: user_id=%d, wishlist_id=%d, product_id=%d, quantity=%d",
+		in.UserId, wishlist.Id, in.ProductId, in.Quantity)
+
+	return &cart.AddToWishlistResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"strconv"
+	"time"
+
+	"letsgo/services/cart/rpc/internal/svc"
+	"letsgo/services/cart/rpc/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// Cart change event types, also the default topic names
+const (
+	EventItemAdded   = "cart.item.added"
+	EventItemUpdated = "cart.item.updated"
+	EventItemRemoved = "cart.item.removed"
+	EventCartCleared = "cart.cleared"
+)
+
+// publishCartEvent queues a cart change event, it is published with the next batch
+// Events are keyed by cart, so the events of a cart stay in order within a topic
+func publishCartEvent(svcCtx *svc.ServiceContext, topic string, eventType string, userId int64, tempCartId string, data interface{}) {
+	key := tempCartId
+	if userId > 0 {
+		key = strconv.FormatInt(userId, 10)
+	}
+
+	svcCtx.CartEvents.Add(topic, key, utils.CartEvent{
+		EventType: eventType,
+		EventID:   uuid.New().String(),
+		Timestamp: time.Now().Unix(),
+		Data:      data,
+	})
+}
+
+// publishItemEvent queues cart.item.added, cart.item.updated or cart.item.removed
+func publishItemEvent(svcCtx *svc.ServiceContext, eventType string, userId int64, tempCartId string, productId, quantity, quantityChange int64, price float64) {
+	topics := svcCtx.Config.Kafka.Topics
+	topic := topics.CartItemAdded
+	switch eventType {
+	case EventItemUpdated:
+		topic = topics.CartItemUpdated
+	case EventItemRemoved:
+		topic = topics.CartItemRemoved
+	}
+
+	data := utils.CartItemChangedData{
+		UserID:         userId,
+		ProductID:      productId,
+		Quantity:       quantity,
+		QuantityChange: quantityChange,
+		Price:          price,
+	}
+	if userId <= 0 {
+		data.TempCartID = tempCartId
+	}
+
+	publishCartEvent(svcCtx, topic, eventType, userId, tempCartId, data)
+}
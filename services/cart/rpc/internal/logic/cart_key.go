@@ -0,0 +1,38 @@
+package logic
+
+import (
+	"fmt"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/google/uuid"
+)
+
+// resolveCart returns the Redis key and expiration (seconds) of a cart
+// Logged-in users own cart:user:{id}, guests get cart:guest:{temp_cart_id} until they log in
+func resolveCart(svcCtx *svc.ServiceContext, userId int64, tempCartId string) (string, int, error) {
+	if userId > 0 {
+		return fmt.Sprintf("cart:user:%d", userId), svcCtx.Config.Cart.Expire, nil
+	}
+	if userId < 0 || tempCartId == "" {
+		return "", 0, errorx.NewCodeError(1001, "Invalid user ID")
+	}
+
+	guestKey, err := guestCartKey(tempCartId)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return guestKey, svcCtx.Config.Cart.GuestExpire, nil
+}
+
+// guestCartKey returns the Redis key of a guest cart, temporary cart IDs are UUIDs
+func guestCartKey(tempCartId string) (string, error) {
+	id, err := uuid.Parse(tempCartId)
+	if err != nil {
+		return "", errorx.NewCodeError(1001, "Invalid cart ID")
+	}
+
+	return fmt.Sprintf("cart:guest:%s", id.String()), nil
+}
@@ -0,0 +1,201 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/model"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// CartDirtyKey is the Redis set of user IDs whose cart changed since it was last saved to the database
+const CartDirtyKey = "cart:dirty"
+
+// cartClearedKey returns the Redis key marking a user cart as emptied on purpose
+// A missing cart without the marker was lost (eviction, failover) and is loaded from the database
+func cartClearedKey(userId int64) string {
+	return fmt.Sprintf("cart:cleared:%d", userId)
+}
+
+// loadCart resolves a cart like resolveCart and loads a user cart back from the database
+// when its Redis copy is missing, e.g. after a Redis failover, eviction or expiration
+func loadCart(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, tempCartId string) (string, int, error) {
+	cartKey, expire, err := resolveCart(svcCtx, userId, tempCartId)
+	if err != nil || userId <= 0 {
+		return cartKey, expire, err
+	}
+
+	exists, err := svcCtx.Redis.ExistsCtx(ctx, cartKey)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Failed to check cart: cart=%s, err=%v", cartKey, err)
+		return "", 0, errorx.ErrCache
+	}
+	if exists {
+		return cartKey, expire, nil
+	}
+
+	// A cart emptied on purpose is newer than the saved copy, leave it empty
+	cleared, err := svcCtx.Redis.ExistsCtx(ctx, cartClearedKey(userId))
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Failed to check cart state: cart=%s, err=%v", cartKey, err)
+		return "", 0, errorx.ErrCache
+	}
+	if cleared {
+		return cartKey, expire, nil
+	}
+
+	since := time.Now().Add(-time.Duration(svcCtx.Config.Cart.Retention) * time.Second)
+	items, err := svcCtx.CartModel.FindOne(ctx, userId, since)
+	if err == model.ErrNotFound {
+		return cartKey, expire, nil
+	}
+	if err != nil {
+		// Going on with an empty cart would overwrite the saved copy on the next change
+		logx.WithContext(ctx).Errorf("Failed to load saved cart: user_id=%d, err=%v", userId, err)
+		return "", 0, errorx.ErrDatabase
+	}
+	if len(items) == 0 {
+		return cartKey, expire, nil
+	}
+
+	// Only fill the cart if nothing was added to it in the meantime
+	script := `
+		if redis.call('EXISTS', KEYS[1]) == 1 then
+			return 0
+		end
+		for i = 2, #ARGV, 2 do
+			redis.call('HSET', KEYS[1], ARGV[i], ARGV[i + 1])
+		end
+		redis.call('EXPIRE', KEYS[1], tonumber(ARGV[1]))
+		return 1
+	`
+
+	args := make([]interface{}, 0, 1+len(items)*2)
+	args = append(args, expire)
+	for field, item := range items {
+		args = append(args, field, item)
+	}
+
+	if _, err := svcCtx.Redis.EvalCtx(ctx, script, []string{cartKey}, args...); err != nil {
+		logx.WithContext(ctx).Errorf("Failed to restore cart: cart=%s, err=%v", cartKey, err)
+		return "", 0, errorx.ErrCache
+	}
+
+	logx.WithContext(ctx).Infof("Restored cart from database: cart=%s, items=%d", cartKey, len(items))
+
+	return cartKey, expire, nil
+}
+
+// markCartDirty queues a changed user cart for saving, guest carts are not saved
+// A failure is only logged, the change itself is in Redis already
+func markCartDirty(ctx context.Context, svcCtx *svc.ServiceContext, userId int64) {
+	if userId <= 0 {
+		return
+	}
+
+	if _, err := svcCtx.Redis.SaddCtx(ctx, CartDirtyKey, userId); err != nil {
+		logx.WithContext(ctx).Errorf("Failed to queue cart for saving: user_id=%d, err=%v", userId, err)
+	}
+}
+
+// cartRemovalKeys returns the keys of a script that removes cart lines: the cart, and for a user cart the cleared marker
+// The script sets the marker when the cart ends up empty, see markClearedScript
+func cartRemovalKeys(userId int64, cartKey string) []string {
+	if userId <= 0 {
+		return []string{cartKey}
+	}
+	return []string{cartKey, cartClearedKey(userId)}
+}
+
+// markClearedScript is appended to scripts using cartRemovalKeys, ARGV[1] is the marker expiration (Cart.Retention)
+// The marker is set in the same script as the removal, a missing cart without it would be loaded back from the database
+const markClearedScript = `
+	if #KEYS > 1 and redis.call('EXISTS', KEYS[1]) == 0 then
+		redis.call('SET', KEYS[2], '1', 'EX', tonumber(ARGV[1]))
+	end
+`
+
+// removeCartLines removes lines from a cart, returns how many were removed
+func removeCartLines(ctx context.Context, svcCtx *svc.ServiceContext, userId int64, cartKey string, fields ...string) (int64, error) {
+	script := `
+		local removed = 0
+		for i = 2, #ARGV do
+			removed = removed + redis.call('HDEL', KEYS[1], ARGV[i])
+		end
+	` + markClearedScript + `
+		return removed
+	`
+
+	args := make([]interface{}, 0, 1+len(fields))
+	args = append(args, svcCtx.Config.Cart.Retention)
+	for _, field := range fields {
+		args = append(args, field)
+	}
+
+	result, err := svcCtx.Redis.EvalCtx(ctx, script, cartRemovalKeys(userId, cartKey), args...)
+	if err != nil {
+		return 0, err
+	}
+
+	removed, _ := result.(int64)
+	return removed, nil
+}
+
+// PersistCart saves the current Redis copy of a user cart to the database
+// An empty cart removes the saved copy only when it was emptied on purpose, a lost Redis copy keeps it
+func PersistCart(ctx context.Context, svcCtx *svc.ServiceContext, userId int64) error {
+	cartKey, _, err := resolveCart(svcCtx, userId, "")
+	if err != nil {
+		return err
+	}
+
+	// Read the lines and the cleared marker together, a cart with lines drops a stale marker
+	script := `
+		local items = redis.call('HGETALL', KEYS[1])
+		if #items > 0 then
+			redis.call('DEL', KEYS[2])
+			return {0, items}
+		end
+		return {redis.call('EXISTS', KEYS[2]), items}
+	`
+
+	result, err := svcCtx.Redis.EvalCtx(ctx, script, []string{cartKey, cartClearedKey(userId)})
+	if err != nil {
+		return err
+	}
+
+	values, _ := result.([]interface{})
+	if len(values) != 2 {
+		return fmt.Errorf("unexpected cart state: %v", result)
+	}
+	cleared, _ := values[0].(int64)
+	pairs, _ := values[1].([]interface{})
+
+	items := make(map[string]string, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		field, _ := pairs[i].(string)
+		item, _ := pairs[i+1].(string)
+		items[field] = item
+	}
+
+	if len(items) == 0 {
+		if cleared == 0 {
+			logx.WithContext(ctx).Infof("Cart missing in Redis, saved copy kept: user_id=%d", userId)
+			return nil
+		}
+		return svcCtx.CartModel.Delete(ctx, userId)
+	}
+
+	return svcCtx.CartModel.Upsert(ctx, userId, items)
+}
+
+// scriptError returns the message a cart script raised with redis.error_reply
+// Redis returns it as is, some Redis compatible servers prefix single word messages with "ERR "
+func scriptError(err error) string {
+	return strings.TrimPrefix(err.Error(), "ERR ")
+}
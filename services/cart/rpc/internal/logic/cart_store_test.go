@@ -0,0 +1,129 @@
+package logic
+
+import (
+	"testing"
+
+	"letsgo/services/cart/rpc/cart"
+)
+
+func TestLoadCartRestoresLostCart(t *testing.T) {
+	ct := newCartTest(t)
+	ct.carts.saved[testUserId] = map[string]string{
+		productField(1): `{"product_id":1,"name":"Pen","price":2.5,"quantity":3}`,
+	}
+	// Dirty but lost, e.g. evicted before the next save
+	ct.redis.SAdd(CartDirtyKey, "42")
+
+	cartKey, _, err := loadCart(ct.ctx, ct.svcCtx, testUserId, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item := ct.item(t, cartKey, 1); item.Quantity != 3 || item.Price != 2.5 {
+		t.Fatalf("restored item = %+v", item)
+	}
+	if ttl := ct.redis.TTL(cartKey); ttl <= 0 {
+		t.Fatalf("restored cart has no expiration: %v", ttl)
+	}
+}
+
+func TestLoadCartKeepsClearedCartEmpty(t *testing.T) {
+	ct := newCartTest(t)
+	ct.carts.saved[testUserId] = map[string]string{
+		productField(1): `{"product_id":1,"quantity":3}`,
+	}
+	ct.redis.Set(cartClearedKey(testUserId), "1")
+
+	cartKey, _, err := loadCart(ct.ctx, ct.svcCtx, testUserId, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ct.redis.Exists(cartKey) {
+		t.Fatal("cleared cart was loaded back from the database")
+	}
+}
+
+func TestPersistCartKeepsSavedCopyOfLostCart(t *testing.T) {
+	ct := newCartTest(t)
+	ct.carts.saved[testUserId] = map[string]string{
+		productField(1): `{"product_id":1,"quantity":3}`,
+	}
+
+	if err := PersistCart(ct.ctx, ct.svcCtx, testUserId); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ct.carts.deleted) != 0 {
+		t.Fatal("saved copy deleted although the cart was not cleared")
+	}
+	if len(ct.carts.saved[testUserId]) != 1 {
+		t.Fatalf("saved copy changed: %v", ct.carts.saved[testUserId])
+	}
+}
+
+func TestPersistCartDeletesClearedCart(t *testing.T) {
+	ct := newCartTest(t)
+	ct.carts.saved[testUserId] = map[string]string{
+		productField(1): `{"product_id":1,"quantity":3}`,
+	}
+	cartKey, _, _ := resolveCart(ct.svcCtx, testUserId, "")
+	ct.setItem(t, cartKey, CartItemData{ProductId: 1, Quantity: 3})
+
+	if _, err := NewClearCartLogic(ct.ctx, ct.svcCtx).ClearCart(&cart.ClearCartRequest{UserId: testUserId}); err != nil {
+		t.Fatal(err)
+	}
+	if !ct.redis.Exists(cartClearedKey(testUserId)) {
+		t.Fatal("ClearCart did not mark the cart as cleared")
+	}
+
+	if err := PersistCart(ct.ctx, ct.svcCtx, testUserId); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := ct.carts.saved[testUserId]; ok {
+		t.Fatal("saved copy of a cleared cart was kept")
+	}
+}
+
+func TestPersistCartSavesLinesAndDropsStaleMarker(t *testing.T) {
+	ct := newCartTest(t)
+	cartKey, _, _ := resolveCart(ct.svcCtx, testUserId, "")
+	ct.redis.Set(cartClearedKey(testUserId), "1")
+	ct.setItem(t, cartKey, CartItemData{ProductId: 1, Quantity: 2})
+	ct.setItem(t, cartKey, CartItemData{ProductId: 2, Quantity: 1})
+
+	if err := PersistCart(ct.ctx, ct.svcCtx, testUserId); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ct.carts.saved[testUserId]) != 2 {
+		t.Fatalf("saved lines = %v", ct.carts.saved[testUserId])
+	}
+	if ct.redis.Exists(cartClearedKey(testUserId)) {
+		t.Fatal("cleared marker kept for a cart with lines")
+	}
+}
+
+func TestRemoveCartLinesMarksEmptiedCart(t *testing.T) {
+	ct := newCartTest(t)
+	cartKey, _, _ := resolveCart(ct.svcCtx, testUserId, "")
+	ct.setItem(t, cartKey, CartItemData{ProductId: 1, Quantity: 1})
+	ct.setItem(t, cartKey, CartItemData{ProductId: 2, Quantity: 1})
+
+	removed, err := removeCartLines(ct.ctx, ct.svcCtx, testUserId, cartKey, productField(1))
+	if err != nil || removed != 1 {
+		t.Fatalf("removed = %d, err = %v", removed, err)
+	}
+	if ct.redis.Exists(cartClearedKey(testUserId)) {
+		t.Fatal("cart with lines left was marked as cleared")
+	}
+
+	removed, err = removeCartLines(ct.ctx, ct.svcCtx, testUserId, cartKey, productField(2), productField(3))
+	if err != nil || removed != 1 {
+		t.Fatalf("removed = %d, err = %v", removed, err)
+	}
+	if !ct.redis.Exists(cartClearedKey(testUserId)) {
+		t.Fatal("emptied cart was not marked as cleared")
+	}
+}
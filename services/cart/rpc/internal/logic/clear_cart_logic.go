@@ -2,11 +2,12 @@ package logic
 
 import (
 	"context"
-	"fmt"
+	"encoding/json"
 
 	"letsgo/common/errorx"
 	"letsgo/services/cart/rpc/cart"
 	"letsgo/services/cart/rpc/internal/svc"
+	"letsgo/services/cart/rpc/internal/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -28,22 +29,66 @@ func NewClearCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ClearCa
 // Clear entire cart
 func (l *ClearCartLogic) ClearCart(in *cart.ClearCartRequest) (*cart.ClearCartResponse, error) {
 	// 1. Validate input
-	if in.UserId <= 0 {
-		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	cartKey, _, err := resolveCart(l.svcCtx, in.UserId, in.TempCartId)
+	if err != nil {
+		return nil, err
 	}
 
-	// 2. Delete entire cart from Redis (DEL is atomic)
-	cartKey := fmt.Sprintf("cart:user:%d", in.UserId)
+	// 2. Delete entire cart from Redis, the removed lines are returned for the change event
+	script := `
+		local items = redis.call('HVALS', KEYS[1])
+		redis.call('DEL', KEYS[1])
+	` + markClearedScript + `
+		return items
+	`
 
-	deleted, err := l.svcCtx.Redis.DelCtx(l.ctx, cartKey)
+	result, err := l.svcCtx.Redis.EvalCtx(l.ctx, script, cartRemovalKeys(in.UserId, cartKey), l.svcCtx.Config.Cart.Retention)
 	if err != nil {
-		l.Logger.Errorf("Failed to clear cart: user_id=%d, err=%v", in.UserId, err)
+		l.Logger.Errorf("Failed to clear cart: cart=%s, err=%v", cartKey, err)
 		return nil, errorx.ErrCache
 	}
+	values, _ := result.([]interface{})
 
-	l.Logger.Infof("Cleared cart successfully: user_id=%d, deleted=%d", in.UserId, deleted)
+	// The saved copy is removed by the next save, until then the cleared marker keeps it from being restored
+	markCartDirty(l.ctx, l.svcCtx, in.UserId)
+
+	if len(values) > 0 {
+		l.publishCleared(in, values)
+	}
+
+	l.Logger.Infof("Cleared cart successfully: cart=%s, lines=%d", cartKey, len(values))
 
 	return &cart.ClearCartResponse{
 		Success: true,
 	}, nil
 }
+
+// publishCleared queues cart.cleared with the lines that were in the cart
+func (l *ClearCartLogic) publishCleared(in *cart.ClearCartRequest, values []interface{}) {
+	data := utils.CartClearedData{
+		UserID: in.UserId,
+		Items:  make([]utils.CartLine, 0, len(values)),
+	}
+	if in.UserId <= 0 {
+		data.TempCartID = in.TempCartId
+	}
+
+	for _, value := range values {
+		itemJSON, _ := value.(string)
+		var item CartItemData
+		if err := json.Unmarshal([]byte(itemJSON), &item); err != nil {
+			l.Logger.Errorf("Failed to unmarshal cart item: %v", err)
+			continue
+		}
+		data.Items = append(data.Items, utils.CartLine{
+			ProductID: item.ProductId,
+			Name:      item.Name,
+			Price:     item.Price,
+			Quantity:  item.Quantity,
+			Image:     item.Image,
+			AddedAt:   item.AddedAt,
+		})
+	}
+
+	publishCartEvent(l.svcCtx, l.svcCtx.Config.Kafka.Topics.CartCleared, EventCartCleared, in.UserId, in.TempCartId, data)
+}
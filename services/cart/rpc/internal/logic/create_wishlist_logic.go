@@ -0,0 +1,56 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/model"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateWishlistLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateWishlistLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateWishlistLogic {
+	return &CreateWishlistLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Create a named wishlist
+func (l *CreateWishlistLogic) CreateWishlist(in *cart.CreateWishlistRequest) (*cart.CreateWishlistResponse, error) {
+	if in.UserId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	}
+	name, err := normalizeWishlistName(in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	wishlist, err := l.svcCtx.WishlistModel.Insert(l.ctx, in.UserId, name, l.svcCtx.Config.Wishlist.MaxLists)
+	switch err {
+	case nil:
+	case model.ErrDuplicateWishlistName:
+		return nil, errorx.ErrWishlistNameExists
+	case model.ErrWishlistLimit:
+		return nil, errorx.NewCodeError(4007, fmt.Sprintf("Too many wishlists, maximum %d allowed", l.svcCtx.Config.Wishlist.MaxLists))
+	default:
+		l.Logger.Errorf("Failed to create wishlist: user_id=%d, err=%v", in.UserId, err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Wishlist created: user_id=%d, wishlist_id=%d", in.UserId, wishlist.Id)
+
+	return &cart.CreateWishlistResponse{
+		Wishlist: toWishlist(wishlist),
+	}, nil
+}
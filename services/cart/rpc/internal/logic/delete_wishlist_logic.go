@@ -0,0 +1,49 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/model"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteWishlistLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteWishlistLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteWishlistLogic {
+	return &DeleteWishlistLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Delete a wishlist and its items
+func (l *DeleteWishlistLogic) DeleteWishlist(in *cart.DeleteWishlistRequest) (*cart.DeleteWishlistResponse, error) {
+	wishlist, err := resolveWishlist(l.ctx, l.svcCtx, in.UserId, in.WishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.svcCtx.WishlistModel.Delete(l.ctx, wishlist.Id, in.UserId)
+	if err == model.ErrNotFound {
+		return nil, errorx.ErrWishlistNotFound
+	}
+	if err != nil {
+		l.Logger.Errorf("Failed to delete wishlist: wishlist_id=%d, err=%v", wishlist.Id, err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Wishlist deleted: user_id=%d, wishlist_id=%d, items=%d", in.UserId, wishlist.Id, wishlist.ItemCount)
+
+	return &cart.DeleteWishlistResponse{
+		Success: true,
+	}, nil
+}
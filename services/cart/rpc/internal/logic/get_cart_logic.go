@@ -3,7 +3,7 @@ package logic
 import (
 	"context"
 	"encoding/json"
-	"fmt"
+	"math"
 
 	"letsgo/common/errorx"
 	"letsgo/services/cart/rpc/cart"
@@ -30,15 +30,15 @@ func NewGetCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCartLo
 // Get user's cart
 func (l *GetCartLogic) GetCart(in *cart.GetCartRequest) (*cart.GetCartResponse, error) {
 	// 1. Validate input
-	if in.UserId <= 0 {
-		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	cartKey, expire, err := loadCart(l.ctx, l.svcCtx, in.UserId, in.TempCartId)
+	if err != nil {
+		return nil, err
 	}
 
 	// 2. Get all cart items from Redis
-	cartKey := fmt.Sprintf("cart:user:%d", in.UserId)
 	items, err := l.svcCtx.Redis.HgetallCtx(l.ctx, cartKey)
 	if err != nil {
-		l.Logger.Errorf("Failed to get cart: user_id=%d, err=%v", in.UserId, err)
+		l.Logger.Errorf("Failed to get cart: cart=%s, err=%v", cartKey, err)
 		return nil, errorx.ErrCache
 	}
 
@@ -51,12 +51,8 @@ func (l *GetCartLogic) GetCart(in *cart.GetCartRequest) (*cart.GetCartResponse,
 		}, nil
 	}
 
-	// 4. Parse cart items and collect product IDs
-	var cartItems []*cart.CartItem
-	var totalPrice float64
-	var totalCount int64
-	productIds := make([]int64, 0, len(items))
-
+	// 4. Parse cart items
+	cartItems := make([]*cart.CartItem, 0, len(items))
 	for _, itemJSON := range items {
 		var item CartItemData
 		if err := json.Unmarshal([]byte(itemJSON), &item); err != nil {
@@ -64,52 +60,96 @@ func (l *GetCartLogic) GetCart(in *cart.GetCartRequest) (*cart.GetCartResponse,
 			continue
 		}
 
-		productIds = append(productIds, item.ProductId)
-
 		cartItems = append(cartItems, &cart.CartItem{
-			ProductId: item.ProductId,
-			Name:      item.Name,
-			Price:     item.Price,
-			Quantity:  item.Quantity,
-			Image:     item.Image,
-			Stock:     0,    // Will be filled later
-			Available: true, // Will be filled later
+			ProductId:  item.ProductId,
+			Name:       item.Name,
+			Price:      item.Price, // Will be refreshed later
+			Quantity:   item.Quantity,
+			Image:      item.Image,
+			Stock:      0,    // Will be filled later
+			Available:  true, // Will be filled later
+			SavedPrice: item.Price,
+			Selected:   !item.Deselected,
 		})
+	}
 
-		totalPrice += item.Price * float64(item.Quantity)
-		totalCount += item.Quantity
+	// 5. Compare every line with current product data and stock
+	if len(cartItems) > 0 {
+		l.refreshCartItems(cartItems)
 	}
 
-	// 5. Batch check product stock and availability
-	if len(productIds) > 0 {
-		l.updateCartItemsStock(cartItems, productIds)
+	// Totals use current prices of the selected lines that can still be bought
+	var totalPrice float64
+	var totalCount int64
+	hasChanges := false
+	for _, cartItem := range cartItems {
+		if cartItem.PriceIncreased || cartItem.PriceDecreased || cartItem.ExceedsStock || !cartItem.Available {
+			hasChanges = true
+		}
+		if !cartItem.Selected || !cartItem.Available {
+			continue
+		}
+		totalPrice += cartItem.Price * float64(cartItem.Quantity)
+		totalCount += cartItem.Quantity
 	}
 
 	// 6. Refresh cart expiration time
-	l.svcCtx.Redis.ExpireCtx(l.ctx, cartKey, l.svcCtx.Config.Cart.Expire)
+	l.svcCtx.Redis.ExpireCtx(l.ctx, cartKey, expire)
 
-	l.Logger.Infof("Get cart successfully: user_id=%d, items=%d, total_price=%.2f",
-		in.UserId, len(cartItems), totalPrice)
+	l.Logger.Infof("Get cart successfully: cart=%s, items=%d, total_price=%.2f",
+		cartKey, len(cartItems), totalPrice)
 
 	return &cart.GetCartResponse{
 		Items:      cartItems,
 		TotalPrice: totalPrice,
 		TotalCount: totalCount,
+		HasChanges: hasChanges,
 	}, nil
 }
 
-// updateCartItemsStock updates stock and availability for cart items
-func (l *GetCartLogic) updateCartItemsStock(cartItems []*cart.CartItem, productIds []int64) {
-	// Build stock check request
-	stockItems := make([]*product.StockItem, len(productIds))
-	for i, pid := range productIds {
-		stockItems[i] = &product.StockItem{
-			ProductId:        pid,
-			RequiredQuantity: 0, // We just want to know current stock, not checking if available
+// refreshCartItems compares cart lines with current product data and stock
+// Lines keep their saved data when the product service cannot be reached
+func (l *GetCartLogic) refreshCartItems(cartItems []*cart.CartItem) {
+	productIds := make([]int64, 0, len(cartItems))
+	for _, cartItem := range cartItems {
+		productIds = append(productIds, cartItem.ProductId)
+	}
+
+	// Current product data, products taken off sale are not found
+	productsResp, err := l.svcCtx.ProductRpc.GetProducts(l.ctx, &product.GetProductsRequest{
+		Ids: productIds,
+	})
+	if err != nil {
+		l.Logger.Errorf("Failed to get products: err=%v", err)
+		return
+	}
+
+	// Results are returned in request order
+	for i, cartItem := range cartItems {
+		result := productsResp.Results[i]
+		if !result.Found {
+			cartItem.Available = false
+			continue
 		}
+
+		cartItem.Name = result.Product.Name
+		cartItem.Price = result.Product.Price
+		cartItem.Image = getFirstImage(result.Product.Images)
+
+		current, saved := priceCents(cartItem.Price), priceCents(cartItem.SavedPrice)
+		cartItem.PriceIncreased = current > saved
+		cartItem.PriceDecreased = current < saved
+	}
+
+	// Check stock for the quantity of every line
+	stockItems := make([]*product.StockItem, 0, len(cartItems))
+	for _, cartItem := range cartItems {
+		stockItems = append(stockItems, &product.StockItem{
+			ProductId:        cartItem.ProductId,
+			RequiredQuantity: cartItem.Quantity,
+		})
 	}
 
-	// Call Product RPC to check stock
 	stockResp, err := l.svcCtx.ProductRpc.CheckStock(l.ctx, &product.CheckStockRequest{
 		Items: stockItems,
 	})
@@ -127,12 +167,14 @@ func (l *GetCartLogic) updateCartItemsStock(cartItems []*cart.CartItem, productI
 
 	// Update cart items with stock info
 	for _, cartItem := range cartItems {
-		if stock, exists := stockMap[cartItem.ProductId]; exists {
-			cartItem.Stock = stock
-			cartItem.Available = stock > 0
-		} else {
-			cartItem.Stock = 0
-			cartItem.Available = false
-		}
+		stock := stockMap[cartItem.ProductId]
+		cartItem.Stock = stock
+		cartItem.Available = cartItem.Available && stock > 0
+		cartItem.ExceedsStock = cartItem.Available && cartItem.Quantity > stock
 	}
 }
+
+// priceCents converts a price to cents, so float noise does not show up as a price change
+func priceCents(price float64) int64 {
+	return int64(math.Round(price * 100))
+}
@@ -0,0 +1,86 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCheckoutItemsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetCheckoutItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCheckoutItemsLogic {
+	return &GetCheckoutItemsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Get the selected lines of a user's cart (called by order service at checkout)
+func (l *GetCheckoutItemsLogic) GetCheckoutItems(in *cart.GetCheckoutItemsRequest) (*cart.GetCheckoutItemsResponse, error) {
+	// 1. Validate input
+	if in.UserId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	}
+	cartKey, _, err := loadCart(l.ctx, l.svcCtx, in.UserId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Get selected lines, oldest first so the order follows the cart
+	selected, err := selectedCartLines(l.ctx, l.svcCtx, cartKey)
+	if err != nil {
+		return nil, err
+	}
+
+	checkoutItems := make([]*cart.CheckoutItem, 0, len(selected))
+	for _, item := range selected {
+		checkoutItems = append(checkoutItems, &cart.CheckoutItem{
+			ProductId: item.ProductId,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	return &cart.GetCheckoutItemsResponse{
+		Items: checkoutItems,
+	}, nil
+}
+
+// selectedCartLines returns the selected lines of a cart, oldest first
+func selectedCartLines(ctx context.Context, svcCtx *svc.ServiceContext, cartKey string) ([]CartItemData, error) {
+	items, err := svcCtx.Redis.HgetallCtx(ctx, cartKey)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Failed to get cart: cart=%s, err=%v", cartKey, err)
+		return nil, errorx.ErrCache
+	}
+
+	selected := make([]CartItemData, 0, len(items))
+	for _, itemJSON := range items {
+		var item CartItemData
+		if err := json.Unmarshal([]byte(itemJSON), &item); err != nil {
+			logx.WithContext(ctx).Errorf("Failed to unmarshal cart item: %v", err)
+			continue
+		}
+		if !item.Deselected {
+			selected = append(selected, item)
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		if selected[i].AddedAt != selected[j].AddedAt {
+			return selected[i].AddedAt < selected[j].AddedAt
+		}
+		return selected[i].ProductId < selected[j].ProductId
+	})
+
+	return selected, nil
+}
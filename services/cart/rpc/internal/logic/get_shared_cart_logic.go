@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSharedCartLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetSharedCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSharedCartLogic {
+	return &GetSharedCartLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Get a shared cart by token with current product data (public)
+func (l *GetSharedCartLogic) GetSharedCart(in *cart.GetSharedCartRequest) (*cart.GetSharedCartResponse, error) {
+	// 1. Get the snapshot
+	shared, err := loadSharedCart(l.ctx, l.svcCtx, in.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Compare every line with current product data and stock, like the cart itself
+	items := make([]*cart.CartItem, 0, len(shared.Lines))
+	for _, line := range shared.Lines {
+		items = append(items, &cart.CartItem{
+			ProductId:  line.ProductId,
+			Name:       line.Name,
+			Price:      line.Price,
+			Quantity:   line.Quantity,
+			Image:      line.Image,
+			Available:  true,
+			SavedPrice: line.Price,
+			Selected:   true,
+		})
+	}
+	NewGetCartLogic(l.ctx, l.svcCtx).refreshCartItems(items)
+
+	return &cart.GetSharedCartResponse{
+		Items:     items,
+		ExpiresAt: shared.ExpiresAt,
+	}, nil
+}
@@ -0,0 +1,126 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetWishlistLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetWishlistLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetWishlistLogic {
+	return &GetWishlistLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Get a wishlist with current product data
+func (l *GetWishlistLogic) GetWishlist(in *cart.GetWishlistRequest) (*cart.GetWishlistResponse, error) {
+	// 1. Find the wishlist and its items
+	wishlist, err := resolveWishlist(l.ctx, l.svcCtx, in.UserId, in.WishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := l.svcCtx.WishlistModel.FindItems(l.ctx, wishlist.Id)
+	if err != nil {
+		l.Logger.Errorf("Failed to get wishlist items: wishlist_id=%d, err=%v", wishlist.Id, err)
+		return nil, errorx.ErrDatabase
+	}
+
+	wishlistItems := make([]*cart.WishlistItem, 0, len(items))
+	for _, item := range items {
+		wishlistItems = append(wishlistItems, &cart.WishlistItem{
+			ProductId:  item.ProductId,
+			Price:      item.SavedPrice, // Will be refreshed later
+			SavedPrice: item.SavedPrice,
+			Quantity:   item.Quantity,
+			Available:  true, // Will be filled later
+			AddedAt:    item.AddedAt.Unix(),
+		})
+	}
+
+	// 2. Fill in current product data and stock
+	if len(wishlistItems) > 0 {
+		l.refreshWishlistItems(wishlistItems)
+	}
+
+	return &cart.GetWishlistResponse{
+		Wishlist: toWishlist(wishlist),
+		Items:    wishlistItems,
+	}, nil
+}
+
+// productBatchSize is the most IDs the product service accepts per GetProducts call
+const productBatchSize = 100
+
+// refreshWishlistItems fills in current product data and stock of wishlist items
+// Items keep their saved price when the product service cannot be reached
+func (l *GetWishlistLogic) refreshWishlistItems(wishlistItems []*cart.WishlistItem) {
+	productIds := make([]int64, 0, len(wishlistItems))
+	stockItems := make([]*product.StockItem, 0, len(wishlistItems))
+	for _, item := range wishlistItems {
+		productIds = append(productIds, item.ProductId)
+		stockItems = append(stockItems, &product.StockItem{
+			ProductId:        item.ProductId,
+			RequiredQuantity: item.Quantity,
+		})
+	}
+
+	// Current product data, products taken off sale are not found
+	// A wishlist holds more items than one GetProducts call accepts, so fetch in batches
+	results := make([]*product.ProductResult, 0, len(productIds))
+	for start := 0; start < len(productIds); start += productBatchSize {
+		end := min(start+productBatchSize, len(productIds))
+		productsResp, err := l.svcCtx.ProductRpc.GetProducts(l.ctx, &product.GetProductsRequest{
+			Ids: productIds[start:end],
+		})
+		if err != nil {
+			l.Logger.Errorf("Failed to get products: err=%v", err)
+			return
+		}
+		results = append(results, productsResp.Results...)
+	}
+
+	// Results are returned in request order
+	for i, item := range wishlistItems {
+		result := results[i]
+		if !result.Found {
+			item.Available = false
+			continue
+		}
+
+		item.Name = result.Product.Name
+		item.Price = result.Product.Price
+		item.Image = getFirstImage(result.Product.Images)
+	}
+
+	stockResp, err := l.svcCtx.ProductRpc.CheckStock(l.ctx, &product.CheckStockRequest{
+		Items: stockItems,
+	})
+	if err != nil {
+		l.Logger.Errorf("Failed to check stock: err=%v", err)
+		return
+	}
+
+	stockMap := make(map[int64]int64)
+	for _, item := range stockResp.Items {
+		stockMap[item.ProductId] = item.AvailableStock
+	}
+
+	for _, item := range wishlistItems {
+		item.Stock = stockMap[item.ProductId]
+		item.Available = item.Available && item.Stock > 0
+	}
+}
@@ -0,0 +1,180 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/model"
+	"letsgo/services/cart/rpc/internal/config"
+	"letsgo/services/cart/rpc/internal/svc"
+	"letsgo/services/cart/rpc/internal/utils"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"google.golang.org/grpc"
+)
+
+func TestMain(m *testing.M) {
+	logx.Disable()
+	os.Exit(m.Run())
+}
+
+// fakeCartModel keeps saved carts in memory
+type fakeCartModel struct {
+	model.CartModel
+	saved   map[int64]map[string]string
+	deleted []int64
+}
+
+func (m *fakeCartModel) Upsert(ctx context.Context, userId int64, items map[string]string) error {
+	m.saved[userId] = items
+	return nil
+}
+
+func (m *fakeCartModel) FindOne(ctx context.Context, userId int64, since time.Time) (map[string]string, error) {
+	items, ok := m.saved[userId]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	return items, nil
+}
+
+func (m *fakeCartModel) Delete(ctx context.Context, userId int64) error {
+	delete(m.saved, userId)
+	m.deleted = append(m.deleted, userId)
+	return nil
+}
+
+// fakeProductClient serves products from memory, unknown IDs are not found
+type fakeProductClient struct {
+	product.ProductClient
+	products map[int64]*product.ProductInfo
+}
+
+func (c *fakeProductClient) GetProduct(ctx context.Context, in *product.GetProductRequest, opts ...grpc.CallOption) (*product.GetProductResponse, error) {
+	info, ok := c.products[in.Id]
+	if !ok {
+		return nil, errorx.ErrProductNotFound
+	}
+	return &product.GetProductResponse{Product: info}, nil
+}
+
+func (c *fakeProductClient) GetProducts(ctx context.Context, in *product.GetProductsRequest, opts ...grpc.CallOption) (*product.GetProductsResponse, error) {
+	resp := &product.GetProductsResponse{}
+	for _, id := range in.Ids {
+		info, ok := c.products[id]
+		resp.Results = append(resp.Results, &product.ProductResult{Id: id, Found: ok, Product: info})
+	}
+	return resp, nil
+}
+
+func (c *fakeProductClient) CheckStock(ctx context.Context, in *product.CheckStockRequest, opts ...grpc.CallOption) (*product.CheckStockResponse, error) {
+	resp := &product.CheckStockResponse{}
+	for _, item := range in.Items {
+		var stock int64
+		if info, ok := c.products[item.ProductId]; ok {
+			stock = info.Stock
+		}
+		resp.Items = append(resp.Items, &product.StockItem{ProductId: item.ProductId, AvailableStock: stock})
+	}
+	return resp, nil
+}
+
+// cartTest is a cart service backed by miniredis and in-memory models
+type cartTest struct {
+	ctx      context.Context
+	svcCtx   *svc.ServiceContext
+	redis    *miniredis.Miniredis
+	carts    *fakeCartModel
+	products *fakeProductClient
+}
+
+func newCartTest(t *testing.T) *cartTest {
+	mr := miniredis.RunT(t)
+
+	var c config.Config
+	c.Cart.Expire = 3600
+	c.Cart.GuestExpire = 3600
+	c.Cart.Retention = 86400
+	c.Cart.MaxItems = 3
+	c.Cart.MaxQuantityPerItem = 10
+	c.Cart.QuoteExpire = 900
+	c.Cart.ShareExpire = 3600
+
+	carts := &fakeCartModel{saved: make(map[int64]map[string]string)}
+	products := &fakeProductClient{products: make(map[int64]*product.ProductInfo)}
+
+	return &cartTest{
+		ctx: context.Background(),
+		svcCtx: &svc.ServiceContext{
+			Config:     c,
+			Redis:      redis.New(mr.Addr()),
+			CartModel:  carts,
+			ProductRpc: products,
+			// Events are only queued, the batch interval outlasts the test
+			CartEvents: utils.NewEventBatcher(utils.NewKafkaProducer(nil), 100, time.Hour),
+		},
+		redis:    mr,
+		carts:    carts,
+		products: products,
+	}
+}
+
+// addProduct registers a product on sale with plenty of stock
+func (ct *cartTest) addProduct(id int64, name string, price float64) *product.ProductInfo {
+	info := &product.ProductInfo{Id: id, Name: name, Price: price, Stock: 100, Status: 1}
+	ct.products.products[id] = info
+	return info
+}
+
+// productField returns the cart hash field of a product
+func productField(productId int64) string {
+	return fmt.Sprintf("product:%d", productId)
+}
+
+// item returns a cart line stored in Redis, failing the test if it is missing
+func (ct *cartTest) item(t *testing.T, cartKey string, productId int64) CartItemData {
+	t.Helper()
+
+	itemJSON := ct.redis.HGet(cartKey, productField(productId))
+	if itemJSON == "" {
+		t.Fatalf("product %d not in %s", productId, cartKey)
+	}
+
+	var item CartItemData
+	if err := json.Unmarshal([]byte(itemJSON), &item); err != nil {
+		t.Fatalf("invalid item %s: %v", itemJSON, err)
+	}
+	return item
+}
+
+// setItem stores a cart line in Redis directly
+func (ct *cartTest) setItem(t *testing.T, cartKey string, item CartItemData) {
+	t.Helper()
+
+	itemJSON, err := json.Marshal(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ct.redis.HSet(cartKey, productField(item.ProductId), string(itemJSON))
+}
+
+// assertCode fails the test unless err is a CodeError with the given code
+func assertCode(t *testing.T, err error, code int) {
+	t.Helper()
+
+	codeErr, ok := err.(*errorx.CodeError)
+	if !ok {
+		t.Fatalf("expected error code %d, got %v", code, err)
+	}
+	if codeErr.Code != code {
+		t.Fatalf("expected error code %d, got %d (%s)", code, codeErr.Code, codeErr.Msg)
+	}
+}
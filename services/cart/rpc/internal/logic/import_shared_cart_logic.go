@@ -0,0 +1,156 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ImportSharedCartLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewImportSharedCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportSharedCartLogic {
+	return &ImportSharedCartLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Merge the lines of a shared cart into the caller's cart with the limits of AddToCart
+func (l *ImportSharedCartLogic) ImportSharedCart(in *cart.ImportSharedCartRequest) (*cart.ImportSharedCartResponse, error) {
+	// 1. Validate input
+	cartKey, expire, err := loadCart(l.ctx, l.svcCtx, in.UserId, in.TempCartId)
+	if err != nil {
+		return nil, err
+	}
+
+	shared, err := loadSharedCart(l.ctx, l.svcCtx, in.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Current product data, lines are imported at today's price and products taken off sale are skipped
+	productIds := make([]int64, 0, len(shared.Lines))
+	for _, line := range shared.Lines {
+		productIds = append(productIds, line.ProductId)
+	}
+
+	productsResp, err := l.svcCtx.ProductRpc.GetProducts(l.ctx, &product.GetProductsRequest{
+		Ids: productIds,
+	})
+	if err != nil {
+		l.Logger.Errorf("Failed to get products: err=%v", err)
+		return nil, errorx.ErrRPC
+	}
+
+	now := time.Now().Unix()
+	args := []interface{}{
+		l.svcCtx.Config.Cart.MaxQuantityPerItem,
+		l.svcCtx.Config.Cart.MaxItems,
+		expire,
+	}
+	var candidates int64
+	for i, line := range shared.Lines {
+		// Results are returned in request order
+		result := productsResp.Results[i]
+		if !result.Found {
+			continue
+		}
+
+		itemJSON, err := json.Marshal(&CartItemData{
+			ProductId: line.ProductId,
+			Name:      result.Product.Name,
+			Price:     result.Product.Price,
+			Quantity:  line.Quantity,
+			Image:     getFirstImage(result.Product.Images),
+			AddedAt:   now,
+		})
+		if err != nil {
+			l.Logger.Errorf("Failed to marshal cart item: %v", err)
+			return nil, errorx.ErrSystem
+		}
+
+		limit, _ := purchaseLimit(result.Product)
+		args = append(args, fmt.Sprintf("product:%d", line.ProductId), string(itemJSON), limit)
+		candidates++
+	}
+
+	// 3. Merge every line with the limits of AddToCart and the product, lines that would break a limit are skipped
+	var imported int64
+	if candidates > 0 {
+		script := `
+			local cart_key = KEYS[1]
+			local max_qty = tonumber(ARGV[1])
+			local max_items = tonumber(ARGV[2])
+			local expire_time = tonumber(ARGV[3])
+			local imported = 0
+
+			for i = 4, #ARGV, 3 do
+				local product_field = ARGV[i]
+				local new_item = cjson.decode(ARGV[i + 1])
+				local product_limit = tonumber(ARGV[i + 2])
+				local add_qty = new_item.quantity
+				local current_item = redis.call('HGET', cart_key, product_field)
+				local current_qty = 0
+				local fits = true
+
+				if current_item then
+					-- Existing lines only change quantity, their saved price and selection stay
+					new_item = cjson.decode(current_item)
+					current_qty = new_item.quantity
+				elseif redis.call('HLEN', cart_key) >= max_items then
+					fits = false
+				end
+
+				local new_qty = current_qty + add_qty
+				if product_limit > 0 and new_qty > product_limit then
+					fits = false
+				end
+				if fits and new_qty <= max_qty then
+					new_item.quantity = new_qty
+					redis.call('HSET', cart_key, product_field, cjson.encode(new_item))
+					imported = imported + 1
+				end
+			end
+
+			if imported > 0 then
+				redis.call('EXPIRE', cart_key, expire_time)
+			end
+
+			return imported
+		`
+
+		result, err := l.svcCtx.Redis.EvalCtx(l.ctx, script, []string{cartKey}, args...)
+		if err != nil {
+			l.Logger.Errorf("Failed to import shared cart: cart=%s, err=%v", cartKey, err)
+			return nil, errorx.ErrCache
+		}
+		imported, _ = result.(int64)
+	}
+
+	if imported > 0 {
+		markCartDirty(l.ctx, l.svcCtx, in.UserId)
+	}
+
+	skipped := int64(len(shared.Lines)) - imported
+
+	l.Logger.Infof("Shared cart imported: cart=%s, imported=%d, skipped=%d", cartKey, imported, skipped)
+
+	return &cart.ImportSharedCartResponse{
+		Success:  true,
+		Imported: imported,
+		Skipped:  skipped,
+	}, nil
+}
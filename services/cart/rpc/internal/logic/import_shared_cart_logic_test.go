@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"testing"
+
+	"letsgo/services/cart/rpc/cart"
+)
+
+func TestImportSharedCart(t *testing.T) {
+	ct := newCartTest(t)
+	ct.addProduct(1, "Pen", 3)
+	ct.addProduct(2, "Ink", 5).MaxPerUser = 2
+	ct.addProduct(3, "Pad", 4)
+
+	// Share a guest cart
+	guestKey, _ := guestCartKey(testTempCartId)
+	ct.setItem(t, guestKey, CartItemData{ProductId: 1, Quantity: 2})
+	ct.setItem(t, guestKey, CartItemData{ProductId: 2, Quantity: 3})
+	ct.setItem(t, guestKey, CartItemData{ProductId: 3, Quantity: 1})
+	ct.setItem(t, guestKey, CartItemData{ProductId: 9, Quantity: 1})
+
+	shareResp, err := NewShareCartLogic(ct.ctx, ct.svcCtx).ShareCart(&cart.ShareCartRequest{TempCartId: testTempCartId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Product 1 is already in the user's cart at an older price
+	userKey, _, _ := resolveCart(ct.svcCtx, testUserId, "")
+	ct.setItem(t, userKey, CartItemData{ProductId: 1, Price: 2.5, Quantity: 1, Deselected: true})
+
+	resp, err := NewImportSharedCartLogic(ct.ctx, ct.svcCtx).ImportSharedCart(&cart.ImportSharedCartRequest{
+		UserId: testUserId,
+		Token:  shareResp.Token,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Product 2 goes over its per-user limit, product 9 is off sale
+	if resp.Imported != 2 || resp.Skipped != 2 {
+		t.Fatalf("imported = %d, skipped = %d, want 2 and 2", resp.Imported, resp.Skipped)
+	}
+	if item := ct.item(t, userKey, 1); item.Quantity != 3 || item.Price != 2.5 || !item.Deselected {
+		t.Fatalf("existing line = %+v, want quantity 3 with its saved price and selection", item)
+	}
+	if item := ct.item(t, userKey, 3); item.Quantity != 1 || item.Price != 4 || item.Name != "Pad" {
+		t.Fatalf("new line = %+v", item)
+	}
+	if ct.redis.HGet(userKey, productField(2)) != "" {
+		t.Fatal("line over the purchase limit imported")
+	}
+}
+
+func TestImportSharedCartUnknownToken(t *testing.T) {
+	ct := newCartTest(t)
+
+	_, err := NewImportSharedCartLogic(ct.ctx, ct.svcCtx).ImportSharedCart(&cart.ImportSharedCartRequest{
+		UserId: testUserId,
+		Token:  testTempCartId,
+	})
+	assertCode(t, err, 4009)
+}
@@ -0,0 +1,47 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListWishlistsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListWishlistsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListWishlistsLogic {
+	return &ListWishlistsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// List the wishlists of a user, the "Saved for later" list first
+func (l *ListWishlistsLogic) ListWishlists(in *cart.ListWishlistsRequest) (*cart.ListWishlistsResponse, error) {
+	if in.UserId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	}
+
+	wishlists, err := l.svcCtx.WishlistModel.FindByUserId(l.ctx, in.UserId)
+	if err != nil {
+		l.Logger.Errorf("Failed to list wishlists: user_id=%d, err=%v", in.UserId, err)
+		return nil, errorx.ErrDatabase
+	}
+
+	resp := &cart.ListWishlistsResponse{
+		Wishlists: make([]*cart.Wishlist, 0, len(wishlists)),
+	}
+	for _, wishlist := range wishlists {
+		resp.Wishlists = append(resp.Wishlists, toWishlist(wishlist))
+	}
+
+	return resp, nil
+}
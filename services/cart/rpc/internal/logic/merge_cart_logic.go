@@ -3,6 +3,7 @@ package logic
 import (
 	"context"
 
+	"letsgo/common/errorx"
 	"letsgo/services/cart/rpc/cart"
 	"letsgo/services/cart/rpc/internal/svc"
 
@@ -24,14 +25,96 @@ func NewMergeCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MergeCa
 }
 
 // Merge temporary cart (before login) with user cart (after login)
+// Quantities of products in both carts are added up to MaxQuantityPerItem, new lines are
+// added while the user cart has fewer than MaxItems, oldest guest lines first
 func (l *MergeCartLogic) MergeCart(in *cart.MergeCartRequest) (*cart.MergeCartResponse, error) {
-	// TODO: Implement merge cart logic
-	// This feature is for merging guest cart with user cart after login
-	// For now, return success (can be implemented later if needed)
+	// 1. Validate input
+	if in.UserId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	}
+	guestKey, err := guestCartKey(in.TempCartId)
+	if err != nil {
+		return nil, err
+	}
+	userKey, expire, err := loadCart(l.ctx, l.svcCtx, in.UserId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Merge and delete the guest cart using Lua script (atomic operation)
+	script := `
+		local user_key = KEYS[1]
+		local guest_key = KEYS[2]
+		local max_qty = tonumber(ARGV[1])
+		local max_items = tonumber(ARGV[2])
+		local expire_time = tonumber(ARGV[3])
+
+		-- Guest lines, oldest first
+		local guest = redis.call('HGETALL', guest_key)
+		local lines = {}
+		for i = 1, #guest, 2 do
+			table.insert(lines, {field = guest[i], item = cjson.decode(guest[i + 1])})
+		end
+		table.sort(lines, function(a, b) return (a.item.added_at or 0) < (b.item.added_at or 0) end)
+
+		local count = redis.call('HLEN', user_key)
+		local merged = 0
+		local skipped = 0
+
+		for _, line in ipairs(lines) do
+			local current_item = redis.call('HGET', user_key, line.field)
+			if current_item then
+				-- Product already in user cart, add up quantities
+				local item = cjson.decode(current_item)
+				item.quantity = math.min(item.quantity + line.item.quantity, max_qty)
+				redis.call('HSET', user_key, line.field, cjson.encode(item))
+				merged = merged + 1
+			elseif count < max_items then
+				line.item.quantity = math.min(line.item.quantity, max_qty)
+				redis.call('HSET', user_key, line.field, cjson.encode(line.item))
+				count = count + 1
+				merged = merged + 1
+			else
+				-- User cart is full
+				skipped = skipped + 1
+			end
+		end
+
+		redis.call('DEL', guest_key)
+		if merged > 0 then
+			redis.call('EXPIRE', user_key, expire_time)
+		end
+
+		return {merged, skipped}
+	`
+
+	result, err := l.svcCtx.Redis.EvalCtx(l.ctx, script,
+		[]string{userKey, guestKey},
+		l.svcCtx.Config.Cart.MaxQuantityPerItem,
+		l.svcCtx.Config.Cart.MaxItems,
+		expire,
+	)
+	if err != nil {
+		l.Logger.Errorf("Failed to merge cart: user_id=%d, guest_cart=%s, err=%v", in.UserId, guestKey, err)
+		return nil, errorx.ErrCache
+	}
+
+	counts, ok := result.([]interface{})
+	if !ok || len(counts) != 2 {
+		l.Logger.Errorf("Unexpected merge cart result: %v", result)
+		return nil, errorx.ErrCache
+	}
+	merged, _ := counts[0].(int64)
+	skipped, _ := counts[1].(int64)
+
+	markCartDirty(l.ctx, l.svcCtx, in.UserId)
 
-	l.Logger.Infof("MergeCart called (not implemented): user_id=%d, temp_cart_id=%s", in.UserId, in.TempCartId)
+	l.Logger.Infof("Merged cart successfully: user_id=%d, guest_cart=%s, merged=%d, skipped=%d",
+		in.UserId, guestKey, merged, skipped)
 
 	return &cart.MergeCartResponse{
 		Success: true,
+		Merged:  merged,
+		Skipped: skipped,
 	}, nil
 }
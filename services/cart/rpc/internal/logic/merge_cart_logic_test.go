@@ -0,0 +1,68 @@
+package logic
+
+import (
+	"testing"
+
+	"letsgo/services/cart/rpc/cart"
+)
+
+const (
+	testUserId     = 42
+	testTempCartId = "6f1c2a8e-3b1d-4c55-9a1e-2f0b7d4e9c10"
+)
+
+func TestMergeCart(t *testing.T) {
+	ct := newCartTest(t)
+	userKey, _, _ := resolveCart(ct.svcCtx, testUserId, "")
+	guestKey, _ := guestCartKey(testTempCartId)
+
+	ct.setItem(t, userKey, CartItemData{ProductId: 1, Price: 2.5, Quantity: 6})
+	ct.setItem(t, userKey, CartItemData{ProductId: 2, Quantity: 1})
+
+	// Product 1 is in both carts, 4 and 3 are new but only one more line fits (MaxItems = 3),
+	// the older guest line wins
+	ct.setItem(t, guestKey, CartItemData{ProductId: 1, Price: 3, Quantity: 7, AddedAt: 10})
+	ct.setItem(t, guestKey, CartItemData{ProductId: 3, Quantity: 2, AddedAt: 30})
+	ct.setItem(t, guestKey, CartItemData{ProductId: 4, Quantity: 2, AddedAt: 20})
+
+	resp, err := NewMergeCartLogic(ct.ctx, ct.svcCtx).MergeCart(&cart.MergeCartRequest{UserId: testUserId, TempCartId: testTempCartId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if resp.Merged != 2 || resp.Skipped != 1 {
+		t.Fatalf("merged = %d, skipped = %d, want 2 and 1", resp.Merged, resp.Skipped)
+	}
+	if item := ct.item(t, userKey, 1); item.Quantity != 10 || item.Price != 2.5 {
+		t.Fatalf("merged line = %+v, want quantity capped at 10 and the user's price", item)
+	}
+	if item := ct.item(t, userKey, 4); item.Quantity != 2 {
+		t.Fatalf("new line = %+v", item)
+	}
+	if ct.redis.HGet(userKey, productField(3)) != "" {
+		t.Fatal("line added to a full cart")
+	}
+	if ct.redis.Exists(guestKey) {
+		t.Fatal("guest cart not deleted")
+	}
+}
+
+func TestMergeCartValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		userId     int64
+		tempCartId string
+	}{
+		{name: "guest user", userId: 0, tempCartId: testTempCartId},
+		{name: "cart ID not a UUID", userId: testUserId, tempCartId: "cart:user:1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ct := newCartTest(t)
+
+			_, err := NewMergeCartLogic(ct.ctx, ct.svcCtx).MergeCart(&cart.MergeCartRequest{UserId: tt.userId, TempCartId: tt.tempCartId})
+			assertCode(t, err, 1001)
+		})
+	}
+}
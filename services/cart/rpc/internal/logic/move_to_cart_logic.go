@@ -0,0 +1,71 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/model"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type MoveToCartLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMoveToCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MoveToCartLogic {
+	return &MoveToCartLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Move a wishlist item to the cart, the item is removed from the wishlist
+func (l *MoveToCartLogic) MoveToCart(in *cart.MoveToCartRequest) (*cart.MoveToCartResponse, error) {
+	// 1. Validate input
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+	wishlist, err := resolveWishlist(l.ctx, l.svcCtx, in.UserId, in.WishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := l.svcCtx.WishlistModel.FindItem(l.ctx, wishlist.Id, in.ProductId)
+	if err == model.ErrNotFound {
+		return nil, errorx.ErrWishlistItemNotFound
+	}
+	if err != nil {
+		l.Logger.Errorf("Failed to find wishlist item: wishlist_id=%d, product_id=%d, err=%v", wishlist.Id, in.ProductId, err)
+		return nil, errorx.ErrDatabase
+	}
+
+	// 2. Add to cart with the usual product lookup and cart limits
+	_, err = NewAddToCartLogic(l.ctx, l.svcCtx).AddToCart(&cart.AddToCartRequest{
+		UserId:    in.UserId,
+		ProductId: item.ProductId,
+		Quantity:  item.Quantity,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Remove from the wishlist, a failure leaves the item in both places instead of losing it
+	err = l.svcCtx.WishlistModel.RemoveItem(l.ctx, wishlist.Id, in.ProductId)
+	if err != nil && err != model.ErrNotFound {
+		l.Logger.Errorf("Failed to remove moved wishlist item: wishlist_id=%d, product_id=%d, err=%v", wishlist.Id, in.ProductId, err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Moved wishlist item to cart: user_id=%d, wishlist_id=%d, product_id=%d, quantity=%d",
+		in.UserId, wishlist.Id, in.ProductId, item.Quantity)
+
+	return &cart.MoveToCartResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,89 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/model"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+type MoveToWishlistLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewMoveToWishlistLogic(ctx context.Context, svcCtx *svc.ServiceContext) *MoveToWishlistLogic {
+	return &MoveToWishlistLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Move a cart line to a wishlist, the line is removed from the cart
+func (l *MoveToWishlistLogic) MoveToWishlist(in *cart.MoveToWishlistRequest) (*cart.MoveToWishlistResponse, error) {
+	// 1. Validate input
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+	wishlist, err := resolveWishlist(l.ctx, l.svcCtx, in.UserId, in.WishlistId)
+	if err != nil {
+		return nil, err
+	}
+	cartKey, _, err := loadCart(l.ctx, l.svcCtx, in.UserId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Read the cart line
+	productField := fmt.Sprintf("product:%d", in.ProductId)
+	itemJSON, err := l.svcCtx.Redis.HgetCtx(l.ctx, cartKey, productField)
+	if err == redis.Nil {
+		return nil, errorx.ErrCartItemNotFound
+	}
+	if err != nil {
+		l.Logger.Errorf("Failed to get cart item: cart=%s, product_id=%d, err=%v", cartKey, in.ProductId, err)
+		return nil, errorx.ErrCache
+	}
+
+	var item CartItemData
+	if err := json.Unmarshal([]byte(itemJSON), &item); err != nil {
+		l.Logger.Errorf("Failed to unmarshal cart item: %v", err)
+		return nil, errorx.ErrSystem
+	}
+
+	// 3. Save it first, so a failure leaves the item in the cart instead of losing it
+	// The cart line keeps the price the shopper agreed to, a later drop shows up in the wishlist
+	err = addWishlistItem(l.ctx, l.svcCtx, &model.WishlistItem{
+		WishlistId: wishlist.Id,
+		ProductId:  item.ProductId,
+		Quantity:   item.Quantity,
+		SavedPrice: item.Price,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 4. Remove the cart line
+	if _, err := removeCartLines(l.ctx, l.svcCtx, in.UserId, cartKey, productField); err != nil {
+		l.Logger.Errorf("Failed to remove moved cart item: cart=%s, product_id=%d, err=%v", cartKey, in.ProductId, err)
+		return nil, errorx.ErrCache
+	}
+
+	markCartDirty(l.ctx, l.svcCtx, in.UserId)
+
+	l.Logger.Infof("Moved cart item to wishlist: user_id=%d, wishlist_id=%d, product_id=%d, quantity=%d",
+		in.UserId, wishlist.Id, in.ProductId, item.Quantity)
+
+	return &cart.MoveToWishlistResponse{
+		Success: true,
+	}, nil
+}
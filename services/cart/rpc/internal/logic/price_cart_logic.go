@@ -0,0 +1,228 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PriceCartLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPriceCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PriceCartLogic {
+	return &PriceCartLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Price the selected lines like checkout will and list every issue that blocks the order
+func (l *PriceCartLogic) PriceCart(in *cart.PriceCartRequest) (*cart.PriceCartResponse, error) {
+	// 1. Validate input
+	cartKey, _, err := loadCart(l.ctx, l.svcCtx, in.UserId, in.TempCartId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Get selected lines, oldest first
+	selected, err := selectedCartLines(l.ctx, l.svcCtx, cartKey)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &cart.PriceCartResponse{
+		Lines:  make([]*cart.PricedLine, 0, len(selected)),
+		Issues: []*cart.CartIssue{},
+	}
+	if len(selected) == 0 {
+		resp.Issues = append(resp.Issues, &cart.CartIssue{Code: issueEmpty, Message: "No items selected for checkout"})
+		return resp, nil
+	}
+	if len(selected) > l.svcCtx.Config.Cart.MaxItems {
+		resp.Issues = append(resp.Issues, &cart.CartIssue{
+			Code:    issueTooManyItems,
+			Message: fmt.Sprintf("Cart has %d items, maximum %d allowed", len(selected), l.svcCtx.Config.Cart.MaxItems),
+		})
+	}
+
+	// 3. Current product data and stock, pricing without them would not match checkout
+	productIds := make([]int64, 0, len(selected))
+	stockItems := make([]*product.StockItem, 0, len(selected))
+	for _, item := range selected {
+		productIds = append(productIds, item.ProductId)
+		stockItems = append(stockItems, &product.StockItem{
+			ProductId:        item.ProductId,
+			RequiredQuantity: item.Quantity,
+		})
+	}
+
+	productsResp, err := l.svcCtx.ProductRpc.GetProducts(l.ctx, &product.GetProductsRequest{
+		Ids: productIds,
+	})
+	if err != nil {
+		l.Logger.Errorf("Failed to get products: err=%v", err)
+		return nil, errorx.ErrRPC
+	}
+
+	stockResp, err := l.svcCtx.ProductRpc.CheckStock(l.ctx, &product.CheckStockRequest{
+		Items: stockItems,
+	})
+	if err != nil {
+		l.Logger.Errorf("Failed to check stock: err=%v", err)
+		return nil, errorx.ErrRPC
+	}
+
+	stockMap := make(map[int64]int64)
+	for _, item := range stockResp.Items {
+		stockMap[item.ProductId] = item.AvailableStock
+	}
+
+	// 4. Price every line in cents, so the total is exactly the sum of the lines
+	var subtotalCents int64
+	quote := &cartQuote{
+		UserId:    in.UserId,
+		Lines:     make([]quoteLine, 0, len(selected)),
+		CreatedAt: time.Now().Unix(),
+	}
+	for i, item := range selected {
+		line := &cart.PricedLine{
+			ProductId:  item.ProductId,
+			Name:       item.Name,
+			Image:      item.Image,
+			Quantity:   item.Quantity,
+			UnitPrice:  item.Price,
+			SavedPrice: item.Price,
+		}
+		resp.Lines = append(resp.Lines, line)
+
+		// Results are returned in request order
+		result := productsResp.Results[i]
+		if !result.Found {
+			resp.Issues = append(resp.Issues, &cart.CartIssue{
+				ProductId: item.ProductId,
+				Code:      issueDelisted,
+				Message:   "Product is no longer available",
+			})
+			continue
+		}
+
+		line.Name = result.Product.Name
+		line.Image = getFirstImage(result.Product.Images)
+		line.UnitPrice = result.Product.Price
+		line.Stock = stockMap[item.ProductId]
+
+		lineCents := priceCents(line.UnitPrice) * item.Quantity
+		line.Subtotal = float64(lineCents) / 100
+		line.Total = line.Subtotal
+		subtotalCents += lineCents
+
+		switch {
+		case line.Stock <= 0:
+			resp.Issues = append(resp.Issues, &cart.CartIssue{
+				ProductId: item.ProductId,
+				Code:      issueOutOfStock,
+				Message:   "Product is out of stock",
+			})
+		case item.Quantity > line.Stock:
+			resp.Issues = append(resp.Issues, &cart.CartIssue{
+				ProductId: item.ProductId,
+				Code:      issueInsufficientStock,
+				Message:   fmt.Sprintf("Only %d in stock", line.Stock),
+			})
+		}
+		if item.Quantity > int64(l.svcCtx.Config.Cart.MaxQuantityPerItem) {
+			resp.Issues = append(resp.Issues, &cart.CartIssue{
+				ProductId: item.ProductId,
+				Code:      issueQuantityLimit,
+				Message:   fmt.Sprintf("Quantity cannot exceed %d", l.svcCtx.Config.Cart.MaxQuantityPerItem),
+			})
+		} else if limit, limitErr := purchaseLimit(result.Product); limit > 0 && item.Quantity > limit {
+			resp.Issues = append(resp.Issues, &cart.CartIssue{
+				ProductId: item.ProductId,
+				Code:      issuePurchaseLimit,
+				Message:   limitErr.Msg,
+			})
+		}
+
+		quote.Lines = append(quote.Lines, quoteLine{
+			ProductId: item.ProductId,
+			Quantity:  item.Quantity,
+			UnitPrice: line.UnitPrice,
+		})
+	}
+
+	// There are no promotions, shipping rates or tax rules yet, the total is the subtotal
+	resp.Subtotal = float64(subtotalCents) / 100
+	resp.Total = resp.Subtotal
+	resp.Valid = len(resp.Issues) == 0
+
+	// 5. Quote valid user carts, guests must log in before checkout
+	if !resp.Valid || in.UserId <= 0 {
+		return resp, nil
+	}
+
+	quote.Total = resp.Total
+	quoteId := uuid.New().String()
+	quoteJSON, err := json.Marshal(quote)
+	if err != nil {
+		l.Logger.Errorf("Failed to marshal quote: %v", err)
+		return nil, errorx.ErrSystem
+	}
+
+	expire := l.svcCtx.Config.Cart.QuoteExpire
+	if err := l.svcCtx.Redis.SetexCtx(l.ctx, quoteKey(in.UserId, quoteId), string(quoteJSON), expire); err != nil {
+		l.Logger.Errorf("Failed to save quote: user_id=%d, err=%v", in.UserId, err)
+		return nil, errorx.ErrCache
+	}
+
+	resp.QuoteId = quoteId
+	resp.QuoteExpiresAt = quote.CreatedAt + int64(expire)
+
+	l.Logger.Infof("Cart priced: cart=%s, lines=%d, total=%.2f, quote_id=%s", cartKey, len(resp.Lines), resp.Total, quoteId)
+
+	return resp, nil
+}
+
+// Codes of the issues that block an order
+const (
+	issueEmpty             = "empty"
+	issueTooManyItems      = "too_many_items"
+	issueDelisted          = "delisted"
+	issueOutOfStock        = "out_of_stock"
+	issueInsufficientStock = "insufficient_stock"
+	issueQuantityLimit     = "quantity_limit"
+	issuePurchaseLimit     = "purchase_limit"
+)
+
+// cartQuote is a priced cart stored in Redis until it is ordered or expires
+type cartQuote struct {
+	UserId    int64       `json:"user_id"`
+	Lines     []quoteLine `json:"lines"`
+	Total     float64     `json:"total"`
+	CreatedAt int64       `json:"created_at"`
+}
+
+// quoteLine is a quoted line, the price is charged even if the product price changes
+type quoteLine struct {
+	ProductId int64   `json:"product_id"`
+	Quantity  int64   `json:"quantity"`
+	UnitPrice float64 `json:"unit_price"`
+}
+
+// quoteKey returns the Redis key of a quote, scoped to its user so quotes cannot be used by others
+func quoteKey(userId int64, quoteId string) string {
+	return fmt.Sprintf("cart:quote:%d:%s", userId, quoteId)
+}
@@ -0,0 +1,137 @@
+package logic
+
+import (
+	"reflect"
+	"testing"
+
+	"letsgo/services/cart/rpc/cart"
+)
+
+func issueCodes(issues []*cart.CartIssue) []string {
+	codes := make([]string, 0, len(issues))
+	for _, issue := range issues {
+		codes = append(codes, issue.Code)
+	}
+	return codes
+}
+
+func TestPriceCart(t *testing.T) {
+	tests := []struct {
+		name   string
+		userId int64
+		setup  func(ct *cartTest, cartKey string)
+		issues []string
+		total  float64
+		quoted bool
+	}{
+		{
+			// The price of product 1 went up since it was added, the current price is charged
+			name:   "valid cart is quoted",
+			userId: testUserId,
+			setup: func(ct *cartTest, cartKey string) {
+				ct.setItem(t, cartKey, CartItemData{ProductId: 1, Price: 2, Quantity: 3, AddedAt: 1})
+				ct.setItem(t, cartKey, CartItemData{ProductId: 2, Price: 0.1, Quantity: 3, AddedAt: 2})
+			},
+			issues: []string{},
+			total:  7.8,
+			quoted: true,
+		},
+		{
+			name:   "guest cart is not quoted",
+			setup:  func(ct *cartTest, cartKey string) { ct.setItem(t, cartKey, CartItemData{ProductId: 1, Quantity: 1}) },
+			issues: []string{},
+			total:  2.5,
+		},
+		{
+			name:   "deselected lines left out",
+			userId: testUserId,
+			setup: func(ct *cartTest, cartKey string) {
+				ct.setItem(t, cartKey, CartItemData{ProductId: 1, Quantity: 1, Deselected: true})
+			},
+			issues: []string{issueEmpty},
+		},
+		{
+			name:   "blocking issues",
+			userId: testUserId,
+			setup: func(ct *cartTest, cartKey string) {
+				ct.products.products[2].Stock = 0
+				ct.products.products[3].Stock = 4
+				ct.setItem(t, cartKey, CartItemData{ProductId: 9, Quantity: 1, AddedAt: 1})
+				ct.setItem(t, cartKey, CartItemData{ProductId: 2, Quantity: 1, AddedAt: 2})
+				ct.setItem(t, cartKey, CartItemData{ProductId: 3, Quantity: 5, AddedAt: 3})
+				ct.setItem(t, cartKey, CartItemData{ProductId: 1, Quantity: 11, AddedAt: 4})
+			},
+			issues: []string{issueTooManyItems, issueDelisted, issueOutOfStock, issueInsufficientStock, issueQuantityLimit},
+			total:  42.6,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ct := newCartTest(t)
+			ct.addProduct(1, "Pen", 2.5)
+			ct.addProduct(2, "Refill", 0.1)
+			ct.addProduct(3, "Ink", 3)
+			cartKey, _, _ := resolveCart(ct.svcCtx, tt.userId, testTempCartId)
+			tt.setup(ct, cartKey)
+
+			resp, err := NewPriceCartLogic(ct.ctx, ct.svcCtx).PriceCart(&cart.PriceCartRequest{UserId: tt.userId, TempCartId: testTempCartId})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if codes := issueCodes(resp.Issues); !reflect.DeepEqual(codes, tt.issues) {
+				t.Fatalf("issues = %v, want %v", codes, tt.issues)
+			}
+			if resp.Total != tt.total || resp.Subtotal != tt.total {
+				t.Fatalf("subtotal = %v, total = %v, want %v", resp.Subtotal, resp.Total, tt.total)
+			}
+			// No promotions, shipping rates or tax rules yet
+			if resp.Discount != 0 || resp.Shipping != 0 || resp.Tax != 0 {
+				t.Fatalf("discount = %v, shipping = %v, tax = %v", resp.Discount, resp.Shipping, resp.Tax)
+			}
+			for _, line := range resp.Lines {
+				if line.Discount != 0 || line.Total != line.Subtotal {
+					t.Fatalf("line = %+v", line)
+				}
+			}
+			if resp.Valid != (len(tt.issues) == 0) {
+				t.Fatalf("valid = %v with issues %v", resp.Valid, tt.issues)
+			}
+			if (resp.QuoteId != "") != tt.quoted {
+				t.Fatalf("quote id = %q, want quoted = %v", resp.QuoteId, tt.quoted)
+			}
+		})
+	}
+}
+
+func TestTakeQuote(t *testing.T) {
+	ct := newCartTest(t)
+	ct.addProduct(1, "Pen", 2.5)
+	cartKey, _, _ := resolveCart(ct.svcCtx, testUserId, "")
+	ct.setItem(t, cartKey, CartItemData{ProductId: 1, Price: 2.5, Quantity: 2})
+
+	priced, err := NewPriceCartLogic(ct.ctx, ct.svcCtx).PriceCart(&cart.PriceCartRequest{UserId: testUserId})
+	if err != nil || priced.QuoteId == "" {
+		t.Fatalf("priced = %v, err = %v", priced, err)
+	}
+
+	// The quoted price holds even if the product price changes before checkout
+	ct.products.products[1].Price = 3
+
+	l := NewTakeQuoteLogic(ct.ctx, ct.svcCtx)
+	if _, err := l.TakeQuote(&cart.TakeQuoteRequest{UserId: testUserId + 1, QuoteId: priced.QuoteId}); err == nil {
+		t.Fatal("quote taken by another user")
+	}
+
+	quote, err := l.TakeQuote(&cart.TakeQuoteRequest{UserId: testUserId, QuoteId: priced.QuoteId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quote.Total != 5 || len(quote.Lines) != 1 || quote.Lines[0].UnitPrice != 2.5 || quote.Lines[0].Quantity != 2 {
+		t.Fatalf("quote = %v", quote)
+	}
+
+	_, err = l.TakeQuote(&cart.TakeQuoteRequest{UserId: testUserId, QuoteId: priced.QuoteId})
+	assertCode(t, err, 4008)
+}
@@ -0,0 +1,21 @@
+package logic
+
+import (
+	"fmt"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/rpc/product"
+)
+
+// purchaseLimit returns the most units of a product one cart line can hold (0 = no product limit)
+// and the error for going over it. Units the user already ordered are only known by the order service,
+// so CreateOrder checks the per-user limit again against the order history
+func purchaseLimit(info *product.ProductInfo) (int64, *errorx.CodeError) {
+	if info.MaxPerOrder > 0 && (info.MaxPerUser == 0 || info.MaxPerOrder <= info.MaxPerUser) {
+		return info.MaxPerOrder, errorx.ErrOrderLimitExceeded.WithMsg(fmt.Sprintf("At most %d of %s per order", info.MaxPerOrder, info.Name))
+	}
+	if info.MaxPerUser > 0 {
+		return info.MaxPerUser, errorx.ErrUserLimitExceeded.WithMsg(fmt.Sprintf("At most %d of %s per customer", info.MaxPerUser, info.Name))
+	}
+	return 0, nil
+}
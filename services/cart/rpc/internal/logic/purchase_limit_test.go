@@ -0,0 +1,45 @@
+package logic
+
+import (
+	"testing"
+
+	"letsgo/services/product/rpc/product"
+)
+
+func TestPurchaseLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxPerOrder int64
+		maxPerUser  int64
+		limit       int64
+		code        int
+	}{
+		{name: "no limits"},
+		{name: "per order", maxPerOrder: 3, limit: 3, code: 3018},
+		{name: "per user", maxPerUser: 5, limit: 5, code: 3019},
+		{name: "per order is lower", maxPerOrder: 2, maxPerUser: 5, limit: 2, code: 3018},
+		{name: "per user is lower", maxPerOrder: 5, maxPerUser: 2, limit: 2, code: 3019},
+		{name: "both equal", maxPerOrder: 4, maxPerUser: 4, limit: 4, code: 3018},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limit, limitErr := purchaseLimit(&product.ProductInfo{
+				Name:        "Pen",
+				MaxPerOrder: tt.maxPerOrder,
+				MaxPerUser:  tt.maxPerUser,
+			})
+
+			if limit != tt.limit {
+				t.Fatalf("limit = %d, want %d", limit, tt.limit)
+			}
+			if tt.code == 0 {
+				if limitErr != nil {
+					t.Fatalf("unexpected error %v", limitErr)
+				}
+				return
+			}
+			assertCode(t, limitErr, tt.code)
+		})
+	}
+}
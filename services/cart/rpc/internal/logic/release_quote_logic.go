@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReleaseQuoteLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReleaseQuoteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReleaseQuoteLogic {
+	return &ReleaseQuoteLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Give back a taken quote when the order failed, so it can be used again until it expires
+func (l *ReleaseQuoteLogic) ReleaseQuote(in *cart.ReleaseQuoteRequest) (*cart.ReleaseQuoteResponse, error) {
+	// 1. Validate input
+	if in.UserId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	}
+	if _, err := uuid.Parse(in.QuoteId); err != nil {
+		return nil, errorx.NewCodeError(1001, "Invalid quote ID")
+	}
+
+	// 2. Move the quote back, it keeps the expiration it had when it was taken
+	script := `
+		if redis.call('EXISTS', KEYS[2]) == 0 then
+			return 0
+		end
+		redis.call('RENAME', KEYS[2], KEYS[1])
+		return 1
+	`
+
+	result, err := l.svcCtx.Redis.EvalCtx(l.ctx, script,
+		[]string{quoteKey(in.UserId, in.QuoteId), takenQuoteKey(in.UserId, in.QuoteId)})
+	if err != nil {
+		l.Logger.Errorf("Failed to release quote: user_id=%d, quote_id=%s, err=%v", in.UserId, in.QuoteId, err)
+		return nil, errorx.ErrCache
+	}
+	released, _ := result.(int64)
+
+	l.Logger.Infof("Quote released: user_id=%d, quote_id=%s, released=%v", in.UserId, in.QuoteId, released == 1)
+
+	return &cart.ReleaseQuoteResponse{
+		Success: released == 1,
+	}, nil
+}
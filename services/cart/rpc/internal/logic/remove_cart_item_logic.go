@@ -2,6 +2,7 @@ package logic
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"letsgo/common/errorx"
@@ -28,31 +29,48 @@ func NewRemoveCartItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Re
 // Remove item from cart
 func (l *RemoveCartItemLogic) RemoveCartItem(in *cart.RemoveCartItemRequest) (*cart.RemoveCartItemResponse, error) {
 	// 1. Validate input
-	if in.UserId <= 0 {
-		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	cartKey, expire, err := loadCart(l.ctx, l.svcCtx, in.UserId, in.TempCartId)
+	if err != nil {
+		return nil, err
 	}
 	if in.ProductId <= 0 {
 		return nil, errorx.NewCodeError(1001, "Invalid product ID")
 	}
 
-	// 2. Remove item from Redis (HDEL is atomic)
-	cartKey := fmt.Sprintf("cart:user:%d", in.UserId)
+	// 2. Remove item from Redis, the removed item is returned for the change event
 	productField := fmt.Sprintf("product:%d", in.ProductId)
 
-	deleted, err := l.svcCtx.Redis.HdelCtx(l.ctx, cartKey, productField)
+	script := `
+		local item = redis.call('HGET', KEYS[1], ARGV[2])
+		if not item then
+			return redis.error_reply('ITEM_NOT_FOUND')
+		end
+		redis.call('HDEL', KEYS[1], ARGV[2])
+	` + markClearedScript + `
+		return item
+	`
+
+	result, err := l.svcCtx.Redis.EvalCtx(l.ctx, script, cartRemovalKeys(in.UserId, cartKey),
+		l.svcCtx.Config.Cart.Retention, productField)
 	if err != nil {
-		l.Logger.Errorf("Failed to remove cart item: user_id=%d, product_id=%d, err=%v", in.UserId, in.ProductId, err)
+		if scriptError(err) == "ITEM_NOT_FOUND" {
+			return nil, errorx.ErrCartItemNotFound
+		}
+		l.Logger.Errorf("Failed to remove cart item: cart=%s, product_id=%d, err=%v", cartKey, in.ProductId, err)
 		return nil, errorx.ErrCache
 	}
 
-	if !deleted {
-		return nil, errorx.ErrCartItemNotFound
-	}
-
 	// 3. Refresh cart expiration time
-	l.svcCtx.Redis.ExpireCtx(l.ctx, cartKey, l.svcCtx.Config.Cart.Expire)
+	l.svcCtx.Redis.ExpireCtx(l.ctx, cartKey, expire)
+
+	markCartDirty(l.ctx, l.svcCtx, in.UserId)
+
+	var item CartItemData
+	if itemJSON, ok := result.(string); ok && json.Unmarshal([]byte(itemJSON), &item) == nil {
+		publishItemEvent(l.svcCtx, EventItemRemoved, in.UserId, in.TempCartId, in.ProductId, 0, -item.Quantity, item.Price)
+	}
 
-	l.Logger.Infof("Removed cart item successfully: user_id=%d, product_id=%d", in.UserId, in.ProductId)
+	l.Logger.Infof("Removed cart item successfully: cart=%s, product_id=%d", cartKey, in.ProductId)
 
 	return &cart.RemoveCartItemResponse{
 		Success: true,
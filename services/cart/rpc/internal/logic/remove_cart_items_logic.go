@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RemoveCartItemsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRemoveCartItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveCartItemsLogic {
+	return &RemoveCartItemsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Remove several items from a user's cart (called by order service after checkout)
+func (l *RemoveCartItemsLogic) RemoveCartItems(in *cart.RemoveCartItemsRequest) (*cart.RemoveCartItemsResponse, error) {
+	// 1. Validate input
+	if in.UserId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	}
+	if len(in.ProductIds) == 0 {
+		return nil, errorx.NewCodeError(1001, "No items to remove")
+	}
+
+	fields := make([]string, 0, len(in.ProductIds))
+	for _, productId := range in.ProductIds {
+		if productId <= 0 {
+			return nil, errorx.NewCodeError(1001, "Invalid product ID")
+		}
+		fields = append(fields, fmt.Sprintf("product:%d", productId))
+	}
+
+	// 2. Remove items from Redis in one script
+	cartKey, _, err := loadCart(l.ctx, l.svcCtx, in.UserId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := removeCartLines(l.ctx, l.svcCtx, in.UserId, cartKey, fields...)
+	if err != nil {
+		l.Logger.Errorf("Failed to remove cart items: cart=%s, err=%v", cartKey, err)
+		return nil, errorx.ErrCache
+	}
+
+	markCartDirty(l.ctx, l.svcCtx, in.UserId)
+
+	l.Logger.Infof("Removed cart items successfully: cart=%s, items=%d, removed=%d", cartKey, len(fields), removed)
+
+	return &cart.RemoveCartItemsResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/model"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RemoveFromWishlistLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRemoveFromWishlistLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RemoveFromWishlistLogic {
+	return &RemoveFromWishlistLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Remove a product from a wishlist
+func (l *RemoveFromWishlistLogic) RemoveFromWishlist(in *cart.RemoveFromWishlistRequest) (*cart.RemoveFromWishlistResponse, error) {
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+
+	wishlist, err := resolveWishlist(l.ctx, l.svcCtx, in.UserId, in.WishlistId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.svcCtx.WishlistModel.RemoveItem(l.ctx, wishlist.Id, in.ProductId)
+	if err == model.ErrNotFound {
+		return nil, errorx.ErrWishlistItemNotFound
+	}
+	if err != nil {
+		l.Logger.Errorf("Failed to remove wishlist item: wishlist_id=%d, product_id=%d, err=%v", wishlist.Id, in.ProductId, err)
+		return nil, errorx.ErrDatabase
+	}
+
+	return &cart.RemoveFromWishlistResponse{
+		Success: true,
+	}, nil
+}
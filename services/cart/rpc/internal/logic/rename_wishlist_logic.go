@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/model"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RenameWishlistLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRenameWishlistLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RenameWishlistLogic {
+	return &RenameWishlistLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Rename a named wishlist
+func (l *RenameWishlistLogic) RenameWishlist(in *cart.RenameWishlistRequest) (*cart.RenameWishlistResponse, error) {
+	if in.WishlistId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid wishlist ID")
+	}
+	name, err := normalizeWishlistName(in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	wishlist, err := resolveWishlist(l.ctx, l.svcCtx, in.UserId, in.WishlistId)
+	if err != nil {
+		return nil, err
+	}
+	if wishlist.IsDefault {
+		return nil, errorx.NewCodeError(1001, "The saved for later list cannot be renamed")
+	}
+
+	err = l.svcCtx.WishlistModel.Rename(l.ctx, wishlist.Id, in.UserId, name)
+	switch err {
+	case nil:
+	case model.ErrNotFound:
+		return nil, errorx.ErrWishlistNotFound
+	case model.ErrDuplicateWishlistName:
+		return nil, errorx.ErrWishlistNameExists
+	default:
+		l.Logger.Errorf("Failed to rename wishlist: wishlist_id=%d, err=%v", wishlist.Id, err)
+		return nil, errorx.ErrDatabase
+	}
+
+	return &cart.RenameWishlistResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SelectAllCartItemsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSelectAllCartItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SelectAllCartItemsLogic {
+	return &SelectAllCartItemsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Select or deselect all cart lines
+func (l *SelectAllCartItemsLogic) SelectAllCartItems(in *cart.SelectAllCartItemsRequest) (*cart.SelectAllCartItemsResponse, error) {
+	// 1. Validate input
+	cartKey, expire, err := loadCart(l.ctx, l.svcCtx, in.UserId, in.TempCartId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Update selection of every line
+	updated, err := setCartSelection(l.ctx, l.svcCtx, cartKey, expire, nil, in.Selected)
+	if err != nil {
+		l.Logger.Errorf("Failed to select all cart items: cart=%s, err=%v", cartKey, err)
+		return nil, errorx.ErrCache
+	}
+
+	markCartDirty(l.ctx, l.svcCtx, in.UserId)
+
+	l.Logger.Infof("Selected all cart items successfully: cart=%s, selected=%v, updated=%d", cartKey, in.Selected, updated)
+
+	return &cart.SelectAllCartItemsResponse{
+		Success: true,
+		Updated: updated,
+	}, nil
+}
@@ -0,0 +1,128 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ShareCartLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewShareCartLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ShareCartLogic {
+	return &ShareCartLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Snapshot a cart into a share token that expires, later changes to the cart are not shared
+func (l *ShareCartLogic) ShareCart(in *cart.ShareCartRequest) (*cart.ShareCartResponse, error) {
+	// 1. Validate input
+	cartKey, _, err := loadCart(l.ctx, l.svcCtx, in.UserId, in.TempCartId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Get all lines, selection is not shared
+	items, err := l.svcCtx.Redis.HgetallCtx(l.ctx, cartKey)
+	if err != nil {
+		l.Logger.Errorf("Failed to get cart: cart=%s, err=%v", cartKey, err)
+		return nil, errorx.ErrCache
+	}
+
+	lines := make([]CartItemData, 0, len(items))
+	for _, itemJSON := range items {
+		var item CartItemData
+		if err := json.Unmarshal([]byte(itemJSON), &item); err != nil {
+			l.Logger.Errorf("Failed to unmarshal cart item: %v", err)
+			continue
+		}
+		item.Deselected = false
+		lines = append(lines, item)
+	}
+	if len(lines) == 0 {
+		return nil, errorx.ErrCartEmpty
+	}
+
+	sort.Slice(lines, func(i, j int) bool {
+		if lines[i].AddedAt != lines[j].AddedAt {
+			return lines[i].AddedAt < lines[j].AddedAt
+		}
+		return lines[i].ProductId < lines[j].ProductId
+	})
+
+	// 3. Save the snapshot under a random token, the token is the only way to read it
+	expire := l.svcCtx.Config.Cart.ShareExpire
+	shared := &sharedCart{
+		Lines:     lines,
+		ExpiresAt: time.Now().Unix() + int64(expire),
+	}
+	sharedJSON, err := json.Marshal(shared)
+	if err != nil {
+		l.Logger.Errorf("Failed to marshal shared cart: %v", err)
+		return nil, errorx.ErrSystem
+	}
+
+	token := uuid.New().String()
+	if err := l.svcCtx.Redis.SetexCtx(l.ctx, sharedCartKey(token), string(sharedJSON), expire); err != nil {
+		l.Logger.Errorf("Failed to save shared cart: cart=%s, err=%v", cartKey, err)
+		return nil, errorx.ErrCache
+	}
+
+	l.Logger.Infof("Cart shared: cart=%s, lines=%d, expires_at=%d", cartKey, len(lines), shared.ExpiresAt)
+
+	return &cart.ShareCartResponse{
+		Token:     token,
+		ExpiresAt: shared.ExpiresAt,
+	}, nil
+}
+
+// sharedCart is a snapshot of a cart stored in Redis until it expires
+type sharedCart struct {
+	Lines     []CartItemData `json:"lines"`
+	ExpiresAt int64          `json:"expires_at"`
+}
+
+// sharedCartKey returns the Redis key of a shared cart
+func sharedCartKey(token string) string {
+	return fmt.Sprintf("cart:share:%s", token)
+}
+
+// loadSharedCart returns the shared cart of a token, ErrSharedCartNotFound once it expired
+func loadSharedCart(ctx context.Context, svcCtx *svc.ServiceContext, token string) (*sharedCart, error) {
+	id, err := uuid.Parse(token)
+	if err != nil {
+		return nil, errorx.NewCodeError(1001, "Invalid share token")
+	}
+
+	sharedJSON, err := svcCtx.Redis.GetCtx(ctx, sharedCartKey(id.String()))
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Failed to get shared cart: token=%s, err=%v", token, err)
+		return nil, errorx.ErrCache
+	}
+	if sharedJSON == "" {
+		return nil, errorx.ErrSharedCartNotFound
+	}
+
+	var shared sharedCart
+	if err := json.Unmarshal([]byte(sharedJSON), &shared); err != nil {
+		logx.WithContext(ctx).Errorf("Failed to unmarshal shared cart: token=%s, err=%v", token, err)
+		return nil, errorx.ErrSystem
+	}
+
+	return &shared, nil
+}
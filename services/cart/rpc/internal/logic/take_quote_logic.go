@@ -0,0 +1,88 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TakeQuoteLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewTakeQuoteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TakeQuoteLogic {
+	return &TakeQuoteLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Use up a quote (called by order service at checkout), a quote can be used once
+func (l *TakeQuoteLogic) TakeQuote(in *cart.TakeQuoteRequest) (*cart.TakeQuoteResponse, error) {
+	// 1. Validate input
+	if in.UserId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	}
+	if _, err := uuid.Parse(in.QuoteId); err != nil {
+		return nil, errorx.NewCodeError(1001, "Invalid quote ID")
+	}
+
+	// 2. Take the quote, it is moved aside (keeping its expiration) so it is used once
+	// ReleaseQuote moves it back when the order fails
+	script := `
+		local quote = redis.call('GET', KEYS[1])
+		if not quote then
+			return ''
+		end
+		redis.call('RENAME', KEYS[1], KEYS[2])
+		return quote
+	`
+
+	result, err := l.svcCtx.Redis.EvalCtx(l.ctx, script,
+		[]string{quoteKey(in.UserId, in.QuoteId), takenQuoteKey(in.UserId, in.QuoteId)})
+	if err != nil {
+		l.Logger.Errorf("Failed to take quote: user_id=%d, quote_id=%s, err=%v", in.UserId, in.QuoteId, err)
+		return nil, errorx.ErrCache
+	}
+	quoteJSON, _ := result.(string)
+	if quoteJSON == "" {
+		return nil, errorx.ErrQuoteNotFound
+	}
+
+	var quote cartQuote
+	if err := json.Unmarshal([]byte(quoteJSON), &quote); err != nil {
+		l.Logger.Errorf("Failed to unmarshal quote: quote_id=%s, err=%v", in.QuoteId, err)
+		return nil, errorx.ErrSystem
+	}
+
+	lines := make([]*cart.QuoteLine, 0, len(quote.Lines))
+	for _, line := range quote.Lines {
+		lines = append(lines, &cart.QuoteLine{
+			ProductId: line.ProductId,
+			Quantity:  line.Quantity,
+			UnitPrice: line.UnitPrice,
+		})
+	}
+
+	l.Logger.Infof("Quote taken: user_id=%d, quote_id=%s, total=%.2f", in.UserId, in.QuoteId, quote.Total)
+
+	return &cart.TakeQuoteResponse{
+		Lines: lines,
+		Total: quote.Total,
+	}, nil
+}
+
+// takenQuoteKey returns the Redis key of a quote taken by an order that is not finished yet
+func takenQuoteKey(userId int64, quoteId string) string {
+	return fmt.Sprintf("cart:quote:taken:%d:%s", userId, quoteId)
+}
@@ -2,11 +2,13 @@ package logic
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"letsgo/common/errorx"
 	"letsgo/services/cart/rpc/cart"
 	"letsgo/services/cart/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -28,8 +30,9 @@ func NewUpdateCartItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Up
 // Update cart item quantity
 func (l *UpdateCartItemLogic) UpdateCartItem(in *cart.UpdateCartItemRequest) (*cart.UpdateCartItemResponse, error) {
 	// 1. Validate input
-	if in.UserId <= 0 {
-		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	cartKey, expire, err := loadCart(l.ctx, l.svcCtx, in.UserId, in.TempCartId)
+	if err != nil {
+		return nil, err
 	}
 	if in.ProductId <= 0 {
 		return nil, errorx.NewCodeError(1001, "Invalid product ID")
@@ -38,8 +41,19 @@ func (l *UpdateCartItemLogic) UpdateCartItem(in *cart.UpdateCartItemRequest) (*c
 		return nil, errorx.NewCodeError(1001, fmt.Sprintf("Quantity must be between 1 and %d", l.svcCtx.Config.Cart.MaxQuantityPerItem))
 	}
 
-	// 2. Update quantity using Lua script (atomic operation)
-	cartKey := fmt.Sprintf("cart:user:%d", in.UserId)
+	// 2. Check the purchase limits of the product
+	productInfo, err := l.svcCtx.ProductRpc.GetProduct(l.ctx, &product.GetProductRequest{
+		Id: in.ProductId,
+	})
+	if err != nil {
+		l.Logger.Errorf("Failed to get product info: product_id=%d, err=%v", in.ProductId, err)
+		return nil, errorx.ErrProductNotFound
+	}
+	if limit, limitErr := purchaseLimit(productInfo.Product); limit > 0 && in.Quantity > limit {
+		return nil, limitErr
+	}
+
+	// 3. Update quantity using Lua script (atomic operation)
 	productField := fmt.Sprintf("product:%d", in.ProductId)
 
 	// Lua script for atomic update
@@ -70,7 +84,8 @@ func (l *UpdateCartItemLogic) UpdateCartItem(in *cart.UpdateCartItemRequest) (*c
 		redis.call('HSET', cart_key, product_field, new_item_json)
 		redis.call('EXPIRE', cart_key, expire_time)
 
-		return new_qty
+		-- Return the item before the update for the change event
+		return current_item
 	`
 
 	result, err := l.svcCtx.Redis.EvalCtx(l.ctx, script,
@@ -78,23 +93,31 @@ func (l *UpdateCartItemLogic) UpdateCartItem(in *cart.UpdateCartItemRequest) (*c
 		productField,
 		in.Quantity,
 		l.svcCtx.Config.Cart.MaxQuantityPerItem,
-		l.svcCtx.Config.Cart.Expire,
+		expire,
 	)
 
 	if err != nil {
-		errMsg := err.Error()
+		errMsg := scriptError(err)
 		if errMsg == "ITEM_NOT_FOUND" {
 			return nil, errorx.ErrCartItemNotFound
 		}
 		if errMsg == "QUANTITY_LIMIT_EXCEEDED" {
 			return nil, errorx.NewCodeError(4003, fmt.Sprintf("Quantity limit exceeded, maximum %d per item", l.svcCtx.Config.Cart.MaxQuantityPerItem))
 		}
-		l.Logger.Errorf("Failed to update cart item: user_id=%d, product_id=%d, err=%v", in.UserId, in.ProductId, err)
+		l.Logger.Errorf("Failed to update cart item: cart=%s, product_id=%d, err=%v", cartKey, in.ProductId, err)
 		return nil, errorx.ErrCache
 	}
 
-	l.Logger.Infof("Updated cart item successfully: user_id=%d, product_id=%d, new_quantity=%v",
-		in.UserId, in.ProductId, result)
+	markCartDirty(l.ctx, l.svcCtx, in.UserId)
+
+	var oldItem CartItemData
+	if itemJSON, ok := result.(string); ok && json.Unmarshal([]byte(itemJSON), &oldItem) == nil {
+		publishItemEvent(l.svcCtx, EventItemUpdated, in.UserId, in.TempCartId, in.ProductId,
+			in.Quantity, in.Quantity-oldItem.Quantity, oldItem.Price)
+	}
+
+	l.Logger.Infof("Updated cart item successfully: cart=%s, product_id=%d, new_quantity=%d",
+		cartKey, in.ProductId, in.Quantity)
 
 	return &cart.UpdateCartItemResponse{
 		Success: true,
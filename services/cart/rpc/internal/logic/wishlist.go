@@ -0,0 +1,92 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"letsgo/common/errorx"
+	"letsgo/services/cart/model"
+	"letsgo/services/cart/rpc/cart"
+	"letsgo/services/cart/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// defaultWishlistName is the name of the list wishlist_id 0 refers to, other lists cannot use it
+const defaultWishlistName = "Saved for later"
+
+// maxWishlistNameLength matches wishlists.name VARCHAR(100)
+const maxWishlistNameLength = 100
+
+// resolveWishlist returns a wishlist of a user, wishlistId 0 is the "Saved for later" list
+func resolveWishlist(ctx context.Context, svcCtx *svc.ServiceContext, userId, wishlistId int64) (*model.Wishlist, error) {
+	if userId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	}
+	if wishlistId < 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid wishlist ID")
+	}
+
+	var wishlist *model.Wishlist
+	var err error
+	if wishlistId == 0 {
+		wishlist, err = svcCtx.WishlistModel.FindOrCreateDefault(ctx, userId, defaultWishlistName)
+	} else {
+		wishlist, err = svcCtx.WishlistModel.FindOne(ctx, wishlistId, userId)
+	}
+	if err == model.ErrNotFound {
+		return nil, errorx.ErrWishlistNotFound
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Failed to find wishlist: user_id=%d, wishlist_id=%d, err=%v", userId, wishlistId, err)
+		return nil, errorx.ErrDatabase
+	}
+
+	return wishlist, nil
+}
+
+// normalizeWishlistName trims a wishlist name and checks it can be used for a named list
+func normalizeWishlistName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errorx.NewCodeError(1001, "Wishlist name is required")
+	}
+	if utf8.RuneCountInString(name) > maxWishlistNameLength {
+		return "", errorx.NewCodeError(1001, "Wishlist name is too long")
+	}
+	if strings.EqualFold(name, defaultWishlistName) {
+		return "", errorx.ErrWishlistNameExists
+	}
+	return name, nil
+}
+
+// toWishlist converts a wishlist row to its RPC message
+func toWishlist(wishlist *model.Wishlist) *cart.Wishlist {
+	return &cart.Wishlist{
+		Id:        wishlist.Id,
+		Name:      wishlist.Name,
+		IsDefault: wishlist.IsDefault,
+		ItemCount: wishlist.ItemCount,
+		CreatedAt: wishlist.CreatedAt.Unix(),
+		UpdatedAt: wishlist.UpdatedAt.Unix(),
+	}
+}
+
+// addWishlistItem saves a product to a wishlist and maps model errors to error codes
+func addWishlistItem(ctx context.Context, svcCtx *svc.ServiceContext, item *model.WishlistItem) error {
+	err := svcCtx.WishlistModel.AddItem(ctx, item, svcCtx.Config.Wishlist.MaxItems, svcCtx.Config.Cart.MaxQuantityPerItem)
+	switch err {
+	case nil:
+		return nil
+	case model.ErrNotFound:
+		return errorx.ErrWishlistNotFound
+	case model.ErrWishlistFull:
+		return errorx.NewCodeError(4007, fmt.Sprintf("Wishlist is full, maximum %d items allowed", svcCtx.Config.Wishlist.MaxItems))
+	default:
+		logx.WithContext(ctx).Errorf("Failed to add wishlist item: wishlist_id=%d, product_id=%d, err=%v",
+			item.WishlistId, item.ProductId, err)
+		return errorx.ErrDatabase
+	}
+}
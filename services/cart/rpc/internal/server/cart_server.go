@@ -23,7 +23,7 @@ func NewCartServer(svcCtx *svc.ServiceContext) *CartServer {
 	}
 }
 
-// Add product to cart
+// Add product to cart, requests with user_id 0 use the guest cart temp_cart_id instead
 func (s *CartServer) AddToCart(ctx context.Context, in *cart.AddToCartRequest) (*cart.AddToCartResponse, error) {
 	l := logic.NewAddToCartLogic(ctx, s.svcCtx)
 	return l.AddToCart(in)
@@ -53,8 +53,128 @@ func (s *CartServer) ClearCart(ctx context.Context, in *cart.ClearCartRequest) (
 	return l.ClearCart(in)
 }
 
-// Merge guest cart with user cart (after login)
+// Merge guest cart into user cart (after login), the guest cart is deleted
 func (s *CartServer) MergeCart(ctx context.Context, in *cart.MergeCartRequest) (*cart.MergeCartResponse, error) {
 	l := logic.NewMergeCartLogic(ctx, s.svcCtx)
 	return l.MergeCart(in)
 }
+
+// Accept the current prices of cart lines whose price changed since they were added
+func (s *CartServer) AcceptCartPrices(ctx context.Context, in *cart.AcceptCartPricesRequest) (*cart.AcceptCartPricesResponse, error) {
+	l := logic.NewAcceptCartPricesLogic(ctx, s.svcCtx)
+	return l.AcceptCartPrices(in)
+}
+
+// Select or deselect cart lines for checkout, new lines are selected
+func (s *CartServer) SelectCartItems(ctx context.Context, in *cart.SelectCartItemsRequest) (*cart.SelectCartItemsResponse, error) {
+	l := logic.NewSelectCartItemsLogic(ctx, s.svcCtx)
+	return l.SelectCartItems(in)
+}
+
+// Select or deselect all cart lines
+func (s *CartServer) SelectAllCartItems(ctx context.Context, in *cart.SelectAllCartItemsRequest) (*cart.SelectAllCartItemsResponse, error) {
+	l := logic.NewSelectAllCartItemsLogic(ctx, s.svcCtx)
+	return l.SelectAllCartItems(in)
+}
+
+// Get the selected lines of a user's cart (called by order service at checkout)
+func (s *CartServer) GetCheckoutItems(ctx context.Context, in *cart.GetCheckoutItemsRequest) (*cart.GetCheckoutItemsResponse, error) {
+	l := logic.NewGetCheckoutItemsLogic(ctx, s.svcCtx)
+	return l.GetCheckoutItems(in)
+}
+
+// Remove several items from a user's cart (called by order service after checkout)
+func (s *CartServer) RemoveCartItems(ctx context.Context, in *cart.RemoveCartItemsRequest) (*cart.RemoveCartItemsResponse, error) {
+	l := logic.NewRemoveCartItemsLogic(ctx, s.svcCtx)
+	return l.RemoveCartItems(in)
+}
+
+// Price the selected lines like checkout will and list every issue that blocks the order
+func (s *CartServer) PriceCart(ctx context.Context, in *cart.PriceCartRequest) (*cart.PriceCartResponse, error) {
+	l := logic.NewPriceCartLogic(ctx, s.svcCtx)
+	return l.PriceCart(in)
+}
+
+// Take a quote (called by order service at checkout), a quote can be used once, ReleaseQuote gives it back if the order fails
+func (s *CartServer) TakeQuote(ctx context.Context, in *cart.TakeQuoteRequest) (*cart.TakeQuoteResponse, error) {
+	l := logic.NewTakeQuoteLogic(ctx, s.svcCtx)
+	return l.TakeQuote(in)
+}
+
+// Give back a taken quote when the order failed, so it can be used again until it expires
+func (s *CartServer) ReleaseQuote(ctx context.Context, in *cart.ReleaseQuoteRequest) (*cart.ReleaseQuoteResponse, error) {
+	l := logic.NewReleaseQuoteLogic(ctx, s.svcCtx)
+	return l.ReleaseQuote(in)
+}
+
+// Snapshot a cart into a share token that expires, later changes to the cart are not shared
+func (s *CartServer) ShareCart(ctx context.Context, in *cart.ShareCartRequest) (*cart.ShareCartResponse, error) {
+	l := logic.NewShareCartLogic(ctx, s.svcCtx)
+	return l.ShareCart(in)
+}
+
+// Get a shared cart by token with current product data (public)
+func (s *CartServer) GetSharedCart(ctx context.Context, in *cart.GetSharedCartRequest) (*cart.GetSharedCartResponse, error) {
+	l := logic.NewGetSharedCartLogic(ctx, s.svcCtx)
+	return l.GetSharedCart(in)
+}
+
+// Merge the lines of a shared cart into the caller's cart with the limits of AddToCart
+func (s *CartServer) ImportSharedCart(ctx context.Context, in *cart.ImportSharedCartRequest) (*cart.ImportSharedCartResponse, error) {
+	l := logic.NewImportSharedCartLogic(ctx, s.svcCtx)
+	return l.ImportSharedCart(in)
+}
+
+// Create a named wishlist
+func (s *CartServer) CreateWishlist(ctx context.Context, in *cart.CreateWishlistRequest) (*cart.CreateWishlistResponse, error) {
+	l := logic.NewCreateWishlistLogic(ctx, s.svcCtx)
+	return l.CreateWishlist(in)
+}
+
+// List the wishlists of a user, the "Saved for later" list first
+func (s *CartServer) ListWishlists(ctx context.Context, in *cart.ListWishlistsRequest) (*cart.ListWishlistsResponse, error) {
+	l := logic.NewListWishlistsLogic(ctx, s.svcCtx)
+	return l.ListWishlists(in)
+}
+
+// Rename a named wishlist
+func (s *CartServer) RenameWishlist(ctx context.Context, in *cart.RenameWishlistRequest) (*cart.RenameWishlistResponse, error) {
+	l := logic.NewRenameWishlistLogic(ctx, s.svcCtx)
+	return l.RenameWishlist(in)
+}
+
+// Delete a wishlist and its items
+func (s *CartServer) DeleteWishlist(ctx context.Context, in *cart.DeleteWishlistRequest) (*cart.DeleteWishlistResponse, error) {
+	l := logic.NewDeleteWishlistLogic(ctx, s.svcCtx)
+	return l.DeleteWishlist(in)
+}
+
+// Get a wishlist with current product data
+func (s *CartServer) GetWishlist(ctx context.Context, in *cart.GetWishlistRequest) (*cart.GetWishlistResponse, error) {
+	l := logic.NewGetWishlistLogic(ctx, s.svcCtx)
+	return l.GetWishlist(in)
+}
+
+// Save a product to a wishlist
+func (s *CartServer) AddToWishlist(ctx context.Context, in *cart.AddToWishlistRequest) (*cart.AddToWishlistResponse, error) {
+	l := logic.NewAddToWishlistLogic(ctx, s.svcCtx)
+	return l.AddToWishlist(in)
+}
+
+// Remove a product from a wishlist
+func (s *CartServer) RemoveFromWishlist(ctx context.Context, in *cart.RemoveFromWishlistRequest) (*cart.RemoveFromWishlistResponse, error) {
+	l := logic.NewRemoveFromWishlistLogic(ctx, s.svcCtx)
+	return l.RemoveFromWishlist(in)
+}
+
+// Move a cart line to a wishlist, the line is removed from the cart
+func (s *CartServer) MoveToWishlist(ctx context.Context, in *cart.MoveToWishlistRequest) (*cart.MoveToWishlistResponse, error) {
+	l := logic.NewMoveToWishlistLogic(ctx, s.svcCtx)
+	return l.MoveToWishlist(in)
+}
+
+// Move a wishlist item to the cart, the item is removed from the wishlist
+func (s *CartServer) MoveToCart(ctx context.Context, in *cart.MoveToCartRequest) (*cart.MoveToCartResponse, error) {
+	l := logic.NewMoveToCartLogic(ctx, s.svcCtx)
+	return l.MoveToCart(in)
+}
@@ -1,29 +1,58 @@
 package svc
 
 import (
+	"time"
+
+	"letsgo/services/cart/model"
 	"letsgo/services/cart/rpc/internal/config"
+	"letsgo/services/cart/rpc/internal/utils"
 	"letsgo/services/product/rpc/product"
 
+	_ "github.com/lib/pq"
 	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
 	"github.com/zeromicro/go-zero/zrpc"
 )
 
 type ServiceContext struct {
-	Config     config.Config
-	Redis      *redis.Redis
-	ProductRpc product.ProductClient
+	Config        config.Config
+	Redis         *redis.Redis
+	CartModel     model.CartModel
+	WishlistModel model.WishlistModel
+	KafkaProducer *utils.KafkaProducer
+	CartEvents    *utils.EventBatcher
+	ProductRpc    product.ProductClient
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
 	// Initialize Redis connection
 	rds := redis.MustNewRedis(c.RedisConf)
 
+	// Initialize PostgreSQL connection for wishlists and saved carts
+	conn := sqlx.NewSqlConn("postgres", c.DB.DataSource)
+
+	// Set connection pool parameters
+	sqlDB, err := conn.RawDB()
+	if err == nil && sqlDB != nil {
+		sqlDB.SetMaxOpenConns(c.DBPool.MaxOpenConns)
+		sqlDB.SetMaxIdleConns(c.DBPool.MaxIdleConns)
+		sqlDB.SetConnMaxLifetime(time.Duration(c.DBPool.ConnMaxLifetime) * time.Second)
+	}
+
+	// Cart change events are batched, so publishing does not slow down cart requests
+	kafkaProducer := utils.NewKafkaProducer(c.Kafka.Brokers)
+	cartEvents := utils.NewEventBatcher(kafkaProducer, c.Kafka.BatchSize, time.Duration(c.Kafka.BatchInterval)*time.Millisecond)
+
 	// Initialize Product RPC client
 	productRpc := product.NewProductClient(zrpc.MustNewClient(c.ProductRpc).Conn())
 
 	return &ServiceContext{
-		Config:     c,
-		Redis:      rds,
-		ProductRpc: productRpc,
+		Config:        c,
+		Redis:         rds,
+		CartModel:     model.NewCartModel(conn),
+		WishlistModel: model.NewWishlistModel(conn),
+		KafkaProducer: kafkaProducer,
+		CartEvents:    cartEvents,
+		ProductRpc:    productRpc,
 	}
 }
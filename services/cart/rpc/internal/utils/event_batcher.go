@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/zeromicro/go-zero/core/executors"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// EventBatcher collects events in memory and publishes them to Kafka in batches in background,
+// a batch is written when it is full or the interval elapsed. Events are best effort, a failed batch is logged and dropped
+type EventBatcher struct {
+	writer   messageWriter
+	executor *executors.BulkExecutor
+}
+
+// messageWriter is the part of kafka.Writer the batcher uses
+type messageWriter interface {
+	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
+}
+
+// NewEventBatcher creates an event batcher on top of a producer
+func NewEventBatcher(producer *KafkaProducer, batchSize int, interval time.Duration) *EventBatcher {
+	b := &EventBatcher{
+		writer: producer.writer,
+	}
+	b.executor = executors.NewBulkExecutor(b.publish,
+		executors.WithBulkTasks(batchSize),
+		executors.WithBulkInterval(interval),
+	)
+
+	return b
+}
+
+// Add queues an event, the caller does not wait for Kafka
+func (b *EventBatcher) Add(topic string, key string, value interface{}) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		logx.Errorf("Failed to marshal event: topic=%s, err=%v", topic, err)
+		return
+	}
+
+	b.executor.Add(kafka.Message{
+		Topic: topic,
+		Key:   []byte(key),
+		Value: data,
+		Time:  time.Now(),
+	})
+}
+
+// Close publishes the queued events and waits for them
+func (b *EventBatcher) Close() {
+	b.executor.Flush()
+	b.executor.Wait()
+}
+
+// publish writes a batch with a single call, messages of different topics can be mixed
+func (b *EventBatcher) publish(tasks []interface{}) {
+	messages := make([]kafka.Message, 0, len(tasks))
+	for _, task := range tasks {
+		messages = append(messages, task.(kafka.Message))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := b.writer.WriteMessages(ctx, messages...); err != nil {
+		logx.Errorf("Failed to publish %d events: %v", len(messages), err)
+	}
+}
@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const testTopic = "cart.test"
+
+// fakeWriter records the batches written to Kafka
+type fakeWriter struct {
+	mu      sync.Mutex
+	batches [][]kafka.Message
+}
+
+func (w *fakeWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.batches = append(w.batches, msgs)
+	return nil
+}
+
+func (w *fakeWriter) sizes() []int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	sizes := make([]int, 0, len(w.batches))
+	for _, batch := range w.batches {
+		sizes = append(sizes, len(batch))
+	}
+	return sizes
+}
+
+func newTestBatcher(batchSize int, interval time.Duration) (*EventBatcher, *fakeWriter) {
+	logx.Disable()
+	writer := &fakeWriter{}
+	b := NewEventBatcher(&KafkaProducer{}, batchSize, interval)
+	b.writer = writer
+	return b, writer
+}
+
+func TestEventBatcherFullBatch(t *testing.T) {
+	// The interval never elapses, only full batches and Close write
+	b, writer := newTestBatcher(2, time.Hour)
+
+	b.Add(testTopic, "42", CartEvent{EventType: "cart.item.added", EventID: "e1", Timestamp: 100,
+		Data: CartItemChangedData{UserID: 42, ProductID: 1, Quantity: 2, QuantityChange: 2, Price: 2.5}})
+	b.Add(testTopic, "guest", CartEvent{EventType: "cart.cleared", EventID: "e2", Timestamp: 100,
+		Data: CartClearedData{TempCartID: "guest", Items: []CartLine{}}})
+	b.Add(testTopic, "42", CartEvent{EventType: "cart.item.removed", EventID: "e3", Timestamp: 100,
+		Data: CartItemChangedData{UserID: 42, ProductID: 1, QuantityChange: -2, Price: 2.5}})
+	b.Close()
+
+	sizes := writer.sizes()
+	if len(sizes) != 2 || sizes[0] != 2 || sizes[1] != 1 {
+		t.Fatalf("batch sizes = %v, want [2 1]", sizes)
+	}
+
+	first := writer.batches[0]
+	if string(first[0].Key) != "42" || string(first[1].Key) != "guest" || first[0].Topic != testTopic {
+		t.Fatalf("messages = %+v", first)
+	}
+
+	want := `{"event_type":"cart.item.added","event_id":"e1","timestamp":100,"data":{"user_id":42,"product_id":1,"quantity":2,"quantity_change":2,"price":2.5}}`
+	if string(first[0].Value) != want {
+		t.Fatalf("value = %s\nwant    %s", first[0].Value, want)
+	}
+	want = `{"event_type":"cart.cleared","event_id":"e2","timestamp":100,"data":{"user_id":0,"temp_cart_id":"guest","items":[]}}`
+	if string(first[1].Value) != want {
+		t.Fatalf("value = %s\nwant    %s", first[1].Value, want)
+	}
+}
+
+func TestEventBatcherInterval(t *testing.T) {
+	b, writer := newTestBatcher(100, 50*time.Millisecond)
+	defer b.Close()
+
+	b.Add(testTopic, "42", CartEvent{EventType: "cart.item.updated"})
+
+	deadline := time.Now().Add(2 * time.Second)
+	for len(writer.sizes()) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("partial batch not written after the interval")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	var event CartEvent
+	if err := json.Unmarshal(writer.batches[0][0].Value, &event); err != nil || event.EventType != "cart.item.updated" {
+		t.Fatalf("event = %+v, err = %v", event, err)
+	}
+}
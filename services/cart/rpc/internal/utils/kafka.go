@@ -0,0 +1,126 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaProducer is a helper for publishing events to Kafka
+// Like the product service it keeps one writer for its lifetime
+type KafkaProducer struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaProducer creates a new Kafka producer
+func NewKafkaProducer(brokers []string) *KafkaProducer {
+	return &KafkaProducer{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Balancer:     &kafka.Hash{}, // Same key, same partition
+			RequiredAcks: kafka.RequireOne,
+			Async:        false, // Synchronous to ensure message is sent
+			MaxAttempts:  3,
+			WriteTimeout: 5 * time.Second,
+			BatchTimeout: 10 * time.Millisecond,
+		},
+	}
+}
+
+// PublishEvent publishes an event to Kafka topic
+func (p *KafkaProducer) PublishEvent(ctx context.Context, topic string, key string, value interface{}) error {
+	// Marshal value to JSON
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	// Write message
+	err = p.writer.WriteMessages(ctx, kafka.Message{
+		Topic: topic,
+		Key:   []byte(key),
+		Value: data,
+		Time:  time.Now(),
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to publish event to topic %s: %w", topic, err)
+	}
+
+	return nil
+}
+
+// Close flushes pending messages and closes the writer
+func (p *KafkaProducer) Close() error {
+	return p.writer.Close()
+}
+
+// CartAbandonedEvent is published once per cart state when a user cart stays idle
+// longer than the abandonment threshold without an order, e.g. to send a reminder
+type CartAbandonedEvent struct {
+	EventType string            `json:"event_type"`
+	EventID   string            `json:"event_id"`
+	Timestamp int64             `json:"timestamp"`
+	Data      CartAbandonedData `json:"data"`
+}
+
+// CartAbandonedData contains the abandoned cart
+type CartAbandonedData struct {
+	UserID     int64      `json:"user_id"`
+	Items      []CartLine `json:"items"`
+	TotalPrice float64    `json:"total_price"` // Saved prices of all lines
+	UpdatedAt  int64      `json:"updated_at"`  // Last change of the cart
+}
+
+// CartLine is a cart line as stored, with the price saved when it was added or last accepted
+type CartLine struct {
+	ProductID int64   `json:"product_id"`
+	Name      string  `json:"name"`
+	Price     float64 `json:"price"`
+	Quantity  int64   `json:"quantity"`
+	Image     string  `json:"image"`
+	AddedAt   int64   `json:"added_at"`
+}
+
+// OrderCreatedEvent is published by the order service when an order is created
+// Only the fields used for abandoned cart detection are decoded
+type OrderCreatedEvent struct {
+	EventType string           `json:"event_type"`
+	EventID   string           `json:"event_id"`
+	Timestamp int64            `json:"timestamp"`
+	Data      OrderCreatedData `json:"data"`
+}
+
+// OrderCreatedData contains the created order
+type OrderCreatedData struct {
+	OrderNo string `json:"order_no"`
+	UserID  int64  `json:"user_id"`
+}
+
+// CartEvent is published for every cart change, for analytics
+type CartEvent struct {
+	EventType string      `json:"event_type"`
+	EventID   string      `json:"event_id"`
+	Timestamp int64       `json:"timestamp"`
+	Data      interface{} `json:"data"` // CartItemChangedData or CartClearedData
+}
+
+// CartItemChangedData is the data of cart.item.added, cart.item.updated and cart.item.removed
+type CartItemChangedData struct {
+	UserID         int64   `json:"user_id"`                // 0 = guest cart
+	TempCartID     string  `json:"temp_cart_id,omitempty"` // Guest cart identifier
+	ProductID      int64   `json:"product_id"`
+	Quantity       int64   `json:"quantity"`        // Quantity of the line after the change, 0 when removed
+	QuantityChange int64   `json:"quantity_change"` // Difference to the quantity before, negative when lowered or removed
+	Price          float64 `json:"price"`           // Unit price of the line
+}
+
+// CartClearedData is the data of cart.cleared
+type CartClearedData struct {
+	UserID     int64      `json:"user_id"`                // 0 = guest cart
+	TempCartID string     `json:"temp_cart_id,omitempty"` // Guest cart identifier
+	Items      []CartLine `json:"items"`                  // Lines that were in the cart
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"time"
 
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
 )
@@ -21,6 +22,9 @@ type (
 
 		// DeleteByOrderId deletes all items for an order
 		DeleteByOrderId(ctx context.Context, orderId int64) error
+
+		// SumOrderedByUser returns units of a product a user ordered since a time (with transaction)
+		SumOrderedByUser(ctx context.Context, tx *sql.Tx, userId, productId int64, since time.Time) (int64, error)
 	}
 
 	customOrderItemModel struct {
@@ -94,3 +98,20 @@ func (m *customOrderItemModel) DeleteByOrderId(ctx context.Context, orderId int6
 
 	return nil
 }
+
+// SumOrderedByUser returns units of a product a user ordered since a time, cancelled orders are left out
+// A zero since counts all orders
+func (m *customOrderItemModel) SumOrderedByUser(ctx context.Context, tx *sql.Tx, userId, productId int64, since time.Time) (int64, error) {
+	query := `SELECT COALESCE(SUM(oi.quantity), 0)
+		FROM order_items oi
+		JOIN orders o ON o.id = oi.order_id
+		WHERE o.user_id = $1 AND oi.product_id = $2 AND o.status <> $3 AND o.created_at >= $4`
+
+	var quantity int64
+	err := tx.QueryRowContext(ctx, query, userId, productId, OrderStatusCancelled, since).Scan(&quantity)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sum ordered quantity: %w", err)
+	}
+
+	return quantity, nil
+}
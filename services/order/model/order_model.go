@@ -37,6 +37,9 @@ type (
 
 		// BeginTrans starts a transaction
 		BeginTrans(ctx context.Context) (*sql.Tx, error)
+
+		// LockUser serializes the transactions of a user until the transaction ends
+		LockUser(ctx context.Context, tx *sql.Tx, userId int64) error
 	}
 
 	customOrderModel struct {
@@ -53,8 +56,8 @@ func NewOrderModel(conn sqlx.SqlConn) OrderModel {
 
 // Insert inserts a new order into database (with transaction)
 func (m *customOrderModel) Insert(ctx context.Context, tx *sql.Tx, data *Order) (int64, error) {
-	query := `INSERT INTO orders (user_id, order_no, total_amount, status, address, phone, remark, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	query := `INSERT INTO orders (user_id, order_no, total_amount, status, address, phone, remark, flash_sale_id, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 		RETURNING id`
 
 	var id int64
@@ -66,6 +69,7 @@ func (m *customOrderModel) Insert(ctx context.Context, tx *sql.Tx, data *Order)
 		data.Address,
 		data.Phone,
 		data.Remark,
+		data.FlashSaleId,
 		data.CreatedAt,
 		data.UpdatedAt,
 	).Scan(&id)
@@ -79,7 +83,7 @@ func (m *customOrderModel) Insert(ctx context.Context, tx *sql.Tx, data *Order)
 
 // FindOne finds an order by ID
 func (m *customOrderModel) FindOne(ctx context.Context, id int64) (*Order, error) {
-	query := `SELECT id, user_id, order_no, total_amount, status, address, phone, remark,
+	query := `SELECT id, user_id, order_no, total_amount, status, address, phone, remark, flash_sale_id,
 		created_at, updated_at, paid_at, shipped_at, completed_at
 		FROM orders WHERE id = $1`
 
@@ -97,7 +101,7 @@ func (m *customOrderModel) FindOne(ctx context.Context, id int64) (*Order, error
 
 // FindOneByOrderNo finds an order by order number
 func (m *customOrderModel) FindOneByOrderNo(ctx context.Context, orderNo string) (*Order, error) {
-	query := `SELECT id, user_id, order_no, total_amount, status, address, phone, remark,
+	query := `SELECT id, user_id, order_no, total_amount, status, address, phone, remark, flash_sale_id,
 		created_at, updated_at, paid_at, shipped_at, completed_at
 		FROM orders WHERE order_no = $1`
 
@@ -122,7 +126,7 @@ func (m *customOrderModel) FindByUserId(ctx context.Context, userId int64, page,
 
 	if status == 0 {
 		// Get all orders
-		query = `SELECT id, user_id, order_no, total_amount, status, address, phone, remark,
+		query = `SELECT id, user_id, order_no, total_amount, status, address, phone, remark, flash_sale_id,
 			created_at, updated_at, paid_at, shipped_at, completed_at
 			FROM orders WHERE user_id = $1
 			ORDER BY created_at DESC
@@ -130,7 +134,7 @@ func (m *customOrderModel) FindByUserId(ctx context.Context, userId int64, page,
 		args = []interface{}{userId, pageSize, offset}
 	} else {
 		// Filter by status
-		query = `SELECT id, user_id, order_no, total_amount, status, address, phone, remark,
+		query = `SELECT id, user_id, order_no, total_amount, status, address, phone, remark, flash_sale_id,
 			created_at, updated_at, paid_at, shipped_at, completed_at
 			FROM orders WHERE user_id = $1 AND status = $2
 			ORDER BY created_at DESC
@@ -235,3 +239,10 @@ func (m *customOrderModel) BeginTrans(ctx context.Context) (*sql.Tx, error) {
 	}
 	return rawdb.BeginTx(ctx, nil)
 }
+
+// LockUser takes a transaction-level advisory lock on the user,
+// so concurrent orders of the same user cannot both pass a per-user purchase limit
+func (m *customOrderModel) LockUser(ctx context.Context, tx *sql.Tx, userId int64) error {
+	_, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, userId)
+	return err
+}
@@ -15,6 +15,7 @@ type Order struct {
 	Address     string       `db:"address"`
 	Phone       string       `db:"phone"`
 	Remark      string       `db:"remark"`
+	FlashSaleId int64        `db:"flash_sale_id"` // Sale of a flash sale order, 0 = regular order
 	CreatedAt   time.Time    `db:"created_at"`
 	UpdatedAt   time.Time    `db:"updated_at"`
 	PaidAt      sql.NullTime `db:"paid_at"`
@@ -25,6 +25,7 @@ type Config struct {
 	// Kafka configuration
 	Kafka struct {
 		Brokers []string
+		GroupId string `json:",default=order.rpc"` // Consumer group for topics consumed by the order service
 		Topics  struct {
 			OrderCreated       string
 			OrderPaid          string
@@ -32,6 +33,7 @@ type Config struct {
 			OrderCompleted     string
 			OrderCancelled     string
 			OrderStatusChanged string
+			FlashSaleOrder     string `json:",default=flashsale.order"` // Consumed: flash sale orders queued by the product service
 		}
 	}
 
@@ -0,0 +1,214 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"letsgo/services/order/model"
+	"letsgo/services/order/rpc/internal/svc"
+	"letsgo/services/order/rpc/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/segmentio/kafka-go"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// maxRetryBackoff caps the wait between attempts to create the same order
+const maxRetryBackoff = 30 * time.Second
+
+// FlashSaleOrderConsumer creates the orders of flash sale buys queued by the product service.
+// Offsets are committed only after the order is stored, so a crash redelivers the event
+// and the unique order number turns the redelivery into a no-op
+type FlashSaleOrderConsumer struct {
+	svcCtx *svc.ServiceContext
+	reader *kafka.Reader
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewFlashSaleOrderConsumer creates a flash sale order consumer
+func NewFlashSaleOrderConsumer(svcCtx *svc.ServiceContext) *FlashSaleOrderConsumer {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &FlashSaleOrderConsumer{
+		svcCtx: svcCtx,
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers:  svcCtx.KafkaBrokers,
+			GroupID:  svcCtx.Config.Kafka.GroupId,
+			Topic:    svcCtx.KafkaTopics.FlashSaleOrder,
+			MinBytes: 1,
+			MaxBytes: 10e6,
+		}),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Start consumes in background until Stop is called
+func (c *FlashSaleOrderConsumer) Start() {
+	threading.GoSafe(func() {
+		for {
+			msg, err := c.reader.FetchMessage(c.ctx)
+			if err != nil {
+				if c.ctx.Err() != nil {
+					return
+				}
+				logx.Errorf("Failed to fetch flash sale order event: %v", err)
+				time.Sleep(time.Second)
+				continue
+			}
+
+			if !c.handle(msg) {
+				// Stopped before the order was stored, leave the offset for the next start
+				return
+			}
+
+			if err := c.reader.CommitMessages(c.ctx, msg); err != nil {
+				logx.Errorf("Failed to commit flash sale order event: offset=%d, err=%v", msg.Offset, err)
+			}
+		}
+	})
+}
+
+// Stop stops consuming and closes the reader
+func (c *FlashSaleOrderConsumer) Stop() {
+	c.cancel()
+	c.reader.Close()
+}
+
+// handle creates the order of one event, retrying until it is stored.
+// Returns false if the consumer was stopped first
+func (c *FlashSaleOrderConsumer) handle(msg kafka.Message) bool {
+	var event utils.FlashSaleOrderEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		// Retrying cannot fix a malformed event, skip it
+		logx.Errorf("CRITICAL_FLASH_SALE_ORDER_INVALID offset=%d value=%s err=%v", msg.Offset, string(msg.Value), err)
+		return true
+	}
+
+	backoff := time.Second
+	for {
+		err := c.createOrder(event.Data)
+		if err == nil {
+			return true
+		}
+
+		logx.Errorf("Failed to create flash sale order, retrying in %s: order_no=%s, err=%v", backoff, event.Data.OrderNo, err)
+
+		select {
+		case <-time.After(backoff):
+		case <-c.ctx.Done():
+			return false
+		}
+
+		backoff *= 2
+		if backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+	}
+}
+
+// createOrder stores a pending order at the flash sale price
+// Stock was deducted by the product service, so unlike CreateOrder it is not touched here
+func (c *FlashSaleOrderConsumer) createOrder(data utils.FlashSaleOrderData) (err error) {
+	ctx, cancel := context.WithTimeout(c.ctx, 10*time.Second)
+	defer cancel()
+
+	now := time.Now()
+
+	// 1. Start database transaction
+	tx, err := c.svcCtx.OrderModel.BeginTrans(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// 2. Insert order record
+	orderId, err := c.svcCtx.OrderModel.Insert(ctx, tx, &model.Order{
+		UserId:      data.UserID,
+		OrderNo:     data.OrderNo,
+		TotalAmount: data.TotalAmount,
+		Status:      model.OrderStatusPending,
+		Address:     data.Address,
+		Phone:       data.Phone,
+		Remark:      data.Remark,
+		FlashSaleId: data.SaleID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	})
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			// Redelivered event, the order already exists
+			logx.Infof("Flash sale order already created: order_no=%s", data.OrderNo)
+			tx.Rollback()
+			return nil
+		}
+		return err
+	}
+
+	// 3. Insert order item
+	err = c.svcCtx.OrderItemModel.BatchInsert(ctx, tx, []*model.OrderItem{{
+		OrderId:   orderId,
+		ProductId: data.ProductID,
+		Name:      data.Name,
+		Price:     data.Price,
+		Quantity:  int(data.Quantity),
+		Image:     data.Image,
+		CreatedAt: now,
+	}})
+	if err != nil {
+		return err
+	}
+
+	// 4. Commit transaction
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	logx.Infof("Flash sale order created: order_no=%s (id: %d), sale_id=%d", data.OrderNo, orderId, data.SaleID)
+
+	// 5. Publish order created event to Kafka (异步，不影响订单创建)
+	go c.publishOrderCreatedEvent(orderId, data)
+
+	return nil
+}
+
+// publishOrderCreatedEvent publishes order created event to Kafka, same as regular orders
+func (c *FlashSaleOrderConsumer) publishOrderCreatedEvent(orderId int64, data utils.FlashSaleOrderData) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	event := utils.OrderCreatedEvent{
+		EventType: "order.created",
+		EventID:   uuid.New().String(),
+		Timestamp: time.Now().Unix(),
+		Data: utils.OrderData{
+			OrderID:     orderId,
+			OrderNo:     data.OrderNo,
+			UserID:      data.UserID,
+			TotalAmount: data.TotalAmount,
+			Items: []utils.OrderItem{{
+				ProductID: data.ProductID,
+				Quantity:  data.Quantity,
+				Price:     data.Price,
+			}},
+		},
+	}
+
+	producer := utils.NewKafkaProducer(c.svcCtx.KafkaBrokers)
+	err := producer.PublishEvent(ctx, c.svcCtx.KafkaTopics.OrderCreated, data.OrderNo, event)
+	if err != nil {
+		logx.Errorf("failed to publish order created event: %v", err)
+	}
+}
@@ -98,7 +98,9 @@ func (l *CancelOrderLogic) CancelOrder(in *order.CancelOrderRequest) (*order.Can
 	}
 
 	_, err = l.svcCtx.ProductRpc.BatchUpdateStock(l.ctx, &product.BatchUpdateStockRequest{
-		Items: stockItems,
+		Items:       stockItems,
+		Reason:      "cancel",
+		ReferenceId: orderData.OrderNo,
 	})
 	if err != nil {
 		l.Logger.Errorf("failed to restore stock for cancelled order %d: %v", in.OrderId, err)
@@ -108,9 +110,14 @@ func (l *CancelOrderLogic) CancelOrder(in *order.CancelOrderRequest) (*order.Can
 		l.Logger.Infof("restored stock for cancelled order %d", in.OrderId)
 	}
 
+	// 7. Give the units back to the user's flash sale limit
+	if orderData.FlashSaleId > 0 {
+		l.releaseFlashSaleLimit(orderData, items)
+	}
+
 	l.Logger.Infof("order %d cancelled successfully by user %d", in.OrderId, in.UserId)
 
-	// 7. Publish order cancelled event to Kafka (异步，不影响取消操作)
+	// 8. Publish order cancelled event to Kafka (异步，不影响取消操作)
 	go l.publishOrderCancelledEvent(in.OrderId, orderData.OrderNo, in.UserId, orderData.TotalAmount, items)
 
 	return &order.CancelOrderResponse{
@@ -119,6 +126,26 @@ func (l *CancelOrderLogic) CancelOrder(in *order.CancelOrderRequest) (*order.Can
 	}, nil
 }
 
+// releaseFlashSaleLimit lowers the user's purchase count of the flash sale the order was bought from
+// Failures are only logged like the stock restore, the order is already cancelled
+func (l *CancelOrderLogic) releaseFlashSaleLimit(orderData *model.Order, items []*model.OrderItem) {
+	var quantity int64
+	for _, item := range items {
+		quantity += int64(item.Quantity)
+	}
+
+	_, err := l.svcCtx.ProductRpc.ReleaseFlashSaleLimit(l.ctx, &product.ReleaseFlashSaleLimitRequest{
+		SaleId:   orderData.FlashSaleId,
+		UserId:   orderData.UserId,
+		Quantity: quantity,
+	})
+	if err != nil {
+		l.Logger.Errorf("failed to release flash sale limit for cancelled order %d (sale %d): %v", orderData.Id, orderData.FlashSaleId, err)
+	} else {
+		l.Logger.Infof("released flash sale limit for cancelled order %d (sale %d)", orderData.Id, orderData.FlashSaleId)
+	}
+}
+
 // publishOrderCancelledEvent publishes order cancelled event to Kafka
 func (l *CancelOrderLogic) publishOrderCancelledEvent(orderId int64, orderNo string, userId int64, totalAmount float64, items []*model.OrderItem) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -2,12 +2,14 @@ package logic
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/zeromicro/go-zero/core/logx"
 
+	"letsgo/common/errorx"
 	"letsgo/services/cart/rpc/cart"
 	"letsgo/services/order/model"
 	"letsgo/services/order/rpc/internal/svc"
@@ -32,8 +34,70 @@ func NewCreateOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Creat
 
 // Create new order from cart
 func (l *CreateOrderLogic) CreateOrder(in *order.CreateOrderRequest) (*order.CreateOrderResponse, error) {
-	// 1. Validate input
-	if len(in.Items) == 0 {
+	// 1. Validate input, items come from the request, the selected cart lines or a cart price quote
+	sources := 0
+	for _, used := range []bool{len(in.Items) > 0, in.FromCart, in.QuoteId != ""} {
+		if used {
+			sources++
+		}
+	}
+	if sources > 1 {
+		return nil, fmt.Errorf("use only one of items, from_cart or quote_id")
+	}
+
+	items := in.Items
+	if in.FromCart {
+		checkoutResp, err := l.svcCtx.CartRpc.GetCheckoutItems(l.ctx, &cart.GetCheckoutItemsRequest{
+			UserId: in.UserId,
+		})
+		if err != nil {
+			l.Logger.Errorf("failed to get cart items: %v", err)
+			return nil, fmt.Errorf("failed to get cart items: %w", err)
+		}
+
+		items = make([]*order.OrderItem, 0, len(checkoutResp.Items))
+		for _, item := range checkoutResp.Items {
+			items = append(items, &order.OrderItem{
+				ProductId: item.ProductId,
+				Quantity:  item.Quantity,
+			})
+		}
+	}
+
+	// A quote guarantees its prices and total, it can only be used once
+	committed := false
+	var quotedPrices map[int64]float64
+	var quotedTotal float64
+	if in.QuoteId != "" {
+		quoteResp, err := l.svcCtx.CartRpc.TakeQuote(l.ctx, &cart.TakeQuoteRequest{
+			UserId:  in.UserId,
+			QuoteId: in.QuoteId,
+		})
+		if err != nil {
+			l.Logger.Errorf("failed to take quote: %v", err)
+			return nil, fmt.Errorf("failed to take quote: %w", err)
+		}
+
+		items = make([]*order.OrderItem, 0, len(quoteResp.Lines))
+		quotedPrices = make(map[int64]float64, len(quoteResp.Lines))
+		for _, line := range quoteResp.Lines {
+			items = append(items, &order.OrderItem{
+				ProductId: line.ProductId,
+				Quantity:  line.Quantity,
+			})
+			quotedPrices[line.ProductId] = line.UnitPrice
+		}
+		quotedTotal = quoteResp.Total
+
+		// The quote is given back unless the order is committed, so a failed order keeps the quoted prices
+		defer func() {
+			if !committed {
+				l.releaseQuote(in.UserId, in.QuoteId)
+			}
+		}()
+	}
+
+	if len(items) == 0 {
 		return nil, fmt.Errorf("order must have at least one item")
 	}
 
@@ -42,18 +106,51 @@ func (l *CreateOrderLogic) CreateOrder(in *order.CreateOrderRequest) (*order.Cre
 
 	// 3. Verify products and calculate total amount
 	var totalAmount float64
-	orderItems := make([]*model.OrderItem, 0, len(in.Items))
+	orderItems := make([]*model.OrderItem, 0, len(items))
 
-	for _, item := range in.Items {
-		// Get product info from Product Service
-		productResp, err := l.svcCtx.ProductRpc.GetProduct(l.ctx, &product.GetProductRequest{
-			Id: item.ProductId,
-		})
-		if err != nil {
-			l.Logger.Errorf("failed to get product %d: %v", item.ProductId, err)
+	// Get product info of all items from Product Service in one call
+	productIds := make([]int64, 0, len(items))
+	for _, item := range items {
+		productIds = append(productIds, item.ProductId)
+	}
+
+	productsResp, err := l.svcCtx.ProductRpc.GetProducts(l.ctx, &product.GetProductsRequest{
+		Ids: productIds,
+	})
+	if err != nil {
+		l.Logger.Errorf("failed to get products: %v", err)
+		return nil, fmt.Errorf("failed to get products: %w", err)
+	}
+
+	// Units per product for the purchase limits, a product can be listed more than once
+	quantities := make(map[int64]int64, len(items))
+	for _, item := range items {
+		quantities[item.ProductId] += item.Quantity
+	}
+	userLimited := make([]*product.ProductInfo, 0)
+	limitChecked := make(map[int64]bool)
+
+	for i, item := range items {
+		// Results are returned in request order
+		productResp := productsResp.Results[i]
+		if !productResp.Found {
 			return nil, fmt.Errorf("product %d not found", item.ProductId)
 		}
 
+		// Enforce purchase limits here too, items can be ordered without going through the cart
+		if info := productResp.Product; !limitChecked[info.Id] {
+			limitChecked[info.Id] = true
+			if info.MaxPerOrder > 0 && quantities[info.Id] > info.MaxPerOrder {
+				return nil, errorx.ErrOrderLimitExceeded.WithMsg(fmt.Sprintf("At most %d of %s per order", info.MaxPerOrder, info.Name))
+			}
+			if info.MaxPerUser > 0 {
+				if quantities[info.Id] > info.MaxPerUser {
+					return nil, errorx.ErrUserLimitExceeded.WithMsg(fmt.Sprintf("At most %d of %s per customer", info.MaxPerUser, info.Name))
+				}
+				userLimited = append(userLimited, info)
+			}
+		}
+
 		// Check stock availability
 		// if productResp.Product.Stock < item.Quantity {
 		// 	return nil, fmt.Errorf("product %s is out of stock (available: %d, requested: %d)",
@@ -62,6 +159,9 @@ func (l *CreateOrderLogic) CreateOrder(in *order.CreateOrderRequest) (*order.Cre
 
 		// Use real-time price from product service (防止前端篡改价格)
 		itemPrice := productResp.Product.Price
+		if quotedPrices != nil {
+			itemPrice = quotedPrices[item.ProductId]
+		}
 		itemTotal := itemPrice * float64(item.Quantity)
 		totalAmount += itemTotal
 
@@ -82,6 +182,10 @@ func (l *CreateOrderLogic) CreateOrder(in *order.CreateOrderRequest) (*order.Cre
 		})
 	}
 
+	if quotedPrices != nil {
+		totalAmount = quotedTotal
+	}
+
 	// 4. Start database transaction
 	tx, err := l.svcCtx.OrderModel.BeginTrans(l.ctx)
 	if err != nil {
@@ -96,6 +200,13 @@ func (l *CreateOrderLogic) CreateOrder(in *order.CreateOrderRequest) (*order.Cre
 		}
 	}()
 
+	// Per-user limits count the user's earlier orders, checked in the transaction so concurrent orders cannot both pass
+	if len(userLimited) > 0 {
+		if err = l.checkUserLimits(tx, in.UserId, quantities, userLimited); err != nil {
+			return nil, err
+		}
+	}
+
 	// 5. Insert order record
 	orderData := &model.Order{
 		UserId:      in.UserId,
@@ -127,8 +238,8 @@ func (l *CreateOrderLogic) CreateOrder(in *order.CreateOrderRequest) (*order.Cre
 	}
 
 	// 7. Deduct stock using batch update (transactional)
-	stockItems := make([]*product.StockUpdateItem, 0, len(in.Items))
-	for _, item := range in.Items {
+	stockItems := make([]*product.StockUpdateItem, 0, len(items))
+	for _, item := range items {
 		stockItems = append(stockItems, &product.StockUpdateItem{
 			ProductId: item.ProductId,
 			Quantity:  -item.Quantity, // Negative to deduct
@@ -136,7 +247,10 @@ func (l *CreateOrderLogic) CreateOrder(in *order.CreateOrderRequest) (*order.Cre
 	}
 
 	_, err = l.svcCtx.ProductRpc.BatchUpdateStock(l.ctx, &product.BatchUpdateStockRequest{
-		Items: stockItems,
+		Items:       stockItems,
+		Reason:      "order",
+		ReferenceId: orderNo,
+		Region:      in.Region,
 	})
 	if err != nil {
 		l.Logger.Errorf("failed to batch deduct stock: %v", err)
@@ -152,7 +266,9 @@ func (l *CreateOrderLogic) CreateOrder(in *order.CreateOrderRequest) (*order.Cre
 			stockItems[i].Quantity = -stockItems[i].Quantity // positive to add back
 		}
 		_, compensateErr := l.svcCtx.ProductRpc.BatchUpdateStock(l.ctx, &product.BatchUpdateStockRequest{
-			Items: stockItems,
+			Items:       stockItems,
+			Reason:      "compensation",
+			ReferenceId: orderNo,
 		})
 		if compensateErr != nil {
 			// Critical: Stock compensation failed, need manual intervention
@@ -167,14 +283,15 @@ func (l *CreateOrderLogic) CreateOrder(in *order.CreateOrderRequest) (*order.Cre
 
 		return nil, fmt.Errorf("failed to create order")
 	}
+	committed = true
 
 	l.Logger.Infof("order created successfully: %s (id: %d)", orderNo, orderId)
 
 	// 9. Publish order created event to Kafka (异步，不影响订单创建)
-	go l.publishOrderCreatedEvent(orderId, orderNo, in.UserId, totalAmount, in.Items)
+	go l.publishOrderCreatedEvent(orderId, orderNo, in.UserId, totalAmount, items)
 
-	// 10. Clear user's cart (异步，不影响订单创建)
-	go l.clearUserCart(in.UserId)
+	// 10. Remove ordered products from user's cart (异步，不影响订单创建)
+	go l.removeOrderedCartItems(in.UserId, productIds)
 
 	return &order.CreateOrderResponse{
 		OrderId:     orderId,
@@ -183,6 +300,48 @@ func (l *CreateOrderLogic) CreateOrder(in *order.CreateOrderRequest) (*order.Cre
 	}, nil
 }
 
+// checkUserLimits checks the per-user purchase limits against the units the user ordered within each product's window
+// The user is locked until the transaction ends, so a concurrent order waits for this one
+func (l *CreateOrderLogic) checkUserLimits(tx *sql.Tx, userId int64, quantities map[int64]int64, products []*product.ProductInfo) error {
+	if err := l.svcCtx.OrderModel.LockUser(l.ctx, tx, userId); err != nil {
+		l.Logger.Errorf("failed to lock user orders: %v", err)
+		return fmt.Errorf("failed to create order")
+	}
+
+	for _, info := range products {
+		var since time.Time
+		if info.LimitWindow > 0 {
+			since = time.Now().Add(-time.Duration(info.LimitWindow) * time.Second)
+		}
+
+		ordered, err := l.svcCtx.OrderItemModel.SumOrderedByUser(l.ctx, tx, userId, info.Id, since)
+		if err != nil {
+			l.Logger.Errorf("failed to get ordered quantity: %v", err)
+			return fmt.Errorf("failed to create order")
+		}
+		if ordered+quantities[info.Id] > info.MaxPerUser {
+			return errorx.ErrUserLimitExceeded.WithMsg(fmt.Sprintf("At most %d of %s per customer, %d already ordered",
+				info.MaxPerUser, info.Name, ordered))
+		}
+	}
+
+	return nil
+}
+
+// releaseQuote gives a taken quote back after the order failed, a failure is logged only and the quote stays used
+func (l *CreateOrderLogic) releaseQuote(userId int64, quoteId string) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(l.ctx), 5*time.Second)
+	defer cancel()
+
+	_, err := l.svcCtx.CartRpc.ReleaseQuote(ctx, &cart.ReleaseQuoteRequest{
+		UserId:  userId,
+		QuoteId: quoteId,
+	})
+	if err != nil {
+		l.Logger.Errorf("failed to release quote %s of user %d: %v", quoteId, userId, err)
+	}
+}
+
 // publishOrderCreatedEvent publishes order created event to Kafka
 func (l *CreateOrderLogic) publishOrderCreatedEvent(orderId int64, orderNo string, userId int64, totalAmount float64, items []*order.OrderItem) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -221,19 +380,20 @@ func (l *CreateOrderLogic) publishOrderCreatedEvent(orderId int64, orderNo strin
 	}
 }
 
-// clearUserCart clears user's shopping cart
-func (l *CreateOrderLogic) clearUserCart(userId int64) {
+// removeOrderedCartItems removes ordered products from user's shopping cart, other lines stay for later
+func (l *CreateOrderLogic) removeOrderedCartItems(userId int64, productIds []int64) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	_, err := l.svcCtx.CartRpc.ClearCart(ctx, &cart.ClearCartRequest{
-		UserId: userId,
+	_, err := l.svcCtx.CartRpc.RemoveCartItems(ctx, &cart.RemoveCartItemsRequest{
+		UserId:     userId,
+		ProductIds: productIds,
 	})
 	if err != nil {
-		l.Logger.Errorf("failed to clear cart for user %d: %v", userId, err)
-		// Don't return error, user can manually clear cart
+		l.Logger.Errorf("failed to remove ordered items from cart for user %d: %v", userId, err)
+		// Don't return error, user can manually remove them
 	} else {
-		l.Logger.Infof("cleared cart for user %d", userId)
+		l.Logger.Infof("removed ordered items from cart for user %d", userId)
 	}
 }
 
@@ -33,6 +33,7 @@ type ServiceContext struct {
 		OrderCompleted     string
 		OrderCancelled     string
 		OrderStatusChanged string
+		FlashSaleOrder     string
 	}
 
 	// RPC clients
@@ -80,6 +81,7 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	ctx.KafkaTopics.OrderCompleted = c.Kafka.Topics.OrderCompleted
 	ctx.KafkaTopics.OrderCancelled = c.Kafka.Topics.OrderCancelled
 	ctx.KafkaTopics.OrderStatusChanged = c.Kafka.Topics.OrderStatusChanged
+	ctx.KafkaTopics.FlashSaleOrder = c.Kafka.Topics.FlashSaleOrder
 
 	return ctx
 }
@@ -103,3 +103,28 @@ type OrderStatusChangedEvent struct {
 		NewStatus int   `json:"new_status"`
 	} `json:"data"`
 }
+
+// FlashSaleOrderEvent is published by the product service for every flash sale buy
+// Stock is already deducted there, the order is created without touching product stock
+type FlashSaleOrderEvent struct {
+	EventType string             `json:"event_type"`
+	EventID   string             `json:"event_id"`
+	Timestamp int64              `json:"timestamp"`
+	Data      FlashSaleOrderData `json:"data"`
+}
+
+// FlashSaleOrderData contains the order to create
+type FlashSaleOrderData struct {
+	OrderNo     string  `json:"order_no"`
+	SaleID      int64   `json:"sale_id"`
+	UserID      int64   `json:"user_id"`
+	ProductID   int64   `json:"product_id"`
+	Name        string  `json:"name"`
+	Image       string  `json:"image"`
+	Price       float64 `json:"price"`
+	Quantity    int64   `json:"quantity"`
+	TotalAmount float64 `json:"total_amount"`
+	Address     string  `json:"address"`
+	Phone       string  `json:"phone"`
+	Remark      string  `json:"remark"`
+}
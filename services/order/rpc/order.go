@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"letsgo/services/order/rpc/internal/config"
+	"letsgo/services/order/rpc/internal/consumer"
 	"letsgo/services/order/rpc/internal/server"
 	"letsgo/services/order/rpc/internal/svc"
 	"letsgo/services/order/rpc/order"
@@ -34,6 +35,11 @@ func main() {
 	})
 	defer s.Stop()
 
+	// Create orders of flash sale buys queued by the product service
+	flashSaleConsumer := consumer.NewFlashSaleOrderConsumer(ctx)
+	flashSaleConsumer.Start()
+	defer flashSaleConsumer.Stop()
+
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
 	s.Start()
 }
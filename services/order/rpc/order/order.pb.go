@@ -28,6 +28,9 @@ type CreateOrderRequest struct {
 	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
 	Remark        string                 `protobuf:"bytes,5,opt,name=remark,proto3" json:"remark,omitempty"`
+	Region        string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`                      // Delivery region, stock is shipped from warehouses in it first (optional)
+	FromCart      bool                   `protobuf:"varint,7,opt,name=from_cart,json=fromCart,proto3" json:"from_cart,omitempty"` // Order the selected cart lines instead of items
+	QuoteId       string                 `protobuf:"bytes,8,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`     // Order a cart price quote at its quoted prices instead of items
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -97,6 +100,27 @@ func (x *CreateOrderRequest) GetRemark() string {
 	return ""
 }
 
+func (x *CreateOrderRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CreateOrderRequest) GetFromCart() bool {
+	if x != nil {
+		return x.FromCart
+	}
+	return false
+}
+
+func (x *CreateOrderRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
 type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
@@ -899,13 +923,16 @@ var File_order_proto protoreflect.FileDescriptor
 
 const file_order_proto_rawDesc = "" +
 	"\n" +
-	"\vorder.proto\x12\x05order\"\x9d\x01\n" +
+	"\vorder.proto\x12\x05order\"\xed\x01\n" +
 	"\x12CreateOrderRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12&\n" +
 	"\x05items\x18\x02 \x03(\v2\x10.order.OrderItemR\x05items\x12\x18\n" +
 	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x14\n" +
 	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x16\n" +
-	"\x06remark\x18\x05 \x01(\tR\x06remark\"n\n" +
+	"\x06remark\x18\x05 \x01(\tR\x06remark\x12\x16\n" +
+	"\x06region\x18\x06 \x01(\tR\x06region\x12\x1b\n" +
+	"\tfrom_cart\x18\a \x01(\bR\bfromCart\x12\x19\n" +
+	"\bquote_id\x18\b \x01(\tR\aquoteId\"n\n" +
 	"\x13CreateOrderResponse\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x19\n" +
 	"\border_no\x18\x02 \x01(\tR\aorderNo\x12!\n" +
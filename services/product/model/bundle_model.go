@@ -0,0 +1,213 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ BundleModel = (*customBundleModel)(nil)
+
+type (
+	// BundleModel is an interface for bundle products made of component products
+	BundleModel interface {
+		// SetItems replaces the components of a bundle, no items turns it back into a standalone product
+		SetItems(ctx context.Context, bundleId int64, items []BundleItem) error
+
+		// FindItems returns the components of a bundle, empty if the product is not a bundle
+		FindItems(ctx context.Context, bundleId int64) ([]*BundleItem, error)
+
+		// FindContainingBundles returns the bundles made of any of the components, bundle ID -> category
+		FindContainingBundles(ctx context.Context, componentIds []int64) (map[int64]string, error)
+	}
+
+	customBundleModel struct {
+		conn sqlx.SqlConn
+	}
+)
+
+// NewBundleModel returns a BundleModel instance
+func NewBundleModel(conn sqlx.SqlConn) BundleModel {
+	return &customBundleModel{
+		conn: conn,
+	}
+}
+
+const bundleItemFields = `bundle_id, component_id, quantity`
+
+// SetItems replaces the components of a bundle in a transaction
+// The bundle and its components are locked, so two bundles cannot be made components of each other concurrently
+func (m *customBundleModel) SetItems(ctx context.Context, bundleId int64, items []BundleItem) error {
+	db, err := m.conn.RawDB()
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// A bundle has no stock of its own, everything is taken from the components
+	var stock int64
+	err = tx.QueryRowContext(ctx, `SELECT stock FROM products WHERE id = $1 AND status <> $2 FOR UPDATE`,
+		bundleId, ProductStatusDeleted).Scan(&stock)
+	if err == sql.ErrNoRows {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(items) > 0 {
+		if stock != 0 {
+			err = ErrBundleHasStock
+			return err
+		}
+
+		componentIds := make([]int64, 0, len(items))
+		for _, item := range items {
+			componentIds = append(componentIds, item.ComponentId)
+		}
+
+		var rows *sql.Rows
+		rows, err = tx.QueryContext(ctx, `SELECT id FROM products WHERE id = ANY($1) AND status <> $2 FOR UPDATE`,
+			pq.Array(componentIds), ProductStatusDeleted)
+		if err != nil {
+			return err
+		}
+		found := 0
+		for rows.Next() {
+			found++
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+		if found != len(componentIds) {
+			err = ErrNotFound
+			return err
+		}
+
+		// Bundles are one level deep: a bundle is not a component and a component is not a bundle
+		var nested int64
+		err = tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM product_bundle_items WHERE component_id = $1 OR bundle_id = ANY($2)`,
+			bundleId, pq.Array(componentIds)).Scan(&nested)
+		if err != nil {
+			return err
+		}
+		if nested > 0 {
+			err = ErrBundleNesting
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM product_bundle_items WHERE bundle_id = $1`, bundleId)
+	if err != nil {
+		return err
+	}
+
+	if len(items) > 0 {
+		componentIds := make([]int64, 0, len(items))
+		quantities := make([]int64, 0, len(items))
+		for _, item := range items {
+			componentIds = append(componentIds, item.ComponentId)
+			quantities = append(quantities, item.Quantity)
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO product_bundle_items (bundle_id, component_id, quantity)
+			SELECT $1, unnest($2::BIGINT[]), unnest($3::BIGINT[])`,
+			bundleId, pq.Array(componentIds), pq.Array(quantities))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = tx.Commit()
+	return err
+}
+
+// FindItems returns the components of a bundle ordered by component ID
+func (m *customBundleModel) FindItems(ctx context.Context, bundleId int64) ([]*BundleItem, error) {
+	var items []*BundleItem
+	query := `SELECT ` + bundleItemFields + ` FROM product_bundle_items WHERE bundle_id = $1 ORDER BY component_id`
+	if err := m.conn.QueryRowsCtx(ctx, &items, query, bundleId); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// FindContainingBundles returns the non-deleted bundles containing any of the components
+func (m *customBundleModel) FindContainingBundles(ctx context.Context, componentIds []int64) (map[int64]string, error) {
+	bundles := make(map[int64]string)
+	if len(componentIds) == 0 {
+		return bundles, nil
+	}
+
+	db, err := m.conn.RawDB()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT DISTINCT p.id, p.category
+		FROM product_bundle_items bi
+		JOIN products p ON p.id = bi.bundle_id
+		WHERE bi.component_id = ANY($1) AND p.status <> $2`, pq.Array(componentIds), ProductStatusDeleted)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int64
+		var category string
+		if err := rows.Scan(&id, &category); err != nil {
+			return nil, err
+		}
+		bundles[id] = category
+	}
+
+	return bundles, rows.Err()
+}
+
+// findBundleItems returns the components of the bundles among productIds inside the caller's transaction
+// Products that are not bundles are left out
+func findBundleItems(ctx context.Context, tx *sql.Tx, productIds []int64) (map[int64][]BundleItem, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT `+bundleItemFields+` FROM product_bundle_items
+		WHERE bundle_id = ANY($1)
+		ORDER BY bundle_id, component_id`, pq.Array(productIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bundles := make(map[int64][]BundleItem)
+	for rows.Next() {
+		var item BundleItem
+		if err := rows.Scan(&item.BundleId, &item.ComponentId, &item.Quantity); err != nil {
+			return nil, err
+		}
+		bundles[item.BundleId] = append(bundles[item.BundleId], item)
+	}
+
+	return bundles, rows.Err()
+}
+
+// ErrBundleHasStock is returned when a product with stock of its own is made a bundle
+var ErrBundleHasStock = fmt.Errorf("bundle has stock of its own")
+
+// ErrBundleNesting is returned when a bundle would contain another bundle
+var ErrBundleNesting = fmt.Errorf("bundles cannot be nested")
+
+// ErrBundleStock is returned when stock of a bundle is changed directly instead of through its components
+var ErrBundleStock = fmt.Errorf("bundle stock is derived from its components")
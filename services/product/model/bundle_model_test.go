@@ -0,0 +1,144 @@
+package model
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var bundleItemColumns = []string{"bundle_id", "component_id", "quantity"}
+
+func TestBatchUpdateStockBundle(t *testing.T) {
+	tests := []struct {
+		name         string
+		insufficient bool
+	}{
+		{name: "components deducted"},
+		{name: "component short rolls back", insufficient: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, mock := newModelTest(t)
+			change := StockChange{Reason: StockReasonOrder, ReferenceId: "ORD1", WarehouseId: 1}
+
+			// Bundle 9 is one pen and two refills
+			mock.ExpectBegin()
+			mock.ExpectQuery(`FROM product_bundle_items`).WithArgs(sqlmock.AnyArg()).
+				WillReturnRows(sqlmock.NewRows(bundleItemColumns).AddRow(9, 1, 1).AddRow(9, 2, 2))
+			mock.ExpectQuery(`SELECT category FROM products WHERE id = \$1 AND status <> 3`).WithArgs(int64(9)).
+				WillReturnRows(sqlmock.NewRows([]string{"category"}).AddRow("kits"))
+
+			mock.ExpectQuery(`UPDATE products`).WithArgs(int64(-2), int64(1)).
+				WillReturnRows(sqlmock.NewRows([]string{"stock", "updated_at", "category"}).AddRow(8, 100, "office"))
+			mock.ExpectExec(`UPDATE warehouse_stock`).WithArgs(int64(-2), int64(100), int64(1), int64(1)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(`INSERT INTO inventory_movements`).
+				WithArgs(int64(1), int64(1), int64(-2), int64(8), StockReasonOrder, "ORD1", int64(0), int64(100)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+
+			refills := mock.ExpectQuery(`UPDATE products`).WithArgs(int64(-4), int64(2))
+			if tt.insufficient {
+				refills.WillReturnRows(sqlmock.NewRows([]string{"stock", "updated_at", "category"}))
+				mock.ExpectRollback()
+			} else {
+				refills.WillReturnRows(sqlmock.NewRows([]string{"stock", "updated_at", "category"}).AddRow(6, 100, "supplies"))
+				mock.ExpectExec(`UPDATE warehouse_stock`).WithArgs(int64(-4), int64(100), int64(1), int64(2)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO inventory_movements`).
+					WithArgs(int64(2), int64(1), int64(-4), int64(6), StockReasonOrder, "ORD1", int64(0), int64(100)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			}
+
+			results, err := NewProductModel(conn).BatchUpdateStock(context.Background(), []StockUpdateItem{
+				{ProductId: 9, Quantity: -2},
+			}, change)
+
+			if tt.insufficient {
+				if err != ErrInsufficientStock {
+					t.Fatalf("err = %v, want %v", err, ErrInsufficientStock)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 8 pens and 6 refills still make 3 bundles
+			if r := results[0]; r.ProductId != 9 || r.NewStock != 3 || r.Category != "kits" ||
+				len(r.Components) != 2 || r.Components[1].Quantity != -4 || r.Components[1].Category != "supplies" {
+				t.Fatalf("result = %+v", r)
+			}
+		})
+	}
+}
+
+func TestUpdateStockRejectsBundle(t *testing.T) {
+	conn, mock := newModelTest(t)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM product_bundle_items`).
+		WillReturnRows(sqlmock.NewRows(bundleItemColumns).AddRow(9, 1, 1))
+	mock.ExpectRollback()
+
+	_, _, err := NewProductModel(conn).UpdateStock(context.Background(), 9, 5, StockChange{Reason: StockReasonAdminAdjust})
+	if err != ErrBundleStock {
+		t.Fatalf("err = %v, want %v", err, ErrBundleStock)
+	}
+}
+
+func TestSetItemsRejectsNesting(t *testing.T) {
+	conn, mock := newModelTest(t)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT stock FROM products WHERE id = \$1`).WithArgs(int64(9), int64(ProductStatusDeleted)).
+		WillReturnRows(sqlmock.NewRows([]string{"stock"}).AddRow(0))
+	mock.ExpectQuery(`SELECT id FROM products WHERE id = ANY\(\$1\)`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(8))
+	// Product 8 is a bundle itself
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM product_bundle_items`).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectRollback()
+
+	err := NewBundleModel(conn).SetItems(context.Background(), 9, []BundleItem{{ComponentId: 1, Quantity: 1}, {ComponentId: 8, Quantity: 1}})
+	if err != ErrBundleNesting {
+		t.Fatalf("err = %v, want %v", err, ErrBundleNesting)
+	}
+}
+
+func TestFindContainingBundles(t *testing.T) {
+	conn, mock := newModelTest(t)
+
+	mock.ExpectQuery(`FROM product_bundle_items bi\s+JOIN products p ON p.id = bi.bundle_id\s+WHERE bi.component_id = ANY\(\$1\) AND p.status <> \$2`).
+		WithArgs(sqlmock.AnyArg(), int64(ProductStatusDeleted)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "category"}).AddRow(9, "kits").AddRow(10, "office"))
+
+	m := NewBundleModel(conn)
+	bundles, err := m.FindContainingBundles(context.Background(), []int64{1, 2})
+	if err != nil || !reflect.DeepEqual(bundles, map[int64]string{9: "kits", 10: "office"}) {
+		t.Fatalf("bundles = %v, err = %v", bundles, err)
+	}
+
+	// No components, no query
+	if bundles, err := m.FindContainingBundles(context.Background(), nil); err != nil || len(bundles) != 0 {
+		t.Fatalf("bundles = %v, err = %v", bundles, err)
+	}
+}
+
+func TestFindOneDerivesBundleStock(t *testing.T) {
+	conn, mock := newModelTest(t)
+
+	// The stock column is taken from the components, falling back to the product's own stock
+	mock.ExpectQuery(`COALESCE\(\(SELECT MIN\(CASE WHEN c.status = 1 THEN c.stock / bi.quantity ELSE 0 END\)\s+FROM product_bundle_items bi JOIN products c ON c.id = bi.component_id\s+WHERE bi.bundle_id = products.id\), products.stock\) AS stock`).
+		WithArgs(int64(9)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "sku", "name", "description", "price", "stock", "category", "images", "attributes",
+			"sales", "status", "publish_at", "created_at", "updated_at", "version", "max_per_order", "max_per_user", "limit_window"}).
+			AddRow(9, "SET-1", "Pen set", "", 10, 3, "kits", "{}", "{}", 0, 1, 0, 100, 100, 1, 0, 0, 0))
+
+	p, err := NewProductModel(conn).FindOne(context.Background(), 9)
+	if err != nil || p.Stock != 3 {
+		t.Fatalf("product = %+v, err = %v", p, err)
+	}
+}
@@ -0,0 +1,288 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ FlashSaleModel = (*customFlashSaleModel)(nil)
+
+type (
+	// FlashSaleModel is an interface for flash sale operations
+	FlashSaleModel interface {
+		// Insert creates a pending flash sale and reserves its quantity from product stock
+		Insert(ctx context.Context, data *FlashSale) (int64, error)
+
+		// FindOne finds a flash sale by ID
+		FindOne(ctx context.Context, id int64) (*FlashSale, error)
+
+		// List returns flash sales filtered by product and status, newest start time first
+		List(ctx context.Context, productId int64, status int64, page, pageSize int32) ([]*FlashSale, int64, error)
+
+		// ActivateDue marks pending flash sales whose start time has passed as active
+		ActivateDue(ctx context.Context, now int64) (int64, error)
+
+		// FindDueToClose returns open flash sales whose end time has passed
+		FindDueToClose(ctx context.Context, now int64, limit int) ([]*FlashSale, error)
+
+		// Close finishes or cancels an open flash sale and returns the unsold quantity to product stock
+		Close(ctx context.Context, id int64, status int64, unsold int64, operatorId int64, now int64) (string, error)
+	}
+
+	customFlashSaleModel struct {
+		conn sqlx.SqlConn
+	}
+)
+
+// NewFlashSaleModel returns a FlashSaleModel instance
+func NewFlashSaleModel(conn sqlx.SqlConn) FlashSaleModel {
+	return &customFlashSaleModel{
+		conn: conn,
+	}
+}
+
+const flashSaleFields = `id, product_id, price, quantity, sold, per_user_limit, start_at, end_at, status, operator_id, created_at, updated_at`
+
+// Insert creates a pending flash sale
+// The sale quantity is deducted from products.stock in the same transaction,
+// so regular orders can never sell the units reserved for the sale.
+// The reservation is recorded in inventory_movements with the sale ID as reference
+func (m *customFlashSaleModel) Insert(ctx context.Context, data *FlashSale) (int64, error) {
+	db, err := m.conn.RawDB()
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var stock int64
+	err = tx.QueryRowContext(ctx, `SELECT stock FROM products WHERE id = $1 AND status <> 3 FOR UPDATE`, data.ProductId).Scan(&stock)
+	if err == sql.ErrNoRows {
+		return 0, ErrNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	if stock < data.Quantity {
+		err = ErrInsufficientStock
+		return 0, err
+	}
+
+	// One open flash sale per product at a time, so the sale price shown to customers is unambiguous
+	var overlapping int64
+	err = tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM flash_sales
+		WHERE product_id = $1 AND status IN (1, 2) AND start_at < $3 AND end_at > $2`,
+		data.ProductId, data.StartAt, data.EndAt).Scan(&overlapping)
+	if err != nil {
+		return 0, err
+	}
+	if overlapping > 0 {
+		err = ErrFlashSaleOverlap
+		return 0, err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE products SET stock = stock - $1, updated_at = $2 WHERE id = $3`,
+		data.Quantity, data.CreatedAt, data.ProductId)
+	if err != nil {
+		return 0, err
+	}
+
+	query := `INSERT INTO flash_sales (product_id, price, quantity, sold, per_user_limit, start_at, end_at, status, operator_id, created_at, updated_at)
+			  VALUES ($1, $2, $3, 0, $4, $5, $6, $7, $8, $9, $9)
+			  RETURNING id`
+
+	var id int64
+	err = tx.QueryRowContext(ctx, query,
+		data.ProductId,
+		data.Price,
+		data.Quantity,
+		data.PerUserLimit,
+		data.StartAt,
+		data.EndAt,
+		FlashSalePending,
+		data.OperatorId,
+		data.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	err = changeWarehouseStock(ctx, tx, data.ProductId, -data.Quantity, stock-data.Quantity, StockChange{
+		Reason:      StockReasonFlashSale,
+		ReferenceId: strconv.FormatInt(id, 10),
+		OperatorId:  data.OperatorId,
+	}, data.CreatedAt)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// FindOne finds a flash sale by ID
+func (m *customFlashSaleModel) FindOne(ctx context.Context, id int64) (*FlashSale, error) {
+	query := `SELECT ` + flashSaleFields + ` FROM flash_sales WHERE id = $1`
+
+	var sale FlashSale
+	err := m.conn.QueryRowCtx(ctx, &sale, query, id)
+	switch err {
+	case nil:
+		return &sale, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// List returns flash sales with pagination, newest start time first
+// productId = 0 and status = 0 mean no filter
+func (m *customFlashSaleModel) List(ctx context.Context, productId int64, status int64, page, pageSize int32) ([]*FlashSale, int64, error) {
+	where := `WHERE ($1::BIGINT = 0 OR product_id = $1) AND ($2::INT = 0 OR status = $2)`
+
+	var total int64
+	err := m.conn.QueryRowCtx(ctx, &total, `SELECT COUNT(*) FROM flash_sales `+where, productId, status)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + flashSaleFields + ` FROM flash_sales ` + where + `
+			  ORDER BY start_at DESC, id DESC
+			  LIMIT $3 OFFSET $4`
+
+	var sales []*FlashSale
+	offset := (page - 1) * pageSize
+	err = m.conn.QueryRowsCtx(ctx, &sales, query, productId, status, pageSize, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return sales, total, nil
+}
+
+// ActivateDue marks pending flash sales whose start time has passed as active
+// Buying is gated by the sale window itself, the status only reflects it for admins
+func (m *customFlashSaleModel) ActivateDue(ctx context.Context, now int64) (int64, error) {
+	result, err := m.conn.ExecCtx(ctx, `UPDATE flash_sales SET status = $1, updated_at = $2
+		WHERE status = $3 AND start_at <= $2 AND end_at > $2`,
+		FlashSaleActive, now, FlashSalePending)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// FindDueToClose returns pending or active flash sales whose end time has passed
+func (m *customFlashSaleModel) FindDueToClose(ctx context.Context, now int64, limit int) ([]*FlashSale, error) {
+	query := `SELECT ` + flashSaleFields + ` FROM flash_sales
+			  WHERE status IN (1, 2) AND end_at <= $1
+			  ORDER BY end_at
+			  LIMIT $2`
+
+	var sales []*FlashSale
+	err := m.conn.QueryRowsCtx(ctx, &sales, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return sales, nil
+}
+
+// Close finishes or cancels an open flash sale
+// The unsold quantity goes back to products.stock and the sold quantity is settled on the sale.
+// operatorId is the admin cancelling the sale (0 = system).
+// Returns the product category for cache invalidation
+func (m *customFlashSaleModel) Close(ctx context.Context, id int64, status int64, unsold int64, operatorId int64, now int64) (string, error) {
+	db, err := m.conn.RawDB()
+	if err != nil {
+		return "", err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var productId, quantity, current int64
+	err = tx.QueryRowContext(ctx, `SELECT product_id, quantity, status FROM flash_sales WHERE id = $1 FOR UPDATE`, id).
+		Scan(&productId, &quantity, &current)
+	if err == sql.ErrNoRows {
+		return "", ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if current != FlashSalePending && current != FlashSaleActive {
+		err = ErrFlashSaleClosed
+		return "", err
+	}
+
+	// Never return more than was reserved
+	if unsold > quantity {
+		unsold = quantity
+	}
+	if unsold < 0 {
+		unsold = 0
+	}
+
+	var category string
+	var stock int64
+	err = tx.QueryRowContext(ctx, `UPDATE products SET stock = stock + $1, updated_at = $2 WHERE id = $3 RETURNING category, stock`,
+		unsold, now, productId).Scan(&category, &stock)
+	if err != nil {
+		return "", err
+	}
+
+	err = changeWarehouseStock(ctx, tx, productId, unsold, stock, StockChange{
+		Reason:      StockReasonFlashSale,
+		ReferenceId: strconv.FormatInt(id, 10),
+		OperatorId:  operatorId,
+	}, now)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE flash_sales SET status = $1, sold = $2, updated_at = $3 WHERE id = $4`,
+		status, quantity-unsold, now, id)
+	if err != nil {
+		return "", err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", err
+	}
+
+	return category, nil
+}
+
+// ErrFlashSaleOverlap is returned when a flash sale overlaps another open sale of the same product
+var ErrFlashSaleOverlap = fmt.Errorf("flash sale overlaps an existing sale")
+
+// ErrFlashSaleClosed is returned when closing a flash sale that already finished or was cancelled
+var ErrFlashSaleClosed = fmt.Errorf("flash sale already closed")
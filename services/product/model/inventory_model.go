@@ -0,0 +1,93 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ InventoryModel = (*customInventoryModel)(nil)
+
+type (
+	// InventoryModel is an interface for the inventory movement ledger
+	// Movements are written by the models that change stock, this model only reads them
+	InventoryModel interface {
+		// ListMovements returns inventory movements of a product, newest first
+		ListMovements(ctx context.Context, productId int64, reason string, page, pageSize int32) ([]*InventoryMovement, int64, error)
+
+		// FindDiscrepancies returns products whose stock differs from their ledger or warehouse stock
+		FindDiscrepancies(ctx context.Context, productId int64, limit int) ([]*StockDiscrepancy, error)
+	}
+
+	customInventoryModel struct {
+		conn sqlx.SqlConn
+	}
+)
+
+// NewInventoryModel returns an InventoryModel instance
+func NewInventoryModel(conn sqlx.SqlConn) InventoryModel {
+	return &customInventoryModel{
+		conn: conn,
+	}
+}
+
+// ListMovements returns inventory movements of a product with pagination, newest first
+// reason = "" means no filter
+func (m *customInventoryModel) ListMovements(ctx context.Context, productId int64, reason string, page, pageSize int32) ([]*InventoryMovement, int64, error) {
+	where := `WHERE product_id = $1 AND ($2 = '' OR reason = $2)`
+
+	var total int64
+	err := m.conn.QueryRowCtx(ctx, &total, `SELECT COUNT(*) FROM inventory_movements `+where, productId, reason)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT id, product_id, warehouse_id, quantity, stock_after, reason, reference_id, operator_id, created_at
+			  FROM inventory_movements ` + where + `
+			  ORDER BY id DESC
+			  LIMIT $3 OFFSET $4`
+
+	var movements []*InventoryMovement
+	offset := (page - 1) * pageSize
+	err = m.conn.QueryRowsCtx(ctx, &movements, query, productId, reason, pageSize, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return movements, total, nil
+}
+
+// FindDiscrepancies recomputes stock from the ledger and the warehouses, and returns products
+// where either does not match products.stock
+// productId = 0 checks all products, deleted ones included since they can still hold stock
+func (m *customInventoryModel) FindDiscrepancies(ctx context.Context, productId int64, limit int) ([]*StockDiscrepancy, error) {
+	query := `SELECT product_id, stock, ledger_stock, warehouse_stock FROM (
+				  SELECT p.id AS product_id, p.stock,
+				         COALESCE((SELECT SUM(im.quantity) FROM inventory_movements im WHERE im.product_id = p.id), 0) AS ledger_stock,
+				         COALESCE((SELECT SUM(ws.stock) FROM warehouse_stock ws WHERE ws.product_id = p.id), 0) AS warehouse_stock
+				  FROM products p
+				  WHERE $1::BIGINT = 0 OR p.id = $1
+			  ) s
+			  WHERE stock <> ledger_stock OR stock <> warehouse_stock
+			  ORDER BY product_id
+			  LIMIT $2`
+
+	var discrepancies []*StockDiscrepancy
+	err := m.conn.QueryRowsCtx(ctx, &discrepancies, query, productId, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return discrepancies, nil
+}
+
+// insertMovement records a stock change of one warehouse inside the caller's transaction
+// Stock changes go through changeWarehouseStock, which writes the movements
+func insertMovement(ctx context.Context, tx *sql.Tx, productId, warehouseId, quantity, stockAfter int64, change StockChange, now int64) error {
+	query := `INSERT INTO inventory_movements (product_id, warehouse_id, quantity, stock_after, reason, reference_id, operator_id, created_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+
+	_, err := tx.ExecContext(ctx, query, productId, warehouseId, quantity, stockAfter, change.Reason, change.ReferenceId, change.OperatorId, now)
+	return err
+}
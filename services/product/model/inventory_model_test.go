@@ -0,0 +1,119 @@
+package model
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestFindDiscrepancies(t *testing.T) {
+	conn, mock := newModelTest(t)
+
+	mock.ExpectQuery(`WHERE stock <> ledger_stock OR stock <> warehouse_stock`).WithArgs(int64(0), 50).
+		WillReturnRows(sqlmock.NewRows([]string{"product_id", "stock", "ledger_stock", "warehouse_stock"}).
+			AddRow(1, 10, 8, 10).
+			AddRow(4, 0, 0, 3))
+
+	discrepancies, err := NewInventoryModel(conn).FindDiscrepancies(context.Background(), 0, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []*StockDiscrepancy{
+		{ProductId: 1, Stock: 10, LedgerStock: 8, WarehouseStock: 10},
+		{ProductId: 4, Stock: 0, LedgerStock: 0, WarehouseStock: 3},
+	}
+	if !reflect.DeepEqual(discrepancies, want) {
+		t.Fatalf("discrepancies = %+v", discrepancies)
+	}
+}
+
+func TestUpdateStockRecordsMovement(t *testing.T) {
+	conn, mock := newModelTest(t)
+	change := StockChange{Reason: StockReasonOrder, ReferenceId: "ORD1"}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM product_bundle_items`).WillReturnRows(sqlmock.NewRows(bundleItemColumns))
+	// Drafts and unpublished products keep their stock up to date, only deleted ones are left out
+	mock.ExpectQuery(`UPDATE products\s+SET stock = stock \+ \$1, .*\s+WHERE id = \$2 AND status <> 3 AND stock \+ \$1 >= 0`).WithArgs(int64(-2), int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"stock", "category", "updated_at"}).AddRow(8, "office", 100))
+	mock.ExpectQuery(`FROM warehouse_stock ws`).WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"warehouse_id", "stock"}).AddRow(3, 10))
+	mock.ExpectExec(`UPDATE warehouse_stock`).WithArgs(int64(-2), int64(100), int64(3), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO inventory_movements`).
+		WithArgs(int64(1), int64(3), int64(-2), int64(8), StockReasonOrder, "ORD1", int64(0), int64(100)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	stock, category, err := NewProductModel(conn).UpdateStock(context.Background(), 1, -2, change)
+	if err != nil || stock != 8 || category != "office" {
+		t.Fatalf("stock = %d, category = %s, err = %v", stock, category, err)
+	}
+}
+
+func TestBatchUpdateStockRecordsMovements(t *testing.T) {
+	tests := []struct {
+		name         string
+		insufficient bool
+	}{
+		{name: "all updated"},
+		{name: "insufficient stock rolls back"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, mock := newModelTest(t)
+			change := StockChange{Reason: StockReasonCancel, ReferenceId: "ORD1"}
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(`FROM product_bundle_items`).WillReturnRows(sqlmock.NewRows(bundleItemColumns))
+			// A cancelled order gives stock back even if the product was taken off sale since
+			mock.ExpectQuery(`UPDATE products\s+SET stock = stock \+ \$1, .*\s+WHERE id = \$2 AND status <> 3 AND stock \+ \$1 >= 0`).WithArgs(int64(2), int64(1)).
+				WillReturnRows(sqlmock.NewRows([]string{"stock", "updated_at", "category"}).AddRow(12, 100, "office"))
+			// The cancelled order took the units from warehouse 3
+			mock.ExpectQuery(`FROM inventory_movements`).WithArgs(int64(1), "ORD1", sqlmock.AnyArg()).
+				WillReturnRows(sqlmock.NewRows([]string{"warehouse_id", "taken"}).AddRow(3, 2))
+			mock.ExpectExec(`INSERT INTO warehouse_stock`).WithArgs(int64(3), int64(1), int64(2), int64(100)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectExec(`INSERT INTO inventory_movements`).
+				WithArgs(int64(1), int64(3), int64(2), int64(12), StockReasonCancel, "ORD1", int64(0), int64(100)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			second := mock.ExpectQuery(`UPDATE products`).WithArgs(int64(-3), int64(2))
+			if tt.insufficient {
+				second.WillReturnRows(sqlmock.NewRows([]string{"stock", "updated_at", "category"}))
+				mock.ExpectRollback()
+			} else {
+				second.WillReturnRows(sqlmock.NewRows([]string{"stock", "updated_at", "category"}).AddRow(1, 100, "paper"))
+				mock.ExpectQuery(`FROM warehouse_stock ws`).WithArgs(int64(2)).
+					WillReturnRows(sqlmock.NewRows([]string{"warehouse_id", "stock"}).AddRow(3, 4))
+				mock.ExpectExec(`UPDATE warehouse_stock`).WithArgs(int64(-3), int64(100), int64(3), int64(2)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO inventory_movements`).
+					WithArgs(int64(2), int64(3), int64(-3), int64(1), StockReasonCancel, "ORD1", int64(0), int64(100)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			}
+
+			results, err := NewProductModel(conn).BatchUpdateStock(context.Background(), []StockUpdateItem{
+				{ProductId: 1, Quantity: 2},
+				{ProductId: 2, Quantity: -3},
+			}, change)
+
+			if tt.insufficient {
+				if err != ErrInsufficientStock {
+					t.Fatalf("err = %v, want %v", err, ErrInsufficientStock)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(results) != 2 || results[0].NewStock != 12 || results[1].NewStock != 1 || results[1].Category != "paper" {
+				t.Fatalf("results = %+v", results)
+			}
+		})
+	}
+}
@@ -0,0 +1,389 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ PriceModel = (*customPriceModel)(nil)
+
+type (
+	// PriceModel is an interface for price history and scheduled price changes
+	PriceModel interface {
+		// ListHistory returns price changes of a product, newest first
+		ListHistory(ctx context.Context, productId int64, page, pageSize int32) ([]*PriceHistory, int64, error)
+
+		// InsertSchedule creates a pending price schedule
+		InsertSchedule(ctx context.Context, data *PriceSchedule) (int64, error)
+
+		// ListSchedules returns price schedules of a product ordered by start time
+		ListSchedules(ctx context.Context, productId int64, includeFinished bool) ([]*PriceSchedule, error)
+
+		// CancelSchedule cancels a pending schedule or reverts an active one
+		CancelSchedule(ctx context.Context, id int64, operatorId int64, now int64) (*PriceChange, error)
+
+		// StartDue applies pending schedules whose start time has passed
+		StartDue(ctx context.Context, now int64, limit int) ([]*PriceChange, error)
+
+		// EndDue reverts active schedules whose end time has passed
+		EndDue(ctx context.Context, now int64, limit int) ([]*PriceChange, error)
+	}
+
+	customPriceModel struct {
+		conn sqlx.SqlConn
+	}
+)
+
+// NewPriceModel returns a PriceModel instance
+func NewPriceModel(conn sqlx.SqlConn) PriceModel {
+	return &customPriceModel{
+		conn: conn,
+	}
+}
+
+// ListHistory returns price changes of a product with pagination, newest first
+func (m *customPriceModel) ListHistory(ctx context.Context, productId int64, page, pageSize int32) ([]*PriceHistory, int64, error) {
+	var total int64
+	err := m.conn.QueryRowCtx(ctx, &total, `SELECT COUNT(*) FROM product_price_history WHERE product_id = $1`, productId)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT id, product_id, old_price, new_price, operator_id, source, schedule_id, created_at
+			  FROM product_price_history
+			  WHERE product_id = $1
+			  ORDER BY created_at DESC, id DESC
+			  LIMIT $2 OFFSET $3`
+
+	var history []*PriceHistory
+	offset := (page - 1) * pageSize
+	err = m.conn.QueryRowsCtx(ctx, &history, query, productId, pageSize, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return history, total, nil
+}
+
+// InsertSchedule creates a pending price schedule
+// The product row is locked so that concurrent requests cannot create overlapping time windows
+func (m *customPriceModel) InsertSchedule(ctx context.Context, data *PriceSchedule) (int64, error) {
+	db, err := m.conn.RawDB()
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var productId int64
+	err = tx.QueryRowContext(ctx, `SELECT id FROM products WHERE id = $1 AND status <> 3 FOR UPDATE`, data.ProductId).Scan(&productId)
+	if err == sql.ErrNoRows {
+		return 0, ErrNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	// Timed schedules (end_at > 0) revert the price, so their windows must not overlap.
+	// Permanent changes never overlap anything, they finish as soon as they are applied.
+	if data.EndAt > 0 {
+		var overlapping int64
+		err = tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM product_price_schedules
+			WHERE product_id = $1 AND status IN (1, 2) AND end_at > 0
+			  AND start_at < $3 AND end_at > $2`,
+			data.ProductId, data.StartAt, data.EndAt).Scan(&overlapping)
+		if err != nil {
+			return 0, err
+		}
+		if overlapping > 0 {
+			err = ErrScheduleOverlap
+			return 0, err
+		}
+	}
+
+	query := `INSERT INTO product_price_schedules (product_id, price, original_price, start_at, end_at, status, operator_id, created_at, updated_at)
+			  VALUES ($1, $2, 0, $3, $4, $5, $6, $7, $7)
+			  RETURNING id`
+
+	var id int64
+	err = tx.QueryRowContext(ctx, query,
+		data.ProductId,
+		data.Price,
+		data.StartAt,
+		data.EndAt,
+		PriceSchedulePending,
+		data.OperatorId,
+		data.CreatedAt,
+	).Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// ListSchedules returns price schedules of a product ordered by start time
+func (m *customPriceModel) ListSchedules(ctx context.Context, productId int64, includeFinished bool) ([]*PriceSchedule, error) {
+	query := `SELECT id, product_id, price, original_price, start_at, end_at, status, operator_id, created_at, updated_at
+			  FROM product_price_schedules
+			  WHERE product_id = $1`
+	if !includeFinished {
+		query += ` AND status IN (1, 2)`
+	}
+	query += ` ORDER BY start_at ASC, id ASC`
+
+	var schedules []*PriceSchedule
+	err := m.conn.QueryRowsCtx(ctx, &schedules, query, productId)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// CancelSchedule cancels a pending schedule, or reverts the price of an active one
+// Returns ErrNotFound if the schedule does not exist and ErrScheduleNotCancellable if it already ended
+func (m *customPriceModel) CancelSchedule(ctx context.Context, id int64, operatorId int64, now int64) (*PriceChange, error) {
+	var change *PriceChange
+	err := m.inScheduleTx(ctx, id, func(tx *sql.Tx, schedule *PriceSchedule) error {
+		switch schedule.Status {
+		case PriceSchedulePending:
+			change = &PriceChange{ScheduleId: schedule.Id, ProductId: schedule.ProductId}
+			return updateScheduleStatus(ctx, tx, schedule.Id, PriceScheduleCancelled, schedule.OriginalPrice, now)
+		case PriceScheduleActive:
+			var err error
+			change, err = revertSchedule(ctx, tx, schedule, operatorId, PriceSourceScheduleCancel, PriceScheduleCancelled, now)
+			return err
+		default:
+			return ErrScheduleNotCancellable
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// StartDue applies pending schedules whose start time has passed
+// Each schedule runs in its own transaction, a failure is returned after the successful ones are kept
+func (m *customPriceModel) StartDue(ctx context.Context, now int64, limit int) ([]*PriceChange, error) {
+	var ids []int64
+	err := m.conn.QueryRowsCtx(ctx, &ids, `SELECT id FROM product_price_schedules
+		WHERE status = 1 AND start_at <= $1
+		ORDER BY start_at ASC
+		LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*PriceChange, 0, len(ids))
+	for _, id := range ids {
+		var change *PriceChange
+		err = m.inScheduleTx(ctx, id, func(tx *sql.Tx, schedule *PriceSchedule) error {
+			// Another worker got here first
+			if schedule.Status != PriceSchedulePending {
+				return nil
+			}
+
+			var err error
+			change, err = applySchedule(ctx, tx, schedule, now)
+			return err
+		})
+		if err != nil {
+			return changes, fmt.Errorf("apply price schedule %d: %w", id, err)
+		}
+		if change != nil {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, nil
+}
+
+// EndDue reverts active schedules whose end time has passed
+func (m *customPriceModel) EndDue(ctx context.Context, now int64, limit int) ([]*PriceChange, error) {
+	var ids []int64
+	err := m.conn.QueryRowsCtx(ctx, &ids, `SELECT id FROM product_price_schedules
+		WHERE status = 2 AND end_at > 0 AND end_at <= $1
+		ORDER BY end_at ASC
+		LIMIT $2`, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]*PriceChange, 0, len(ids))
+	for _, id := range ids {
+		var change *PriceChange
+		err = m.inScheduleTx(ctx, id, func(tx *sql.Tx, schedule *PriceSchedule) error {
+			if schedule.Status != PriceScheduleActive {
+				return nil
+			}
+
+			var err error
+			change, err = revertSchedule(ctx, tx, schedule, schedule.OperatorId, PriceSourceScheduleEnd, PriceScheduleFinished, now)
+			return err
+		})
+		if err != nil {
+			return changes, fmt.Errorf("revert price schedule %d: %w", id, err)
+		}
+		if change != nil {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes, nil
+}
+
+// inScheduleTx locks the schedule row and runs fn in a transaction
+func (m *customPriceModel) inScheduleTx(ctx context.Context, id int64, fn func(tx *sql.Tx, schedule *PriceSchedule) error) error {
+	db, err := m.conn.RawDB()
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	var schedule PriceSchedule
+	err = tx.QueryRowContext(ctx, `SELECT id, product_id, price, original_price, start_at, end_at, status, operator_id, created_at, updated_at
+		FROM product_price_schedules WHERE id = $1 FOR UPDATE`, id).Scan(
+		&schedule.Id,
+		&schedule.ProductId,
+		&schedule.Price,
+		&schedule.OriginalPrice,
+		&schedule.StartAt,
+		&schedule.EndAt,
+		&schedule.Status,
+		&schedule.OperatorId,
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = fn(tx, &schedule); err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	return err
+}
+
+// applySchedule sets the scheduled price on the product and activates the schedule
+func applySchedule(ctx context.Context, tx *sql.Tx, schedule *PriceSchedule, now int64) (*PriceChange, error) {
+	change := &PriceChange{ScheduleId: schedule.Id, ProductId: schedule.ProductId}
+
+	err := tx.QueryRowContext(ctx, `SELECT price, category FROM products WHERE id = $1 AND status <> 3 FOR UPDATE`,
+		schedule.ProductId).Scan(&change.OldPrice, &change.Category)
+	if err == sql.ErrNoRows {
+		// Product was deleted in the meantime
+		return nil, updateScheduleStatus(ctx, tx, schedule.Id, PriceScheduleCancelled, 0, now)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The window already closed (e.g. worker was down), never apply an expired price
+	if schedule.EndAt > 0 && schedule.EndAt <= now {
+		return nil, updateScheduleStatus(ctx, tx, schedule.Id, PriceScheduleFinished, 0, now)
+	}
+
+	if _, err = tx.ExecContext(ctx, `UPDATE products SET price = $1, updated_at = $2, version = version + 1 WHERE id = $3`,
+		schedule.Price, now, schedule.ProductId); err != nil {
+		return nil, err
+	}
+
+	err = insertPriceHistory(ctx, tx, schedule.ProductId, change.OldPrice, schedule.Price, schedule.OperatorId, PriceSourceScheduleStart, schedule.Id, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// Permanent changes are done once applied
+	status := int64(PriceScheduleActive)
+	if schedule.EndAt == 0 {
+		status = PriceScheduleFinished
+	}
+	if err = updateScheduleStatus(ctx, tx, schedule.Id, status, change.OldPrice, now); err != nil {
+		return nil, err
+	}
+
+	change.NewPrice = schedule.Price
+	change.Changed = true
+	return change, nil
+}
+
+// revertSchedule restores the original price of an active schedule and moves it to status
+// If the price was changed by someone else while the schedule was active, that newer price is kept
+func revertSchedule(ctx context.Context, tx *sql.Tx, schedule *PriceSchedule, operatorId int64, source string, status int64, now int64) (*PriceChange, error) {
+	change := &PriceChange{ScheduleId: schedule.Id, ProductId: schedule.ProductId}
+
+	var stillScheduled bool
+	err := tx.QueryRowContext(ctx, `SELECT price, category, price = $2::DECIMAL(10,2) FROM products WHERE id = $1 FOR UPDATE`,
+		schedule.ProductId, schedule.Price).Scan(&change.OldPrice, &change.Category, &stillScheduled)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	if err == nil && stillScheduled {
+		if _, err = tx.ExecContext(ctx, `UPDATE products SET price = $1, updated_at = $2, version = version + 1 WHERE id = $3`,
+			schedule.OriginalPrice, now, schedule.ProductId); err != nil {
+			return nil, err
+		}
+
+		err = insertPriceHistory(ctx, tx, schedule.ProductId, change.OldPrice, schedule.OriginalPrice, operatorId, source, schedule.Id, now)
+		if err != nil {
+			return nil, err
+		}
+
+		change.NewPrice = schedule.OriginalPrice
+		change.Changed = true
+	}
+
+	if err = updateScheduleStatus(ctx, tx, schedule.Id, status, schedule.OriginalPrice, now); err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// updateScheduleStatus changes schedule status and the remembered original price
+func updateScheduleStatus(ctx context.Context, tx *sql.Tx, id int64, status int64, originalPrice float64, now int64) error {
+	_, err := tx.ExecContext(ctx, `UPDATE product_price_schedules SET status = $1, original_price = $2, updated_at = $3 WHERE id = $4`,
+		status, originalPrice, now, id)
+	return err
+}
+
+// ErrScheduleOverlap is returned when a timed price schedule overlaps another pending or active one
+var ErrScheduleOverlap = fmt.Errorf("price schedule overlaps an existing schedule")
+
+// ErrScheduleNotCancellable is returned when cancelling a finished or cancelled schedule
+var ErrScheduleNotCancellable = fmt.Errorf("price schedule already ended")
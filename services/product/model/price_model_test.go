@@ -0,0 +1,150 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+var scheduleColumns = []string{"id", "product_id", "price", "original_price", "start_at", "end_at", "status", "operator_id", "created_at", "updated_at"}
+
+func scheduleRow(s PriceSchedule) *sqlmock.Rows {
+	return sqlmock.NewRows(scheduleColumns).
+		AddRow(s.Id, s.ProductId, s.Price, s.OriginalPrice, s.StartAt, s.EndAt, s.Status, s.OperatorId, s.CreatedAt, s.UpdatedAt)
+}
+
+func TestStartDue(t *testing.T) {
+	tests := []struct {
+		name     string
+		endAt    int64
+		deleted  bool
+		status   int64 // Schedule status after the run
+		original float64
+		changed  bool
+	}{
+		{name: "timed schedule", endAt: 200, status: PriceScheduleActive, original: 10, changed: true},
+		{name: "permanent change", status: PriceScheduleFinished, original: 10, changed: true},
+		{name: "window already closed", endAt: 150, status: PriceScheduleFinished},
+		{name: "product deleted", endAt: 200, deleted: true, status: PriceScheduleCancelled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, mock := newModelTest(t)
+			now := int64(150)
+
+			mock.ExpectQuery(`SELECT id FROM product_price_schedules`).WithArgs(now, 10).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+			mock.ExpectBegin()
+			mock.ExpectQuery(`FROM product_price_schedules WHERE id = \$1 FOR UPDATE`).WithArgs(int64(5)).
+				WillReturnRows(scheduleRow(PriceSchedule{Id: 5, ProductId: 1, Price: 8, StartAt: 100, EndAt: tt.endAt, Status: PriceSchedulePending, OperatorId: 7}))
+
+			product := mock.ExpectQuery(`SELECT price, category FROM products WHERE id = \$1 AND status <> 3 FOR UPDATE`)
+			if tt.deleted {
+				product.WillReturnError(sql.ErrNoRows)
+			} else {
+				product.WillReturnRows(sqlmock.NewRows([]string{"price", "category"}).AddRow(10, "office"))
+			}
+			if tt.changed {
+				mock.ExpectExec(`UPDATE products SET price = \$1`).WithArgs(8.0, now, int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO product_price_history`).
+					WithArgs(int64(1), 10.0, 8.0, int64(7), PriceSourceScheduleStart, int64(5), now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			mock.ExpectExec(`UPDATE product_price_schedules SET status`).WithArgs(tt.status, tt.original, now, int64(5)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			changes, err := NewPriceModel(conn).StartDue(context.Background(), now, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !tt.changed {
+				if len(changes) != 0 {
+					t.Fatalf("changes = %+v, want none", changes)
+				}
+				return
+			}
+			if len(changes) != 1 || changes[0].OldPrice != 10 || changes[0].NewPrice != 8 || changes[0].Category != "office" {
+				t.Fatalf("changes = %+v", changes)
+			}
+		})
+	}
+}
+
+func TestEndDue(t *testing.T) {
+	tests := []struct {
+		name           string
+		stillScheduled bool
+	}{
+		{name: "scheduled price reverted", stillScheduled: true},
+		{name: "newer manual price kept"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, mock := newModelTest(t)
+			now := int64(300)
+
+			mock.ExpectQuery(`SELECT id FROM product_price_schedules`).WithArgs(now, 10).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+			mock.ExpectBegin()
+			mock.ExpectQuery(`FROM product_price_schedules WHERE id = \$1 FOR UPDATE`).
+				WillReturnRows(scheduleRow(PriceSchedule{Id: 5, ProductId: 1, Price: 8, OriginalPrice: 10, StartAt: 100, EndAt: 200, Status: PriceScheduleActive, OperatorId: 7}))
+			mock.ExpectQuery(`SELECT price, category, price = \$2::DECIMAL\(10,2\) FROM products`).WithArgs(int64(1), 8.0).
+				WillReturnRows(sqlmock.NewRows([]string{"price", "category", "scheduled"}).AddRow(8, "office", tt.stillScheduled))
+			if tt.stillScheduled {
+				mock.ExpectExec(`UPDATE products SET price = \$1`).WithArgs(10.0, now, int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO product_price_history`).
+					WithArgs(int64(1), 8.0, 10.0, int64(7), PriceSourceScheduleEnd, int64(5), now).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+			mock.ExpectExec(`UPDATE product_price_schedules SET status`).WithArgs(int64(PriceScheduleFinished), 10.0, now, int64(5)).
+				WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectCommit()
+
+			changes, err := NewPriceModel(conn).EndDue(context.Background(), now, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changes) != 1 || changes[0].Changed != tt.stillScheduled {
+				t.Fatalf("changes = %+v", changes)
+			}
+		})
+	}
+}
+
+func TestCancelFinishedSchedule(t *testing.T) {
+	conn, mock := newModelTest(t)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM product_price_schedules WHERE id = \$1 FOR UPDATE`).
+		WillReturnRows(scheduleRow(PriceSchedule{Id: 5, ProductId: 1, Status: PriceScheduleFinished}))
+	mock.ExpectRollback()
+
+	_, err := NewPriceModel(conn).CancelSchedule(context.Background(), 5, 7, 300)
+	if err != ErrScheduleNotCancellable {
+		t.Fatalf("err = %v, want %v", err, ErrScheduleNotCancellable)
+	}
+}
+
+func TestInsertScheduleOverlap(t *testing.T) {
+	conn, mock := newModelTest(t)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id FROM products WHERE id = \$1 AND status <> 3 FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM product_price_schedules`).WithArgs(int64(1), int64(100), int64(200)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectRollback()
+
+	_, err := NewPriceModel(conn).InsertSchedule(context.Background(), &PriceSchedule{ProductId: 1, Price: 8, StartAt: 100, EndAt: 200})
+	if err != ErrScheduleOverlap {
+		t.Fatalf("err = %v, want %v", err, ErrScheduleOverlap)
+	}
+}
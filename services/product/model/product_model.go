@@ -15,35 +15,63 @@ var _ ProductModel = (*customProductModel)(nil)
 type (
 	// ProductModel is an interface for product database operations
 	ProductModel interface {
-		// Insert a new product
-		Insert(ctx context.Context, data *Product) (sql.Result, error)
+		// Insert a new product, its initial stock is recorded in the inventory ledger
+		Insert(ctx context.Context, data *Product, operatorId int64) (sql.Result, error)
 
 		// FindOne by product ID
 		FindOne(ctx context.Context, id int64) (*Product, error)
 
-		// Update product information
-		Update(ctx context.Context, data *Product) error
+		// FindByIds finds published products by IDs, missing ones are left out
+		FindByIds(ctx context.Context, ids []int64) ([]*Product, error)
 
-		// Delete product (soft delete by setting status to 2)
+		// Update product information if data.Version is still current, a price change is recorded in price history
+		// Stock and sales are left alone. Returns the new version
+		Update(ctx context.Context, data *Product, operatorId int64) (int64, error)
+
+		// FindOneAnyStatus finds product by ID regardless of status (admin)
+		FindOneAnyStatus(ctx context.Context, id int64) (*Product, error)
+
+		// Delete product (soft delete by setting status to deleted)
 		Delete(ctx context.Context, id int64) error
 
+		// Restore a soft deleted product as unpublished
+		Restore(ctx context.Context, id int64) error
+
+		// SetPurchaseLimits sets the per-order and per-user purchase limits of a product
+		SetPurchaseLimits(ctx context.Context, id int64, maxPerOrder, maxPerUser, limitWindow int64) error
+
+		// UpdateStatus changes product status and scheduled publish time
+		UpdateStatus(ctx context.Context, id int64, status int64, publishAt int64) error
+
+		// PublishDue publishes products whose scheduled publish time has passed
+		PublishDue(ctx context.Context, now int64) ([]*Product, error)
+
 		// List products with pagination and filters
 		List(ctx context.Context, page, pageSize int32, category, sortBy, order string) ([]*Product, int64, error)
 
 		// Search products by keyword
 		Search(ctx context.Context, keyword string, page, pageSize int32) ([]*Product, int64, error)
 
-		// UpdateStock updates product stock (for order processing)
-		UpdateStock(ctx context.Context, productId int64, quantity int64) (int64, string, error)
+		// UpdateStock updates product stock and records the movement
+		UpdateStock(ctx context.Context, productId int64, quantity int64, change StockChange) (int64, string, error)
 
-		// BatchUpdateStock updates multiple products' stock in a transaction
-		BatchUpdateStock(ctx context.Context, items []StockUpdateItem) ([]StockUpdateResult, error)
+		// BatchUpdateStock updates multiple products' stock in a transaction and records the movements
+		BatchUpdateStock(ctx context.Context, items []StockUpdateItem, change StockChange) ([]StockUpdateResult, error)
 
 		// CheckStock checks if products are in stock (batch check)
 		CheckStock(ctx context.Context, productIds []int64) (map[int64]int64, error)
 
 		// IncrementSales increments product sales count and returns new sales and category
 		IncrementSales(ctx context.Context, productId int64, quantity int64) (int64, string, error)
+
+		// UpsertBySku inserts or updates products by SKU in a single transaction
+		UpsertBySku(ctx context.Context, products []*Product, operatorId int64) ([]UpsertResult, error)
+
+		// ListForExport returns non-deleted products with id > afterId ordered by id
+		ListForExport(ctx context.Context, afterId int64, limit int) ([]*Product, error)
+
+		// ListCategories returns the categories of published products
+		ListCategories(ctx context.Context) ([]string, error)
 	}
 
 	customProductModel struct {
@@ -59,13 +87,31 @@ func NewProductModel(conn sqlx.SqlConn) ProductModel {
 }
 
 // Insert inserts a new product into database
-func (m *customProductModel) Insert(ctx context.Context, data *Product) (sql.Result, error) {
-	query := `INSERT INTO products (name, description, price, stock, category, images, attributes, sales, status, created_at, updated_at)
-			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+// Runs in a transaction so that the initial stock and its inventory movement are written together
+func (m *customProductModel) Insert(ctx context.Context, data *Product, operatorId int64) (sql.Result, error) {
+	db, err := m.conn.RawDB()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	query := `INSERT INTO products (sku, name, description, price, stock, category, images, attributes, sales, status, publish_at, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 			  RETURNING id`
 
 	var id int64
-	err := m.conn.QueryRowCtx(ctx, &id, query,
+	err = tx.QueryRowContext(ctx, query,
+		data.Sku,
 		data.Name,
 		data.Description,
 		data.Price,
@@ -75,11 +121,27 @@ func (m *customProductModel) Insert(ctx context.Context, data *Product) (sql.Res
 		data.Attributes,
 		data.Sales,
 		data.Status,
+		data.PublishAt,
 		data.CreatedAt,
 		data.UpdatedAt,
-	)
+	).Scan(&id)
 
 	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return nil, ErrDuplicateSku
+		}
+		return nil, err
+	}
+
+	err = changeWarehouseStock(ctx, tx, id, data.Stock, data.Stock, StockChange{
+		Reason:     StockReasonInitial,
+		OperatorId: operatorId,
+	}, data.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
 		return nil, err
 	}
 
@@ -87,9 +149,16 @@ func (m *customProductModel) Insert(ctx context.Context, data *Product) (sql.Res
 	return &insertResult{lastInsertId: id}, nil
 }
 
+// productStockColumn selects the stock customers see. A bundle has no stock of its own, it is available
+// as many times as its scarcest component allows and unpublished components make it unavailable
+const productStockColumn = `COALESCE((SELECT MIN(CASE WHEN c.status = 1 THEN c.stock / bi.quantity ELSE 0 END)
+			  FROM product_bundle_items bi JOIN products c ON c.id = bi.component_id
+			  WHERE bi.bundle_id = products.id), products.stock) AS stock`
+
 // FindOne finds product by ID
 func (m *customProductModel) FindOne(ctx context.Context, id int64) (*Product, error) {
-	query := `SELECT id, name, description, price, stock, category, images, attributes, sales, status, created_at, updated_at
+	query := `SELECT id, sku, name, description, price, ` + productStockColumn + `, category, images, attributes, sales, status, publish_at, created_at, updated_at, version,
+			  max_per_order, max_per_user, limit_window
 			  FROM products
 			  WHERE id = $1 AND status = 1`
 
@@ -106,25 +175,181 @@ func (m *customProductModel) FindOne(ctx context.Context, id int64) (*Product, e
 	}
 }
 
-// Update updates product information
-func (m *customProductModel) Update(ctx context.Context, data *Product) error {
+// FindByIds finds published products by IDs in a single query
+// The result is in no particular order and leaves out IDs that were not found
+func (m *customProductModel) FindByIds(ctx context.Context, ids []int64) ([]*Product, error) {
+	if len(ids) == 0 {
+		return []*Product{}, nil
+	}
+
+	query := `SELECT id, sku, name, description, price, ` + productStockColumn + `, category, images, attributes, sales, status, publish_at, created_at, updated_at, version,
+			  max_per_order, max_per_user, limit_window
+			  FROM products
+			  WHERE id = ANY($1) AND status = 1`
+
+	var products []*Product
+	err := m.conn.QueryRowsCtx(ctx, &products, query, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// FindOneAnyStatus finds product by ID including drafts, unpublished and deleted products
+func (m *customProductModel) FindOneAnyStatus(ctx context.Context, id int64) (*Product, error) {
+	query := `SELECT id, sku, name, description, price, stock, category, images, attributes, sales, status, publish_at, created_at, updated_at, version,
+			  max_per_order, max_per_user, limit_window
+			  FROM products
+			  WHERE id = $1`
+
+	var product Product
+	err := m.conn.QueryRowCtx(ctx, &product, query, id)
+
+	switch err {
+	case nil:
+		return &product, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// Update updates the editable fields of a product (name, description, price, category, images, attributes)
+// The write only succeeds if data.Version is the current version, so concurrent edits cannot overwrite each other.
+// Stock and sales are not written, they are changed by dedicated calls that must not be clobbered.
+// Runs in a transaction so that a price change and its history row are written together
+func (m *customProductModel) Update(ctx context.Context, data *Product, operatorId int64) (int64, error) {
+	db, err := m.conn.RawDB()
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Lock the row and read the current price and version
+	var oldPrice float64
+	var version int64
+	err = tx.QueryRowContext(ctx, `SELECT price, version FROM products WHERE id = $1 AND status <> 3 FOR UPDATE`, data.Id).Scan(&oldPrice, &version)
+	if err == sql.ErrNoRows {
+		return 0, ErrNotFound
+	}
+	if err != nil {
+		return 0, err
+	}
+	if version != data.Version {
+		err = ErrVersionConflict
+		return 0, err
+	}
+
 	query := `UPDATE products
-			  SET name = $1, description = $2, price = $3, stock = $4, category = $5,
-			      images = $6, attributes = $7, updated_at = $8
-			  WHERE id = $9 AND status = 1`
+			  SET name = $1, description = $2, price = $3, category = $4,
+			      images = $5, attributes = $6, updated_at = $7, version = version + 1
+			  WHERE id = $8
+			  RETURNING version`
 
-	result, err := m.conn.ExecCtx(ctx, query,
+	err = tx.QueryRowContext(ctx, query,
 		data.Name,
 		data.Description,
 		data.Price,
-		data.Stock,
 		data.Category,
 		data.Images,
 		data.Attributes,
 		data.UpdatedAt,
 		data.Id,
-	)
+	).Scan(&version)
+	if err != nil {
+		return 0, err
+	}
 
+	err = insertPriceHistory(ctx, tx, data.Id, oldPrice, data.Price, operatorId, PriceSourceManual, 0, data.UpdatedAt)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.Commit()
+	return version, err
+}
+
+// Delete soft deletes product by setting status to deleted
+// Scheduled publishing is cleared so the product is not published by the scheduler
+func (m *customProductModel) Delete(ctx context.Context, id int64) error {
+	query := `UPDATE products
+			  SET status = $1, publish_at = 0, updated_at = EXTRACT(EPOCH FROM NOW())::BIGINT
+			  WHERE id = $2 AND status <> $1`
+	return m.execAffectOne(ctx, query, ProductStatusDeleted, id)
+}
+
+// Restore restores a soft deleted product as unpublished
+// The admin must publish it again explicitly
+func (m *customProductModel) Restore(ctx context.Context, id int64) error {
+	query := `UPDATE products
+			  SET status = $1, updated_at = EXTRACT(EPOCH FROM NOW())::BIGINT
+			  WHERE id = $2 AND status = $3`
+	return m.execAffectOne(ctx, query, ProductStatusUnpublished, id, ProductStatusDeleted)
+}
+
+// UpdateStatus changes status and scheduled publish time of a non-deleted product
+func (m *customProductModel) UpdateStatus(ctx context.Context, id int64, status int64, publishAt int64) error {
+	query := `UPDATE products
+			  SET status = $1, publish_at = $2, version = version + 1, updated_at = EXTRACT(EPOCH FROM NOW())::BIGINT
+			  WHERE id = $3 AND status <> $4`
+	return m.execAffectOne(ctx, query, status, publishAt, id, ProductStatusDeleted)
+}
+
+// SetPurchaseLimits sets the purchase limits of a product that is not deleted
+// The version is bumped so that an edit based on the old limits conflicts
+func (m *customProductModel) SetPurchaseLimits(ctx context.Context, id int64, maxPerOrder, maxPerUser, limitWindow int64) error {
+	query := `UPDATE products
+			  SET max_per_order = $1, max_per_user = $2, limit_window = $3, version = version + 1, updated_at = EXTRACT(EPOCH FROM NOW())::BIGINT
+			  WHERE id = $4 AND status <> $5`
+	return m.execAffectOne(ctx, query, maxPerOrder, maxPerUser, limitWindow, id, ProductStatusDeleted)
+}
+
+// PublishDue publishes all draft/unpublished products whose publish_at has passed
+// Returns the published products so that callers can invalidate caches
+func (m *customProductModel) PublishDue(ctx context.Context, now int64) ([]*Product, error) {
+	query := `UPDATE products
+			  SET status = $1, publish_at = 0, version = version + 1, updated_at = $2
+			  WHERE status IN ($3, $4) AND publish_at > 0 AND publish_at <= $2
+			  RETURNING id, category`
+
+	db, err := m.conn.RawDB()
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.QueryContext(ctx, query, ProductStatusPublished, now, ProductStatusDraft, ProductStatusUnpublished)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var products []*Product
+	for rows.Next() {
+		var product Product
+		if err := rows.Scan(&product.Id, &product.Category); err != nil {
+			return nil, err
+		}
+		products = append(products, &product)
+	}
+
+	return products, rows.Err()
+}
+
+// execAffectOne executes an update and returns ErrNotFound if no row was affected
+func (m *customProductModel) execAffectOne(ctx context.Context, query string, args ...interface{}) error {
+	result, err := m.conn.ExecCtx(ctx, query, args...)
 	if err != nil {
 		return err
 	}
@@ -141,13 +366,6 @@ func (m *customProductModel) Update(ctx context.Context, data *Product) error {
 	return nil
 }
 
-// Delete soft deletes product by setting status to 2
-func (m *customProductModel) Delete(ctx context.Context, id int64) error {
-	query := `UPDATE products SET status = 2 WHERE id = $1`
-	_, err := m.conn.ExecCtx(ctx, query, id)
-	return err
-}
-
 // List returns paginated products with filters
 func (m *customProductModel) List(ctx context.Context, page, pageSize int32, category, sortBy, order string) ([]*Product, int64, error) {
 	// Build WHERE clause
@@ -190,7 +408,8 @@ func (m *customProductModel) List(ctx context.Context, page, pageSize int32, cat
 	offset := (page - 1) * pageSize
 	args = append(args, pageSize, offset)
 
-	query := fmt.Sprintf(`SELECT id, name, description, price, stock, category, images, attributes, sales, status, created_at, updated_at
+	query := fmt.Sprintf(`SELECT id, sku, name, description, price, `+productStockColumn+`, category, images, attributes, sales, status, publish_at, created_at, updated_at, version,
+						  max_per_order, max_per_user, limit_window
 						  FROM products
 						  %s
 						  ORDER BY %s
@@ -215,6 +434,7 @@ func (m *customProductModel) List(ctx context.Context, page, pageSize int32, cat
 
 		err := rows.Scan(
 			&product.Id,
+			&product.Sku,
 			&product.Name,
 			&product.Description,
 			&product.Price,
@@ -224,8 +444,13 @@ func (m *customProductModel) List(ctx context.Context, page, pageSize int32, cat
 			&product.Attributes,
 			&product.Sales,
 			&product.Status,
+			&product.PublishAt,
 			&product.CreatedAt,
 			&product.UpdatedAt,
+			&product.Version,
+			&product.MaxPerOrder,
+			&product.MaxPerUser,
+			&product.LimitWindow,
 		)
 		if err != nil {
 			return nil, 0, err
@@ -254,7 +479,8 @@ func (m *customProductModel) Search(ctx context.Context, keyword string, page, p
 
 	// Get paginated results
 	offset := (page - 1) * pageSize
-	query := `SELECT id, name, description, price, stock, category, images, attributes, sales, status, created_at, updated_at
+	query := `SELECT id, sku, name, description, price, ` + productStockColumn + `, category, images, attributes, sales, status, publish_at, created_at, updated_at, version,
+			  max_per_order, max_per_user, limit_window
 			  FROM products
 			  WHERE status = 1 AND (LOWER(name) LIKE $1 OR LOWER(description) LIKE $1)
 			  ORDER BY sales DESC, created_at DESC
@@ -278,6 +504,7 @@ func (m *customProductModel) Search(ctx context.Context, keyword string, page, p
 
 		err := rows.Scan(
 			&product.Id,
+			&product.Sku,
 			&product.Name,
 			&product.Description,
 			&product.Price,
@@ -287,8 +514,13 @@ func (m *customProductModel) Search(ctx context.Context, keyword string, page, p
 			&product.Attributes,
 			&product.Sales,
 			&product.Status,
+			&product.PublishAt,
 			&product.CreatedAt,
 			&product.UpdatedAt,
+			&product.Version,
+			&product.MaxPerOrder,
+			&product.MaxPerUser,
+			&product.LimitWindow,
 		)
 		if err != nil {
 			return nil, 0, err
@@ -302,19 +534,42 @@ func (m *customProductModel) Search(ctx context.Context, keyword string, page, p
 
 // UpdateStock updates product stock atomically
 // quantity can be positive (increase) or negative (decrease)
-func (m *customProductModel) UpdateStock(ctx context.Context, productId int64, quantity int64) (int64, string, error) {
+// Stock of drafts and unpublished products can be changed too, only deleted products are left alone
+// The change is recorded in inventory_movements in the same transaction
+func (m *customProductModel) UpdateStock(ctx context.Context, productId int64, quantity int64, change StockChange) (int64, string, error) {
 	query := `UPDATE products
 			  SET stock = stock + $1, updated_at = EXTRACT(EPOCH FROM NOW())::BIGINT
-			  WHERE id = $2 AND status = 1 AND stock + $1 >= 0
-			  RETURNING stock, category`
+			  WHERE id = $2 AND status <> 3 AND stock + $1 >= 0
+			  RETURNING stock, category, updated_at`
 	db, err := m.conn.RawDB()
 	if err != nil {
 		return 0, "", err
 	}
 
-	var newStock int64
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, "", err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// A bundle has no stock of its own, it is ordered through BatchUpdateStock which changes its components
+	bundles, err := findBundleItems(ctx, tx, []int64{productId})
+	if err != nil {
+		return 0, "", err
+	}
+	if len(bundles) > 0 {
+		err = ErrBundleStock
+		return 0, "", err
+	}
+
+	var newStock, updatedAt int64
 	var category string
-	err = db.QueryRowContext(ctx, query, quantity, productId).Scan(&newStock, &category)
+	err = tx.QueryRowContext(ctx, query, quantity, productId).Scan(&newStock, &category, &updatedAt)
 	if err == sql.ErrNoRows {
 		return 0, "", ErrNotFound
 	}
@@ -323,16 +578,35 @@ func (m *customProductModel) UpdateStock(ctx context.Context, productId int64, q
 		return 0, "", err
 	}
 
+	if err = changeWarehouseStock(ctx, tx, productId, quantity, newStock, change, updatedAt); err != nil {
+		return 0, "", err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, "", err
+	}
+
 	return newStock, category, nil
 }
 
 // CheckStock checks stock availability for multiple products
+// Bundles report the number of bundles their components can make
 func (m *customProductModel) CheckStock(ctx context.Context, productIds []int64) (map[int64]int64, error) {
 	if len(productIds) == 0 {
 		return map[int64]int64{}, nil
 	}
 
-	query := `SELECT id, stock FROM products WHERE id = ANY($1) AND status = 1`
+	// A bundle is available as many times as its scarcest component, unpublished components make it unavailable
+	query := `SELECT p.id, COALESCE(b.available, p.stock)
+			  FROM products p
+			  LEFT JOIN (
+				  SELECT bi.bundle_id, MIN(CASE WHEN c.status = 1 THEN c.stock / bi.quantity ELSE 0 END) AS available
+				  FROM product_bundle_items bi
+				  JOIN products c ON c.id = bi.component_id
+				  WHERE bi.bundle_id = ANY($1)
+				  GROUP BY bi.bundle_id
+			  ) b ON b.bundle_id = p.id
+			  WHERE p.id = ANY($1) AND p.status = 1`
 
 	// Use RawDB for custom query
 	db, err := m.conn.RawDB()
@@ -388,6 +662,158 @@ func (m *customProductModel) IncrementSales(ctx context.Context, productId int64
 	return newSales, category, nil
 }
 
+// UpsertBySku inserts or updates products by SKU in a single transaction
+// Each row runs inside its own savepoint so that a failing row is reported
+// in its UpsertResult without aborting the rest of the batch.
+// Stock and sales are only set for new products, existing stock is left untouched.
+func (m *customProductModel) UpsertBySku(ctx context.Context, products []*Product, operatorId int64) ([]UpsertResult, error) {
+	if len(products) == 0 {
+		return []UpsertResult{}, nil
+	}
+
+	db, err := m.conn.RawDB()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Lock existing SKUs and load their category (cache invalidation) and price (price history)
+	skus := make([]string, 0, len(products))
+	for _, p := range products {
+		skus = append(skus, p.Sku)
+	}
+
+	rows, err := tx.QueryContext(ctx, `SELECT sku, category, price FROM products WHERE sku = ANY($1) FOR UPDATE`, pq.Array(skus))
+	if err != nil {
+		return nil, err
+	}
+	oldCategories := make(map[string]string)
+	oldPrices := make(map[string]float64)
+	for rows.Next() {
+		var sku, category string
+		var price float64
+		if err = rows.Scan(&sku, &category, &price); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		oldCategories[sku] = category
+		oldPrices[sku] = price
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	query := `INSERT INTO products (sku, name, description, price, stock, category, images, attributes, sales, status, publish_at, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $6, $7, $8, 0, $9, 0, $10, $10)
+			  ON CONFLICT (sku) WHERE sku <> '' DO UPDATE
+			  SET name = EXCLUDED.name, description = EXCLUDED.description, price = EXCLUDED.price,
+			      category = EXCLUDED.category, images = EXCLUDED.images, attributes = EXCLUDED.attributes,
+			      status = EXCLUDED.status, publish_at = 0, updated_at = EXCLUDED.updated_at,
+			      version = products.version + 1
+			  WHERE products.status <> $11
+			  RETURNING id, (xmax = 0) AS inserted`
+
+	results := make([]UpsertResult, len(products))
+	for i, p := range products {
+		if _, err = tx.ExecContext(ctx, "SAVEPOINT upsert_row"); err != nil {
+			return nil, err
+		}
+
+		var result UpsertResult
+		rowErr := tx.QueryRowContext(ctx, query,
+			p.Sku,
+			p.Name,
+			p.Description,
+			p.Price,
+			p.Stock,
+			p.Category,
+			p.Images,
+			p.Attributes,
+			p.Status,
+			p.UpdatedAt,
+			ProductStatusDeleted,
+		).Scan(&result.Id, &result.Inserted)
+		if rowErr == sql.ErrNoRows {
+			// The SKU belongs to a deleted product, importing must not bring it back
+			rowErr = ErrSkuDeleted
+		}
+
+		if rowErr == nil && !result.Inserted {
+			rowErr = insertPriceHistory(ctx, tx, result.Id, oldPrices[p.Sku], p.Price, operatorId, PriceSourceImport, 0, p.UpdatedAt)
+		}
+		if rowErr == nil && result.Inserted {
+			rowErr = changeWarehouseStock(ctx, tx, result.Id, p.Stock, p.Stock, StockChange{
+				Reason:     StockReasonImport,
+				OperatorId: operatorId,
+			}, p.UpdatedAt)
+		}
+
+		if rowErr != nil {
+			// Undo only this row and keep the transaction usable
+			if _, err = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT upsert_row"); err != nil {
+				return nil, err
+			}
+			results[i] = UpsertResult{Err: rowErr}
+			continue
+		}
+
+		if _, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT upsert_row"); err != nil {
+			return nil, err
+		}
+
+		if !result.Inserted {
+			result.OldCategory = oldCategories[p.Sku]
+		}
+		results[i] = result
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// ListForExport returns non-deleted products after the given ID (keyset pagination)
+func (m *customProductModel) ListForExport(ctx context.Context, afterId int64, limit int) ([]*Product, error) {
+	query := `SELECT id, sku, name, description, price, stock, category, images, attributes, sales, status, publish_at, created_at, updated_at, version,
+			  max_per_order, max_per_user, limit_window
+			  FROM products
+			  WHERE id > $1 AND status <> $2
+			  ORDER BY id ASC
+			  LIMIT $3`
+
+	var products []*Product
+	err := m.conn.QueryRowsCtx(ctx, &products, query, afterId, ProductStatusDeleted, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// ListCategories returns the distinct categories of published products ordered by name
+func (m *customProductModel) ListCategories(ctx context.Context) ([]string, error) {
+	var categories []string
+	err := m.conn.QueryRowsCtx(ctx, &categories, `SELECT DISTINCT category FROM products WHERE status = 1 ORDER BY category`)
+	if err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
 // StockUpdateItem represents a single stock update operation
 type StockUpdateItem struct {
 	ProductId int64
@@ -395,14 +821,28 @@ type StockUpdateItem struct {
 }
 
 // StockUpdateResult represents the result of a stock update
+// For a bundle NewStock is the number of bundles its components can still make
+// and Components holds the changes applied to the components
 type StockUpdateResult struct {
+	ProductId  int64
+	NewStock   int64
+	Category   string
+	Components []ComponentStockResult
+}
+
+// ComponentStockResult is the stock change of a component caused by a bundle update
+type ComponentStockResult struct {
 	ProductId int64
+	Quantity  int64
 	NewStock  int64
+	Category  string
 }
 
 // BatchUpdateStock updates multiple products' stock in a single transaction
 // All updates succeed or all fail (atomic operation)
-func (m *customProductModel) BatchUpdateStock(ctx context.Context, items []StockUpdateItem) ([]StockUpdateResult, error) {
+// Every update is recorded in inventory_movements with the same reason and reference
+// Only deleted products are rejected, so cancelled orders of products taken off sale still return their stock
+func (m *customProductModel) BatchUpdateStock(ctx context.Context, items []StockUpdateItem, change StockChange) ([]StockUpdateResult, error) {
 	if len(items) == 0 {
 		return []StockUpdateResult{}, nil
 	}
@@ -425,17 +865,39 @@ func (m *customProductModel) BatchUpdateStock(ctx context.Context, items []Stock
 		}
 	}()
 
+	productIds := make([]int64, 0, len(items))
+	for _, item := range items {
+		productIds = append(productIds, item.ProductId)
+	}
+
+	// Bundles have no stock of their own, their components are updated instead
+	bundles, err := findBundleItems(ctx, tx, productIds)
+	if err != nil {
+		return nil, err
+	}
+
 	results := make([]StockUpdateResult, 0, len(items))
 
 	// Update each product's stock
 	query := `UPDATE products
 			  SET stock = stock + $1, updated_at = EXTRACT(EPOCH FROM NOW())::BIGINT
-			  WHERE id = $2 AND status = 1 AND stock + $1 >= 0
-			  RETURNING stock`
+			  WHERE id = $2 AND status <> 3 AND stock + $1 >= 0
+			  RETURNING stock, updated_at, category`
 
 	for _, item := range items {
-		var newStock int64
-		err = tx.QueryRowContext(ctx, query, item.Quantity, item.ProductId).Scan(&newStock)
+		if components, ok := bundles[item.ProductId]; ok {
+			var result StockUpdateResult
+			result, err = updateBundleStock(ctx, tx, query, item, components, change)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, result)
+			continue
+		}
+
+		var newStock, updatedAt int64
+		var category string
+		err = tx.QueryRowContext(ctx, query, item.Quantity, item.ProductId).Scan(&newStock, &updatedAt, &category)
 
 		if err == sql.ErrNoRows {
 			// Product not found or insufficient stock
@@ -446,9 +908,14 @@ func (m *customProductModel) BatchUpdateStock(ctx context.Context, items []Stock
 			return nil, err
 		}
 
+		if err = changeWarehouseStock(ctx, tx, item.ProductId, item.Quantity, newStock, change, updatedAt); err != nil {
+			return nil, err
+		}
+
 		results = append(results, StockUpdateResult{
 			ProductId: item.ProductId,
 			NewStock:  newStock,
+			Category:  category,
 		})
 	}
 
@@ -460,12 +927,83 @@ func (m *customProductModel) BatchUpdateStock(ctx context.Context, items []Stock
 	return results, nil
 }
 
+// updateBundleStock applies a bundle stock change to its components inside the caller's transaction
+// Every component movement carries the reason and reference of the bundle change, so cancelling
+// an order returns the components to the warehouses they were taken from
+func updateBundleStock(ctx context.Context, tx *sql.Tx, query string, item StockUpdateItem, components []BundleItem, change StockChange) (StockUpdateResult, error) {
+	result := StockUpdateResult{
+		ProductId:  item.ProductId,
+		NewStock:   -1,
+		Components: make([]ComponentStockResult, 0, len(components)),
+	}
+
+	// The bundle itself must not be deleted like any other product
+	err := tx.QueryRowContext(ctx, `SELECT category FROM products WHERE id = $1 AND status <> 3`, item.ProductId).Scan(&result.Category)
+	if err == sql.ErrNoRows {
+		return result, ErrInsufficientStock
+	}
+	if err != nil {
+		return result, err
+	}
+
+	for _, component := range components {
+		quantity := item.Quantity * component.Quantity
+
+		var newStock, updatedAt int64
+		var category string
+		err = tx.QueryRowContext(ctx, query, quantity, component.ComponentId).Scan(&newStock, &updatedAt, &category)
+		if err == sql.ErrNoRows {
+			// Component deleted or insufficient stock
+			return result, ErrInsufficientStock
+		}
+		if err != nil {
+			return result, err
+		}
+
+		if err = changeWarehouseStock(ctx, tx, component.ComponentId, quantity, newStock, change, updatedAt); err != nil {
+			return result, err
+		}
+
+		if available := newStock / component.Quantity; result.NewStock < 0 || available < result.NewStock {
+			result.NewStock = available
+		}
+		result.Components = append(result.Components, ComponentStockResult{
+			ProductId: component.ComponentId,
+			Quantity:  quantity,
+			NewStock:  newStock,
+			Category:  category,
+		})
+	}
+
+	return result, nil
+}
+
+// insertPriceHistory records a price change inside the caller's transaction
+// Prices are compared as DECIMAL(10,2) like the products.price column, nothing is written if the price did not change
+func insertPriceHistory(ctx context.Context, tx *sql.Tx, productId int64, oldPrice, newPrice float64, operatorId int64, source string, scheduleId int64, now int64) error {
+	query := `INSERT INTO product_price_history (product_id, old_price, new_price, operator_id, source, schedule_id, created_at)
+			  SELECT $1, $2::DECIMAL(10,2), $3::DECIMAL(10,2), $4, $5, $6, $7
+			  WHERE $2::DECIMAL(10,2) <> $3::DECIMAL(10,2)`
+
+	_, err := tx.ExecContext(ctx, query, productId, oldPrice, newPrice, operatorId, source, scheduleId, now)
+	return err
+}
+
 // ErrNotFound is returned when a product is not found
 var ErrNotFound = sqlx.ErrNotFound
 
 // ErrInsufficientStock is returned when stock is insufficient
 var ErrInsufficientStock = fmt.Errorf("insufficient stock")
 
+// ErrSkuDeleted is returned for an import row whose SKU belongs to a deleted product
+var ErrSkuDeleted = fmt.Errorf("sku belongs to a deleted product, restore it first")
+
+// ErrDuplicateSku is returned when the SKU is already used by another product
+var ErrDuplicateSku = fmt.Errorf("duplicate sku")
+
+// ErrVersionConflict is returned when a product was changed since the caller read it
+var ErrVersionConflict = fmt.Errorf("version conflict")
+
 // insertResult implements sql.Result for Insert operation
 type insertResult struct {
 	lastInsertId int64
@@ -0,0 +1,181 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+func newModelTest(t *testing.T) (sqlx.SqlConn, sqlmock.Sqlmock) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Error(err)
+		}
+		db.Close()
+	})
+
+	return sqlx.NewSqlConnFromDB(db), mock
+}
+
+func TestUpsertBySku(t *testing.T) {
+	conn, mock := newModelTest(t)
+	products := []*Product{
+		{Sku: "PEN-1", Name: "Pen", Price: 2.5, Stock: 5, Category: "office", UpdatedAt: 100},
+		{Sku: "INK-1", Name: "Ink", Price: 4, Category: "office", UpdatedAt: 100},
+		{Sku: "PAD-1", Name: "Pad", Price: 3, Category: "paper", UpdatedAt: 100},
+		{Sku: "OLD-1", Name: "Old", Price: 1, Category: "paper", UpdatedAt: 100},
+	}
+	rowErr := errors.New("value too long")
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT sku, category, price FROM products WHERE sku = ANY\(\$1\) FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"sku", "category", "price"}).
+			AddRow("INK-1", "supplies", 3.5).
+			AddRow("OLD-1", "paper", 1))
+
+	// PEN-1 is new, its stock goes to the default warehouse and the ledger
+	mock.ExpectExec(`SAVEPOINT upsert_row`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`INSERT INTO products`).
+		WithArgs("PEN-1", "Pen", "", 2.5, int64(5), "office", sqlmock.AnyArg(), "", int64(0), int64(100), int64(ProductStatusDeleted)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "inserted"}).AddRow(1, true))
+	mock.ExpectQuery(`SELECT id FROM warehouses ORDER BY priority, id LIMIT 1`).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectExec(`INSERT INTO warehouse_stock`).WithArgs(int64(1), int64(1), int64(5), int64(100)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`INSERT INTO inventory_movements`).
+		WithArgs(int64(1), int64(1), int64(5), int64(5), StockReasonImport, "", int64(7), int64(100)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`RELEASE SAVEPOINT upsert_row`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// INK-1 exists, its price change is recorded
+	mock.ExpectExec(`SAVEPOINT upsert_row`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`INSERT INTO products`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "inserted"}).AddRow(2, false))
+	mock.ExpectExec(`INSERT INTO product_price_history`).
+		WithArgs(int64(2), 3.5, 4.0, int64(7), PriceSourceImport, int64(0), int64(100)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`RELEASE SAVEPOINT upsert_row`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// PAD-1 fails, only its row is undone
+	mock.ExpectExec(`SAVEPOINT upsert_row`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`INSERT INTO products`).WillReturnError(rowErr)
+	mock.ExpectExec(`ROLLBACK TO SAVEPOINT upsert_row`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	// OLD-1 belongs to a deleted product, the conflict update matches no row
+	mock.ExpectExec(`SAVEPOINT upsert_row`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(`INSERT INTO products`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "inserted"}))
+	mock.ExpectExec(`ROLLBACK TO SAVEPOINT upsert_row`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	mock.ExpectCommit()
+
+	results, err := NewProductModel(conn).UpsertBySku(context.Background(), products, 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r := results[0]; r.Err != nil || r.Id != 1 || !r.Inserted || r.OldCategory != "" {
+		t.Fatalf("new product result = %+v", r)
+	}
+	if r := results[1]; r.Err != nil || r.Id != 2 || r.Inserted || r.OldCategory != "supplies" {
+		t.Fatalf("updated product result = %+v", r)
+	}
+	if r := results[2]; r.Err != rowErr || r.Id != 0 {
+		t.Fatalf("failed row result = %+v", r)
+	}
+	if r := results[3]; r.Err != ErrSkuDeleted || r.Id != 0 {
+		t.Fatalf("deleted product result = %+v", r)
+	}
+}
+
+func TestUpsertBySkuRollsBackOnSavepointError(t *testing.T) {
+	conn, mock := newModelTest(t)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT sku, category, price FROM products`).
+		WillReturnRows(sqlmock.NewRows([]string{"sku", "category", "price"}))
+	mock.ExpectExec(`SAVEPOINT upsert_row`).WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	_, err := NewProductModel(conn).UpsertBySku(context.Background(), []*Product{{Sku: "PEN-1"}}, 7)
+	if err != sql.ErrConnDone {
+		t.Fatalf("err = %v, want %v", err, sql.ErrConnDone)
+	}
+}
+
+func TestUpdateChecksVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		current int64 // Version of the locked row, 0 = deleted
+		version int64 // New version
+		err     error
+	}{
+		{name: "current version", current: 3, version: 4},
+		{name: "changed since read", current: 4, err: ErrVersionConflict},
+		{name: "deleted", err: ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, mock := newModelTest(t)
+
+			mock.ExpectBegin()
+			lock := mock.ExpectQuery(`SELECT price, version FROM products WHERE id = \$1 AND status <> 3 FOR UPDATE`).WithArgs(int64(1))
+			if tt.current == 0 {
+				lock.WillReturnError(sql.ErrNoRows)
+			} else {
+				lock.WillReturnRows(sqlmock.NewRows([]string{"price", "version"}).AddRow(2.5, tt.current))
+			}
+			if tt.err != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectQuery(`version = version \+ 1\s+WHERE id = \$8\s+RETURNING version`).
+					WithArgs("Pen", "", 3.0, "office", sqlmock.AnyArg(), "", int64(100), int64(1)).
+					WillReturnRows(sqlmock.NewRows([]string{"version"}).AddRow(tt.version))
+				mock.ExpectExec(`INSERT INTO product_price_history`).
+					WithArgs(int64(1), 2.5, 3.0, int64(7), PriceSourceManual, int64(0), int64(100)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			}
+
+			version, err := NewProductModel(conn).Update(context.Background(), &Product{
+				Id:        1,
+				Name:      "Pen",
+				Price:     3,
+				Category:  "office",
+				UpdatedAt: 100,
+				Version:   3,
+			}, 7)
+			if err != tt.err || version != tt.version {
+				t.Fatalf("version = %d, err = %v, want %d, %v", version, err, tt.version, tt.err)
+			}
+		})
+	}
+}
+
+func TestStatusAndLimitsBumpVersion(t *testing.T) {
+	conn, mock := newModelTest(t)
+	m := NewProductModel(conn)
+
+	mock.ExpectExec(`SET status = \$1, publish_at = \$2, version = version \+ 1`).
+		WithArgs(ProductStatusUnpublished, int64(0), int64(1), ProductStatusDeleted).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`limit_window = \$3, version = version \+ 1`).
+		WithArgs(int64(2), int64(5), int64(86400), int64(1), ProductStatusDeleted).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	if err := m.UpdateStatus(context.Background(), 1, ProductStatusUnpublished, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.SetPurchaseLimits(context.Background(), 1, 2, 5, 86400); err != nil {
+		t.Fatal(err)
+	}
+}
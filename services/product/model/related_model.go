@@ -0,0 +1,212 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+// coPurchaseBatchSize limits rows per INSERT when rebuilding co-purchase pairs
+const coPurchaseBatchSize = 5000
+
+var _ RelatedModel = (*customRelatedModel)(nil)
+
+type (
+	// RelatedModel is an interface for product recommendations
+	RelatedModel interface {
+		// AddOrder counts the products of an order as bought together
+		// Returns false if the order was already counted
+		AddOrder(ctx context.Context, orderId int64, productIds []int64, now int64) (bool, error)
+
+		// Rebuild replaces all co-purchase pairs, orders up to lastOrderId and the given
+		// newer orders are marked as counted
+		Rebuild(ctx context.Context, pairs []*CoPurchase, lastOrderId int64, newerOrderIds []int64, now int64) error
+
+		// FindBoughtTogether returns published products most often bought with a product
+		FindBoughtTogether(ctx context.Context, productId int64, limit int) ([]int64, error)
+
+		// FindBestSellers returns published best sellers of a category by sales, excluding some products
+		FindBestSellers(ctx context.Context, category string, excludeIds []int64, limit int) ([]int64, error)
+	}
+
+	customRelatedModel struct {
+		conn sqlx.SqlConn
+	}
+)
+
+// NewRelatedModel returns a RelatedModel instance
+func NewRelatedModel(conn sqlx.SqlConn) RelatedModel {
+	return &customRelatedModel{
+		conn: conn,
+	}
+}
+
+// AddOrder counts every pair of distinct products of an order once
+// Orders up to the rebuild watermark are already counted. Newer orders are recorded
+// in the same transaction, so a redelivered event is a no-op
+func (m *customRelatedModel) AddOrder(ctx context.Context, orderId int64, productIds []int64, now int64) (bool, error) {
+	db, err := m.conn.RawDB()
+	if err != nil {
+		return false, err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// FOR SHARE waits for a running rebuild, which moves the watermark first
+	var watermark int64
+	err = tx.QueryRowContext(ctx, `SELECT order_id FROM co_purchase_watermark WHERE id = 1 FOR SHARE`).Scan(&watermark)
+	if err == sql.ErrNoRows {
+		err = nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if orderId <= watermark {
+		err = tx.Commit()
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctx, `INSERT INTO co_purchase_orders (order_id, created_at) VALUES ($1, $2)
+		ON CONFLICT (order_id) DO NOTHING`, orderId, now)
+	if err != nil {
+		return false, err
+	}
+
+	added, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	if added == 0 {
+		err = tx.Commit()
+		return false, err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO product_co_purchases (product_id, related_id, orders, updated_at)
+		SELECT a.id, b.id, 1, $2
+		FROM (SELECT DISTINCT unnest($1::BIGINT[]) AS id) a, (SELECT DISTINCT unnest($1::BIGINT[]) AS id) b
+		WHERE a.id <> b.id
+		ON CONFLICT (product_id, related_id) DO UPDATE
+		SET orders = product_co_purchases.orders + 1, updated_at = EXCLUDED.updated_at`,
+		pq.Array(productIds), now)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	return err == nil, err
+}
+
+// Rebuild replaces all co-purchase pairs in a single transaction
+// The watermark is moved first, so orders counted concurrently from events wait for the
+// rebuild and are skipped if it already counted them. Only orders above the watermark
+// are kept in co_purchase_orders, older ones are covered by the watermark.
+func (m *customRelatedModel) Rebuild(ctx context.Context, pairs []*CoPurchase, lastOrderId int64, newerOrderIds []int64, now int64) error {
+	db, err := m.conn.RawDB()
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO co_purchase_watermark (id, order_id, updated_at) VALUES (1, $1, $2)
+		ON CONFLICT (id) DO UPDATE SET order_id = EXCLUDED.order_id, updated_at = EXCLUDED.updated_at`,
+		lastOrderId, now)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `TRUNCATE product_co_purchases`)
+	if err != nil {
+		return err
+	}
+
+	for start := 0; start < len(pairs); start += coPurchaseBatchSize {
+		end := start + coPurchaseBatchSize
+		if end > len(pairs) {
+			end = len(pairs)
+		}
+
+		productIds := make([]int64, 0, end-start)
+		relatedIds := make([]int64, 0, end-start)
+		orders := make([]int64, 0, end-start)
+		for _, p := range pairs[start:end] {
+			productIds = append(productIds, p.ProductId)
+			relatedIds = append(relatedIds, p.RelatedId)
+			orders = append(orders, p.Orders)
+		}
+
+		_, err = tx.ExecContext(ctx, `INSERT INTO product_co_purchases (product_id, related_id, orders, updated_at)
+			SELECT unnest($1::BIGINT[]), unnest($2::BIGINT[]), unnest($3::BIGINT[]), $4`,
+			pq.Array(productIds), pq.Array(relatedIds), pq.Array(orders), now)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM co_purchase_orders WHERE order_id <= $1`, lastOrderId)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `INSERT INTO co_purchase_orders (order_id, created_at)
+		SELECT unnest($1::BIGINT[]), $2
+		ON CONFLICT (order_id) DO NOTHING`,
+		pq.Array(newerOrderIds), now)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	return err
+}
+
+// FindBoughtTogether returns published products most often bought with a product, most orders first
+func (m *customRelatedModel) FindBoughtTogether(ctx context.Context, productId int64, limit int) ([]int64, error) {
+	query := `SELECT c.related_id FROM product_co_purchases c
+			  JOIN products p ON p.id = c.related_id
+			  WHERE c.product_id = $1 AND p.status = $2
+			  ORDER BY c.orders DESC, c.related_id
+			  LIMIT $3`
+
+	var productIds []int64
+	err := m.conn.QueryRowsCtx(ctx, &productIds, query, productId, ProductStatusPublished, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return productIds, nil
+}
+
+// FindBestSellers returns published products of a category by sales, best first
+func (m *customRelatedModel) FindBestSellers(ctx context.Context, category string, excludeIds []int64, limit int) ([]int64, error) {
+	query := `SELECT id FROM products
+			  WHERE category = $1 AND status = $2 AND NOT (id = ANY($3))
+			  ORDER BY sales DESC, id
+			  LIMIT $4`
+
+	var productIds []int64
+	err := m.conn.QueryRowsCtx(ctx, &productIds, query, category, ProductStatusPublished, pq.Array(excludeIds), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return productIds, nil
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestAddOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		orderId   int64
+		watermark int64 // 0 = no rebuild ran yet
+		counted   bool  // Order already counted, e.g. a redelivered event
+		added     bool
+	}{
+		{name: "new order", orderId: 11, added: true},
+		{name: "newer than the rebuild", orderId: 11, watermark: 10, added: true},
+		{name: "counted by the rebuild", orderId: 10, watermark: 10},
+		{name: "redelivered order", orderId: 11, counted: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, mock := newModelTest(t)
+
+			mock.ExpectBegin()
+			rows := sqlmock.NewRows([]string{"order_id"})
+			if tt.watermark > 0 {
+				rows.AddRow(tt.watermark)
+			}
+			mock.ExpectQuery(`SELECT order_id FROM co_purchase_watermark WHERE id = 1 FOR SHARE`).WillReturnRows(rows)
+
+			if tt.orderId > tt.watermark {
+				affected := int64(1)
+				if tt.counted {
+					affected = 0
+				}
+				mock.ExpectExec(`INSERT INTO co_purchase_orders`).WithArgs(tt.orderId, int64(100)).
+					WillReturnResult(sqlmock.NewResult(0, affected))
+			}
+			if tt.added {
+				mock.ExpectExec(`INSERT INTO product_co_purchases`).WithArgs(sqlmock.AnyArg(), int64(100)).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			}
+			mock.ExpectCommit()
+
+			added, err := NewRelatedModel(conn).AddOrder(context.Background(), tt.orderId, []int64{1, 2}, 100)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if added != tt.added {
+				t.Fatalf("added = %v, want %v", added, tt.added)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ CREATE DATABASE letsgo_product
 -- Create products table
 CREATE TABLE IF NOT EXISTS products (
     id          BIGSERIAL PRIMARY KEY,
+    sku         VARCHAR(64) NOT NULL DEFAULT '', -- External SKU code ('' = none)
     name        VARCHAR(200) NOT NULL,
     description TEXT,
     price       DECIMAL(10,2) NOT NULL CHECK (price >= 0),
@@ -17,9 +18,16 @@ CREATE TABLE IF NOT EXISTS products (
     images      TEXT[],                      -- Array of image URLs
     attributes  TEXT,                        -- JSON string of product attributes
     sales       BIGINT NOT NULL DEFAULT 0,   -- Total sales count
-    status      INT NOT NULL DEFAULT 1,      -- 1:active, 2:inactive
+    status      INT NOT NULL DEFAULT 1,      -- 1:published, 2:unpublished, 3:deleted, 4:draft
+    publish_at  BIGINT NOT NULL DEFAULT 0,   -- Scheduled publish time (0 = not scheduled)
+    low_stock_threshold BIGINT NOT NULL DEFAULT 0,     -- Alert when stock drops to this level (0 = no alerts)
+    low_stock_alerted   BOOLEAN NOT NULL DEFAULT FALSE, -- Alert sent, re-armed when stock goes back above the threshold
+    max_per_order BIGINT NOT NULL DEFAULT 0 CHECK (max_per_order >= 0), -- Max units in one order (0 = unlimited)
+    max_per_user  BIGINT NOT NULL DEFAULT 0 CHECK (max_per_user >= 0),  -- Max units per user within limit_window (0 = unlimited)
+    limit_window  BIGINT NOT NULL DEFAULT 0 CHECK (limit_window >= 0),  -- Seconds counted for max_per_user (0 = all time)
     created_at  BIGINT NOT NULL,             -- Unix timestamp
     updated_at  BIGINT NOT NULL,             -- Unix timestamp
+    version     BIGINT NOT NULL DEFAULT 1,   -- Bumped by every edit, UpdateProduct requires the current version
 
     -- Indexes for common queries
     CONSTRAINT products_name_not_empty CHECK (LENGTH(TRIM(name)) > 0),
@@ -27,17 +35,21 @@ CREATE TABLE IF NOT EXISTS products (
 );
 
 -- Create indexes for performance
+CREATE UNIQUE INDEX IF NOT EXISTS idx_products_sku ON products(sku) WHERE sku <> '';
 CREATE INDEX IF NOT EXISTS idx_products_category ON products(category);
 CREATE INDEX IF NOT EXISTS idx_products_created_at ON products(created_at DESC);
 CREATE INDEX IF NOT EXISTS idx_products_sales ON products(sales DESC);
 CREATE INDEX IF NOT EXISTS idx_products_price ON products(price);
 CREATE INDEX IF NOT EXISTS idx_products_status ON products(status);
+CREATE INDEX IF NOT EXISTS idx_products_publish_at ON products(publish_at) WHERE publish_at > 0;
+CREATE INDEX IF NOT EXISTS idx_products_low_stock ON products(stock) WHERE low_stock_threshold > 0;
 CREATE INDEX IF NOT EXISTS idx_products_name ON products USING gin(to_tsvector('english', name));
 CREATE INDEX IF NOT EXISTS idx_products_description ON products USING gin(to_tsvector('english', description));
 
 -- Add comments for documentation
 COMMENT ON TABLE products IS 'Product catalog table storing core product information';
 COMMENT ON COLUMN products.id IS 'Primary key, auto-incrementing product ID';
+COMMENT ON COLUMN products.sku IS 'External SKU code, unique when set, used as the key for bulk import';
 COMMENT ON COLUMN products.name IS 'Product name';
 COMMENT ON COLUMN products.description IS 'Product description';
 COMMENT ON COLUMN products.price IS 'Product price in decimal format';
@@ -46,9 +58,185 @@ COMMENT ON COLUMN products.category IS 'Product category for filtering';
 COMMENT ON COLUMN products.images IS 'Array of product image URLs';
 COMMENT ON COLUMN products.attributes IS 'JSON string of product attributes (color, size, etc.)';
 COMMENT ON COLUMN products.sales IS 'Total number of units sold';
-COMMENT ON COLUMN products.status IS '1=active (available for sale), 2=inactive (hidden)';
+COMMENT ON COLUMN products.status IS '1=published (available for sale), 2=unpublished (hidden), 3=deleted (soft delete), 4=draft';
+COMMENT ON COLUMN products.publish_at IS 'Scheduled publish timestamp (Unix epoch), 0 = not scheduled';
+COMMENT ON COLUMN products.low_stock_threshold IS 'Low-stock alert threshold, 0 = no alerts';
+COMMENT ON COLUMN products.low_stock_alerted IS 'Whether the low-stock alert was sent since stock last went above the threshold';
 COMMENT ON COLUMN products.created_at IS 'Creation timestamp (Unix epoch)';
 COMMENT ON COLUMN products.updated_at IS 'Last update timestamp (Unix epoch)';
+COMMENT ON COLUMN products.version IS 'Optimistic lock version of the editable fields, stock and sales changes do not bump it';
+
+-- ========================================
+-- Price History
+-- ========================================
+-- One row per change of products.price (manual update, import, scheduled change)
+CREATE TABLE IF NOT EXISTS product_price_history (
+    id          BIGSERIAL PRIMARY KEY,
+    product_id  BIGINT NOT NULL REFERENCES products(id),
+    old_price   DECIMAL(10,2) NOT NULL,
+    new_price   DECIMAL(10,2) NOT NULL,
+    operator_id BIGINT NOT NULL DEFAULT 0,       -- Admin user ID (0 = system)
+    source      VARCHAR(20) NOT NULL,            -- manual, import, schedule_start, schedule_end, schedule_cancel
+    schedule_id BIGINT NOT NULL DEFAULT 0,       -- Related price schedule (0 = none)
+    created_at  BIGINT NOT NULL                  -- Unix timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_price_history_product ON product_price_history(product_id, created_at DESC);
+
+COMMENT ON TABLE product_price_history IS 'Audit log of product price changes';
+
+-- ========================================
+-- Scheduled Price Changes
+-- ========================================
+-- Applied at start_at and reverted at end_at by the product service price worker
+CREATE TABLE IF NOT EXISTS product_price_schedules (
+    id             BIGSERIAL PRIMARY KEY,
+    product_id     BIGINT NOT NULL REFERENCES products(id),
+    price          DECIMAL(10,2) NOT NULL CHECK (price > 0),
+    original_price DECIMAL(10,2) NOT NULL DEFAULT 0, -- Price before start, restored at end_at
+    start_at       BIGINT NOT NULL,
+    end_at         BIGINT NOT NULL DEFAULT 0,        -- 0 = permanent change
+    status         INT NOT NULL DEFAULT 1,           -- 1:pending, 2:active, 3:finished, 4:cancelled
+    operator_id    BIGINT NOT NULL DEFAULT 0,
+    created_at     BIGINT NOT NULL,
+    updated_at     BIGINT NOT NULL,
+
+    CONSTRAINT price_schedules_end_after_start CHECK (end_at = 0 OR end_at > start_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_price_schedules_product ON product_price_schedules(product_id, start_at);
+CREATE INDEX IF NOT EXISTS idx_price_schedules_pending ON product_price_schedules(start_at) WHERE status = 1;
+CREATE INDEX IF NOT EXISTS idx_price_schedules_active ON product_price_schedules(end_at) WHERE status = 2;
+
+COMMENT ON TABLE product_price_schedules IS 'Scheduled product price changes (sales, permanent price changes)';
+
+-- Flash sales (quantity is reserved from products.stock when the sale is created,
+-- sold in Redis during the window and the unsold part is returned when it closes)
+CREATE TABLE IF NOT EXISTS flash_sales (
+    id             BIGSERIAL PRIMARY KEY,
+    product_id     BIGINT NOT NULL REFERENCES products(id),
+    price          DECIMAL(10,2) NOT NULL CHECK (price > 0),
+    quantity       INT NOT NULL CHECK (quantity > 0),  -- Units reserved from products.stock
+    sold           INT NOT NULL DEFAULT 0,             -- Units sold, settled when the sale closes
+    per_user_limit INT NOT NULL DEFAULT 0,             -- Max units per user (0 = unlimited)
+    start_at       BIGINT NOT NULL,
+    end_at         BIGINT NOT NULL,
+    status         INT NOT NULL DEFAULT 1,             -- 1:pending, 2:active, 3:finished, 4:cancelled
+    operator_id    BIGINT NOT NULL DEFAULT 0,
+    created_at     BIGINT NOT NULL,
+    updated_at     BIGINT NOT NULL,
+
+    CONSTRAINT flash_sales_end_after_start CHECK (end_at > start_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_flash_sales_product ON flash_sales(product_id, start_at);
+CREATE INDEX IF NOT EXISTS idx_flash_sales_open ON flash_sales(end_at) WHERE status IN (1, 2);
+
+COMMENT ON TABLE flash_sales IS 'Flash sales with stock pre-deducted into Redis';
+
+-- Warehouses (stock is kept per warehouse, products.stock is the sum over all of them)
+CREATE TABLE IF NOT EXISTS warehouses (
+    id         BIGSERIAL PRIMARY KEY,
+    code       VARCHAR(32) NOT NULL UNIQUE,     -- Short code, e.g. SH01
+    name       VARCHAR(100) NOT NULL,
+    region     VARCHAR(64) NOT NULL DEFAULT '', -- Matched against the customer region by nearest allocation
+    priority   INT NOT NULL DEFAULT 0,          -- Lower ships first, the first warehouse is the default one
+    created_at BIGINT NOT NULL,
+    updated_at BIGINT NOT NULL
+);
+
+COMMENT ON TABLE warehouses IS 'Stock locations';
+
+-- Per-warehouse stock levels
+CREATE TABLE IF NOT EXISTS warehouse_stock (
+    warehouse_id BIGINT NOT NULL REFERENCES warehouses(id),
+    product_id   BIGINT NOT NULL REFERENCES products(id),
+    stock        BIGINT NOT NULL DEFAULT 0 CHECK (stock >= 0),
+    updated_at   BIGINT NOT NULL,
+
+    PRIMARY KEY (warehouse_id, product_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_warehouse_stock_product ON warehouse_stock(product_id);
+
+COMMENT ON TABLE warehouse_stock IS 'Stock of each product per warehouse';
+
+-- Inventory movements (one row per change of products.stock, written in the same transaction,
+-- so the quantities of a product add up to its stock)
+CREATE TABLE IF NOT EXISTS inventory_movements (
+    id           BIGSERIAL PRIMARY KEY,
+    product_id   BIGINT NOT NULL REFERENCES products(id),
+    warehouse_id BIGINT NOT NULL DEFAULT 0,       -- Warehouse whose stock changed
+    quantity     BIGINT NOT NULL,                 -- Positive = in, negative = out
+    stock_after  BIGINT NOT NULL,                 -- products.stock after the change
+    reason       VARCHAR(20) NOT NULL,            -- initial, import, order, cancel, compensation, admin_adjust, flash_sale, transfer
+    reference_id VARCHAR(64) NOT NULL DEFAULT '', -- Order number, flash sale ID... ('' = none)
+    operator_id  BIGINT NOT NULL DEFAULT 0,       -- Admin user ID (0 = system)
+    created_at   BIGINT NOT NULL                  -- Unix timestamp
+);
+
+CREATE INDEX IF NOT EXISTS idx_inventory_movements_product ON inventory_movements(product_id, id DESC);
+
+COMMENT ON TABLE inventory_movements IS 'Ledger of product stock changes';
+
+-- Back-in-stock subscriptions (users notified once when an out-of-stock product is restocked)
+CREATE TABLE IF NOT EXISTS stock_subscriptions (
+    id          BIGSERIAL PRIMARY KEY,
+    product_id  BIGINT NOT NULL REFERENCES products(id),
+    user_id     BIGINT NOT NULL,
+    created_at  BIGINT NOT NULL,           -- Unix timestamp
+    notified_at BIGINT NOT NULL DEFAULT 0, -- Unix timestamp (0 = pending)
+
+    UNIQUE (product_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_stock_subscriptions_pending ON stock_subscriptions(product_id) WHERE notified_at = 0;
+
+COMMENT ON TABLE stock_subscriptions IS 'Users waiting for a product to be back in stock';
+
+-- Products bought together, counted from orders (every pair is stored in both directions)
+CREATE TABLE IF NOT EXISTS product_co_purchases (
+    product_id BIGINT NOT NULL,
+    related_id BIGINT NOT NULL,
+    orders     BIGINT NOT NULL DEFAULT 0, -- Orders containing both products
+    updated_at BIGINT NOT NULL,           -- Unix timestamp
+
+    PRIMARY KEY (product_id, related_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_co_purchases_ranking ON product_co_purchases(product_id, orders DESC);
+
+COMMENT ON TABLE product_co_purchases IS 'Frequently bought together pairs, rebuilt offline and updated from order.created events';
+
+-- Highest order ID counted by the last rebuild, events of older orders are skipped
+CREATE TABLE IF NOT EXISTS co_purchase_watermark (
+    id         SMALLINT PRIMARY KEY DEFAULT 1 CHECK (id = 1), -- Single row
+    order_id   BIGINT NOT NULL DEFAULT 0,
+    updated_at BIGINT NOT NULL DEFAULT 0 -- Unix timestamp
+);
+
+INSERT INTO co_purchase_watermark (id, order_id, updated_at) VALUES (1, 0, 0) ON CONFLICT (id) DO NOTHING;
+
+-- Orders above the watermark already counted in product_co_purchases, makes redelivered events a no-op
+-- Rows at or below the watermark are removed by the rebuild
+CREATE TABLE IF NOT EXISTS co_purchase_orders (
+    order_id   BIGINT PRIMARY KEY,
+    created_at BIGINT NOT NULL -- Unix timestamp
+);
+
+-- Components of bundle products (a bundle has no stock of its own)
+CREATE TABLE IF NOT EXISTS product_bundle_items (
+    bundle_id    BIGINT NOT NULL REFERENCES products(id),
+    component_id BIGINT NOT NULL REFERENCES products(id),
+    quantity     BIGINT NOT NULL CHECK (quantity > 0), -- Units of the component in one bundle
+
+    PRIMARY KEY (bundle_id, component_id),
+    CHECK (bundle_id <> component_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bundle_items_component ON product_bundle_items(component_id);
+
+COMMENT ON TABLE product_bundle_items IS 'Components of bundle products, stock is deducted from the components when a bundle is ordered';
 
 -- Insert sample data for testing
 INSERT INTO products (name, description, price, stock, category, images, attributes, sales, status, created_at, updated_at)
@@ -78,6 +266,17 @@ VALUES
      '{"weight": "250g", "origin": "Japan"}',
      0, 1, EXTRACT(EPOCH FROM NOW())::BIGINT, EXTRACT(EPOCH FROM NOW())::BIGINT);
 
+-- Default warehouse holding the stock of the sample products
+INSERT INTO warehouses (code, name, region, priority, created_at, updated_at)
+VALUES ('DEFAULT', 'Default warehouse', '', 0, EXTRACT(EPOCH FROM NOW())::BIGINT, EXTRACT(EPOCH FROM NOW())::BIGINT);
+
+INSERT INTO warehouse_stock (warehouse_id, product_id, stock, updated_at)
+SELECT w.id, p.id, p.stock, p.created_at FROM products p, warehouses w WHERE w.code = 'DEFAULT' AND p.stock > 0;
+
+-- Record the initial stock of the sample products
+INSERT INTO inventory_movements (product_id, warehouse_id, quantity, stock_after, reason, created_at)
+SELECT p.id, w.id, p.stock, p.stock, 'initial', p.created_at FROM products p, warehouses w WHERE w.code = 'DEFAULT' AND p.stock > 0;
+
 -- Verify table creation
 SELECT 'Products table created successfully!' AS status;
 SELECT COUNT(*) AS sample_products FROM products;
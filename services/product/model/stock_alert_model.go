@@ -0,0 +1,218 @@
+package model
+
+import (
+	"context"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ StockAlertModel = (*customStockAlertModel)(nil)
+
+type (
+	// StockAlertModel is an interface for low-stock thresholds and back-in-stock subscriptions
+	StockAlertModel interface {
+		// SetThreshold sets the low-stock threshold of a product (0 = no alerts)
+		SetThreshold(ctx context.Context, productId int64, threshold int64) error
+
+		// ListLowStock returns products at or below their threshold, lowest stock first
+		ListLowStock(ctx context.Context, page, pageSize int32) ([]*LowStockProduct, int64, error)
+
+		// FindLowStockToAlert returns low-stock products not alerted yet
+		FindLowStockToAlert(ctx context.Context, limit int) ([]*LowStockProduct, error)
+
+		// MarkLowStockAlerted remembers that an alert was sent for the products
+		MarkLowStockAlerted(ctx context.Context, productIds []int64) error
+
+		// ResetLowStockAlerts re-arms alerts of products back above their threshold
+		ResetLowStockAlerts(ctx context.Context) (int64, error)
+
+		// Subscribe registers a user for a back-in-stock notification
+		Subscribe(ctx context.Context, productId, userId int64, now int64) error
+
+		// Unsubscribe removes a pending back-in-stock subscription
+		Unsubscribe(ctx context.Context, productId, userId int64) error
+
+		// FindRestocked returns products in stock that have pending subscriptions
+		FindRestocked(ctx context.Context, limit int) ([]int64, error)
+
+		// TakeSubscribers marks up to limit pending subscriptions of a product as notified and returns their users
+		TakeSubscribers(ctx context.Context, productId int64, now int64, limit int) ([]int64, error)
+
+		// RestoreSubscribers puts subscriptions back to pending when their notification could not be sent
+		RestoreSubscribers(ctx context.Context, productId int64, userIds []int64) error
+	}
+
+	customStockAlertModel struct {
+		conn sqlx.SqlConn
+	}
+)
+
+// NewStockAlertModel returns a StockAlertModel instance
+func NewStockAlertModel(conn sqlx.SqlConn) StockAlertModel {
+	return &customStockAlertModel{
+		conn: conn,
+	}
+}
+
+const lowStockFields = `id, sku, name, stock, low_stock_threshold`
+
+// SetThreshold sets the low-stock threshold of a product
+// The alert is re-armed, so a product already below the new threshold is reported again
+func (m *customStockAlertModel) SetThreshold(ctx context.Context, productId int64, threshold int64) error {
+	result, err := m.conn.ExecCtx(ctx, `UPDATE products SET low_stock_threshold = $1, low_stock_alerted = FALSE
+		WHERE id = $2 AND status <> $3`,
+		threshold, productId, ProductStatusDeleted)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ListLowStock returns non-deleted products at or below their threshold with pagination
+func (m *customStockAlertModel) ListLowStock(ctx context.Context, page, pageSize int32) ([]*LowStockProduct, int64, error) {
+	where := `WHERE low_stock_threshold > 0 AND stock <= low_stock_threshold AND status <> $1`
+
+	var total int64
+	err := m.conn.QueryRowCtx(ctx, &total, `SELECT COUNT(*) FROM products `+where, ProductStatusDeleted)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query := `SELECT ` + lowStockFields + ` FROM products ` + where + `
+			  ORDER BY stock, id
+			  LIMIT $2 OFFSET $3`
+
+	var products []*LowStockProduct
+	offset := (page - 1) * pageSize
+	err = m.conn.QueryRowsCtx(ctx, &products, query, ProductStatusDeleted, pageSize, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return products, total, nil
+}
+
+// FindLowStockToAlert returns non-deleted low-stock products whose alert was not sent yet
+func (m *customStockAlertModel) FindLowStockToAlert(ctx context.Context, limit int) ([]*LowStockProduct, error) {
+	query := `SELECT ` + lowStockFields + ` FROM products
+			  WHERE low_stock_threshold > 0 AND stock <= low_stock_threshold AND NOT low_stock_alerted AND status <> $1
+			  ORDER BY id
+			  LIMIT $2`
+
+	var products []*LowStockProduct
+	err := m.conn.QueryRowsCtx(ctx, &products, query, ProductStatusDeleted, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// MarkLowStockAlerted remembers that an alert was sent, updated_at is left alone
+func (m *customStockAlertModel) MarkLowStockAlerted(ctx context.Context, productIds []int64) error {
+	if len(productIds) == 0 {
+		return nil
+	}
+
+	_, err := m.conn.ExecCtx(ctx, `UPDATE products SET low_stock_alerted = TRUE WHERE id = ANY($1)`, pq.Array(productIds))
+	return err
+}
+
+// ResetLowStockAlerts re-arms alerts of products that went back above their threshold
+func (m *customStockAlertModel) ResetLowStockAlerts(ctx context.Context) (int64, error) {
+	result, err := m.conn.ExecCtx(ctx, `UPDATE products SET low_stock_alerted = FALSE
+		WHERE low_stock_alerted AND stock > low_stock_threshold`)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.RowsAffected()
+}
+
+// Subscribe registers a user for a back-in-stock notification
+// Subscribing again after being notified makes the subscription pending again
+func (m *customStockAlertModel) Subscribe(ctx context.Context, productId, userId int64, now int64) error {
+	_, err := m.conn.ExecCtx(ctx, `INSERT INTO stock_subscriptions (product_id, user_id, created_at, notified_at)
+		VALUES ($1, $2, $3, 0)
+		ON CONFLICT (product_id, user_id) DO UPDATE SET created_at = EXCLUDED.created_at, notified_at = 0`,
+		productId, userId, now)
+	return err
+}
+
+// Unsubscribe removes a pending back-in-stock subscription
+func (m *customStockAlertModel) Unsubscribe(ctx context.Context, productId, userId int64) error {
+	result, err := m.conn.ExecCtx(ctx, `DELETE FROM stock_subscriptions WHERE product_id = $1 AND user_id = $2 AND notified_at = 0`,
+		productId, userId)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// FindRestocked returns published products in stock that have pending subscriptions
+func (m *customStockAlertModel) FindRestocked(ctx context.Context, limit int) ([]int64, error) {
+	query := `SELECT p.id FROM products p
+			  WHERE p.stock > 0 AND p.status = $1
+			    AND EXISTS (SELECT 1 FROM stock_subscriptions s WHERE s.product_id = p.id AND s.notified_at = 0)
+			  ORDER BY p.id
+			  LIMIT $2`
+
+	var productIds []int64
+	err := m.conn.QueryRowsCtx(ctx, &productIds, query, ProductStatusPublished, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return productIds, nil
+}
+
+// TakeSubscribers marks up to limit pending subscriptions of a product as notified and returns their users
+func (m *customStockAlertModel) TakeSubscribers(ctx context.Context, productId int64, now int64, limit int) ([]int64, error) {
+	query := `UPDATE stock_subscriptions SET notified_at = $2
+			  WHERE id IN (
+				  SELECT id FROM stock_subscriptions
+				  WHERE product_id = $1 AND notified_at = 0
+				  ORDER BY id
+				  LIMIT $3
+				  FOR UPDATE SKIP LOCKED
+			  )
+			  RETURNING user_id`
+
+	var userIds []int64
+	err := m.conn.QueryRowsCtx(ctx, &userIds, query, productId, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return userIds, nil
+}
+
+// RestoreSubscribers puts subscriptions back to pending
+func (m *customStockAlertModel) RestoreSubscribers(ctx context.Context, productId int64, userIds []int64) error {
+	if len(userIds) == 0 {
+		return nil
+	}
+
+	_, err := m.conn.ExecCtx(ctx, `UPDATE stock_subscriptions SET notified_at = 0 WHERE product_id = $1 AND user_id = ANY($2)`,
+		productId, pq.Array(userIds))
+	return err
+}
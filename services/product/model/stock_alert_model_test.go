@@ -0,0 +1,97 @@
+package model
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestLowStockAlertCrossing(t *testing.T) {
+	conn, mock := newModelTest(t)
+	m := NewStockAlertModel(conn)
+	ctx := context.Background()
+
+	// Only products at or below a non-zero threshold that were not alerted yet are reported
+	mock.ExpectQuery(`WHERE low_stock_threshold > 0 AND stock <= low_stock_threshold AND NOT low_stock_alerted AND status <> \$1`).
+		WithArgs(int64(ProductStatusDeleted), 100).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "sku", "name", "stock", "low_stock_threshold"}).
+			AddRow(1, "PEN-1", "Pen", 5, 5).
+			AddRow(3, "INK-1", "Ink", 0, 2))
+	mock.ExpectExec(`UPDATE products SET low_stock_alerted = TRUE WHERE id = ANY\(\$1\)`).
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	// Restocked above the threshold, alerted again next time it runs low
+	mock.ExpectExec(`UPDATE products SET low_stock_alerted = FALSE\s+WHERE low_stock_alerted AND stock > low_stock_threshold`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	products, err := m.FindLowStockToAlert(ctx, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*LowStockProduct{
+		{ProductId: 1, Sku: "PEN-1", Name: "Pen", Stock: 5, Threshold: 5},
+		{ProductId: 3, Sku: "INK-1", Name: "Ink", Stock: 0, Threshold: 2},
+	}
+	if !reflect.DeepEqual(products, want) {
+		t.Fatalf("products = %+v", products)
+	}
+
+	if err := m.MarkLowStockAlerted(ctx, []int64{1, 3}); err != nil {
+		t.Fatal(err)
+	}
+	// Nothing alerted, nothing written
+	if err := m.MarkLowStockAlerted(ctx, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	reset, err := m.ResetLowStockAlerts(ctx)
+	if err != nil || reset != 1 {
+		t.Fatalf("reset = %d, err = %v", reset, err)
+	}
+}
+
+func TestSetThreshold(t *testing.T) {
+	tests := []struct {
+		name     string
+		affected int64
+		err      error
+	}{
+		{name: "re-armed", affected: 1},
+		{name: "deleted product", affected: 0, err: ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, mock := newModelTest(t)
+
+			mock.ExpectExec(`UPDATE products SET low_stock_threshold = \$1, low_stock_alerted = FALSE`).
+				WithArgs(int64(10), int64(1), int64(ProductStatusDeleted)).
+				WillReturnResult(sqlmock.NewResult(0, tt.affected))
+
+			err := NewStockAlertModel(conn).SetThreshold(context.Background(), 1, 10)
+			if err != tt.err {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestTakeSubscribers(t *testing.T) {
+	conn, mock := newModelTest(t)
+
+	mock.ExpectQuery(`UPDATE stock_subscriptions SET notified_at = \$2`).WithArgs(int64(1), int64(100), 500).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(42).AddRow(43))
+	mock.ExpectExec(`UPDATE stock_subscriptions SET notified_at = 0`).WithArgs(int64(1), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	m := NewStockAlertModel(conn)
+	userIds, err := m.TakeSubscribers(context.Background(), 1, 100, 500)
+	if err != nil || !reflect.DeepEqual(userIds, []int64{42, 43}) {
+		t.Fatalf("userIds = %v, err = %v", userIds, err)
+	}
+	if err := m.RestoreSubscribers(context.Background(), 1, userIds); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -6,6 +6,7 @@ import "github.com/lib/pq"
 // All product data including images and attributes are stored in PostgreSQL
 type Product struct {
 	Id          int64           `db:"id"`
+	Sku         string          `db:"sku"`         // External SKU code ('' = none)
 	Name        string          `db:"name"`
 	Description string          `db:"description"`
 	Price       float64         `db:"price"`
@@ -14,7 +15,204 @@ type Product struct {
 	Images      pq.StringArray  `db:"images"`      // PostgreSQL array
 	Attributes  string          `db:"attributes"`  // JSON string
 	Sales       int64           `db:"sales"`       // Total sales count
-	Status      int64           `db:"status"`      // 1:active, 2:inactive
+	Status      int64           `db:"status"`      // 1:published, 2:unpublished, 3:deleted, 4:draft
+	PublishAt   int64           `db:"publish_at"`  // Scheduled publish time (0 = not scheduled)
 	CreatedAt   int64           `db:"created_at"`  // Unix timestamp
 	UpdatedAt   int64           `db:"updated_at"`
+	Version     int64           `db:"version"`     // Bumped by every edit of the editable fields, for optimistic locking
+	MaxPerOrder int64           `db:"max_per_order"` // Max units in one order (0 = unlimited)
+	MaxPerUser  int64           `db:"max_per_user"`  // Max units one user can order within LimitWindow (0 = unlimited)
+	LimitWindow int64           `db:"limit_window"`  // Seconds counted for MaxPerUser (0 = all time)
+}
+
+// Product Status Constants
+const (
+	ProductStatusPublished   = 1 // Visible and available for sale
+	ProductStatusUnpublished = 2 // Hidden from customers (delisted)
+	ProductStatusDeleted     = 3 // Soft deleted, can be restored by admin
+	ProductStatusDraft       = 4 // Not yet published
+)
+
+// UpsertResult represents the result of upserting a single product by SKU
+type UpsertResult struct {
+	Id          int64  // Product ID (0 if failed)
+	Inserted    bool   // true = created, false = updated
+	OldCategory string // Category before the update (empty if inserted)
+	Err         error  // Row level database error
+}
+
+// PriceHistory represents the product_price_history table
+// Every change of products.price writes one row
+type PriceHistory struct {
+	Id         int64   `db:"id"`
+	ProductId  int64   `db:"product_id"`
+	OldPrice   float64 `db:"old_price"`
+	NewPrice   float64 `db:"new_price"`
+	OperatorId int64   `db:"operator_id"` // Admin user ID (0 = system)
+	Source     string  `db:"source"`      // See PriceSource constants
+	ScheduleId int64   `db:"schedule_id"` // Related price schedule (0 = none)
+	CreatedAt  int64   `db:"created_at"`
+}
+
+// Price change sources
+const (
+	PriceSourceManual         = "manual"          // UpdateProduct
+	PriceSourceImport         = "import"          // Bulk import
+	PriceSourceScheduleStart  = "schedule_start"  // Scheduled price applied
+	PriceSourceScheduleEnd    = "schedule_end"    // Scheduled price reverted at end_at
+	PriceSourceScheduleCancel = "schedule_cancel" // Active schedule cancelled by admin
+)
+
+// PriceSchedule represents the product_price_schedules table
+type PriceSchedule struct {
+	Id            int64   `db:"id"`
+	ProductId     int64   `db:"product_id"`
+	Price         float64 `db:"price"`          // Price applied at start_at
+	OriginalPrice float64 `db:"original_price"` // Price before start, restored at end_at (0 = not started)
+	StartAt       int64   `db:"start_at"`
+	EndAt         int64   `db:"end_at"` // 0 = permanent change
+	Status        int64   `db:"status"` // 1:pending, 2:active, 3:finished, 4:cancelled
+	OperatorId    int64   `db:"operator_id"`
+	CreatedAt     int64   `db:"created_at"`
+	UpdatedAt     int64   `db:"updated_at"`
+}
+
+// Price Schedule Status Constants
+const (
+	PriceSchedulePending   = 1 // Waiting for start_at
+	PriceScheduleActive    = 2 // Price applied, waiting for end_at
+	PriceScheduleFinished  = 3 // Done (reverted, permanent change applied, or expired before start)
+	PriceScheduleCancelled = 4 // Cancelled by admin or product deleted
+)
+
+// PriceChange describes a price change applied by the schedule worker,
+// used by the caller to invalidate caches
+type PriceChange struct {
+	ScheduleId int64
+	ProductId  int64
+	Category   string
+	OldPrice   float64
+	NewPrice   float64
+	Changed    bool // false = schedule finished without touching the price
+}
+
+// FlashSale represents a flash sale of a product
+type FlashSale struct {
+	Id           int64   `db:"id"`
+	ProductId    int64   `db:"product_id"`
+	Price        float64 `db:"price"`          // Flash sale price
+	Quantity     int64   `db:"quantity"`       // Units reserved from products.stock
+	Sold         int64   `db:"sold"`           // Units sold, settled when the sale closes
+	PerUserLimit int64   `db:"per_user_limit"` // 0 = unlimited
+	StartAt      int64   `db:"start_at"`
+	EndAt        int64   `db:"end_at"`
+	Status       int64   `db:"status"` // 1:pending, 2:active, 3:finished, 4:cancelled
+	OperatorId   int64   `db:"operator_id"`
+	CreatedAt    int64   `db:"created_at"`
+	UpdatedAt    int64   `db:"updated_at"`
+}
+
+// Flash Sale Status Constants
+const (
+	FlashSalePending   = 1 // Waiting for start_at
+	FlashSaleActive    = 2 // Selling
+	FlashSaleFinished  = 3 // Closed at end_at, unsold quantity returned
+	FlashSaleCancelled = 4 // Cancelled by admin, unsold quantity returned
+)
+
+// InventoryMovement represents the inventory_movements table
+// Every change of products.stock writes one row, so the quantities of a product add up to its stock
+type InventoryMovement struct {
+	Id          int64  `db:"id"`
+	ProductId   int64  `db:"product_id"`
+	WarehouseId int64  `db:"warehouse_id"`
+	Quantity    int64  `db:"quantity"`     // Positive = in, negative = out
+	StockAfter  int64  `db:"stock_after"`  // products.stock after the change
+	Reason      string `db:"reason"`       // See StockReason constants
+	ReferenceId string `db:"reference_id"` // Order number, flash sale ID... ('' = none)
+	OperatorId  int64  `db:"operator_id"`  // Admin user ID (0 = system)
+	CreatedAt   int64  `db:"created_at"`
+}
+
+// Inventory movement reasons
+const (
+	StockReasonInitial      = "initial"      // Stock of a new product
+	StockReasonImport       = "import"       // Stock of a product created by bulk import
+	StockReasonOrder        = "order"        // Deducted when an order is created
+	StockReasonCancel       = "cancel"       // Returned when an order is cancelled
+	StockReasonCompensation = "compensation" // Returned when order creation failed after deducting
+	StockReasonAdminAdjust  = "admin_adjust" // Changed by an admin
+	StockReasonFlashSale    = "flash_sale"   // Reserved for a flash sale, or its unsold part returned
+	StockReasonTransfer     = "transfer"     // Moved between warehouses, the product total does not change
+)
+
+// StockChange tells why stock is changed, it is recorded in the inventory movement.
+// It also tells which warehouses the change applies to, see changeWarehouseStock
+type StockChange struct {
+	Reason      string
+	ReferenceId string
+	OperatorId  int64
+	WarehouseId int64  // 0 = allocated by Allocation for deductions, returned to origin or the default warehouse for additions
+	Allocation  string // See Allocation constants ('' = AllocationPriority)
+	Region      string // Customer region, used by AllocationNearest
+}
+
+// StockDiscrepancy is a product whose stock does not match the sum of its inventory movements
+type StockDiscrepancy struct {
+	ProductId      int64 `db:"product_id"`
+	Stock          int64 `db:"stock"`
+	LedgerStock    int64 `db:"ledger_stock"`
+	WarehouseStock int64 `db:"warehouse_stock"` // Sum of warehouse_stock rows
+}
+
+// Warehouse represents the warehouses table
+type Warehouse struct {
+	Id        int64  `db:"id"`
+	Code      string `db:"code"` // Unique short code
+	Name      string `db:"name"`
+	Region    string `db:"region"`   // Matched against the customer region by AllocationNearest
+	Priority  int64  `db:"priority"` // Lower ships first, the first warehouse is the default one
+	CreatedAt int64  `db:"created_at"`
+	UpdatedAt int64  `db:"updated_at"`
+}
+
+// WarehouseStock is the stock of a product in one warehouse
+// products.stock is the sum over all warehouses
+type WarehouseStock struct {
+	ProductId     int64  `db:"product_id"`
+	WarehouseId   int64  `db:"warehouse_id"`
+	WarehouseCode string `db:"warehouse_code"`
+	WarehouseName string `db:"warehouse_name"`
+	Stock         int64  `db:"stock"`
+}
+
+// Allocation strategies choosing the warehouses a deduction is taken from
+const (
+	AllocationPriority = "priority" // First warehouse by priority that can ship the whole quantity, split if none can
+	AllocationNearest  = "nearest"  // Same as priority, warehouses in the customer region first
+	AllocationSplit    = "split"    // Drain warehouses in priority order
+)
+
+// LowStockProduct is a product at or below its low-stock threshold
+type LowStockProduct struct {
+	ProductId int64  `db:"id"`
+	Sku       string `db:"sku"`
+	Name      string `db:"name"`
+	Stock     int64  `db:"stock"`
+	Threshold int64  `db:"low_stock_threshold"`
+}
+
+// CoPurchase counts the orders in which two products were bought together
+// Every pair is stored in both directions
+type CoPurchase struct {
+	ProductId int64 `db:"product_id"`
+	RelatedId int64 `db:"related_id"`
+	Orders    int64 `db:"orders"`
+}
+
+// BundleItem is a component product of a bundle and how many units one bundle contains
+type BundleItem struct {
+	BundleId    int64 `db:"bundle_id"`
+	ComponentId int64 `db:"component_id"`
+	Quantity    int64 `db:"quantity"`
 }
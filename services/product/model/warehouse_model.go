@@ -0,0 +1,418 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/lib/pq"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var _ WarehouseModel = (*customWarehouseModel)(nil)
+
+type (
+	// WarehouseModel is an interface for warehouses and per-warehouse stock
+	WarehouseModel interface {
+		// Insert creates a warehouse
+		Insert(ctx context.Context, data *Warehouse) (int64, error)
+
+		// FindOne finds a warehouse by ID
+		FindOne(ctx context.Context, id int64) (*Warehouse, error)
+
+		// Update changes name, region and priority of a warehouse
+		Update(ctx context.Context, data *Warehouse) error
+
+		// List returns all warehouses ordered by priority
+		List(ctx context.Context) ([]*Warehouse, error)
+
+		// FindStock returns the per-warehouse stock of products
+		FindStock(ctx context.Context, productIds []int64) (map[int64][]*WarehouseStock, error)
+
+		// Transfer moves stock of a product between two warehouses
+		Transfer(ctx context.Context, productId, fromId, toId, quantity int64, change StockChange, now int64) error
+	}
+
+	customWarehouseModel struct {
+		conn sqlx.SqlConn
+	}
+)
+
+// NewWarehouseModel returns a WarehouseModel instance
+func NewWarehouseModel(conn sqlx.SqlConn) WarehouseModel {
+	return &customWarehouseModel{
+		conn: conn,
+	}
+}
+
+const warehouseFields = `id, code, name, region, priority, created_at, updated_at`
+
+// Insert creates a warehouse
+func (m *customWarehouseModel) Insert(ctx context.Context, data *Warehouse) (int64, error) {
+	query := `INSERT INTO warehouses (code, name, region, priority, created_at, updated_at)
+			  VALUES ($1, $2, $3, $4, $5, $5)
+			  RETURNING id`
+
+	var id int64
+	err := m.conn.QueryRowCtx(ctx, &id, query, data.Code, data.Name, data.Region, data.Priority, data.CreatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+			return 0, ErrDuplicateWarehouseCode
+		}
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// FindOne finds a warehouse by ID
+func (m *customWarehouseModel) FindOne(ctx context.Context, id int64) (*Warehouse, error) {
+	query := `SELECT ` + warehouseFields + ` FROM warehouses WHERE id = $1`
+
+	var warehouse Warehouse
+	err := m.conn.QueryRowCtx(ctx, &warehouse, query, id)
+	switch err {
+	case nil:
+		return &warehouse, nil
+	case sqlx.ErrNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, err
+	}
+}
+
+// Update changes name, region and priority of a warehouse, the code never changes
+func (m *customWarehouseModel) Update(ctx context.Context, data *Warehouse) error {
+	result, err := m.conn.ExecCtx(ctx, `UPDATE warehouses SET name = $1, region = $2, priority = $3, updated_at = $4 WHERE id = $5`,
+		data.Name, data.Region, data.Priority, data.UpdatedAt, data.Id)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// List returns all warehouses, the default warehouse first
+func (m *customWarehouseModel) List(ctx context.Context) ([]*Warehouse, error) {
+	query := `SELECT ` + warehouseFields + ` FROM warehouses ORDER BY priority, id`
+
+	var warehouses []*Warehouse
+	err := m.conn.QueryRowsCtx(ctx, &warehouses, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return warehouses, nil
+}
+
+// FindStock returns the per-warehouse stock of products, warehouses without stock rows are left out
+func (m *customWarehouseModel) FindStock(ctx context.Context, productIds []int64) (map[int64][]*WarehouseStock, error) {
+	if len(productIds) == 0 {
+		return map[int64][]*WarehouseStock{}, nil
+	}
+
+	query := `SELECT ws.product_id, ws.warehouse_id, w.code AS warehouse_code, w.name AS warehouse_name, ws.stock
+			  FROM warehouse_stock ws
+			  JOIN warehouses w ON w.id = ws.warehouse_id
+			  WHERE ws.product_id = ANY($1)
+			  ORDER BY ws.product_id, w.priority, w.id`
+
+	var levels []*WarehouseStock
+	err := m.conn.QueryRowsCtx(ctx, &levels, query, pq.Array(productIds))
+	if err != nil {
+		return nil, err
+	}
+
+	stockMap := make(map[int64][]*WarehouseStock)
+	for _, level := range levels {
+		stockMap[level.ProductId] = append(stockMap[level.ProductId], level)
+	}
+
+	return stockMap, nil
+}
+
+// Transfer moves stock of a product between two warehouses
+// products.stock does not change, the ledger gets one movement out of the source and one into the target
+func (m *customWarehouseModel) Transfer(ctx context.Context, productId, fromId, toId, quantity int64, change StockChange, now int64) error {
+	db, err := m.conn.RawDB()
+	if err != nil {
+		return err
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil {
+			tx.Rollback()
+		}
+	}()
+
+	// Lock the product like every other stock change, so movements of a product are written in order
+	var stock int64
+	err = tx.QueryRowContext(ctx, `SELECT stock FROM products WHERE id = $1 AND status <> 3 FOR UPDATE`, productId).Scan(&stock)
+	if err == sql.ErrNoRows {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+
+	var found int
+	err = tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM warehouses WHERE id IN ($1, $2)`, fromId, toId).Scan(&found)
+	if err != nil {
+		return err
+	}
+	if found != 2 {
+		err = ErrWarehouseNotFound
+		return err
+	}
+
+	if err = addWarehouseStock(ctx, tx, productId, fromId, -quantity, now); err != nil {
+		return err
+	}
+	if err = insertMovement(ctx, tx, productId, fromId, -quantity, stock, change, now); err != nil {
+		return err
+	}
+
+	if err = addWarehouseStock(ctx, tx, productId, toId, quantity, now); err != nil {
+		return err
+	}
+	if err = insertMovement(ctx, tx, productId, toId, quantity, stock, change, now); err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	return err
+}
+
+// changeWarehouseStock spreads a change of products.stock over the warehouses and records
+// one inventory movement per warehouse. The caller has already updated (and so locked) the products row,
+// stockAfter is the new products.stock.
+//
+//   - change.WarehouseId > 0: the whole change applies to that warehouse
+//   - deductions: taken from the warehouses chosen by change.Allocation
+//   - additions: returned to the warehouses the same reference took stock from, the rest goes to the default warehouse
+func changeWarehouseStock(ctx context.Context, tx *sql.Tx, productId, quantity, stockAfter int64, change StockChange, now int64) error {
+	if quantity == 0 {
+		return nil
+	}
+
+	var parts []warehousePart
+	var err error
+	switch {
+	case change.WarehouseId > 0:
+		parts = []warehousePart{{WarehouseId: change.WarehouseId, Quantity: quantity}}
+	case quantity < 0:
+		parts, err = allocateWarehouses(ctx, tx, productId, -quantity, change)
+		for i := range parts {
+			parts[i].Quantity = -parts[i].Quantity
+		}
+	default:
+		parts, err = restockWarehouses(ctx, tx, productId, quantity, change)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Each movement shows the product total right after it
+	running := stockAfter - quantity
+	for _, part := range parts {
+		if err := addWarehouseStock(ctx, tx, productId, part.WarehouseId, part.Quantity, now); err != nil {
+			return err
+		}
+
+		running += part.Quantity
+		if err := insertMovement(ctx, tx, productId, part.WarehouseId, part.Quantity, running, change, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// warehousePart is the share of a stock change applied to one warehouse
+type warehousePart struct {
+	WarehouseId int64
+	Quantity    int64
+}
+
+// allocateWarehouses chooses the warehouses a deduction of quantity units is taken from
+func allocateWarehouses(ctx context.Context, tx *sql.Tx, productId, quantity int64, change StockChange) ([]warehousePart, error) {
+	order := `w.priority, w.id`
+	args := []interface{}{productId}
+	if change.Allocation == AllocationNearest && change.Region != "" {
+		order = `(w.region = $2) DESC, w.priority, w.id`
+		args = append(args, change.Region)
+	}
+
+	query := `SELECT ws.warehouse_id, ws.stock
+			  FROM warehouse_stock ws
+			  JOIN warehouses w ON w.id = ws.warehouse_id
+			  WHERE ws.product_id = $1 AND ws.stock > 0
+			  ORDER BY ` + order + `
+			  FOR UPDATE OF ws`
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var levels []warehousePart
+	for rows.Next() {
+		var level warehousePart
+		if err := rows.Scan(&level.WarehouseId, &level.Quantity); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		levels = append(levels, level)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Ship from a single warehouse when one can, fewer parcels
+	if change.Allocation != AllocationSplit {
+		for _, level := range levels {
+			if level.Quantity >= quantity {
+				return []warehousePart{{WarehouseId: level.WarehouseId, Quantity: quantity}}, nil
+			}
+		}
+	}
+
+	var parts []warehousePart
+	remaining := quantity
+	for _, level := range levels {
+		if remaining == 0 {
+			break
+		}
+
+		take := min(level.Quantity, remaining)
+		parts = append(parts, warehousePart{WarehouseId: level.WarehouseId, Quantity: take})
+		remaining -= take
+	}
+
+	if remaining > 0 {
+		return nil, ErrInsufficientStock
+	}
+
+	return parts, nil
+}
+
+// restockWarehouses chooses the warehouses an addition of quantity units goes to
+// Cancelled orders and closed flash sales give stock back where it was taken from,
+// anything else goes to the default warehouse
+func restockWarehouses(ctx context.Context, tx *sql.Tx, productId, quantity int64, change StockChange) ([]warehousePart, error) {
+	var parts []warehousePart
+
+	var reasons []string
+	switch change.Reason {
+	case StockReasonCancel, StockReasonCompensation:
+		reasons = []string{StockReasonOrder, StockReasonCancel, StockReasonCompensation}
+	case StockReasonFlashSale:
+		reasons = []string{StockReasonFlashSale}
+	}
+
+	remaining := quantity
+	if len(reasons) > 0 && change.ReferenceId != "" {
+		// Net quantity the reference still holds per warehouse
+		rows, err := tx.QueryContext(ctx, `SELECT warehouse_id, -SUM(quantity)
+			FROM inventory_movements
+			WHERE product_id = $1 AND reference_id = $2 AND reason = ANY($3) AND warehouse_id > 0
+			GROUP BY warehouse_id
+			HAVING SUM(quantity) < 0
+			ORDER BY warehouse_id`,
+			productId, change.ReferenceId, pq.Array(reasons))
+		if err != nil {
+			return nil, err
+		}
+
+		for rows.Next() {
+			var taken warehousePart
+			if err := rows.Scan(&taken.WarehouseId, &taken.Quantity); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			if remaining == 0 {
+				continue
+			}
+
+			give := min(taken.Quantity, remaining)
+			parts = append(parts, warehousePart{WarehouseId: taken.WarehouseId, Quantity: give})
+			remaining -= give
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	if remaining > 0 {
+		var defaultId int64
+		err := tx.QueryRowContext(ctx, `SELECT id FROM warehouses ORDER BY priority, id LIMIT 1`).Scan(&defaultId)
+		if err == sql.ErrNoRows {
+			return nil, ErrWarehouseNotFound
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, warehousePart{WarehouseId: defaultId, Quantity: remaining})
+	}
+
+	return parts, nil
+}
+
+// addWarehouseStock changes the stock of a product in one warehouse, quantity can be negative
+// Returns ErrInsufficientStock if the warehouse does not hold enough
+func addWarehouseStock(ctx context.Context, tx *sql.Tx, productId, warehouseId, quantity, now int64) error {
+	if quantity < 0 {
+		result, err := tx.ExecContext(ctx, `UPDATE warehouse_stock SET stock = stock + $1, updated_at = $2
+			WHERE warehouse_id = $3 AND product_id = $4 AND stock + $1 >= 0`,
+			quantity, now, warehouseId, productId)
+		if err != nil {
+			return err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if affected == 0 {
+			return ErrInsufficientStock
+		}
+
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, `INSERT INTO warehouse_stock (warehouse_id, product_id, stock, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (warehouse_id, product_id) DO UPDATE
+		SET stock = warehouse_stock.stock + EXCLUDED.stock, updated_at = EXCLUDED.updated_at`,
+		warehouseId, productId, quantity, now)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23503" {
+			return ErrWarehouseNotFound
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ErrWarehouseNotFound is returned when a warehouse does not exist
+var ErrWarehouseNotFound = fmt.Errorf("warehouse not found")
+
+// ErrDuplicateWarehouseCode is returned when the warehouse code is already used
+var ErrDuplicateWarehouseCode = fmt.Errorf("duplicate warehouse code")
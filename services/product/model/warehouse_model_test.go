@@ -0,0 +1,139 @@
+package model
+
+import (
+	"context"
+	"database/sql"
+	"reflect"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestAllocateWarehouses(t *testing.T) {
+	// Warehouse 1 and 2 come first by priority, 3 is in the customer region
+	levels := []warehousePart{{1, 2}, {2, 5}, {3, 4}}
+
+	tests := []struct {
+		name       string
+		quantity   int64
+		allocation string
+		want       []warehousePart
+		err        error
+	}{
+		{name: "first warehouse holding everything", quantity: 4, want: []warehousePart{{2, 4}}},
+		{name: "split when none can ship alone", quantity: 9, want: []warehousePart{{1, 2}, {2, 5}, {3, 2}}},
+		{name: "nearest", quantity: 3, allocation: AllocationNearest, want: []warehousePart{{3, 3}}},
+		{name: "split strategy drains in order", quantity: 4, allocation: AllocationSplit, want: []warehousePart{{1, 2}, {2, 2}}},
+		{name: "insufficient", quantity: 12, err: ErrInsufficientStock},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			rows := sqlmock.NewRows([]string{"warehouse_id", "stock"})
+			ordered := levels
+			if tt.allocation == AllocationNearest {
+				ordered = []warehousePart{levels[2], levels[0], levels[1]}
+			}
+			for _, level := range ordered {
+				rows.AddRow(level.WarehouseId, level.Quantity)
+			}
+
+			mock.ExpectBegin()
+			query := mock.ExpectQuery(`FROM warehouse_stock ws`)
+			if tt.allocation == AllocationNearest {
+				query.WithArgs(int64(1), "east")
+			} else {
+				query.WithArgs(int64(1))
+			}
+			query.WillReturnRows(rows)
+
+			tx, err := db.Begin()
+			if err != nil {
+				t.Fatal(err)
+			}
+			parts, err := allocateWarehouses(context.Background(), tx, 1, tt.quantity, StockChange{Allocation: tt.allocation, Region: "east"})
+
+			if err != tt.err {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if !reflect.DeepEqual(parts, tt.want) {
+				t.Fatalf("parts = %v, want %v", parts, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	tests := []struct {
+		name         string
+		found        int
+		insufficient bool
+		err          error
+	}{
+		{name: "moved", found: 2},
+		{name: "unknown warehouse", found: 1, err: ErrWarehouseNotFound},
+		{name: "source lacks stock", found: 2, insufficient: true, err: ErrInsufficientStock},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conn, mock := newModelTest(t)
+			change := StockChange{Reason: StockReasonTransfer, OperatorId: 7}
+
+			mock.ExpectBegin()
+			mock.ExpectQuery(`SELECT stock FROM products WHERE id = \$1 AND status <> 3 FOR UPDATE`).WithArgs(int64(1)).
+				WillReturnRows(sqlmock.NewRows([]string{"stock"}).AddRow(10))
+			mock.ExpectQuery(`SELECT COUNT\(\*\) FROM warehouses`).WithArgs(int64(1), int64(2)).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(tt.found))
+
+			if tt.found == 2 {
+				affected := int64(1)
+				if tt.insufficient {
+					affected = 0
+				}
+				mock.ExpectExec(`UPDATE warehouse_stock`).WithArgs(int64(-3), int64(100), int64(1), int64(1)).
+					WillReturnResult(sqlmock.NewResult(0, affected))
+			}
+			if tt.err != nil {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectExec(`INSERT INTO inventory_movements`).
+					WithArgs(int64(1), int64(1), int64(-3), int64(10), StockReasonTransfer, "", int64(7), int64(100)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO warehouse_stock`).WithArgs(int64(2), int64(1), int64(3), int64(100)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`INSERT INTO inventory_movements`).
+					WithArgs(int64(1), int64(2), int64(3), int64(10), StockReasonTransfer, "", int64(7), int64(100)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			}
+
+			err := NewWarehouseModel(conn).Transfer(context.Background(), 1, 1, 2, 3, change, 100)
+			if err != tt.err {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestTransferDeletedProduct(t *testing.T) {
+	conn, mock := newModelTest(t)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT stock FROM products`).WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	err := NewWarehouseModel(conn).Transfer(context.Background(), 1, 1, 2, 3, StockChange{Reason: StockReasonTransfer}, 100)
+	if err != ErrNotFound {
+		t.Fatalf("err = %v, want %v", err, ErrNotFound)
+	}
+}
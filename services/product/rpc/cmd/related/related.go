@@ -0,0 +1,134 @@
+// Command related rebuilds the "frequently bought together" pairs of the product service
+// from the order database. Run it from services/product/rpc:
+//
+//	go run cmd/related/related.go -f etc/product.yaml
+//
+// Orders created while it runs are counted from order.created events, the
+// rebuild only replaces the counts and never counts an order twice: it stores
+// the highest order ID it counted as a watermark, and only the few orders newer
+// than -settle (which may still be committing) are tracked one by one.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"time"
+
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/config"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/sqlx"
+)
+
+var (
+	configFile = flag.String("f", "etc/product.yaml", "the config file")
+	timeout    = flag.Duration("timeout", 30*time.Minute, "maximum run time")
+	settle     = flag.Duration("settle", 10*time.Minute, "orders created more recently are tracked by ID instead of the watermark")
+)
+
+// orderStatusCancelled is OrderStatusCancelled of the order model
+const orderStatusCancelled = 5
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+	if c.Related.OrderDataSource == "" {
+		logx.Must(fmt.Errorf("Related.OrderDataSource is not configured"))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	start := time.Now()
+
+	// 1. Read pairs and counted orders from one snapshot of the order database
+	orderConn := sqlx.NewSqlConn("postgres", c.Related.OrderDataSource)
+	snapshot, err := loadCoPurchases(ctx, orderConn, *settle)
+	logx.Must(err)
+
+	// 2. Replace the pairs in the product database
+	relatedModel := model.NewRelatedModel(sqlx.NewSqlConn("postgres", c.DB.DataSource))
+	logx.Must(relatedModel.Rebuild(ctx, snapshot.pairs, snapshot.lastOrderId, snapshot.newerOrderIds, time.Now().Unix()))
+
+	fmt.Printf("Rebuilt %d co-purchase pairs up to order %d (+%d newer orders) in %s\n",
+		len(snapshot.pairs), snapshot.lastOrderId, len(snapshot.newerOrderIds), time.Since(start).Round(time.Millisecond))
+}
+
+// coPurchaseSnapshot is what the rebuild reads from the order database
+type coPurchaseSnapshot struct {
+	pairs []*model.CoPurchase
+
+	// Watermark: every order up to this ID is in the snapshot
+	lastOrderId int64
+
+	// Orders of the snapshot above the watermark
+	newerOrderIds []int64
+}
+
+// loadCoPurchases counts the orders containing each pair of products, cancelled orders excluded.
+// Order IDs are not assigned in commit order, so the watermark is the highest ID of the orders
+// created more than settle ago; newer orders of the snapshot are returned by ID.
+// Cancelled orders are counted as seen too, so their events are not counted afterwards
+func loadCoPurchases(ctx context.Context, conn sqlx.SqlConn, settle time.Duration) (*coPurchaseSnapshot, error) {
+	db, err := conn.RawDB()
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	rows, err := tx.QueryContext(ctx, `SELECT a.product_id, b.product_id, COUNT(DISTINCT a.order_id)
+		FROM order_items a
+		JOIN order_items b ON b.order_id = a.order_id AND b.product_id <> a.product_id
+		JOIN orders o ON o.id = a.order_id
+		WHERE o.status <> $1
+		GROUP BY a.product_id, b.product_id`, orderStatusCancelled)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	snapshot := &coPurchaseSnapshot{}
+	for rows.Next() {
+		var pair model.CoPurchase
+		if err := rows.Scan(&pair.ProductId, &pair.RelatedId, &pair.Orders); err != nil {
+			return nil, err
+		}
+		snapshot.pairs = append(snapshot.pairs, &pair)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	err = tx.QueryRowContext(ctx, `SELECT COALESCE(MAX(id), 0) FROM orders
+		WHERE created_at < CURRENT_TIMESTAMP - make_interval(secs => $1)`, settle.Seconds()).Scan(&snapshot.lastOrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	orderRows, err := tx.QueryContext(ctx, `SELECT id FROM orders WHERE id > $1`, snapshot.lastOrderId)
+	if err != nil {
+		return nil, err
+	}
+	defer orderRows.Close()
+
+	for orderRows.Next() {
+		var orderId int64
+		if err := orderRows.Scan(&orderId); err != nil {
+			return nil, err
+		}
+		snapshot.newerOrderIds = append(snapshot.newerOrderIds, orderId)
+	}
+
+	return snapshot, orderRows.Err()
+}
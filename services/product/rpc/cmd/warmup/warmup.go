@@ -0,0 +1,67 @@
+// Command warmup rebuilds the product caches in Redis, e.g. after a flush. Run it from services/product/rpc:
+//
+//	go run cmd/warmup/warmup.go -f etc/product.yaml
+//
+// It first raises the list/search version counters above every version still in use,
+// then caches the details of the best sellers and the first list pages of every category.
+// The service can keep running, everything it writes is what a request would have cached.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"letsgo/services/product/rpc/internal/config"
+	"letsgo/services/product/rpc/internal/logic"
+	"letsgo/services/product/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	configFile = flag.String("f", "etc/product.yaml", "the config file")
+	top        = flag.Int("top", 1000, "number of best-selling products whose detail is cached")
+	pages      = flag.Int("pages", 3, "number of list pages cached per category")
+	pageSize   = flag.Int("page-size", 20, "list page size, the gateway default is 20")
+	timeout    = flag.Duration("timeout", 10*time.Minute, "maximum run time")
+)
+
+func main() {
+	flag.Parse()
+
+	var c config.Config
+	conf.MustLoad(*configFile, &c)
+
+	// Only Redis is warmed up, this process has no local cache of its own
+	c.Cache.Local.Enabled = false
+	svcCtx := svc.NewServiceContext(c)
+	defer svcCtx.KafkaProducer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	start := time.Now()
+
+	categories, err := svcCtx.ProductModel.ListCategories(ctx)
+	logx.Must(err)
+
+	// 1. Versions first, pages rendered below must carry the new versions
+	versions, err := logic.ReseedVersions(ctx, svcCtx, categories)
+	logx.Must(err)
+	fmt.Printf("Reseeded %d version counters, global version %d\n", len(versions), versions[""])
+
+	// 2. Product details of the best sellers
+	products, err := logic.WarmUpProductDetails(ctx, svcCtx, *top)
+	logx.Must(err)
+	fmt.Printf("Cached %d product details\n", products)
+
+	// 3. First list pages, for all products and per category
+	listPages, err := logic.WarmUpListPages(ctx, svcCtx, categories, *pages, int32(*pageSize))
+	logx.Must(err)
+	fmt.Printf("Cached %d list pages of %d categories\n", listPages, len(categories))
+
+	fmt.Printf("Warm-up finished in %s\n", time.Since(start).Round(time.Millisecond))
+}
@@ -0,0 +1,135 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	red "github.com/redis/go-redis/v9"
+	"github.com/zeromicro/go-zero/core/collection"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// InvalidationChannel is the Redis pub/sub channel carrying cache keys to drop from every local cache
+const InvalidationChannel = "product:cache:invalidate"
+
+// LocalCache is an in-process LRU in front of Redis.
+// Each instance subscribes to InvalidationChannel, so a key invalidated on one node is dropped
+// on all of them. Pub/sub is fire-and-forget, messages sent while a node is reconnecting are lost,
+// so entries also expire quickly on their own.
+// A nil *LocalCache is a valid, disabled cache: Get always misses and the rest is a no-op.
+type LocalCache struct {
+	cache  *collection.Cache
+	client red.UniversalClient
+	pubsub *red.PubSub
+}
+
+// NewLocalCache creates a local cache holding up to size entries for expire,
+// and starts listening for invalidations
+func NewLocalCache(conf redis.RedisConf, size int, expire time.Duration) (*LocalCache, error) {
+	lru, err := collection.NewCache(expire, collection.WithLimit(size), collection.WithName("product-local"))
+	if err != nil {
+		return nil, err
+	}
+
+	var client red.UniversalClient
+	if conf.Type == redis.ClusterType {
+		client = red.NewClusterClient(&red.ClusterOptions{
+			Addrs:    strings.Split(conf.Host, ","),
+			Username: conf.User,
+			Password: conf.Pass,
+		})
+	} else {
+		client = red.NewClient(&red.Options{
+			Addr:     conf.Host,
+			Username: conf.User,
+			Password: conf.Pass,
+		})
+	}
+
+	c := &LocalCache{
+		cache:  lru,
+		client: client,
+		pubsub: client.Subscribe(context.Background(), InvalidationChannel),
+	}
+	c.listen()
+
+	return c, nil
+}
+
+// Get returns the cached value of key
+func (c *LocalCache) Get(key string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	val, ok := c.cache.Get(key)
+	if !ok {
+		return "", false
+	}
+
+	return val.(string), true
+}
+
+// Set caches val under key with the default expire
+func (c *LocalCache) Set(key, val string) {
+	if c == nil {
+		return
+	}
+
+	c.cache.Set(key, val)
+}
+
+// Del drops keys from this node only, use Publish to drop them everywhere
+func (c *LocalCache) Del(keys ...string) {
+	if c == nil {
+		return
+	}
+
+	for _, key := range keys {
+		c.cache.Del(key)
+	}
+}
+
+// Close stops listening for invalidations
+func (c *LocalCache) Close() {
+	if c == nil {
+		return
+	}
+
+	c.pubsub.Close()
+	c.client.Close()
+}
+
+// listen drops keys announced on InvalidationChannel until Close is called
+func (c *LocalCache) listen() {
+	threading.GoSafe(func() {
+		for msg := range c.pubsub.Channel() {
+			var keys []string
+			if err := json.Unmarshal([]byte(msg.Payload), &keys); err != nil {
+				logx.Errorf("Invalid cache invalidation message: %s, err:%s", msg.Payload, err)
+				continue
+			}
+			c.Del(keys...)
+		}
+	})
+}
+
+// Publish announces keys to drop from every local cache, including this node's.
+// Publishing is cheap when nobody listens, so it is done whether local caching is enabled or not
+func Publish(ctx context.Context, rds *redis.Redis, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+
+	_, err = rds.PublishCtx(ctx, InvalidationChannel, string(payload))
+	return err
+}
@@ -0,0 +1,70 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+func TestLocalCacheInvalidation(t *testing.T) {
+	mr := miniredis.RunT(t)
+	conf := redis.RedisConf{Host: mr.Addr(), Type: redis.NodeType}
+
+	// Two instances sharing Redis
+	a, err := NewLocalCache(conf, 10, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+	b, err := NewLocalCache(conf, 10, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+
+	a.Set("product:detail:1", "a")
+	b.Set("product:detail:1", "b")
+	b.Set("product:detail:2", "b")
+
+	// Wait until both instances listen
+	deadline := time.Now().Add(2 * time.Second)
+	for mr.PubSubNumSub(InvalidationChannel)[InvalidationChannel] < 2 {
+		if time.Now().After(deadline) {
+			t.Fatal("local caches not subscribed")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if err := Publish(context.Background(), redis.New(mr.Addr()), "product:detail:1"); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, c := range []*LocalCache{a, b} {
+		for {
+			if _, ok := c.Get("product:detail:1"); !ok {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatal("invalidated key still cached")
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+	}
+	if val, ok := b.Get("product:detail:2"); !ok || val != "b" {
+		t.Fatal("key that was not invalidated dropped")
+	}
+}
+
+func TestDisabledLocalCache(t *testing.T) {
+	var c *LocalCache
+
+	c.Set("product:detail:1", "a")
+	if _, ok := c.Get("product:detail:1"); ok {
+		t.Fatal("disabled cache returned a value")
+	}
+	c.Del("product:detail:1")
+	c.Close()
+}
@@ -28,5 +28,57 @@ type Config struct {
 		ProductExpire int
 		ListExpire    int
 		SearchExpire  int
+		ExpireJitter  float64 `json:",default=0.1"`  // Randomize expire times by ±10%, so keys written together don't expire together
+		ListStale     int     `json:",default=60"`   // Seconds an outdated list page is still served while a single request refreshes it
+		RelatedExpire int     `json:",default=3600"` // Seconds related product IDs are cached
+
+		// In-process LRU in front of Redis, invalidated through Redis pub/sub
+		Local struct {
+			Enabled bool `json:",default=false"`
+			Size    int  `json:",default=10000"` // Max entries per instance
+			Expire  int  `json:",default=10"`    // Seconds, bounds staleness if an invalidation message is missed
+		}
+	}
+
+	// Background schedule settings
+	Schedule struct {
+		PublishInterval    int `json:",default=30"` // Seconds between scans for products due to publish
+		PriceInterval      int `json:",default=30"` // Seconds between scans for price schedules to apply/revert
+		FlashSaleInterval  int `json:",default=5"`  // Seconds between scans for flash sales to activate/close
+		StockAlertInterval int `json:",default=30"` // Seconds between scans for low-stock and back-in-stock notifications
+	}
+
+	// Bulk import/export settings
+	Import struct {
+		BatchSize int `json:",default=200"`   // Rows per transaction on import, rows per chunk on export
+		MaxRows   int `json:",default=10000"` // Maximum rows accepted in a single import file
+	}
+
+	// Inventory settings
+	Inventory struct {
+		Allocation string `json:",default=priority,options=priority|nearest|split"` // Default warehouse allocation of stock deductions
+	}
+
+	// Related products settings
+	Related struct {
+		OrderDataSource string `json:",optional"`   // Order database read by the rebuild command
+		MaxProducts     int    `json:",default=20"` // Related products kept per product
+	}
+
+	// Kafka configuration
+	Kafka struct {
+		Brokers []string
+		GroupId string `json:",default=product.rpc"` // Consumer group for topics consumed by the product service
+		Topics  struct {
+			FlashSaleOrder string `json:",default=flashsale.order"`             // Flash sale orders queued for the order service
+			StockLow       string `json:",default=product.stock.low"`           // Stock dropped to the low-stock threshold
+			BackInStock    string `json:",default=product.stock.back_in_stock"` // Out-of-stock product restocked, with the users to notify
+			OrderCreated   string `json:",default=order.created"`               // Consumed: products bought together are counted for recommendations
+			ProductCreated string `json:",default=product.created"`             // Product change events for carts, search indexes and caches elsewhere
+			ProductUpdated string `json:",default=product.updated"`
+			PriceChanged   string `json:",default=product.price.changed"`
+			StockChanged   string `json:",default=product.stock.changed"`
+			ProductDeleted string `json:",default=product.deleted"`
+		}
 	}
 }
@@ -0,0 +1,137 @@
+package consumer
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/internal/utils"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// maxRetryBackoff caps the wait between attempts to count the same order
+const maxRetryBackoff = 30 * time.Second
+
+// OrderCreatedConsumer counts the products of created orders as bought together.
+// Offsets are committed only after the order is counted, a redelivered order is skipped
+// because counted order numbers are recorded with the counts
+type OrderCreatedConsumer struct {
+	svcCtx *svc.ServiceContext
+	reader *kafka.Reader
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewOrderCreatedConsumer creates an order created consumer
+func NewOrderCreatedConsumer(svcCtx *svc.ServiceContext) *OrderCreatedConsumer {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &OrderCreatedConsumer{
+		svcCtx: svcCtx,
+		reader: kafka.NewReader(kafka.ReaderConfig{
+			Brokers:  svcCtx.Config.Kafka.Brokers,
+			GroupID:  svcCtx.Config.Kafka.GroupId,
+			Topic:    svcCtx.Config.Kafka.Topics.OrderCreated,
+			MinBytes: 1,
+			MaxBytes: 10e6,
+		}),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Start consumes in background until Stop is called
+func (c *OrderCreatedConsumer) Start() {
+	threading.GoSafe(func() {
+		for {
+			msg, err := c.reader.FetchMessage(c.ctx)
+			if err != nil {
+				if c.ctx.Err() != nil {
+					return
+				}
+				logx.Errorf("Failed to fetch order created event: %v", err)
+				time.Sleep(time.Second)
+				continue
+			}
+
+			if !c.handle(msg) {
+				// Stopped before the order was counted, leave the offset for the next start
+				return
+			}
+
+			if err := c.reader.CommitMessages(c.ctx, msg); err != nil {
+				logx.Errorf("Failed to commit order created event: offset=%d, err=%v", msg.Offset, err)
+			}
+		}
+	})
+}
+
+// Stop stops consuming and closes the reader
+func (c *OrderCreatedConsumer) Stop() {
+	c.cancel()
+	c.reader.Close()
+}
+
+// handle counts the products of one order, retrying until it is stored.
+// Returns false if the consumer was stopped first
+func (c *OrderCreatedConsumer) handle(msg kafka.Message) bool {
+	var event utils.OrderCreatedEvent
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		// Retrying cannot fix a malformed event, skip it
+		logx.Errorf("Invalid order created event, skipped: offset=%d, err=%v", msg.Offset, err)
+		return true
+	}
+
+	productIds := make([]int64, 0, len(event.Data.Items))
+	for _, item := range event.Data.Items {
+		productIds = append(productIds, item.ProductID)
+	}
+
+	// A single product has nothing to pair with
+	if event.Data.OrderID <= 0 || len(productIds) < 2 {
+		return true
+	}
+
+	backoff := time.Second
+	for {
+		err := c.countOrder(event.Data.OrderID, productIds)
+		if err == nil {
+			return true
+		}
+
+		logx.Errorf("Failed to count order for recommendations, retrying in %s: order_id=%d, err=%v", backoff, event.Data.OrderID, err)
+
+		select {
+		case <-time.After(backoff):
+		case <-c.ctx.Done():
+			return false
+		}
+
+		backoff *= 2
+		if backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+	}
+}
+
+// countOrder adds the order to the co-purchase counts
+// Cached related products pick up the new counts when they expire
+func (c *OrderCreatedConsumer) countOrder(orderId int64, productIds []int64) error {
+	ctx, cancel := context.WithTimeout(c.ctx, 10*time.Second)
+	defer cancel()
+
+	added, err := c.svcCtx.RelatedModel.AddOrder(ctx, orderId, productIds, time.Now().Unix())
+	if err != nil {
+		return err
+	}
+
+	if !added {
+		logx.Infof("Order already counted for recommendations: order_id=%d", orderId)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/logic"
+	"letsgo/services/product/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// flashSaleBatchSize limits sales closed per scan, the rest is picked up by the next tick
+const flashSaleBatchSize = 100
+
+// FlashSaleJob periodically activates started flash sales and closes ended ones,
+// returning their unsold quantity to product stock
+type FlashSaleJob struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+	done     chan struct{}
+}
+
+// NewFlashSaleJob creates a flash sale job
+func NewFlashSaleJob(svcCtx *svc.ServiceContext) *FlashSaleJob {
+	return &FlashSaleJob{
+		svcCtx:   svcCtx,
+		interval: time.Duration(svcCtx.Config.Schedule.FlashSaleInterval) * time.Second,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the job in background until Stop is called
+func (j *FlashSaleJob) Start() {
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				j.runOnce()
+			case <-j.done:
+				return
+			}
+		}
+	})
+}
+
+// Stop stops the job
+func (j *FlashSaleJob) Stop() {
+	close(j.done)
+}
+
+// runOnce activates sales whose window opened and closes sales whose window ended
+func (j *FlashSaleJob) runOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), j.interval)
+	defer cancel()
+
+	now := time.Now().Unix()
+
+	activated, err := j.svcCtx.FlashSaleModel.ActivateDue(ctx, now)
+	if err != nil {
+		logx.Errorf("Failed to activate flash sales: %v", err)
+	} else if activated > 0 {
+		logx.Infof("Activated %d flash sales", activated)
+	}
+
+	sales, err := j.svcCtx.FlashSaleModel.FindDueToClose(ctx, now, flashSaleBatchSize)
+	if err != nil {
+		logx.Errorf("Failed to find flash sales to close: %v", err)
+		return
+	}
+
+	for _, sale := range sales {
+		returned, err := logic.CloseFlashSale(ctx, j.svcCtx, sale, model.FlashSaleFinished, 0)
+		if err != nil {
+			if err != model.ErrFlashSaleClosed {
+				logx.Errorf("Failed to close flash sale: sale_id=%d, err=%v", sale.Id, err)
+			}
+			continue
+		}
+
+		logx.Infof("Flash sale finished: sale_id=%d, product_id=%d, sold=%d, returned=%d",
+			sale.Id, sale.ProductId, sale.Quantity-returned, returned)
+	}
+}
@@ -0,0 +1,90 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/logic"
+	"letsgo/services/product/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// priceBatchSize limits schedules handled per scan, the rest is picked up by the next tick
+const priceBatchSize = 100
+
+// PriceJob periodically applies due price schedules and reverts expired ones
+type PriceJob struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+	done     chan struct{}
+}
+
+// NewPriceJob creates a scheduled price change job
+func NewPriceJob(svcCtx *svc.ServiceContext) *PriceJob {
+	return &PriceJob{
+		svcCtx:   svcCtx,
+		interval: time.Duration(svcCtx.Config.Schedule.PriceInterval) * time.Second,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the job in background until Stop is called
+func (j *PriceJob) Start() {
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				j.runOnce()
+			case <-j.done:
+				return
+			}
+		}
+	})
+}
+
+// Stop stops the job
+func (j *PriceJob) Stop() {
+	close(j.done)
+}
+
+// runOnce reverts ended schedules before applying new ones, so a sale that ends
+// exactly when the next one starts hands over cleanly
+func (j *PriceJob) runOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), j.interval)
+	defer cancel()
+
+	now := time.Now().Unix()
+
+	ended, err := j.svcCtx.PriceModel.EndDue(ctx, now, priceBatchSize)
+	if err != nil {
+		logx.Errorf("Failed to revert price schedules: %v", err)
+	}
+	j.invalidate(ctx, ended, "reverted")
+
+	started, err := j.svcCtx.PriceModel.StartDue(ctx, now, priceBatchSize)
+	if err != nil {
+		logx.Errorf("Failed to apply price schedules: %v", err)
+	}
+	j.invalidate(ctx, started, "applied")
+}
+
+// invalidate drops caches of products whose price changed
+func (j *PriceJob) invalidate(ctx context.Context, changes []*model.PriceChange, action string) {
+	for _, change := range changes {
+		if !change.Changed {
+			logx.Infof("Price schedule %s without price change: schedule_id=%d, product_id=%d", action, change.ScheduleId, change.ProductId)
+			continue
+		}
+
+		logx.Infof("Price schedule %s: schedule_id=%d, product_id=%d, price %.2f -> %.2f",
+			action, change.ScheduleId, change.ProductId, change.OldPrice, change.NewPrice)
+		logic.InvalidateProductCache(ctx, &j.svcCtx.Redis, change.ProductId, change.Category)
+		logic.PublishPriceChanged(j.svcCtx, change.ProductId, change.OldPrice, change.NewPrice, logic.PriceSourceSchedule, change.ScheduleId, 0)
+	}
+}
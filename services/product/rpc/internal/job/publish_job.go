@@ -0,0 +1,67 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"letsgo/services/product/rpc/internal/logic"
+	"letsgo/services/product/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// PublishJob periodically publishes products whose scheduled publish time has passed
+type PublishJob struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+	done     chan struct{}
+}
+
+// NewPublishJob creates a scheduled publish job
+func NewPublishJob(svcCtx *svc.ServiceContext) *PublishJob {
+	return &PublishJob{
+		svcCtx:   svcCtx,
+		interval: time.Duration(svcCtx.Config.Schedule.PublishInterval) * time.Second,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the job in background until Stop is called
+func (j *PublishJob) Start() {
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				j.runOnce()
+			case <-j.done:
+				return
+			}
+		}
+	})
+}
+
+// Stop stops the job
+func (j *PublishJob) Stop() {
+	close(j.done)
+}
+
+// runOnce publishes all due products and invalidates their caches
+func (j *PublishJob) runOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), j.interval)
+	defer cancel()
+
+	products, err := j.svcCtx.ProductModel.PublishDue(ctx, time.Now().Unix())
+	if err != nil {
+		logx.Errorf("Failed to publish scheduled products: %v", err)
+		return
+	}
+
+	for _, p := range products {
+		logx.Infof("Scheduled product published: product_id=%d", p.Id)
+		logic.InvalidateStockCache(ctx, j.svcCtx, []int64{p.Id}, p.Category)
+	}
+}
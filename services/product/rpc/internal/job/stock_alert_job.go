@@ -0,0 +1,169 @@
+package job
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+const (
+	// stockAlertBatchSize bounds the products handled per run, the rest wait for the next tick
+	stockAlertBatchSize = 100
+	// backInStockChunkSize bounds the users carried by a single back-in-stock event
+	backInStockChunkSize = 500
+)
+
+// StockAlertJob periodically publishes low-stock alerts and back-in-stock notifications
+type StockAlertJob struct {
+	svcCtx   *svc.ServiceContext
+	interval time.Duration
+	done     chan struct{}
+}
+
+// NewStockAlertJob creates a stock alert job
+func NewStockAlertJob(svcCtx *svc.ServiceContext) *StockAlertJob {
+	return &StockAlertJob{
+		svcCtx:   svcCtx,
+		interval: time.Duration(svcCtx.Config.Schedule.StockAlertInterval) * time.Second,
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the job in background until Stop is called
+func (j *StockAlertJob) Start() {
+	threading.GoSafe(func() {
+		ticker := time.NewTicker(j.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				j.runOnce()
+			case <-j.done:
+				return
+			}
+		}
+	})
+}
+
+// Stop stops the job
+func (j *StockAlertJob) Stop() {
+	close(j.done)
+}
+
+// runOnce publishes pending low-stock alerts and notifies subscribers of restocked products
+func (j *StockAlertJob) runOnce() {
+	ctx, cancel := context.WithTimeout(context.Background(), j.interval)
+	defer cancel()
+
+	j.alertLowStock(ctx)
+	j.notifyRestocked(ctx)
+}
+
+// alertLowStock publishes one alert per product crossing its threshold
+// Products back above their threshold are re-armed first, so a product that
+// was restocked and ran low again between two runs is still reported
+func (j *StockAlertJob) alertLowStock(ctx context.Context) {
+	reset, err := j.svcCtx.StockAlertModel.ResetLowStockAlerts(ctx)
+	if err != nil {
+		logx.Errorf("Failed to reset low-stock alerts: %v", err)
+		return
+	}
+	if reset > 0 {
+		logx.Infof("Re-armed low-stock alerts of %d products", reset)
+	}
+
+	products, err := j.svcCtx.StockAlertModel.FindLowStockToAlert(ctx, stockAlertBatchSize)
+	if err != nil {
+		logx.Errorf("Failed to find low-stock products: %v", err)
+		return
+	}
+
+	now := time.Now().Unix()
+	alerted := make([]int64, 0, len(products))
+	for _, p := range products {
+		event := utils.StockLowEvent{
+			EventType: "product.stock.low",
+			EventID:   uuid.New().String(),
+			Timestamp: now,
+			Data: utils.StockLowData{
+				ProductID: p.ProductId,
+				Sku:       p.Sku,
+				Name:      p.Name,
+				Stock:     p.Stock,
+				Threshold: p.Threshold,
+			},
+		}
+
+		// Not marked on failure, retried on the next run
+		err := j.svcCtx.KafkaProducer.PublishEvent(ctx, j.svcCtx.Config.Kafka.Topics.StockLow,
+			strconv.FormatInt(p.ProductId, 10), event)
+		if err != nil {
+			logx.Errorf("Failed to publish low-stock alert: product_id=%d, err=%v", p.ProductId, err)
+			continue
+		}
+
+		logx.Infof("Low-stock alert published: product_id=%d, stock=%d, threshold=%d", p.ProductId, p.Stock, p.Threshold)
+		alerted = append(alerted, p.ProductId)
+	}
+
+	if err := j.svcCtx.StockAlertModel.MarkLowStockAlerted(ctx, alerted); err != nil {
+		logx.Errorf("Failed to mark low-stock alerts as sent: %v", err)
+	}
+}
+
+// notifyRestocked publishes the pending subscribers of products back in stock
+// Subscriptions are marked as notified before publishing and restored if publishing fails
+func (j *StockAlertJob) notifyRestocked(ctx context.Context) {
+	productIds, err := j.svcCtx.StockAlertModel.FindRestocked(ctx, stockAlertBatchSize)
+	if err != nil {
+		logx.Errorf("Failed to find restocked products: %v", err)
+		return
+	}
+
+	for _, productId := range productIds {
+		for {
+			now := time.Now().Unix()
+			userIds, err := j.svcCtx.StockAlertModel.TakeSubscribers(ctx, productId, now, backInStockChunkSize)
+			if err != nil {
+				logx.Errorf("Failed to take restock subscribers: product_id=%d, err=%v", productId, err)
+				break
+			}
+			if len(userIds) == 0 {
+				break
+			}
+
+			event := utils.BackInStockEvent{
+				EventType: "product.stock.back_in_stock",
+				EventID:   uuid.New().String(),
+				Timestamp: now,
+				Data: utils.BackInStockData{
+					ProductID: productId,
+					UserIDs:   userIds,
+				},
+			}
+
+			err = j.svcCtx.KafkaProducer.PublishEvent(ctx, j.svcCtx.Config.Kafka.Topics.BackInStock,
+				strconv.FormatInt(productId, 10), event)
+			if err != nil {
+				logx.Errorf("Failed to publish back-in-stock event: product_id=%d, err=%v", productId, err)
+				if err := j.svcCtx.StockAlertModel.RestoreSubscribers(ctx, productId, userIds); err != nil {
+					logx.Errorf("Failed to restore restock subscribers: product_id=%d, err=%v", productId, err)
+				}
+				break
+			}
+
+			logx.Infof("Back-in-stock event published: product_id=%d, users=%d", productId, len(userIds))
+			if len(userIds) < backInStockChunkSize {
+				break
+			}
+		}
+	}
+}
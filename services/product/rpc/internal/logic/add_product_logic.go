@@ -36,7 +36,18 @@ func (l *AddProductLogic) AddProduct(in *product.AddProductRequest) (*product.Ad
 
 	// 2. Prepare product data
 	now := time.Now().Unix()
+
+	// Products scheduled for the future start as draft unless a status is given
+	status := int64(in.Status)
+	if status == 0 {
+		status = model.ProductStatusPublished
+		if in.PublishAt > 0 {
+			status = model.ProductStatusDraft
+		}
+	}
+
 	newProduct := &model.Product{
+		Sku:         strings.TrimSpace(in.Sku),
 		Name:        in.Name,
 		Description: in.Description,
 		Price:       in.Price,
@@ -45,13 +56,17 @@ func (l *AddProductLogic) AddProduct(in *product.AddProductRequest) (*product.Ad
 		Images:      in.Images,
 		Attributes:  in.Attributes,
 		Sales:       0, // Initial sales count is 0
-		Status:      1, // 1 = active
+		Status:      status,
+		PublishAt:   in.PublishAt,
 		CreatedAt:   now,
 		UpdatedAt:   now,
 	}
 
 	// 3. Insert product into database
-	result, err := l.svcCtx.ProductModel.Insert(l.ctx, newProduct)
+	result, err := l.svcCtx.ProductModel.Insert(l.ctx, newProduct, in.OperatorId)
+	if err == model.ErrDuplicateSku {
+		return nil, errorx.ErrProductSkuExists
+	}
 	if err != nil {
 		l.Logger.Errorf("Failed to insert product: %v", err)
 		return nil, errorx.ErrDatabase
@@ -63,7 +78,7 @@ func (l *AddProductLogic) AddProduct(in *product.AddProductRequest) (*product.Ad
 		return nil, errorx.ErrDatabase
 	}
 
-	l.Logger.Infof("Product added successfully: product_id=%d, name=%s", productId, in.Name)
+	l.Logger.Infof("Product added successfully: product_id=%d, name=%s, status=%d", productId, in.Name, status)
 
 	err = IncCategoryVersion(l.ctx, in.Category, &l.svcCtx.Redis)
 	if err != nil {
@@ -75,6 +90,10 @@ func (l *AddProductLogic) AddProduct(in *product.AddProductRequest) (*product.Ad
 		l.Logger.Errorf("Increase global version failed! err:%s", err)
 	}
 
+	// 4. Tell downstream consumers
+	newProduct.Id = productId
+	publishProductChanged(l.svcCtx, EventProductCreated, newProduct, in.OperatorId)
+
 	return &product.AddProductResponse{
 		ProductId: productId,
 	}, nil
@@ -90,6 +109,11 @@ func (l *AddProductLogic) validateAddProductParams(in *product.AddProductRequest
 		return errorx.NewCodeError(1001, "Product name must be less than 200 characters")
 	}
 
+	// Validate SKU (optional)
+	if len(strings.TrimSpace(in.Sku)) > 64 {
+		return errorx.NewCodeError(1001, "Product SKU must be less than 64 characters")
+	}
+
 	// Validate price
 	if in.Price <= 0 {
 		return errorx.NewCodeError(1001, "Product price must be greater than 0")
@@ -105,5 +129,19 @@ func (l *AddProductLogic) validateAddProductParams(in *product.AddProductRequest
 		return errorx.NewCodeError(1001, "Product category cannot be empty")
 	}
 
+	// Validate status and scheduled publish time
+	switch int64(in.Status) {
+	case 0, model.ProductStatusUnpublished, model.ProductStatusDraft:
+	case model.ProductStatusPublished:
+		if in.PublishAt != 0 {
+			return errorx.NewCodeError(1001, "Publish time can only be set for draft or unpublished products")
+		}
+	default:
+		return errorx.NewCodeError(1001, "Invalid product status")
+	}
+	if in.PublishAt < 0 || (in.PublishAt > 0 && in.PublishAt <= time.Now().Unix()) {
+		return errorx.NewCodeError(1001, "Publish time must be in the future")
+	}
+
 	return nil
 }
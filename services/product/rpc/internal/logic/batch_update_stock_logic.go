@@ -2,7 +2,6 @@ package logic
 
 import (
 	"context"
-	"fmt"
 
 	"letsgo/common/errorx"
 	"letsgo/services/product/model"
@@ -33,6 +32,17 @@ func (l *BatchUpdateStockLogic) BatchUpdateStock(in *product.BatchUpdateStockReq
 	if len(in.Items) == 0 {
 		return nil, errorx.NewCodeError(1001, "No items to update")
 	}
+	if !stockChangeReasons[in.Reason] {
+		return nil, errorx.NewCodeError(1001, "Invalid stock change reason")
+	}
+
+	allocation := in.Allocation
+	if allocation == "" {
+		allocation = l.svcCtx.Config.Inventory.Allocation
+	}
+	if !allocations[allocation] {
+		return nil, errorx.NewCodeError(1001, "Invalid warehouse allocation")
+	}
 
 	// 2. Convert protobuf items to model items
 	modelItems := make([]model.StockUpdateItem, 0, len(in.Items))
@@ -46,12 +56,21 @@ func (l *BatchUpdateStockLogic) BatchUpdateStock(in *product.BatchUpdateStockReq
 		})
 	}
 
-	// 3. Update stock in transaction
-	results, err := l.svcCtx.ProductModel.BatchUpdateStock(l.ctx, modelItems)
+	// 3. Update stock in transaction and record the movements
+	results, err := l.svcCtx.ProductModel.BatchUpdateStock(l.ctx, modelItems, model.StockChange{
+		Reason:      in.Reason,
+		ReferenceId: in.ReferenceId,
+		OperatorId:  in.OperatorId,
+		Allocation:  allocation,
+		Region:      in.Region,
+	})
 	if err != nil {
 		if err == model.ErrNotFound {
 			return nil, errorx.ErrProductNotFound
 		}
+		if err == model.ErrWarehouseNotFound {
+			return nil, errorx.ErrWarehouseNotFound
+		}
 		if err == model.ErrInsufficientStock {
 			return nil, errorx.ErrProductOutOfStock
 		}
@@ -59,33 +78,38 @@ func (l *BatchUpdateStockLogic) BatchUpdateStock(in *product.BatchUpdateStockReq
 		return nil, errorx.ErrDatabase
 	}
 
-	l.Logger.Infof("Batch stock updated: %d products", len(results))
+	l.Logger.Infof("Batch stock updated: %d products, reason=%s, reference_id=%s", len(results), in.Reason, in.ReferenceId)
 
-	// 4. Clear cache for all affected products
+	// 4. Clear cache for all affected products and their categories, components of bundles included
 	// Note: We don't fail the request if cache clearing fails
+	productIds := make([]int64, 0, len(results))
+	categories := make(map[string]struct{})
 	for _, result := range results {
-		cacheKey := fmt.Sprintf("product:detail:%d", result.ProductId)
-		_, err = l.svcCtx.Redis.DelCtx(l.ctx, cacheKey)
-		if err != nil {
-			l.Logger.Errorf("Delete product detail cache failed for product %d: %s", result.ProductId, err)
+		productIds = append(productIds, result.ProductId)
+		categories[result.Category] = struct{}{}
+		for _, component := range result.Components {
+			productIds = append(productIds, component.ProductId)
+			categories[component.Category] = struct{}{}
 		}
 	}
-
-	// Note: For category and global version, we increment once since this is a batch operation
-	// We can't determine which categories are affected without additional queries
-	// So we just increment the global version
-	err = IncGlobalVersion(l.ctx, &l.svcCtx.Redis)
-	if err != nil {
-		l.Logger.Errorf("Increase global version failed: %s", err)
+	categoryList := make([]string, 0, len(categories))
+	for category := range categories {
+		categoryList = append(categoryList, category)
 	}
+	InvalidateStockCache(l.ctx, l.svcCtx, productIds, categoryList...)
 
-	// 5. Convert results to protobuf format
+	// 5. Convert results to protobuf format and tell downstream consumers
+	// Results follow the order of the items, one stock change event per item and per bundle component
 	pbResults := make([]*product.StockUpdateResult, 0, len(results))
-	for _, result := range results {
+	for i, result := range results {
 		pbResults = append(pbResults, &product.StockUpdateResult{
 			ProductId: result.ProductId,
 			NewStock:  result.NewStock,
 		})
+		publishStockChanged(l.svcCtx, result.ProductId, in.Items[i].Quantity, result.NewStock, in.Reason, in.ReferenceId)
+		for _, component := range result.Components {
+			publishStockChanged(l.svcCtx, component.ProductId, component.Quantity, component.NewStock, in.Reason, in.ReferenceId)
+		}
 	}
 
 	return &product.BatchUpdateStockResponse{
@@ -0,0 +1,90 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"letsgo/services/product/rpc/internal/cache"
+	"letsgo/services/product/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// InvalidateProductCache deletes the product detail cache (Redis and every instance's local cache) and bumps the list/search versions
+// of the given categories. Failures are logged only, the database stays the source of truth.
+func InvalidateProductCache(ctx context.Context, rds *redis.Redis, productId int64, categories ...string) {
+	InvalidateProductsCache(ctx, rds, []int64{productId}, categories...)
+}
+
+// InvalidateProductsCache is the batch version of InvalidateProductCache,
+// versions are bumped once no matter how many products changed.
+func InvalidateProductsCache(ctx context.Context, rds *redis.Redis, productIds []int64, categories ...string) {
+	logger := logx.WithContext(ctx)
+
+	if len(productIds) > 0 {
+		cacheKeys := make([]string, 0, len(productIds))
+		for _, productId := range productIds {
+			cacheKeys = append(cacheKeys, fmt.Sprintf("product:detail:%d", productId))
+		}
+		if _, err := rds.DelCtx(ctx, cacheKeys...); err != nil {
+			logger.Errorf("Delete product detail cache failed! product_ids=%v, err:%s", productIds, err)
+		}
+		if err := cache.Publish(ctx, rds, cacheKeys...); err != nil {
+			logger.Errorf("Publish local cache invalidation failed! product_ids=%v, err:%s", productIds, err)
+		}
+	}
+
+	for _, category := range categories {
+		if category == "" {
+			continue
+		}
+		if err := IncCategoryVersion(ctx, category, rds); err != nil {
+			logger.Errorf("Increase category version failed! category=%s, err:%s", category, err)
+		}
+	}
+
+	if err := IncGlobalVersion(ctx, rds); err != nil {
+		logger.Errorf("Increase global version failed! err:%s", err)
+	}
+}
+
+// InvalidateStockCache invalidates products whose stock or availability changed together with the bundles
+// made of them, a bundle's stock is derived from its components. Failures are logged only
+func InvalidateStockCache(ctx context.Context, svcCtx *svc.ServiceContext, productIds []int64, categories ...string) {
+	bundles, err := svcCtx.BundleModel.FindContainingBundles(ctx, productIds)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Find bundles of changed products failed! product_ids=%v, err:%s", productIds, err)
+	}
+
+	if len(bundles) > 0 {
+		productIds = append([]int64{}, productIds...)
+		categories = append([]string{}, categories...)
+		for bundleId, category := range bundles {
+			productIds = append(productIds, bundleId)
+			if !slices.Contains(categories, category) {
+				categories = append(categories, category)
+			}
+		}
+	}
+
+	InvalidateProductsCache(ctx, &svcCtx.Redis, productIds, categories...)
+}
+
+// sharedLoadTimeout bounds a load shared by concurrent cache misses
+const sharedLoadTimeout = 10 * time.Second
+
+// sharedLoadContext returns the context of a load shared through CacheFlight.
+// The load serves every waiting caller, so it keeps the values (trace, logging) of the
+// first caller's context but not its cancellation, and is bounded by its own timeout
+func sharedLoadContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), sharedLoadTimeout)
+}
+
+// jitterExpire randomizes an expire time in seconds by Cache.ExpireJitter,
+// so keys written at the same moment (e.g. right after a version bump) don't expire together
+func jitterExpire(svcCtx *svc.ServiceContext, seconds int) int {
+	return int(svcCtx.CacheExpiry.AroundInt(int64(seconds)))
+}
@@ -0,0 +1,219 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+)
+
+const (
+	// versionExpire matches the expire time GetCategoryVersion gives new counters
+	versionExpire = 86400 * 365
+	// warmUpBatchSize limits products loaded per query when warming detail caches
+	warmUpBatchSize = 100
+	// scanBatchSize is the COUNT hint of each SCAN call
+	scanBatchSize = 1000
+)
+
+// raiseVersionScript sets a version counter to ARGV[1] unless it is already higher,
+// so a concurrent IncCategoryVersion is never undone
+const raiseVersionScript = `
+local current = tonumber(redis.call('GET', KEYS[1]) or '0')
+local target = tonumber(ARGV[1])
+if current >= target then
+	return current
+end
+redis.call('SET', KEYS[1], ARGV[1], 'EX', ARGV[2])
+return target`
+
+// ReseedVersions moves the global version and the version of each category above every value
+// still found in Redis: the counters themselves, cached list pages and search keys.
+// The unix time is used as a floor, so counters reset by a flush also end up above
+// versions held in local caches or keys written before the flush
+func ReseedVersions(ctx context.Context, svcCtx *svc.ServiceContext, categories []string) (map[string]int64, error) {
+	// 1. Highest version in use per category, "" is the global version
+	seen := make(map[string]int64, len(categories)+1)
+	seen[""] = 0
+	for _, category := range categories {
+		seen[category] = 0
+	}
+
+	if err := scanListVersions(ctx, svcCtx, seen); err != nil {
+		return nil, err
+	}
+	if err := scanSearchVersions(ctx, svcCtx, seen); err != nil {
+		return nil, err
+	}
+
+	// 2. Raise every counter to max(counter, seen, now) + 1
+	floor := time.Now().Unix()
+	versions := make(map[string]int64, len(seen))
+	for category, version := range seen {
+		cacheKey := "product:GlobalVersion"
+		if category != "" {
+			cacheKey = fmt.Sprintf("product:CategoryVersion:%s", category)
+		}
+
+		current, err := svcCtx.Redis.GetCtx(ctx, cacheKey)
+		if err != nil {
+			return nil, err
+		}
+		if v, _ := strconv.ParseInt(current, 10, 64); v > version {
+			version = v
+		}
+		if floor > version {
+			version = floor
+		}
+
+		val, err := svcCtx.Redis.EvalCtx(ctx, raiseVersionScript, []string{cacheKey}, version+1, versionExpire)
+		if err != nil {
+			return nil, err
+		}
+		versions[category], _ = val.(int64)
+	}
+
+	return versions, nil
+}
+
+// scanListVersions records the version of every cached list page
+// Pages of unknown categories are recorded too, their counters may be read again later
+func scanListVersions(ctx context.Context, svcCtx *svc.ServiceContext, seen map[string]int64) error {
+	return scanKeys(ctx, svcCtx, "product:list:*", func(keys []string) error {
+		// Refresh locks share the prefix
+		pageKeys := make([]string, 0, len(keys))
+		for _, key := range keys {
+			if !strings.HasSuffix(key, ":refresh") {
+				pageKeys = append(pageKeys, key)
+			}
+		}
+		if len(pageKeys) == 0 {
+			return nil
+		}
+
+		values, err := svcCtx.Redis.MgetCtx(ctx, pageKeys...)
+		if err != nil {
+			return err
+		}
+
+		for i, key := range pageKeys {
+			// product:list:{page}:{pageSize}:{category}:{sortBy}:{order}, the category may contain ':'
+			parts := strings.Split(key, ":")
+			if len(parts) < 7 || i >= len(values) {
+				continue
+			}
+			category := strings.Join(parts[4:len(parts)-2], ":")
+
+			if cached := decodeListPage(values[i]); cached != nil && cached.Version > seen[category] {
+				seen[category] = cached.Version
+			}
+		}
+		return nil
+	})
+}
+
+// scanSearchVersions records the global versions found in search keys (product:search:v{version}:...)
+func scanSearchVersions(ctx context.Context, svcCtx *svc.ServiceContext, seen map[string]int64) error {
+	return scanKeys(ctx, svcCtx, "product:search:v*", func(keys []string) error {
+		for _, key := range keys {
+			parts := strings.SplitN(strings.TrimPrefix(key, "product:search:v"), ":", 2)
+			if v, err := strconv.ParseInt(parts[0], 10, 64); err == nil && v > seen[""] {
+				seen[""] = v
+			}
+		}
+		return nil
+	})
+}
+
+// scanKeys calls fn with each batch of keys matching the pattern
+func scanKeys(ctx context.Context, svcCtx *svc.ServiceContext, pattern string, fn func(keys []string) error) error {
+	var cursor uint64
+	for {
+		keys, next, err := svcCtx.Redis.ScanCtx(ctx, cursor, pattern, scanBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			if err := fn(keys); err != nil {
+				return err
+			}
+		}
+
+		cursor = next
+		if cursor == 0 {
+			return nil
+		}
+	}
+}
+
+// WarmUpProductDetails caches the detail of the top-selling published products
+// Returns the number of products cached
+func WarmUpProductDetails(ctx context.Context, svcCtx *svc.ServiceContext, limit int) (int, error) {
+	getProducts := NewGetProductsLogic(ctx, svcCtx)
+
+	warmed := 0
+	for page := int32(1); warmed < limit; page++ {
+		pageSize := warmUpBatchSize
+		if limit-warmed < pageSize {
+			pageSize = limit - warmed
+		}
+
+		products, _, err := svcCtx.ProductModel.List(ctx, page, int32(pageSize), "", "sales", "desc")
+		if err != nil {
+			return warmed, err
+		}
+		if len(products) == 0 {
+			break
+		}
+
+		ids := make([]int64, 0, len(products))
+		for _, p := range products {
+			ids = append(ids, p.Id)
+		}
+		getProducts.backfill(ids, products)
+
+		warmed += len(products)
+		if len(products) < pageSize {
+			break
+		}
+	}
+
+	return warmed, nil
+}
+
+// WarmUpListPages renders the first pages of the default product list, for all products and per category,
+// with the current versions. Returns the number of pages cached
+func WarmUpListPages(ctx context.Context, svcCtx *svc.ServiceContext, categories []string, pages int, pageSize int32) (int, error) {
+	listProducts := NewListProductsLogic(ctx, svcCtx)
+
+	warmed := 0
+	for _, category := range append([]string{""}, categories...) {
+		version := GetCategoryVersion(ctx, category, &svcCtx.Redis)
+		in := &product.ListProductsRequest{
+			PageSize: pageSize,
+			Category: category,
+		}
+
+		for page := int32(1); page <= int32(pages); page++ {
+			in.Page = page
+			cacheKey := fmt.Sprintf("product:list:%d:%d:%s:%s:%s", page, pageSize, in.Category, in.SortBy, in.Order)
+
+			data, err := listProducts.loadPage(ctx, cacheKey, version, page, pageSize, in)
+			if err != nil {
+				return warmed, err
+			}
+			warmed++
+
+			// Stop at the last page of the category
+			if cached := decodeListPage(data); cached == nil || int64(page)*int64(pageSize) >= cached.Data.Total {
+				break
+			}
+		}
+	}
+
+	return warmed, nil
+}
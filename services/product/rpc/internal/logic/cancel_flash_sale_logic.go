@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelFlashSaleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelFlashSaleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelFlashSaleLogic {
+	return &CancelFlashSaleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Cancel an open flash sale, unsold quantity goes back to product stock (admin)
+func (l *CancelFlashSaleLogic) CancelFlashSale(in *product.CancelFlashSaleRequest) (*product.CancelFlashSaleResponse, error) {
+	// 1. Validate sale ID
+	if in.SaleId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid flash sale ID")
+	}
+
+	// 2. Get sale
+	sale, err := l.svcCtx.FlashSaleModel.FindOne(l.ctx, in.SaleId)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrFlashSaleNotFound
+		}
+		l.Logger.Errorf("Failed to get flash sale: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+	if sale.Status != model.FlashSalePending && sale.Status != model.FlashSaleActive {
+		return nil, errorx.NewCodeError(3022, "Flash sale already closed")
+	}
+
+	// 3. Stop selling and return unsold quantity, orders already queued are kept
+	returned, err := CloseFlashSale(l.ctx, l.svcCtx, sale, model.FlashSaleCancelled, in.OperatorId)
+	if err != nil {
+		if err == model.ErrFlashSaleClosed {
+			return nil, errorx.NewCodeError(3022, "Flash sale already closed")
+		}
+		if err == ErrFlashSaleStockMissing {
+			return nil, errorx.ErrCache
+		}
+		l.Logger.Errorf("Failed to cancel flash sale: sale_id=%d, err=%v", in.SaleId, err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Flash sale cancelled: sale_id=%d, returned=%d, operator_id=%d", in.SaleId, returned, in.OperatorId)
+
+	return &product.CancelFlashSaleResponse{
+		Success:  true,
+		Returned: returned,
+	}, nil
+}
@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelPriceScheduleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCancelPriceScheduleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelPriceScheduleLogic {
+	return &CancelPriceScheduleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Cancel a pending or active price schedule, active ones are reverted (admin)
+func (l *CancelPriceScheduleLogic) CancelPriceSchedule(in *product.CancelPriceScheduleRequest) (*product.CancelPriceScheduleResponse, error) {
+	// 1. Validate schedule ID
+	if in.ScheduleId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid schedule ID")
+	}
+
+	// 2. Cancel schedule, an active one gets its original price back
+	change, err := l.svcCtx.PriceModel.CancelSchedule(l.ctx, in.ScheduleId, in.OperatorId, time.Now().Unix())
+	if err != nil {
+		switch err {
+		case model.ErrNotFound:
+			return nil, errorx.ErrPriceScheduleNotFound
+		case model.ErrScheduleNotCancellable:
+			return nil, errorx.ErrPriceScheduleEnded
+		}
+		l.Logger.Errorf("Failed to cancel price schedule: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Price schedule cancelled: schedule_id=%d, product_id=%d, price_reverted=%v, operator_id=%d",
+		in.ScheduleId, change.ProductId, change.Changed, in.OperatorId)
+
+	// 3. Keep cache data consistant.
+	if change.Changed {
+		InvalidateProductCache(l.ctx, &l.svcCtx.Redis, change.ProductId, change.Category)
+	}
+
+	return &product.CancelPriceScheduleResponse{
+		Success: true,
+	}, nil
+}
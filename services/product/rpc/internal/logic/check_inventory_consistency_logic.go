@@ -0,0 +1,68 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CheckInventoryConsistencyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCheckInventoryConsistencyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CheckInventoryConsistencyLogic {
+	return &CheckInventoryConsistencyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Recompute stock from inventory movements and report products that do not match (admin)
+func (l *CheckInventoryConsistencyLogic) CheckInventoryConsistency(in *product.CheckInventoryConsistencyRequest) (*product.CheckInventoryConsistencyResponse, error) {
+	// 1. Validate parameters
+	if in.ProductId < 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+
+	// 2. Recompute stock from the ledger and the warehouses
+	discrepancies, err := l.svcCtx.InventoryModel.FindDiscrepancies(l.ctx, in.ProductId, limit)
+	if err != nil {
+		l.Logger.Errorf("Failed to check inventory consistency: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	// 3. Convert to response
+	discrepancyList := make([]*product.StockDiscrepancy, 0, len(discrepancies))
+	for _, d := range discrepancies {
+		l.Logger.Errorf("Stock does not match inventory ledger: product_id=%d, stock=%d, ledger_stock=%d, warehouse_stock=%d",
+			d.ProductId, d.Stock, d.LedgerStock, d.WarehouseStock)
+
+		discrepancyList = append(discrepancyList, &product.StockDiscrepancy{
+			ProductId:      d.ProductId,
+			Stock:          d.Stock,
+			LedgerStock:    d.LedgerStock,
+			Difference:     d.Stock - d.LedgerStock,
+			WarehouseStock: d.WarehouseStock,
+		})
+	}
+
+	return &product.CheckInventoryConsistencyResponse{
+		Consistent:    len(discrepancyList) == 0,
+		Discrepancies: discrepancyList,
+	}, nil
+}
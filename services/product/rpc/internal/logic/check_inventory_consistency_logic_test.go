@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"testing"
+
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/product"
+)
+
+func TestCheckInventoryConsistency(t *testing.T) {
+	tests := []struct {
+		name          string
+		limit         int32
+		discrepancies []*model.StockDiscrepancy
+		wantLimit     int
+		consistent    bool
+	}{
+		{name: "consistent", wantLimit: 100, consistent: true},
+		{name: "limit kept", limit: 20, wantLimit: 20, consistent: true},
+		{name: "limit capped", limit: 5000, wantLimit: 1000, consistent: true},
+		{
+			name:          "stock differs from ledger",
+			discrepancies: []*model.StockDiscrepancy{{ProductId: 1, Stock: 10, LedgerStock: 8}, {ProductId: 4, LedgerStock: 3}},
+			wantLimit:     100,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pt := newProductTest(t)
+			pt.inventory.discrepancies = tt.discrepancies
+
+			resp, err := NewCheckInventoryConsistencyLogic(pt.ctx, pt.svcCtx).CheckInventoryConsistency(&product.CheckInventoryConsistencyRequest{Limit: tt.limit})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if pt.inventory.limit != tt.wantLimit {
+				t.Fatalf("limit = %d, want %d", pt.inventory.limit, tt.wantLimit)
+			}
+			if resp.Consistent != tt.consistent || len(resp.Discrepancies) != len(tt.discrepancies) {
+				t.Fatalf("response = %+v", resp)
+			}
+			for i, d := range resp.Discrepancies {
+				if want := tt.discrepancies[i]; d.ProductId != want.ProductId || d.Difference != want.Stock-want.LedgerStock {
+					t.Fatalf("discrepancy %d = %+v", i, d)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckInventoryConsistencyInvalidProduct(t *testing.T) {
+	pt := newProductTest(t)
+
+	_, err := NewCheckInventoryConsistencyLogic(pt.ctx, pt.svcCtx).CheckInventoryConsistency(&product.CheckInventoryConsistencyRequest{ProductId: -1})
+	assertCode(t, err, 1001)
+}
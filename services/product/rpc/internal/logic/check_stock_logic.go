@@ -53,7 +53,14 @@ func (l *CheckStockLogic) CheckStock(in *product.CheckStockRequest) (*product.Ch
 		return nil, errorx.ErrDatabase
 	}
 
-	// 4. Build response and check availability
+	// 4. Get stock per warehouse
+	warehouseMap, err := l.svcCtx.WarehouseModel.FindStock(l.ctx, productIds)
+	if err != nil {
+		l.Logger.Errorf("Failed to get warehouse stock: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	// 5. Build response and check availability
 	allAvailable := true
 	resultItems := make([]*product.StockItem, 0, len(in.Items))
 
@@ -75,10 +82,21 @@ func (l *CheckStockLogic) CheckStock(in *product.CheckStockRequest) (*product.Ch
 			allAvailable = false
 		}
 
+		warehouses := make([]*product.WarehouseStock, 0, len(warehouseMap[item.ProductId]))
+		for _, ws := range warehouseMap[item.ProductId] {
+			warehouses = append(warehouses, &product.WarehouseStock{
+				WarehouseId:   ws.WarehouseId,
+				WarehouseCode: ws.WarehouseCode,
+				WarehouseName: ws.WarehouseName,
+				Stock:         ws.Stock,
+			})
+		}
+
 		resultItems = append(resultItems, &product.StockItem{
 			ProductId:        item.ProductId,
 			RequiredQuantity: item.RequiredQuantity,
 			AvailableStock:   availableStock,
+			Warehouses:       warehouses,
 		})
 	}
 
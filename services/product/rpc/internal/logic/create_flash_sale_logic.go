@@ -0,0 +1,108 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateFlashSaleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateFlashSaleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateFlashSaleLogic {
+	return &CreateFlashSaleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Create a flash sale, its quantity is reserved from product stock (admin)
+func (l *CreateFlashSaleLogic) CreateFlashSale(in *product.CreateFlashSaleRequest) (*product.CreateFlashSaleResponse, error) {
+	// 1. Validate input parameters
+	now := time.Now().Unix()
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+	if in.Price <= 0 {
+		return nil, errorx.NewCodeError(1001, "Price must be greater than 0")
+	}
+	if in.Quantity <= 0 {
+		return nil, errorx.NewCodeError(1001, "Quantity must be greater than 0")
+	}
+	if in.PerUserLimit < 0 {
+		return nil, errorx.NewCodeError(1001, "Per user limit cannot be negative")
+	}
+	if in.StartAt <= now {
+		return nil, errorx.NewCodeError(1001, "Start time must be in the future")
+	}
+	if in.EndAt <= in.StartAt {
+		return nil, errorx.NewCodeError(1001, "End time must be after start time")
+	}
+
+	// 2. Get product, its name and image are cached with the sale
+	productInfo, err := l.svcCtx.ProductModel.FindOne(l.ctx, in.ProductId)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrProductNotFound
+		}
+		l.Logger.Errorf("Failed to get product: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	// 3. Create pending sale and reserve its quantity from product stock
+	sale := &model.FlashSale{
+		ProductId:    in.ProductId,
+		Price:        in.Price,
+		Quantity:     in.Quantity,
+		PerUserLimit: in.PerUserLimit,
+		StartAt:      in.StartAt,
+		EndAt:        in.EndAt,
+		Status:       model.FlashSalePending,
+		OperatorId:   in.OperatorId,
+		CreatedAt:    now,
+	}
+	sale.Id, err = l.svcCtx.FlashSaleModel.Insert(l.ctx, sale)
+	if err != nil {
+		switch err {
+		case model.ErrNotFound:
+			return nil, errorx.ErrProductNotFound
+		case model.ErrInsufficientStock:
+			return nil, errorx.ErrProductOutOfStock
+		case model.ErrFlashSaleOverlap:
+			return nil, errorx.ErrFlashSaleOverlap
+		}
+		l.Logger.Errorf("Failed to insert flash sale: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	// 4. Load stock into Redis, a sale that cannot be sold from Redis is cancelled right away
+	if err := loadFlashSale(l.ctx, &l.svcCtx.Redis, newFlashSaleInfo(sale, productInfo), sale.Quantity); err != nil {
+		l.Logger.Errorf("Failed to load flash sale into Redis: sale_id=%d, err=%v", sale.Id, err)
+
+		l.svcCtx.Redis.DelCtx(l.ctx, flashSaleStockKey(sale.Id), flashSaleInfoKey(sale.Id))
+		if _, closeErr := l.svcCtx.FlashSaleModel.Close(l.ctx, sale.Id, model.FlashSaleCancelled, sale.Quantity, in.OperatorId, now); closeErr != nil {
+			l.Logger.Errorf("Failed to cancel unloaded flash sale: sale_id=%d, err=%v", sale.Id, closeErr)
+		}
+		return nil, errorx.ErrCache
+	}
+
+	// 5. Keep cache data consistant.
+	InvalidateStockCache(l.ctx, l.svcCtx, []int64{in.ProductId}, productInfo.Category)
+
+	l.Logger.Infof("Flash sale created: sale_id=%d, product_id=%d, price=%.2f, quantity=%d, start_at=%d, end_at=%d, operator_id=%d",
+		sale.Id, in.ProductId, in.Price, in.Quantity, in.StartAt, in.EndAt, in.OperatorId)
+
+	return &product.CreateFlashSaleResponse{
+		SaleId: sale.Id,
+	}, nil
+}
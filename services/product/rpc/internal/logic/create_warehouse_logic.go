@@ -0,0 +1,66 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateWarehouseLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateWarehouseLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateWarehouseLogic {
+	return &CreateWarehouseLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Create a warehouse (admin)
+func (l *CreateWarehouseLogic) CreateWarehouse(in *product.CreateWarehouseRequest) (*product.CreateWarehouseResponse, error) {
+	// 1. Validate parameters
+	code := strings.ToUpper(strings.TrimSpace(in.Code))
+	if code == "" || len(code) > 32 {
+		return nil, errorx.NewCodeError(1001, "Warehouse code must be 1-32 characters")
+	}
+	if len(strings.TrimSpace(in.Name)) == 0 || len(in.Name) > 100 {
+		return nil, errorx.NewCodeError(1001, "Warehouse name must be 1-100 characters")
+	}
+	if len(in.Region) > 64 {
+		return nil, errorx.NewCodeError(1001, "Warehouse region must be less than 64 characters")
+	}
+
+	// 2. Insert warehouse
+	now := time.Now().Unix()
+	warehouseId, err := l.svcCtx.WarehouseModel.Insert(l.ctx, &model.Warehouse{
+		Code:      code,
+		Name:      strings.TrimSpace(in.Name),
+		Region:    strings.TrimSpace(in.Region),
+		Priority:  in.Priority,
+		CreatedAt: now,
+	})
+	if err != nil {
+		if err == model.ErrDuplicateWarehouseCode {
+			return nil, errorx.ErrWarehouseCodeExists
+		}
+		l.Logger.Errorf("Failed to insert warehouse: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Warehouse created: %s (id: %d), region=%s, priority=%d", code, warehouseId, in.Region, in.Priority)
+
+	return &product.CreateWarehouseResponse{
+		WarehouseId: warehouseId,
+	}, nil
+}
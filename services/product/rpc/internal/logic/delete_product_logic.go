@@ -0,0 +1,70 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteProductLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDeleteProductLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteProductLogic {
+	return &DeleteProductLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Soft delete product (admin)
+func (l *DeleteProductLogic) DeleteProduct(in *product.DeleteProductRequest) (*product.DeleteProductResponse, error) {
+	// 1. Validate product ID
+	if in.Id <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+
+	// 2. Get product (any status) to know its category
+	existingProduct, err := l.svcCtx.ProductModel.FindOneAnyStatus(l.ctx, in.Id)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrProductNotFound
+		}
+		l.Logger.Errorf("Failed to get product: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	if existingProduct.Status == model.ProductStatusDeleted {
+		return nil, errorx.NewCodeError(3020, "Product is already deleted")
+	}
+
+	// 3. Soft delete product
+	err = l.svcCtx.ProductModel.Delete(l.ctx, in.Id)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrProductNotFound
+		}
+		l.Logger.Errorf("Failed to delete product: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Product deleted: product_id=%d", in.Id)
+
+	// 4. Keep cache data consistant.
+	InvalidateStockCache(l.ctx, l.svcCtx, []int64{in.Id}, existingProduct.Category)
+
+	// 5. Tell downstream consumers
+	publishProductDeleted(l.svcCtx, in.Id, existingProduct.Category)
+
+	return &product.DeleteProductResponse{
+		Success: true,
+	}, nil
+}
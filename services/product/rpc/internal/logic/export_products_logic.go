@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/internal/utils"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportProductsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewExportProductsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportProductsLogic {
+	return &ExportProductsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Export product catalog as CSV or JSON Lines, streamed in chunks (admin)
+func (l *ExportProductsLogic) ExportProducts(in *product.ExportProductsRequest, stream product.Product_ExportProductsServer) error {
+	// 1. Validate format
+	encoder, err := utils.NewCatalogEncoder(in.Format)
+	if err != nil {
+		return errorx.NewCodeError(1001, "Format must be csv or jsonl")
+	}
+
+	// 2. Walk the catalog with keyset pagination and stream one chunk per page
+	pageSize := l.svcCtx.Config.Import.BatchSize
+	var lastId, exported int64
+	for {
+		products, err := l.svcCtx.ProductModel.ListForExport(l.ctx, lastId, pageSize)
+		if err != nil {
+			l.Logger.Errorf("Failed to list products for export: %v", err)
+			return errorx.ErrDatabase
+		}
+
+		for _, p := range products {
+			err = encoder.Write(utils.CatalogRow{
+				Sku:         p.Sku,
+				Name:        p.Name,
+				Description: p.Description,
+				Price:       p.Price,
+				Stock:       p.Stock,
+				Category:    p.Category,
+				Images:      p.Images,
+				Attributes:  p.Attributes,
+				Status:      p.Status,
+			})
+			if err != nil {
+				l.Logger.Errorf("Failed to encode product %d: %v", p.Id, err)
+				return errorx.ErrSystem
+			}
+			lastId = p.Id
+		}
+		exported += int64(len(products))
+
+		// The first chunk always carries the CSV header, even for an empty catalog
+		data, err := encoder.Flush()
+		if err != nil {
+			l.Logger.Errorf("Failed to flush export chunk: %v", err)
+			return errorx.ErrSystem
+		}
+		if len(data) > 0 {
+			if err := stream.Send(&product.ExportProductsChunk{Data: data}); err != nil {
+				l.Logger.Errorf("Failed to send export chunk: %v", err)
+				return err
+			}
+		}
+
+		if len(products) < pageSize {
+			break
+		}
+	}
+
+	l.Logger.Infof("Products exported: format=%s, total=%d", in.Format, exported)
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/internal/utils"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type FlashSaleBuyLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFlashSaleBuyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FlashSaleBuyLogic {
+	return &FlashSaleBuyLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Buy from a flash sale, stock is deducted in Redis and the order is queued through Kafka
+func (l *FlashSaleBuyLogic) FlashSaleBuy(in *product.FlashSaleBuyRequest) (*product.FlashSaleBuyResponse, error) {
+	// 1. Validate input parameters
+	if in.SaleId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid flash sale ID")
+	}
+	if in.UserId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	}
+	if in.Quantity <= 0 {
+		return nil, errorx.NewCodeError(1001, "Quantity must be greater than 0")
+	}
+	if in.Address == "" || in.Phone == "" {
+		return nil, errorx.NewCodeError(1001, "Address and phone are required")
+	}
+
+	// 2. Get sale info from Redis and check the sale window
+	info, err := getFlashSaleInfo(l.ctx, l.svcCtx, in.SaleId)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrFlashSaleNotFound
+		}
+		l.Logger.Errorf("Failed to get flash sale info: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	now := time.Now().Unix()
+	if info.Status == model.FlashSaleFinished || info.Status == model.FlashSaleCancelled ||
+		now < info.StartAt || now >= info.EndAt {
+		return nil, errorx.ErrFlashSaleNotActive
+	}
+
+	// 3. Deduct stock using Lua script (atomic operation)
+	result, err := l.svcCtx.Redis.EvalCtx(l.ctx, flashSaleDeductScript,
+		[]string{flashSaleStockKey(in.SaleId), flashSaleUsersKey(in.SaleId), flashSaleSeqKey(in.SaleId)},
+		in.UserId,
+		in.Quantity,
+		info.PerUserLimit,
+		info.EndAt-now+86400,
+	)
+	if err != nil {
+		switch scriptError(err) {
+		case "FLASH_SALE_NOT_AVAILABLE":
+			return nil, errorx.ErrFlashSaleNotActive
+		case "FLASH_SALE_SOLD_OUT":
+			return nil, errorx.ErrFlashSaleSoldOut
+		case "FLASH_SALE_LIMIT_EXCEEDED":
+			return nil, errorx.NewCodeError(3010, fmt.Sprintf("Flash sale purchase limit exceeded, maximum %d per user", info.PerUserLimit))
+		}
+		l.Logger.Errorf("Failed to deduct flash sale stock: sale_id=%d, user_id=%d, err=%v", in.SaleId, in.UserId, err)
+		return nil, errorx.ErrCache
+	}
+
+	values, ok := result.([]interface{})
+	if !ok || len(values) != 2 {
+		l.Logger.Errorf("Unexpected flash sale deduct result: sale_id=%d, result=%v", in.SaleId, result)
+		l.restoreStock(in)
+		return nil, errorx.ErrSystem
+	}
+	seq, _ := values[1].(int64)
+
+	// 4. Queue the order, the order service creates it from the event.
+	// The order number is unique per sale, so redelivered events are deduplicated by it
+	orderNo := fmt.Sprintf("FS%d%08d", in.SaleId, seq)
+	totalAmount := info.Price * float64(in.Quantity)
+
+	event := utils.FlashSaleOrderEvent{
+		EventType: "flashsale.order",
+		EventID:   uuid.New().String(),
+		Timestamp: now,
+		Data: utils.FlashSaleOrderData{
+			OrderNo:     orderNo,
+			SaleID:      in.SaleId,
+			UserID:      in.UserId,
+			ProductID:   info.ProductId,
+			Name:        info.Name,
+			Image:       info.Image,
+			Price:       info.Price,
+			Quantity:    in.Quantity,
+			TotalAmount: totalAmount,
+			Address:     in.Address,
+			Phone:       in.Phone,
+			Remark:      in.Remark,
+		},
+	}
+
+	err = l.svcCtx.KafkaProducer.PublishEvent(l.ctx, l.svcCtx.Config.Kafka.Topics.FlashSaleOrder, orderNo, event)
+	if err != nil {
+		l.Logger.Errorf("Failed to queue flash sale order: order_no=%s, err=%v", orderNo, err)
+		l.restoreStock(in)
+		return nil, errorx.ErrSystem
+	}
+
+	l.Logger.Infof("Flash sale order queued: order_no=%s, sale_id=%d, user_id=%d, quantity=%d, remaining=%v",
+		orderNo, in.SaleId, in.UserId, in.Quantity, values[0])
+
+	return &product.FlashSaleBuyResponse{
+		OrderNo:     orderNo,
+		TotalAmount: totalAmount,
+	}, nil
+}
+
+// restoreStock gives back a deduction whose order could not be queued
+// The request context may already be expired by the failed publish, so use a fresh one
+func (l *FlashSaleBuyLogic) restoreStock(in *product.FlashSaleBuyRequest) {
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	_, err := l.svcCtx.Redis.EvalCtx(ctx, flashSaleRestoreScript,
+		[]string{flashSaleStockKey(in.SaleId), flashSaleUsersKey(in.SaleId)},
+		in.UserId,
+		in.Quantity,
+	)
+	if err != nil {
+		l.Logger.Errorf("CRITICAL_FLASH_SALE_RESTORE_FAILED sale_id=%d user_id=%d quantity=%d err=%v",
+			in.SaleId, in.UserId, in.Quantity, err)
+	}
+}
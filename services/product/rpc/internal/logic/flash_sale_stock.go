@@ -0,0 +1,281 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// Flash sale keys share the {id} hash tag so the Lua scripts also work on Redis Cluster
+func flashSaleStockKey(saleId int64) string   { return fmt.Sprintf("flashsale:{%d}:stock", saleId) }
+func flashSaleClosingKey(saleId int64) string { return fmt.Sprintf("flashsale:{%d}:closing", saleId) }
+func flashSaleUsersKey(saleId int64) string   { return fmt.Sprintf("flashsale:{%d}:users", saleId) }
+func flashSaleSeqKey(saleId int64) string     { return fmt.Sprintf("flashsale:{%d}:seq", saleId) }
+func flashSaleInfoKey(saleId int64) string    { return fmt.Sprintf("flashsale:{%d}:info", saleId) }
+
+// flashSaleInfo is what the buy path needs to know about a sale, cached in Redis
+// so a buy never touches the database
+type flashSaleInfo struct {
+	Id           int64   `json:"id"`
+	ProductId    int64   `json:"product_id"`
+	Name         string  `json:"name"`
+	Image        string  `json:"image"`
+	Price        float64 `json:"price"`
+	PerUserLimit int64   `json:"per_user_limit"`
+	StartAt      int64   `json:"start_at"`
+	EndAt        int64   `json:"end_at"`
+	Status       int64   `json:"status"`
+}
+
+// Lua script for atomic flash sale deduction
+// Checks the per-user limit and remaining stock, then deducts both in one step.
+// Returns {remaining stock, order sequence}
+const flashSaleDeductScript = `
+	local stock_key = KEYS[1]
+	local users_key = KEYS[2]
+	local seq_key = KEYS[3]
+	local user_id = ARGV[1]
+	local qty = tonumber(ARGV[2])
+	local limit = tonumber(ARGV[3])
+	local users_expire = tonumber(ARGV[4])
+
+	-- Stock is removed when the sale closes
+	local stock = redis.call('GET', stock_key)
+	if not stock then
+		return redis.error_reply('FLASH_SALE_NOT_AVAILABLE')
+	end
+	stock = tonumber(stock)
+
+	-- Check per-user limit
+	local bought = tonumber(redis.call('HGET', users_key, user_id) or '0')
+	if limit > 0 and bought + qty > limit then
+		return redis.error_reply('FLASH_SALE_LIMIT_EXCEEDED')
+	end
+
+	-- Check remaining stock
+	if stock < qty then
+		return redis.error_reply('FLASH_SALE_SOLD_OUT')
+	end
+
+	local remaining = redis.call('DECRBY', stock_key, qty)
+	redis.call('HINCRBY', users_key, user_id, qty)
+	redis.call('EXPIRE', users_key, users_expire)
+	local seq = redis.call('INCR', seq_key)
+
+	return {remaining, seq}
+`
+
+// Lua script to give back a deduction whose order could not be queued
+// Only while the sale is open, once closed the unit is settled as sold
+const flashSaleRestoreScript = `
+	local stock_key = KEYS[1]
+	local users_key = KEYS[2]
+	local user_id = ARGV[1]
+	local qty = tonumber(ARGV[2])
+
+	if redis.call('EXISTS', stock_key) == 0 then
+		return 0
+	end
+
+	redis.call('INCRBY', stock_key, qty)
+	if tonumber(redis.call('HINCRBY', users_key, user_id, -qty)) <= 0 then
+		redis.call('HDEL', users_key, user_id)
+	end
+
+	return 1
+`
+
+// Lua script to give back a user's purchase count when their flash sale order is cancelled
+// The sold units went back to product stock, only the limit is released.
+// Returns 0 if the sale is closed, its counters are gone
+const flashSaleReleaseLimitScript = `
+	local users_key = KEYS[1]
+	local user_id = ARGV[1]
+	local qty = tonumber(ARGV[2])
+
+	if redis.call('EXISTS', users_key) == 0 then
+		return 0
+	end
+
+	if tonumber(redis.call('HINCRBY', users_key, user_id, -qty)) <= 0 then
+		redis.call('HDEL', users_key, user_id)
+	end
+
+	return 1
+`
+
+// Lua script to stop selling and take the remaining stock
+// The stock key is renamed rather than deleted, so a failed close can be retried
+// without losing the unsold quantity. Returns false if Redis lost the stock
+const flashSaleTakeScript = `
+	local stock_key = KEYS[1]
+	local closing_key = KEYS[2]
+
+	if redis.call('EXISTS', stock_key) == 1 then
+		redis.call('RENAME', stock_key, closing_key)
+	end
+
+	return redis.call('GET', closing_key)
+`
+
+// loadFlashSale puts the sale quantity and info into Redis when the sale is created
+func loadFlashSale(ctx context.Context, rds *redis.Redis, info *flashSaleInfo, quantity int64) error {
+	if err := setFlashSaleInfo(ctx, rds, info); err != nil {
+		return err
+	}
+
+	// The stock key lives until the sale closes, it must not expire on its own
+	ok, err := rds.SetnxCtx(ctx, flashSaleStockKey(info.Id), strconv.FormatInt(quantity, 10))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("flash sale %d stock already loaded", info.Id)
+	}
+
+	return nil
+}
+
+// setFlashSaleInfo caches sale info until one day after the sale ends
+func setFlashSaleInfo(ctx context.Context, rds *redis.Redis, info *flashSaleInfo) error {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	expire := int(info.EndAt-time.Now().Unix()) + 86400
+	if expire <= 0 {
+		expire = 60
+	}
+
+	return rds.SetexCtx(ctx, flashSaleInfoKey(info.Id), string(data), expire)
+}
+
+// getFlashSaleInfo reads sale info from Redis, falling back to the database on a miss
+func getFlashSaleInfo(ctx context.Context, svcCtx *svc.ServiceContext, saleId int64) (*flashSaleInfo, error) {
+	logger := logx.WithContext(ctx)
+
+	cached, err := svcCtx.Redis.GetCtx(ctx, flashSaleInfoKey(saleId))
+	if err != nil {
+		logger.Errorf("Get flash sale info from cache failed! sale_id=%d, err:%s", saleId, err)
+	}
+	if cached != "" {
+		var info flashSaleInfo
+		if err := json.Unmarshal([]byte(cached), &info); err == nil {
+			return &info, nil
+		}
+	}
+
+	sale, err := svcCtx.FlashSaleModel.FindOne(ctx, saleId)
+	if err != nil {
+		return nil, err
+	}
+
+	productInfo, err := svcCtx.ProductModel.FindOne(ctx, sale.ProductId)
+	if err != nil {
+		return nil, err
+	}
+
+	info := newFlashSaleInfo(sale, productInfo)
+	if err := setFlashSaleInfo(ctx, &svcCtx.Redis, info); err != nil {
+		logger.Errorf("Set flash sale info cache failed! sale_id=%d, err:%s", saleId, err)
+	}
+
+	return info, nil
+}
+
+// newFlashSaleInfo builds the cached info of a sale
+func newFlashSaleInfo(sale *model.FlashSale, productInfo *model.Product) *flashSaleInfo {
+	var image string
+	if len(productInfo.Images) > 0 {
+		image = productInfo.Images[0]
+	}
+
+	return &flashSaleInfo{
+		Id:           sale.Id,
+		ProductId:    sale.ProductId,
+		Name:         productInfo.Name,
+		Image:        image,
+		Price:        sale.Price,
+		PerUserLimit: sale.PerUserLimit,
+		StartAt:      sale.StartAt,
+		EndAt:        sale.EndAt,
+		Status:       sale.Status,
+	}
+}
+
+// ErrFlashSaleStockMissing is returned when closing a sale whose stock is not in Redis
+var ErrFlashSaleStockMissing = fmt.Errorf("flash sale stock missing in redis")
+
+// scriptError returns the message a flash sale script raised with redis.error_reply
+// Redis returns it as is, some Redis compatible servers prefix single word messages with "ERR "
+func scriptError(err error) string {
+	return strings.TrimPrefix(err.Error(), "ERR ")
+}
+
+// getFlashSaleRemaining returns the units left in Redis, 0 if the sale is not selling
+func getFlashSaleRemaining(ctx context.Context, rds *redis.Redis, saleId int64) (int64, error) {
+	val, err := rds.GetCtx(ctx, flashSaleStockKey(saleId))
+	if err != nil || val == "" {
+		return 0, err
+	}
+
+	return strconv.ParseInt(val, 10, 64)
+}
+
+// CloseFlashSale stops selling an open flash sale and returns its unsold quantity to product stock.
+// status is model.FlashSaleFinished when the window ended or model.FlashSaleCancelled for admin cancels,
+// operatorId is the cancelling admin (0 = system). Returns the quantity given back
+func CloseFlashSale(ctx context.Context, svcCtx *svc.ServiceContext, sale *model.FlashSale, status int64, operatorId int64) (int64, error) {
+	logger := logx.WithContext(ctx)
+
+	// 1. Stop selling and take the remaining stock
+	val, err := svcCtx.Redis.EvalCtx(ctx, flashSaleTakeScript,
+		[]string{flashSaleStockKey(sale.Id), flashSaleClosingKey(sale.Id)})
+	if err != nil && err != redis.Nil {
+		return 0, err
+	}
+
+	var unsold int64
+	if s, ok := val.(string); ok {
+		unsold, err = strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+	} else {
+		// Redis lost the stock, the unsold quantity is unknown. Settling now would record
+		// every reserved unit as sold, so the sale stays open and keeps failing until fixed
+		logger.Errorf("Flash sale stock missing in Redis, not closed: sale_id=%d, quantity=%d", sale.Id, sale.Quantity)
+		return 0, ErrFlashSaleStockMissing
+	}
+
+	// 2. Return unsold quantity and settle the sale
+	category, err := svcCtx.FlashSaleModel.Close(ctx, sale.Id, status, unsold, operatorId, time.Now().Unix())
+	if err != nil {
+		return 0, err
+	}
+
+	// 3. Drop the sale state, the per-user counters are no longer needed
+	if _, err := svcCtx.Redis.DelCtx(ctx,
+		flashSaleClosingKey(sale.Id),
+		flashSaleUsersKey(sale.Id),
+		flashSaleSeqKey(sale.Id),
+		flashSaleInfoKey(sale.Id),
+	); err != nil {
+		logger.Errorf("Delete flash sale keys failed! sale_id=%d, err:%s", sale.Id, err)
+	}
+
+	// 4. Keep cache data consistant.
+	InvalidateStockCache(ctx, svcCtx, []int64{sale.ProductId}, category)
+
+	return unsold, nil
+}
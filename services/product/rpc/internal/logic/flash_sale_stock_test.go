@@ -0,0 +1,260 @@
+package logic
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/product"
+)
+
+const testSaleId = 7
+
+func (pt *productTest) deduct(userId, quantity, limit int64) (interface{}, error) {
+	return pt.svcCtx.Redis.EvalCtx(pt.ctx, flashSaleDeductScript,
+		[]string{flashSaleStockKey(testSaleId), flashSaleUsersKey(testSaleId), flashSaleSeqKey(testSaleId)},
+		userId, quantity, limit, 3600)
+}
+
+func (pt *productTest) restore(userId, quantity int64) (interface{}, error) {
+	return pt.svcCtx.Redis.EvalCtx(pt.ctx, flashSaleRestoreScript,
+		[]string{flashSaleStockKey(testSaleId), flashSaleUsersKey(testSaleId)},
+		userId, quantity)
+}
+
+func TestFlashSaleDeduct(t *testing.T) {
+	tests := []struct {
+		name      string
+		stock     string // Empty when the sale is not loaded or already closed
+		bought    string // Units the user already bought
+		quantity  int64
+		limit     int64
+		err       string
+		remaining string
+	}{
+		{name: "deducted", stock: "10", quantity: 3, limit: 5, remaining: "7"},
+		{name: "no limit", stock: "10", bought: "8", quantity: 2, remaining: "8"},
+		{name: "user limit", stock: "10", bought: "4", quantity: 2, limit: 5, err: "FLASH_SALE_LIMIT_EXCEEDED", remaining: "10"},
+		{name: "sold out", stock: "2", quantity: 3, limit: 5, err: "FLASH_SALE_SOLD_OUT", remaining: "2"},
+		{name: "not selling", quantity: 1, err: "FLASH_SALE_NOT_AVAILABLE"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pt := newProductTest(t)
+			if tt.stock != "" {
+				pt.redis.Set(flashSaleStockKey(testSaleId), tt.stock)
+			}
+			if tt.bought != "" {
+				pt.redis.HSet(flashSaleUsersKey(testSaleId), "42", tt.bought)
+			}
+
+			result, err := pt.deduct(42, tt.quantity, tt.limit)
+
+			if tt.err != "" {
+				if err == nil || !strings.HasSuffix(err.Error(), tt.err) {
+					t.Fatalf("err = %v, want %s", err, tt.err)
+				}
+			} else {
+				values, ok := result.([]interface{})
+				if err != nil || !ok || len(values) != 2 || values[1] != int64(1) {
+					t.Fatalf("result = %v, err = %v", result, err)
+				}
+			}
+			if stock, _ := pt.redis.Get(flashSaleStockKey(testSaleId)); stock != tt.remaining {
+				t.Fatalf("stock = %q, want %q", stock, tt.remaining)
+			}
+		})
+	}
+}
+
+func TestFlashSaleBuyScriptErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		stock  string // Empty when the sale is not loaded
+		bought string
+		code   int
+	}{
+		{name: "sold out", stock: "1", code: 3009},
+		{name: "user limit", stock: "10", bought: "5", code: 3010},
+		{name: "not selling", code: 3008},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pt := newProductTest(t)
+			now := time.Now().Unix()
+			info := &flashSaleInfo{Id: testSaleId, ProductId: 1, Price: 1, PerUserLimit: 5, StartAt: now - 60, EndAt: now + 60, Status: model.FlashSaleActive}
+			if err := setFlashSaleInfo(pt.ctx, &pt.svcCtx.Redis, info); err != nil {
+				t.Fatal(err)
+			}
+			if tt.stock != "" {
+				pt.redis.Set(flashSaleStockKey(testSaleId), tt.stock)
+			}
+			if tt.bought != "" {
+				pt.redis.HSet(flashSaleUsersKey(testSaleId), "42", tt.bought)
+			}
+
+			// The script errors come back with the "ERR " prefix of miniredis
+			_, err := NewFlashSaleBuyLogic(pt.ctx, pt.svcCtx).FlashSaleBuy(&product.FlashSaleBuyRequest{
+				SaleId:   testSaleId,
+				UserId:   42,
+				Quantity: 2,
+				Address:  "Main St 1",
+				Phone:    "555",
+			})
+			assertCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestFlashSaleDeductCountsUserPurchases(t *testing.T) {
+	pt := newProductTest(t)
+	pt.redis.Set(flashSaleStockKey(testSaleId), "10")
+
+	for i := 0; i < 2; i++ {
+		if _, err := pt.deduct(42, 2, 5); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if bought := pt.redis.HGet(flashSaleUsersKey(testSaleId), "42"); bought != "4" {
+		t.Fatalf("bought = %s, want 4", bought)
+	}
+	if seq, _ := pt.redis.Get(flashSaleSeqKey(testSaleId)); seq != "2" {
+		t.Fatalf("seq = %s, want 2", seq)
+	}
+	if _, err := pt.deduct(42, 2, 5); err == nil {
+		t.Fatal("limit not enforced across buys")
+	}
+}
+
+func TestFlashSaleRestore(t *testing.T) {
+	tests := []struct {
+		name     string
+		open     bool
+		bought   string
+		restored int64
+		stock    string
+		userLeft string
+	}{
+		{name: "open sale", open: true, bought: "5", restored: 1, stock: "5", userLeft: "3"},
+		{name: "last units of the user", open: true, bought: "2", restored: 1, stock: "5", userLeft: ""},
+		{name: "closed sale", bought: "2", restored: 0, stock: "", userLeft: "2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pt := newProductTest(t)
+			if tt.open {
+				pt.redis.Set(flashSaleStockKey(testSaleId), "3")
+			}
+			pt.redis.HSet(flashSaleUsersKey(testSaleId), "42", tt.bought)
+
+			result, err := pt.restore(42, 2)
+			if err != nil || result != tt.restored {
+				t.Fatalf("result = %v, err = %v", result, err)
+			}
+
+			if stock, _ := pt.redis.Get(flashSaleStockKey(testSaleId)); stock != tt.stock {
+				t.Fatalf("stock = %q, want %q", stock, tt.stock)
+			}
+			if left := pt.redis.HGet(flashSaleUsersKey(testSaleId), "42"); left != tt.userLeft {
+				t.Fatalf("user count = %q, want %q", left, tt.userLeft)
+			}
+		})
+	}
+}
+
+func TestReleaseFlashSaleLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		bought   string // Empty when the sale is closed and its counters are gone
+		success  bool
+		userLeft string
+	}{
+		{name: "count lowered", bought: "5", success: true, userLeft: "3"},
+		{name: "last units of the user", bought: "2", success: true, userLeft: ""},
+		{name: "closed sale"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pt := newProductTest(t)
+			if tt.bought != "" {
+				pt.redis.HSet(flashSaleUsersKey(testSaleId), "42", tt.bought)
+			}
+
+			resp, err := NewReleaseFlashSaleLimitLogic(pt.ctx, pt.svcCtx).ReleaseFlashSaleLimit(&product.ReleaseFlashSaleLimitRequest{
+				SaleId:   testSaleId,
+				UserId:   42,
+				Quantity: 2,
+			})
+			if err != nil || resp.Success != tt.success {
+				t.Fatalf("resp = %v, err = %v", resp, err)
+			}
+			if left := pt.redis.HGet(flashSaleUsersKey(testSaleId), "42"); left != tt.userLeft {
+				t.Fatalf("user count = %q, want %q", left, tt.userLeft)
+			}
+		})
+	}
+}
+
+func TestCloseFlashSale(t *testing.T) {
+	pt := newProductTest(t)
+	pt.redis.Set(flashSaleStockKey(testSaleId), "4")
+	pt.redis.HSet(flashSaleUsersKey(testSaleId), "42", "6")
+	pt.redis.Set(flashSaleSeqKey(testSaleId), "3")
+
+	unsold, err := CloseFlashSale(pt.ctx, pt.svcCtx, &model.FlashSale{Id: testSaleId, ProductId: 1, Quantity: 10}, model.FlashSaleFinished, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if unsold != 4 || pt.flashSales.closed[testSaleId] != 4 {
+		t.Fatalf("unsold = %d, closed = %v, want 4", unsold, pt.flashSales.closed)
+	}
+	for _, key := range []string{flashSaleStockKey(testSaleId), flashSaleClosingKey(testSaleId), flashSaleUsersKey(testSaleId), flashSaleSeqKey(testSaleId)} {
+		if pt.redis.Exists(key) {
+			t.Fatalf("%s left after close", key)
+		}
+	}
+	if _, err := pt.deduct(42, 1, 0); err == nil {
+		t.Fatal("closed sale still selling")
+	}
+}
+
+func TestCloseFlashSaleStockMissing(t *testing.T) {
+	pt := newProductTest(t)
+	pt.redis.HSet(flashSaleUsersKey(testSaleId), "42", "6")
+
+	// Redis lost the stock, the sale must not be settled as sold out
+	_, err := CloseFlashSale(pt.ctx, pt.svcCtx, &model.FlashSale{Id: testSaleId, ProductId: 1, Quantity: 10}, model.FlashSaleFinished, 0)
+	if err != ErrFlashSaleStockMissing {
+		t.Fatalf("err = %v, want %v", err, ErrFlashSaleStockMissing)
+	}
+	if _, ok := pt.flashSales.closed[testSaleId]; ok {
+		t.Fatal("sale settled without its stock")
+	}
+	if !pt.redis.Exists(flashSaleUsersKey(testSaleId)) {
+		t.Fatal("sale state dropped")
+	}
+}
+
+func TestCloseFlashSaleRetry(t *testing.T) {
+	pt := newProductTest(t)
+	pt.redis.Set(flashSaleStockKey(testSaleId), "4")
+
+	// A close that failed after taking the stock left it under the closing key
+	taken, err := pt.svcCtx.Redis.EvalCtx(pt.ctx, flashSaleTakeScript,
+		[]string{flashSaleStockKey(testSaleId), flashSaleClosingKey(testSaleId)})
+	if err != nil || taken != "4" {
+		t.Fatalf("taken = %v, err = %v", taken, err)
+	}
+
+	unsold, err := CloseFlashSale(pt.ctx, pt.svcCtx, &model.FlashSale{Id: testSaleId, ProductId: 1, Quantity: 10}, model.FlashSaleCancelled, 1)
+	if err != nil || unsold != 4 {
+		t.Fatalf("unsold = %d, err = %v, want 4", unsold, err)
+	}
+}
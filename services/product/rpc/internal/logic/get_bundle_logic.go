@@ -0,0 +1,82 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetBundleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetBundleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetBundleLogic {
+	return &GetBundleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Get the components of a bundle product and how many bundles they can make
+func (l *GetBundleLogic) GetBundle(in *product.GetBundleRequest) (*product.GetBundleResponse, error) {
+	// 1. Validate parameters
+	if in.BundleId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+
+	// 2. The bundle must be on sale
+	bundleResp, err := NewGetProductLogic(l.ctx, l.svcCtx).GetProduct(&product.GetProductRequest{Id: in.BundleId})
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := l.svcCtx.BundleModel.FindItems(l.ctx, in.BundleId)
+	if err != nil {
+		l.Logger.Errorf("Failed to find bundle items: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+	if len(items) == 0 {
+		return nil, errorx.ErrProductNotBundle
+	}
+
+	// 3. Load components through the product cache, components taken off sale are left out
+	componentIds := make([]int64, 0, len(items))
+	for _, item := range items {
+		componentIds = append(componentIds, item.ComponentId)
+	}
+	productsResp, err := NewGetProductsLogic(l.ctx, l.svcCtx).GetProducts(&product.GetProductsRequest{Ids: componentIds})
+	if err != nil {
+		return nil, err
+	}
+
+	components := make([]*product.BundleComponent, 0, len(items))
+	for i, result := range productsResp.Results {
+		if !result.Found {
+			continue
+		}
+		components = append(components, &product.BundleComponent{
+			Product:  result.Product,
+			Quantity: items[i].Quantity,
+		})
+	}
+
+	// 4. Availability is derived from component stock
+	stockMap, err := l.svcCtx.ProductModel.CheckStock(l.ctx, []int64{in.BundleId})
+	if err != nil {
+		l.Logger.Errorf("Failed to check stock: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	return &product.GetBundleResponse{
+		Bundle:     bundleResp.Product,
+		Components: components,
+		Available:  stockMap[in.BundleId],
+	}, nil
+}
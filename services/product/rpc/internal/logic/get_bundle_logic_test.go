@@ -0,0 +1,82 @@
+package logic
+
+import (
+	"fmt"
+	"testing"
+
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/product"
+)
+
+func TestGetBundle(t *testing.T) {
+	pt := newProductTest(t)
+	pt.addProduct(9, "Pen set", 3)
+	pt.addProduct(1, "Pen", 8)
+	pt.addProduct(2, "Refill", 6)
+	pt.addProduct(3, "Ink", 5).Status = model.ProductStatusUnpublished
+	pt.bundles.items[9] = []*model.BundleItem{
+		{BundleId: 9, ComponentId: 1, Quantity: 1},
+		{BundleId: 9, ComponentId: 2, Quantity: 2},
+		{BundleId: 9, ComponentId: 3, Quantity: 1},
+	}
+
+	resp, err := NewGetBundleLogic(pt.ctx, pt.svcCtx).GetBundle(&product.GetBundleRequest{BundleId: 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The unpublished component is left out, the others keep their quantities
+	if len(resp.Components) != 2 ||
+		resp.Components[0].Product.Id != 1 || resp.Components[0].Quantity != 1 ||
+		resp.Components[1].Product.Id != 2 || resp.Components[1].Quantity != 2 {
+		t.Fatalf("components = %v", resp.Components)
+	}
+	if resp.Bundle.Id != 9 || resp.Available != 3 {
+		t.Fatalf("bundle = %v, available = %d", resp.Bundle, resp.Available)
+	}
+}
+
+func TestGetBundleErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		id   int64
+		code int
+	}{
+		{name: "invalid ID", id: 0, code: 1001},
+		{name: "not found", id: 5, code: 3000},
+		{name: "not a bundle", id: 1, code: 3017},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pt := newProductTest(t)
+			pt.addProduct(1, "Pen", 8)
+
+			_, err := NewGetBundleLogic(pt.ctx, pt.svcCtx).GetBundle(&product.GetBundleRequest{BundleId: tt.id})
+			assertCode(t, err, tt.code)
+		})
+	}
+}
+
+func TestStockChangeInvalidatesBundles(t *testing.T) {
+	pt := newProductTest(t)
+	pt.addProduct(9, "Pen set", 3)
+	pt.addProduct(1, "Pen", 8)
+	pt.addProduct(2, "Ink", 5)
+	pt.bundles.items[9] = []*model.BundleItem{{BundleId: 9, ComponentId: 1, Quantity: 1}}
+
+	for _, id := range []int64{9, 1, 2} {
+		if _, err := NewGetProductLogic(pt.ctx, pt.svcCtx).GetProduct(&product.GetProductRequest{Id: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The stock of the pen changed, the pen set shows stock derived from it
+	InvalidateStockCache(pt.ctx, pt.svcCtx, []int64{1}, "office")
+
+	for id, cached := range map[int64]bool{9: false, 1: false, 2: true} {
+		if pt.redis.Exists(fmt.Sprintf("product:detail:%d", id)) != cached {
+			t.Fatalf("product %d cached = %v, want %v", id, !cached, cached)
+		}
+	}
+}
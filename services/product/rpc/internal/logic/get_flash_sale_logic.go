@@ -0,0 +1,48 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetFlashSaleLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetFlashSaleLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetFlashSaleLogic {
+	return &GetFlashSaleLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Get flash sale detail with remaining quantity
+func (l *GetFlashSaleLogic) GetFlashSale(in *product.GetFlashSaleRequest) (*product.GetFlashSaleResponse, error) {
+	// 1. Validate sale ID
+	if in.SaleId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid flash sale ID")
+	}
+
+	// 2. Get sale
+	sale, err := l.svcCtx.FlashSaleModel.FindOne(l.ctx, in.SaleId)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrFlashSaleNotFound
+		}
+		l.Logger.Errorf("Failed to get flash sale: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	return &product.GetFlashSaleResponse{
+		Sale: toFlashSale(l.ctx, l.svcCtx, sale),
+	}, nil
+}
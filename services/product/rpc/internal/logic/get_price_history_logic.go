@@ -0,0 +1,72 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPriceHistoryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetPriceHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPriceHistoryLogic {
+	return &GetPriceHistoryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Get price change history of a product (admin)
+func (l *GetPriceHistoryLogic) GetPriceHistory(in *product.GetPriceHistoryRequest) (*product.GetPriceHistoryResponse, error) {
+	// 1. Validate parameters
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+
+	page := in.Page
+	pageSize := in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Max 100 items per page
+	}
+
+	// 2. Query history, newest first
+	history, total, err := l.svcCtx.PriceModel.ListHistory(l.ctx, in.ProductId, page, pageSize)
+	if err != nil {
+		l.Logger.Errorf("Failed to list price history: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	// 3. Convert to response
+	historyList := make([]*product.PriceHistory, 0, len(history))
+	for _, h := range history {
+		historyList = append(historyList, &product.PriceHistory{
+			Id:         h.Id,
+			ProductId:  h.ProductId,
+			OldPrice:   h.OldPrice,
+			NewPrice:   h.NewPrice,
+			OperatorId: h.OperatorId,
+			Source:     h.Source,
+			ScheduleId: h.ScheduleId,
+			CreatedAt:  h.CreatedAt,
+		})
+	}
+
+	return &product.GetPriceHistoryResponse{
+		Total:   total,
+		History: historyList,
+	}, nil
+}
@@ -34,59 +34,73 @@ func (l *GetProductLogic) GetProduct(in *product.GetProductRequest) (*product.Ge
 		return nil, errorx.NewCodeError(1001, "Invalid product ID")
 	}
 
-	// 2. Try to get Product ID from cache
+	// 2. Try local cache, then Redis, then the database.
+	// Concurrent misses of the same product share one load, so a hot product expiring
+	// costs a single query instead of one per waiting request
 	cacheKey := fmt.Sprintf("product:detail:%d", in.Id)
-	cacheData, err := l.svcCtx.Redis.GetCtx(l.ctx, cacheKey)
-	if err == nil && cacheData != "" {
-		if cacheData == "null" {
-			return nil, errorx.ErrProductNotFound
+	cacheData, ok := l.svcCtx.LocalCache.Get(cacheKey)
+	if !ok {
+		val, err := l.svcCtx.CacheFlight.Do(cacheKey, func() (any, error) {
+			ctx, cancel := sharedLoadContext(l.ctx)
+			defer cancel()
+			return l.loadProduct(ctx, cacheKey, in.Id)
+		})
+		if err != nil {
+			return nil, err
 		}
+		cacheData = val.(string)
+		l.svcCtx.LocalCache.Set(cacheKey, cacheData)
+	}
 
-		var cachedProduct product.GetProductResponse
-		if err := json.Unmarshal([]byte(cacheData), &cachedProduct); err == nil {
-			l.Logger.Infof("Product info retrieved from cache: product_id=%d", in.Id)
-			return &cachedProduct, nil
-		}
+	// 3. Build response, every caller gets its own copy
+	if cacheData == "null" {
+		return nil, errorx.ErrProductNotFound
 	}
 
-	// 3. Query product from database
-	productData, err := l.svcCtx.ProductModel.FindOne(l.ctx, in.Id)
+	var result product.GetProductResponse
+	if err := json.Unmarshal([]byte(cacheData), &result); err != nil {
+		l.Logger.Errorf("Failed to unmarshal product info: %v", err)
+		return nil, errorx.ErrSystem
+	}
+
+	return &result, nil
+}
+
+// loadProduct returns the product JSON from Redis, falling back to the database.
+// Missing products are returned and cached as "null" to prevent cache penetration
+func (l *GetProductLogic) loadProduct(ctx context.Context, cacheKey string, id int64) (string, error) {
+	cacheData, err := l.svcCtx.Redis.GetCtx(ctx, cacheKey)
+	if err == nil && cacheData != "" {
+		l.Logger.Infof("Product info retrieved from cache: product_id=%d", id)
+		return cacheData, nil
+	}
+
+	productData, err := l.svcCtx.ProductModel.FindOne(ctx, id)
 	if err != nil {
 		if err == model.ErrNotFound {
-			// Cache "not found" to prevent cache penetration
-			l.svcCtx.Redis.SetexCtx(l.ctx, cacheKey, "null", 60) // 缓存60秒
-			return nil, errorx.ErrProductNotFound
+			l.svcCtx.Redis.SetexCtx(ctx, cacheKey, "null", jitterExpire(l.svcCtx, 60)) // 缓存60秒
+			return "null", nil
 		}
 		l.Logger.Errorf("Failed to get product: %v", err)
-		return nil, errorx.ErrDatabase
+		return "", errorx.ErrDatabase
 	}
 
 	result := &product.GetProductResponse{
-		Product: &product.ProductInfo{
-			Id:          productData.Id,
-			Name:        productData.Name,
-			Description: productData.Description,
-			Price:       productData.Price,
-			Stock:       productData.Stock,
-			Category:    productData.Category,
-			Images:      productData.Images,
-			Attributes:  productData.Attributes,
-			Sales:       productData.Sales,
-			CreatedAt:   productData.CreatedAt,
-			UpdatedAt:   productData.UpdatedAt,
-		},
+		Product: toProductInfo(productData),
 	}
 
 	JsonResult, err := json.Marshal(result)
-	if err == nil {
-		err := l.svcCtx.Redis.SetexCtx(l.ctx, cacheKey, string(JsonResult), l.svcCtx.Config.Cache.ProductExpire)
-		if err != nil {
-			l.Logger.Errorf("Failed to cache product info: %v", err)
-		}
+	if err != nil {
+		l.Logger.Errorf("Failed to marshal product info: %v", err)
+		return "", errorx.ErrSystem
+	}
+
+	err = l.svcCtx.Redis.SetexCtx(ctx, cacheKey, string(JsonResult), jitterExpire(l.svcCtx, l.svcCtx.Config.Cache.ProductExpire))
+	if err != nil {
+		l.Logger.Errorf("Failed to cache product info: %v", err)
 	}
 
-	l.Logger.Infof("Product info retrieved from database: product_id=%d", in.Id)
+	l.Logger.Infof("Product info retrieved from database: product_id=%d", id)
 
-	// 4. Build response
-	return result, nil
+	return string(JsonResult), nil
 }
@@ -0,0 +1,111 @@
+package logic
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"letsgo/services/product/rpc/product"
+)
+
+func TestGetProductSharesConcurrentLoads(t *testing.T) {
+	pt := newProductTest(t)
+	pt.addProduct(1, "Pen", 5)
+	pt.products.release = make(chan struct{})
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := NewGetProductLogic(pt.ctx, pt.svcCtx).GetProduct(&product.GetProductRequest{Id: 1})
+			if err == nil && resp.Product.Name != "Pen" {
+				t.Errorf("product = %+v", resp.Product)
+			}
+			errs <- err
+		}()
+	}
+
+	// Let the requests pile up behind the first load
+	time.Sleep(50 * time.Millisecond)
+	close(pt.products.release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if finds := pt.products.finds.Load(); finds != 1 {
+		t.Fatalf("database read %d times, want 1", finds)
+	}
+}
+
+func TestGetProductSurvivesFirstCallerCancel(t *testing.T) {
+	pt := newProductTest(t)
+	pt.addProduct(1, "Pen", 5)
+	pt.products.release = make(chan struct{})
+
+	// The first caller starts the load and gives up while a second caller waits for it
+	ctx, cancel := context.WithCancel(pt.ctx)
+	errs := make(chan error, 2)
+	go func() {
+		_, err := NewGetProductLogic(ctx, pt.svcCtx).GetProduct(&product.GetProductRequest{Id: 1})
+		errs <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	go func() {
+		_, err := NewGetProductLogic(pt.ctx, pt.svcCtx).GetProduct(&product.GetProductRequest{Id: 1})
+		errs <- err
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+	close(pt.products.release)
+
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+	if finds := pt.products.finds.Load(); finds != 1 {
+		t.Fatalf("database read %d times, want 1", finds)
+	}
+}
+
+func TestGetProductCachesMissingProduct(t *testing.T) {
+	pt := newProductTest(t)
+
+	for i := 0; i < 2; i++ {
+		_, err := NewGetProductLogic(pt.ctx, pt.svcCtx).GetProduct(&product.GetProductRequest{Id: 9})
+		assertCode(t, err, 3000)
+	}
+
+	if finds := pt.products.finds.Load(); finds != 1 {
+		t.Fatalf("database read %d times, want 1", finds)
+	}
+	if cached, _ := pt.redis.Get("product:detail:9"); cached != "null" {
+		t.Fatalf("cached = %q, want null", cached)
+	}
+}
+
+func TestGetProductAfterInvalidation(t *testing.T) {
+	pt := newProductTest(t)
+	p := pt.addProduct(1, "Pen", 5)
+
+	if _, err := NewGetProductLogic(pt.ctx, pt.svcCtx).GetProduct(&product.GetProductRequest{Id: 1}); err != nil {
+		t.Fatal(err)
+	}
+	p.Stock = 2
+	InvalidateProductCache(pt.ctx, &pt.svcCtx.Redis, 1, p.Category)
+
+	resp, err := NewGetProductLogic(pt.ctx, pt.svcCtx).GetProduct(&product.GetProductRequest{Id: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Product.Stock != 2 {
+		t.Fatalf("stock = %d, want 2", resp.Product.Stock)
+	}
+}
@@ -0,0 +1,179 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+type GetProductsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetProductsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetProductsLogic {
+	return &GetProductsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// maxBatchProducts limits IDs per GetProducts call
+const maxBatchProducts = 100
+
+// Get multiple products at once, results follow the order of the requested IDs
+func (l *GetProductsLogic) GetProducts(in *product.GetProductsRequest) (*product.GetProductsResponse, error) {
+	// 1. Validate input
+	if len(in.Ids) > maxBatchProducts {
+		return nil, errorx.NewCodeError(1001, fmt.Sprintf("At most %d products per request", maxBatchProducts))
+	}
+
+	uniqueIds := make([]int64, 0, len(in.Ids))
+	seen := make(map[int64]bool, len(in.Ids))
+	for _, id := range in.Ids {
+		if id > 0 && !seen[id] {
+			seen[id] = true
+			uniqueIds = append(uniqueIds, id)
+		}
+	}
+
+	// 2. Try local cache, then Redis with a single MGET
+	cached := make(map[int64]string, len(uniqueIds))
+	redisIds := make([]int64, 0, len(uniqueIds))
+	for _, id := range uniqueIds {
+		if data, ok := l.svcCtx.LocalCache.Get(fmt.Sprintf("product:detail:%d", id)); ok {
+			cached[id] = data
+		} else {
+			redisIds = append(redisIds, id)
+		}
+	}
+
+	dbIds := make([]int64, 0, len(redisIds))
+	if len(redisIds) > 0 {
+		cacheKeys := make([]string, 0, len(redisIds))
+		for _, id := range redisIds {
+			cacheKeys = append(cacheKeys, fmt.Sprintf("product:detail:%d", id))
+		}
+
+		values, err := l.svcCtx.Redis.MgetCtx(l.ctx, cacheKeys...)
+		if err != nil {
+			// Not fatal, everything is loaded from the database instead
+			l.Logger.Errorf("Failed to get products from cache: %v", err)
+		}
+
+		for i, id := range redisIds {
+			if i < len(values) && values[i] != "" {
+				cached[id] = values[i]
+				l.svcCtx.LocalCache.Set(cacheKeys[i], values[i])
+			} else {
+				dbIds = append(dbIds, id)
+			}
+		}
+	}
+
+	// 3. Load cache misses with a single query and back-fill the cache
+	if len(dbIds) > 0 {
+		products, err := l.svcCtx.ProductModel.FindByIds(l.ctx, dbIds)
+		if err != nil {
+			l.Logger.Errorf("Failed to get products: %v", err)
+			return nil, errorx.ErrDatabase
+		}
+
+		for id, data := range l.backfill(dbIds, products) {
+			cached[id] = data
+		}
+
+		l.Logger.Infof("Products retrieved: requested=%d, from_cache=%d, from_database=%d",
+			len(uniqueIds), len(uniqueIds)-len(dbIds), len(dbIds))
+	}
+
+	// 4. Build results in request order, every entry gets its own copy
+	results := make([]*product.ProductResult, 0, len(in.Ids))
+	for _, id := range in.Ids {
+		result := &product.ProductResult{Id: id}
+		if data, ok := cached[id]; ok && data != "null" {
+			var cachedProduct product.GetProductResponse
+			if err := json.Unmarshal([]byte(data), &cachedProduct); err == nil && cachedProduct.Product != nil {
+				result.Found = true
+				result.Product = cachedProduct.Product
+			}
+		}
+		results = append(results, result)
+	}
+
+	return &product.GetProductsResponse{
+		Results: results,
+	}, nil
+}
+
+// backfill caches loaded products in the same format as GetProduct
+// IDs that were not found are cached as "null" to prevent cache penetration
+func (l *GetProductsLogic) backfill(ids []int64, products []*model.Product) map[int64]string {
+	values := make(map[int64]string, len(ids))
+	for _, id := range ids {
+		values[id] = "null"
+	}
+	for _, p := range products {
+		data, err := json.Marshal(&product.GetProductResponse{Product: toProductInfo(p)})
+		if err != nil {
+			l.Logger.Errorf("Failed to marshal product info: %v", err)
+			continue
+		}
+		values[p.Id] = string(data)
+	}
+
+	err := l.svcCtx.Redis.PipelinedCtx(l.ctx, func(pipe redis.Pipeliner) error {
+		for id, data := range values {
+			expire := l.svcCtx.Config.Cache.ProductExpire
+			if data == "null" {
+				expire = 60 // 缓存60秒
+			}
+			pipe.SetEx(l.ctx, fmt.Sprintf("product:detail:%d", id), data, time.Duration(jitterExpire(l.svcCtx, expire))*time.Second)
+		}
+		return nil
+	})
+	if err != nil {
+		l.Logger.Errorf("Failed to cache product info: %v", err)
+	}
+
+	for id, data := range values {
+		l.svcCtx.LocalCache.Set(fmt.Sprintf("product:detail:%d", id), data)
+	}
+
+	return values
+}
+
+// toProductInfo converts a product to the RPC type
+func toProductInfo(p *model.Product) *product.ProductInfo {
+	return &product.ProductInfo{
+		Id:          p.Id,
+		Sku:         p.Sku,
+		Name:        p.Name,
+		Description: p.Description,
+		Price:       p.Price,
+		Stock:       p.Stock,
+		Category:    p.Category,
+		Images:      p.Images,
+		Attributes:  p.Attributes,
+		Sales:       p.Sales,
+		Status:      int32(p.Status),
+		PublishAt:   p.PublishAt,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+		Version:     p.Version,
+		MaxPerOrder: p.MaxPerOrder,
+		MaxPerUser:  p.MaxPerUser,
+		LimitWindow: p.LimitWindow,
+	}
+}
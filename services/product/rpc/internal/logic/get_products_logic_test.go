@@ -0,0 +1,82 @@
+package logic
+
+import (
+	"reflect"
+	"testing"
+
+	"letsgo/services/product/rpc/product"
+)
+
+func TestGetProductsKeepsRequestOrder(t *testing.T) {
+	pt := newProductTest(t)
+	pt.addProduct(1, "Pen", 5)
+	pt.addProduct(3, "Pad", 2)
+
+	// Product 3 is already cached
+	if _, err := NewGetProductLogic(pt.ctx, pt.svcCtx).GetProduct(&product.GetProductRequest{Id: 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := NewGetProductsLogic(pt.ctx, pt.svcCtx).GetProducts(&product.GetProductsRequest{Ids: []int64{3, 9, 1, 3, 0}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		id    int64
+		found bool
+		name  string
+	}{
+		{3, true, "Pad"},
+		{9, false, ""},
+		{1, true, "Pen"},
+		{3, true, "Pad"},
+		{0, false, ""},
+	}
+	if len(resp.Results) != len(want) {
+		t.Fatalf("got %d results, want %d", len(resp.Results), len(want))
+	}
+	for i, w := range want {
+		r := resp.Results[i]
+		if r.Id != w.id || r.Found != w.found || (w.found && r.Product.Name != w.name) || (!w.found && r.Product != nil) {
+			t.Fatalf("result %d = %+v, want %+v", i, r, w)
+		}
+	}
+
+	// Only the misses go to the database, in a single query
+	if !reflect.DeepEqual(pt.products.batches, [][]int64{{9, 1}}) {
+		t.Fatalf("database queries = %v", pt.products.batches)
+	}
+	if cached, _ := pt.redis.Get("product:detail:9"); cached != "null" {
+		t.Fatalf("missing product cached as %q, want null", cached)
+	}
+}
+
+func TestGetProductsServesMissingFromCache(t *testing.T) {
+	pt := newProductTest(t)
+
+	for i := 0; i < 2; i++ {
+		resp, err := NewGetProductsLogic(pt.ctx, pt.svcCtx).GetProducts(&product.GetProductsRequest{Ids: []int64{9}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Results[0].Found {
+			t.Fatal("missing product found")
+		}
+	}
+
+	if len(pt.products.batches) != 1 {
+		t.Fatalf("database queried %d times, want 1", len(pt.products.batches))
+	}
+}
+
+func TestGetProductsLimit(t *testing.T) {
+	pt := newProductTest(t)
+
+	ids := make([]int64, maxBatchProducts+1)
+	for i := range ids {
+		ids[i] = int64(i + 1)
+	}
+	_, err := NewGetProductsLogic(pt.ctx, pt.svcCtx).GetProducts(&product.GetProductsRequest{Ids: ids})
+	assertCode(t, err, 1001)
+}
@@ -0,0 +1,146 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRelatedProductsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetRelatedProductsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRelatedProductsLogic {
+	return &GetRelatedProductsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Get products frequently bought together with a product, topped up with best sellers of its category
+func (l *GetRelatedProductsLogic) GetRelatedProducts(in *product.GetRelatedProductsRequest) (*product.GetRelatedProductsResponse, error) {
+	// 1. Validate parameters
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+
+	maxProducts := l.svcCtx.Config.Related.MaxProducts
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > maxProducts {
+		limit = maxProducts
+	}
+
+	// 2. The product itself must be on sale, its category drives the fallback
+	productResp, err := NewGetProductLogic(l.ctx, l.svcCtx).GetProduct(&product.GetProductRequest{Id: in.ProductId})
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Get related IDs from cache, concurrent misses share one computation
+	cacheKey := fmt.Sprintf("product:related:%d", in.ProductId)
+	val, err := l.svcCtx.CacheFlight.Do(cacheKey, func() (any, error) {
+		ctx, cancel := sharedLoadContext(l.ctx)
+		defer cancel()
+		return l.loadRelated(ctx, cacheKey, in.ProductId, productResp.Product.Category)
+	})
+	if err != nil {
+		return nil, err
+	}
+	related := val.(*relatedIds)
+
+	// 4. Load details through the product cache, products taken off sale since are skipped
+	productsResp, err := NewGetProductsLogic(l.ctx, l.svcCtx).GetProducts(&product.GetProductsRequest{Ids: related.Ids})
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*product.ProductInfo, 0, limit)
+	var boughtTogether int32
+	for i, result := range productsResp.Results {
+		if len(products) == limit {
+			break
+		}
+		if !result.Found {
+			continue
+		}
+
+		products = append(products, result.Product)
+		if i < related.BoughtTogether {
+			boughtTogether++
+		}
+	}
+
+	return &product.GetRelatedProductsResponse{
+		Products:       products,
+		BoughtTogether: boughtTogether,
+	}, nil
+}
+
+// relatedIds is the cached recommendation of a product
+type relatedIds struct {
+	Ids            []int64 `json:"ids"`
+	BoughtTogether int     `json:"bought_together"` // The first BoughtTogether IDs come from co-purchases
+}
+
+// loadRelated returns the related IDs from Redis, computing them on a miss.
+// Products bought together come first, best sellers of the same category fill the rest
+func (l *GetRelatedProductsLogic) loadRelated(ctx context.Context, cacheKey string, productId int64, category string) (*relatedIds, error) {
+	cacheData, err := l.svcCtx.Redis.GetCtx(ctx, cacheKey)
+	if err == nil && cacheData != "" {
+		var related relatedIds
+		if err := json.Unmarshal([]byte(cacheData), &related); err == nil {
+			return &related, nil
+		}
+	}
+
+	maxProducts := l.svcCtx.Config.Related.MaxProducts
+	boughtTogether, err := l.svcCtx.RelatedModel.FindBoughtTogether(ctx, productId, maxProducts)
+	if err != nil {
+		l.Logger.Errorf("Failed to find products bought together: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	ids := append([]int64{}, boughtTogether...)
+	if len(ids) < maxProducts {
+		exclude := append([]int64{productId}, boughtTogether...)
+		bestSellers, err := l.svcCtx.RelatedModel.FindBestSellers(ctx, category, exclude, maxProducts-len(ids))
+		if err != nil {
+			l.Logger.Errorf("Failed to find best sellers: %v", err)
+			return nil, errorx.ErrDatabase
+		}
+		ids = append(ids, bestSellers...)
+	}
+
+	related := &relatedIds{
+		Ids:            ids,
+		BoughtTogether: len(boughtTogether),
+	}
+
+	data, err := json.Marshal(related)
+	if err != nil {
+		l.Logger.Errorf("Failed to marshal related products: %v", err)
+		return related, nil
+	}
+
+	err = l.svcCtx.Redis.SetexCtx(ctx, cacheKey, string(data), jitterExpire(l.svcCtx, l.svcCtx.Config.Cache.RelatedExpire))
+	if err != nil {
+		l.Logger.Errorf("Failed to cache related products: %v", err)
+	}
+
+	l.Logger.Infof("Related products computed: product_id=%d, bought_together=%d, best_sellers=%d",
+		productId, related.BoughtTogether, len(ids)-related.BoughtTogether)
+
+	return related, nil
+}
@@ -0,0 +1,92 @@
+package logic
+
+import (
+	"reflect"
+	"testing"
+
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/product"
+)
+
+func relatedIdsOf(products []*product.ProductInfo) []int64 {
+	ids := make([]int64, 0, len(products))
+	for _, p := range products {
+		ids = append(ids, p.Id)
+	}
+	return ids
+}
+
+func TestGetRelatedProducts(t *testing.T) {
+	tests := []struct {
+		name           string
+		boughtTogether []int64
+		bestSellers    []int64
+		limit          int32
+		want           []int64
+		wantTogether   int32
+	}{
+		{name: "co-purchases first", boughtTogether: []int64{3, 2}, bestSellers: []int64{4, 5}, want: []int64{3, 2, 4, 5}, wantTogether: 2},
+		{name: "best sellers only", bestSellers: []int64{5, 4}, want: []int64{5, 4}},
+		{name: "limit", boughtTogether: []int64{3}, bestSellers: []int64{4, 5}, limit: 2, want: []int64{3, 4}, wantTogether: 1},
+		{name: "limit above maximum", boughtTogether: []int64{2, 3, 4, 5}, limit: 100, want: []int64{2, 3, 4, 5}, wantTogether: 4},
+		{name: "off sale skipped", boughtTogether: []int64{6, 3}, bestSellers: []int64{4}, want: []int64{3, 4}, wantTogether: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pt := newProductTest(t)
+			for id := int64(1); id <= 5; id++ {
+				pt.addProduct(id, "Pen", 10)
+			}
+			pt.addProduct(6, "Ink", 10).Status = model.ProductStatusUnpublished
+			pt.related.boughtTogether = tt.boughtTogether
+			pt.related.bestSellers = tt.bestSellers
+
+			resp, err := NewGetRelatedProductsLogic(pt.ctx, pt.svcCtx).GetRelatedProducts(&product.GetRelatedProductsRequest{
+				ProductId: 1,
+				Limit:     tt.limit,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if ids := relatedIdsOf(resp.Products); !reflect.DeepEqual(ids, tt.want) {
+				t.Fatalf("ids = %v, want %v", ids, tt.want)
+			}
+			if resp.BoughtTogether != tt.wantTogether {
+				t.Fatalf("bought together = %d, want %d", resp.BoughtTogether, tt.wantTogether)
+			}
+			// The product and its co-purchases are never offered as best sellers
+			if want := append([]int64{1}, tt.boughtTogether...); len(tt.boughtTogether) < 5 && !reflect.DeepEqual(pt.related.excluded, want) {
+				t.Fatalf("excluded = %v, want %v", pt.related.excluded, want)
+			}
+		})
+	}
+}
+
+func TestGetRelatedProductsCached(t *testing.T) {
+	pt := newProductTest(t)
+	pt.addProduct(1, "Pen", 10)
+	pt.addProduct(2, "Ink", 10)
+	pt.related.boughtTogether = []int64{2}
+
+	l := NewGetRelatedProductsLogic(pt.ctx, pt.svcCtx)
+	for i := 0; i < 2; i++ {
+		resp, err := l.GetRelatedProducts(&product.GetRelatedProductsRequest{ProductId: 1})
+		if err != nil || len(resp.Products) != 1 {
+			t.Fatalf("resp = %v, err = %v", resp, err)
+		}
+	}
+
+	if pt.related.computed != 1 {
+		t.Fatalf("computed %d times, want 1", pt.related.computed)
+	}
+}
+
+func TestGetRelatedProductsOffSale(t *testing.T) {
+	pt := newProductTest(t)
+	pt.addProduct(1, "Pen", 10).Status = model.ProductStatusUnpublished
+
+	_, err := NewGetRelatedProductsLogic(pt.ctx, pt.svcCtx).GetRelatedProducts(&product.GetRelatedProductsRequest{ProductId: 1})
+	assertCode(t, err, 3000)
+}
@@ -0,0 +1,245 @@
+package logic
+
+import (
+	"context"
+	"os"
+	"slices"
+	"sync/atomic"
+	"testing"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/mathx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"github.com/zeromicro/go-zero/core/syncx"
+)
+
+func TestMain(m *testing.M) {
+	logx.Disable()
+	os.Exit(m.Run())
+}
+
+// fakeProductModel serves published products from memory and counts database reads
+type fakeProductModel struct {
+	model.ProductModel
+	products map[int64]*model.Product
+	finds    atomic.Int32
+	lists    atomic.Int32
+	batches  [][]int64     // IDs of every FindByIds call
+	release  chan struct{} // When set, reads wait until it is closed
+	conflict bool          // Update finds the row changed since it was read
+}
+
+func (m *fakeProductModel) wait() {
+	if m.release != nil {
+		<-m.release
+	}
+}
+
+func (m *fakeProductModel) FindOne(ctx context.Context, id int64) (*model.Product, error) {
+	m.finds.Add(1)
+	m.wait()
+	// Like the database driver, a cancelled request is not served
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	p, ok := m.products[id]
+	if !ok || p.Status != model.ProductStatusPublished {
+		return nil, model.ErrNotFound
+	}
+	return p, nil
+}
+
+func (m *fakeProductModel) FindOneAnyStatus(ctx context.Context, id int64) (*model.Product, error) {
+	p, ok := m.products[id]
+	if !ok {
+		return nil, model.ErrNotFound
+	}
+	copied := *p
+	return &copied, nil
+}
+
+func (m *fakeProductModel) Update(ctx context.Context, data *model.Product, operatorId int64) (int64, error) {
+	if m.conflict {
+		return 0, model.ErrVersionConflict
+	}
+	return data.Version + 1, nil
+}
+
+// FindByIds returns the products newest first, callers must not rely on the order
+func (m *fakeProductModel) FindByIds(ctx context.Context, ids []int64) ([]*model.Product, error) {
+	m.batches = append(m.batches, ids)
+	var products []*model.Product
+	for i := len(ids) - 1; i >= 0; i-- {
+		if p, ok := m.products[ids[i]]; ok && p.Status == model.ProductStatusPublished {
+			products = append(products, p)
+		}
+	}
+	return products, nil
+}
+
+func (m *fakeProductModel) List(ctx context.Context, page, pageSize int32, category, sortBy, order string) ([]*model.Product, int64, error) {
+	m.lists.Add(1)
+	m.wait()
+	var products []*model.Product
+	for _, p := range m.products {
+		if p.Status == model.ProductStatusPublished && (category == "" || p.Category == category) {
+			products = append(products, p)
+		}
+	}
+	return products, int64(len(products)), nil
+}
+
+// CheckStock returns the stored stock of published products, tests set the availability of bundles directly
+func (m *fakeProductModel) CheckStock(ctx context.Context, productIds []int64) (map[int64]int64, error) {
+	stockMap := make(map[int64]int64)
+	for _, id := range productIds {
+		if p, ok := m.products[id]; ok && p.Status == model.ProductStatusPublished {
+			stockMap[id] = p.Stock
+		}
+	}
+	return stockMap, nil
+}
+
+// fakeInventoryModel returns fixed discrepancies and records the requested limit
+type fakeInventoryModel struct {
+	model.InventoryModel
+	discrepancies []*model.StockDiscrepancy
+	limit         int
+}
+
+func (m *fakeInventoryModel) FindDiscrepancies(ctx context.Context, productId int64, limit int) ([]*model.StockDiscrepancy, error) {
+	m.limit = limit
+	return m.discrepancies, nil
+}
+
+// fakeRelatedModel serves fixed recommendations and counts how often they are computed
+type fakeRelatedModel struct {
+	model.RelatedModel
+	boughtTogether []int64
+	bestSellers    []int64
+	excluded       []int64
+	computed       int
+}
+
+func (m *fakeRelatedModel) FindBoughtTogether(ctx context.Context, productId int64, limit int) ([]int64, error) {
+	m.computed++
+	return m.boughtTogether, nil
+}
+
+func (m *fakeRelatedModel) FindBestSellers(ctx context.Context, category string, excludeIds []int64, limit int) ([]int64, error) {
+	m.excluded = excludeIds
+	return m.bestSellers[:min(limit, len(m.bestSellers))], nil
+}
+
+// fakeBundleModel serves fixed bundle components
+type fakeBundleModel struct {
+	model.BundleModel
+	items map[int64][]*model.BundleItem
+}
+
+func (m *fakeBundleModel) FindItems(ctx context.Context, bundleId int64) ([]*model.BundleItem, error) {
+	return m.items[bundleId], nil
+}
+
+func (m *fakeBundleModel) FindContainingBundles(ctx context.Context, componentIds []int64) (map[int64]string, error) {
+	bundles := make(map[int64]string)
+	for bundleId, items := range m.items {
+		for _, item := range items {
+			if slices.Contains(componentIds, item.ComponentId) {
+				bundles[bundleId] = "kits"
+			}
+		}
+	}
+	return bundles, nil
+}
+
+// fakeFlashSaleModel records how sales were closed
+type fakeFlashSaleModel struct {
+	model.FlashSaleModel
+	closed map[int64]int64 // Sale ID -> unsold quantity given back
+}
+
+func (m *fakeFlashSaleModel) Close(ctx context.Context, id int64, status int64, unsold int64, operatorId int64, now int64) (string, error) {
+	if _, ok := m.closed[id]; ok {
+		return "", model.ErrFlashSaleClosed
+	}
+	m.closed[id] = unsold
+	return "office", nil
+}
+
+type productTest struct {
+	ctx        context.Context
+	svcCtx     *svc.ServiceContext
+	redis      *miniredis.Miniredis
+	products   *fakeProductModel
+	inventory  *fakeInventoryModel
+	flashSales *fakeFlashSaleModel
+	related    *fakeRelatedModel
+	bundles    *fakeBundleModel
+}
+
+func newProductTest(t *testing.T) *productTest {
+	mr := miniredis.RunT(t)
+	products := &fakeProductModel{products: make(map[int64]*model.Product)}
+	inventory := &fakeInventoryModel{}
+	flashSales := &fakeFlashSaleModel{closed: make(map[int64]int64)}
+	related := &fakeRelatedModel{}
+	bundles := &fakeBundleModel{items: make(map[int64][]*model.BundleItem)}
+
+	svcCtx := &svc.ServiceContext{
+		ProductModel:   products,
+		InventoryModel: inventory,
+		FlashSaleModel: flashSales,
+		RelatedModel:   related,
+		BundleModel:    bundles,
+		Redis:          *redis.New(mr.Addr()),
+		CacheFlight:    syncx.NewSingleFlight(),
+		CacheExpiry:    mathx.NewUnstable(0.1),
+	}
+	svcCtx.Config.Cache.ProductExpire = 3600
+	svcCtx.Config.Cache.ListExpire = 3600
+	svcCtx.Config.Cache.ListStale = 60
+	svcCtx.Config.Cache.RelatedExpire = 3600
+	svcCtx.Config.Related.MaxProducts = 5
+
+	return &productTest{
+		ctx:        context.Background(),
+		svcCtx:     svcCtx,
+		redis:      mr,
+		products:   products,
+		inventory:  inventory,
+		flashSales: flashSales,
+		related:    related,
+		bundles:    bundles,
+	}
+}
+
+// addProduct adds a published product
+func (pt *productTest) addProduct(id int64, name string, stock int64) *model.Product {
+	p := &model.Product{
+		Id:       id,
+		Name:     name,
+		Price:    2.5,
+		Stock:    stock,
+		Category: "office",
+		Status:   model.ProductStatusPublished,
+	}
+	pt.products.products[id] = p
+	return p
+}
+
+func assertCode(t *testing.T, err error, code int) {
+	t.Helper()
+	codeErr, ok := err.(*errorx.CodeError)
+	if !ok {
+		t.Fatalf("expected error code %d, got %v", code, err)
+	}
+	if codeErr.Code != code {
+		t.Fatalf("expected error code %d, got %d (%s)", code, codeErr.Code, codeErr.Msg)
+	}
+}
@@ -0,0 +1,208 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/internal/utils"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ImportProductsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewImportProductsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportProductsLogic {
+	return &ImportProductsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Bulk import products from CSV or JSON Lines, upsert by SKU (admin)
+func (l *ImportProductsLogic) ImportProducts(in *product.ImportProductsRequest) (*product.ImportProductsResponse, error) {
+	// 1. Validate request and decode the file
+	if !utils.IsCatalogFormat(in.Format) {
+		return nil, errorx.NewCodeError(1001, "Format must be csv or jsonl")
+	}
+	if len(in.Data) == 0 {
+		return nil, errorx.NewCodeError(1001, "Import file is empty")
+	}
+
+	rows, err := utils.DecodeCatalog(in.Format, in.Data)
+	if err != nil {
+		return nil, errorx.NewCodeError(1001, err.Error())
+	}
+	if len(rows) > l.svcCtx.Config.Import.MaxRows {
+		return nil, errorx.NewCodeError(1001, fmt.Sprintf("Too many rows, maximum %d per import", l.svcCtx.Config.Import.MaxRows))
+	}
+
+	resp := &product.ImportProductsResponse{
+		Total:  int64(len(rows)),
+		Errors: []*product.ImportRowError{},
+	}
+
+	// 2. Validate every row, a SKU may only appear once per file
+	now := time.Now().Unix()
+	seenSkus := make(map[string]int64)
+	valid := make([]utils.ParsedRow, 0, len(rows))
+	for _, row := range rows {
+		if row.Err == nil {
+			row.Err = l.validateRow(&row.Data)
+		}
+		if row.Err == nil {
+			if firstRow, ok := seenSkus[row.Data.Sku]; ok {
+				row.Err = fmt.Errorf("duplicate sku, first used in row %d", firstRow)
+			} else {
+				seenSkus[row.Data.Sku] = row.Row
+			}
+		}
+
+		if row.Err != nil {
+			resp.Errors = append(resp.Errors, &product.ImportRowError{
+				Row:     row.Row,
+				Sku:     row.Data.Sku,
+				Message: row.Err.Error(),
+			})
+			continue
+		}
+		valid = append(valid, row)
+	}
+
+	// 3. Upsert valid rows in batched transactions
+	var productIds []int64
+	categories := make(map[string]struct{})
+	batchSize := l.svcCtx.Config.Import.BatchSize
+
+	for start := 0; start < len(valid); start += batchSize {
+		end := start + batchSize
+		if end > len(valid) {
+			end = len(valid)
+		}
+		batch := valid[start:end]
+
+		products := make([]*model.Product, 0, len(batch))
+		for _, row := range batch {
+			products = append(products, &model.Product{
+				Sku:         row.Data.Sku,
+				Name:        row.Data.Name,
+				Description: row.Data.Description,
+				Price:       row.Data.Price,
+				Stock:       row.Data.Stock,
+				Category:    row.Data.Category,
+				Images:      row.Data.Images,
+				Attributes:  row.Data.Attributes,
+				Status:      row.Data.Status,
+				UpdatedAt:   now,
+			})
+		}
+
+		results, err := l.svcCtx.ProductModel.UpsertBySku(l.ctx, products, in.OperatorId)
+		if err != nil {
+			// The whole batch was rolled back
+			l.Logger.Errorf("Failed to import batch: rows %d-%d, err=%v", batch[0].Row, batch[len(batch)-1].Row, err)
+			for _, row := range batch {
+				resp.Errors = append(resp.Errors, &product.ImportRowError{
+					Row:     row.Row,
+					Sku:     row.Data.Sku,
+					Message: "Database error, batch rolled back",
+				})
+			}
+			continue
+		}
+
+		for i, result := range results {
+			if result.Err != nil {
+				l.Logger.Errorf("Failed to import row %d: %v", batch[i].Row, result.Err)
+				resp.Errors = append(resp.Errors, &product.ImportRowError{
+					Row:     batch[i].Row,
+					Sku:     batch[i].Data.Sku,
+					Message: "Database rejected row",
+				})
+				continue
+			}
+
+			if result.Inserted {
+				resp.Created++
+			} else {
+				resp.Updated++
+				categories[result.OldCategory] = struct{}{}
+			}
+			categories[batch[i].Data.Category] = struct{}{}
+			productIds = append(productIds, result.Id)
+		}
+	}
+
+	resp.Failed = int64(len(resp.Errors))
+	sort.Slice(resp.Errors, func(i, j int) bool {
+		return resp.Errors[i].Row < resp.Errors[j].Row
+	})
+
+	l.Logger.Infof("Products imported: format=%s, total=%d, created=%d, updated=%d, failed=%d",
+		in.Format, resp.Total, resp.Created, resp.Updated, resp.Failed)
+
+	// 4. Keep cache data consistant.
+	if len(productIds) > 0 {
+		categoryList := make([]string, 0, len(categories))
+		for category := range categories {
+			categoryList = append(categoryList, category)
+		}
+		InvalidateProductsCache(l.ctx, &l.svcCtx.Redis, productIds, categoryList...)
+	}
+
+	return resp, nil
+}
+
+// validateRow validates a decoded import row and applies defaults
+func (l *ImportProductsLogic) validateRow(row *utils.CatalogRow) error {
+	row.Sku = strings.TrimSpace(row.Sku)
+	row.Name = strings.TrimSpace(row.Name)
+	row.Category = strings.TrimSpace(row.Category)
+
+	if row.Sku == "" {
+		return fmt.Errorf("sku cannot be empty")
+	}
+	if len(row.Sku) > 64 {
+		return fmt.Errorf("sku must be less than 64 characters")
+	}
+	if row.Name == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	if len(row.Name) > 200 {
+		return fmt.Errorf("name must be less than 200 characters")
+	}
+	if row.Price <= 0 {
+		return fmt.Errorf("price must be greater than 0")
+	}
+	if row.Stock < 0 {
+		return fmt.Errorf("stock cannot be negative")
+	}
+	if row.Category == "" {
+		return fmt.Errorf("category cannot be empty")
+	}
+	if row.Attributes != "" && !json.Valid([]byte(row.Attributes)) {
+		return fmt.Errorf("attributes must be a JSON string")
+	}
+
+	switch row.Status {
+	case 0:
+		row.Status = model.ProductStatusPublished
+	case model.ProductStatusPublished, model.ProductStatusUnpublished, model.ProductStatusDraft:
+	default:
+		return fmt.Errorf("invalid status: %d", row.Status)
+	}
+
+	return nil
+}
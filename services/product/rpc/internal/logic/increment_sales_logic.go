@@ -2,7 +2,6 @@ package logic
 
 import (
 	"context"
-	"fmt"
 
 	"letsgo/common/errorx"
 	"letsgo/services/product/model"
@@ -51,21 +50,7 @@ func (l *IncrementSalesLogic) IncrementSales(in *product.IncrementSalesRequest)
 		in.ProductId, in.Quantity, newSales, category)
 
 	// 3. Keep cache data consistant.
-	cacheKey := fmt.Sprintf("product:detail:%d", in.ProductId)
-	_, err = l.svcCtx.Redis.DelCtx(l.ctx, cacheKey)
-	if err != nil {
-		logx.Errorf("Delete product detail cache failed! err:%s", err)
-	}
-
-	err = IncCategoryVersion(l.ctx, category, &l.svcCtx.Redis)
-	if err != nil {
-		logx.Errorf("Increase category version failed! err:%s", err)
-	}
-
-	err = IncGlobalVersion(l.ctx, &l.svcCtx.Redis)
-	if err != nil {
-		logx.Errorf("Increase global version failed! err:%s", err)
-	}
+	InvalidateProductCache(l.ctx, &l.svcCtx.Redis, in.ProductId, category)
 
 	return &product.IncrementSalesResponse{
 		Success:  true,
@@ -0,0 +1,89 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFlashSalesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListFlashSalesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFlashSalesLogic {
+	return &ListFlashSalesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// List flash sales (admin)
+func (l *ListFlashSalesLogic) ListFlashSales(in *product.ListFlashSalesRequest) (*product.ListFlashSalesResponse, error) {
+	// 1. Set default pagination
+	page := in.Page
+	pageSize := in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Max 100 items per page
+	}
+
+	// 2. Query sales, newest start time first
+	sales, total, err := l.svcCtx.FlashSaleModel.List(l.ctx, in.ProductId, int64(in.Status), page, pageSize)
+	if err != nil {
+		l.Logger.Errorf("Failed to list flash sales: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	// 3. Convert to response
+	saleList := make([]*product.FlashSale, 0, len(sales))
+	for _, s := range sales {
+		saleList = append(saleList, toFlashSale(l.ctx, l.svcCtx, s))
+	}
+
+	return &product.ListFlashSalesResponse{
+		Total: total,
+		Sales: saleList,
+	}, nil
+}
+
+// toFlashSale converts a flash sale to the RPC type
+// Open sales report the units left in Redis, closed ones have nothing left
+func toFlashSale(ctx context.Context, svcCtx *svc.ServiceContext, s *model.FlashSale) *product.FlashSale {
+	var remaining int64
+	if s.Status == model.FlashSalePending || s.Status == model.FlashSaleActive {
+		left, err := getFlashSaleRemaining(ctx, &svcCtx.Redis, s.Id)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("Get flash sale stock failed! sale_id=%d, err:%s", s.Id, err)
+		}
+		remaining = left
+	}
+
+	return &product.FlashSale{
+		Id:           s.Id,
+		ProductId:    s.ProductId,
+		Price:        s.Price,
+		Quantity:     s.Quantity,
+		Sold:         s.Sold,
+		Remaining:    remaining,
+		PerUserLimit: s.PerUserLimit,
+		StartAt:      s.StartAt,
+		EndAt:        s.EndAt,
+		Status:       int32(s.Status),
+		OperatorId:   s.OperatorId,
+		CreatedAt:    s.CreatedAt,
+		UpdatedAt:    s.UpdatedAt,
+	}
+}
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListInventoryMovementsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListInventoryMovementsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListInventoryMovementsLogic {
+	return &ListInventoryMovementsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// List inventory movements of a product (admin)
+func (l *ListInventoryMovementsLogic) ListInventoryMovements(in *product.ListInventoryMovementsRequest) (*product.ListInventoryMovementsResponse, error) {
+	// 1. Validate parameters
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+
+	page := in.Page
+	pageSize := in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Max 100 items per page
+	}
+
+	// 2. Query movements, newest first
+	movements, total, err := l.svcCtx.InventoryModel.ListMovements(l.ctx, in.ProductId, in.Reason, page, pageSize)
+	if err != nil {
+		l.Logger.Errorf("Failed to list inventory movements: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	// 3. Convert to response
+	movementList := make([]*product.InventoryMovement, 0, len(movements))
+	for _, m := range movements {
+		movementList = append(movementList, &product.InventoryMovement{
+			Id:          m.Id,
+			ProductId:   m.ProductId,
+			WarehouseId: m.WarehouseId,
+			Quantity:    m.Quantity,
+			StockAfter:  m.StockAfter,
+			Reason:      m.Reason,
+			ReferenceId: m.ReferenceId,
+			OperatorId:  m.OperatorId,
+			CreatedAt:   m.CreatedAt,
+		})
+	}
+
+	return &product.ListInventoryMovementsResponse{
+		Total:     total,
+		Movements: movementList,
+	}, nil
+}
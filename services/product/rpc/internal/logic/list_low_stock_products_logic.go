@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListLowStockProductsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListLowStockProductsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListLowStockProductsLogic {
+	return &ListLowStockProductsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// List products at or below their low-stock threshold (admin)
+func (l *ListLowStockProductsLogic) ListLowStockProducts(in *product.ListLowStockProductsRequest) (*product.ListLowStockProductsResponse, error) {
+	// 1. Validate parameters
+	page := in.Page
+	pageSize := in.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 20
+	}
+	if pageSize > 100 {
+		pageSize = 100 // Max 100 items per page
+	}
+
+	// 2. Query products at or below their threshold, lowest stock first
+	products, total, err := l.svcCtx.StockAlertModel.ListLowStock(l.ctx, page, pageSize)
+	if err != nil {
+		l.Logger.Errorf("Failed to list low-stock products: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	// 3. Convert to response
+	productList := make([]*product.LowStockProduct, 0, len(products))
+	for _, p := range products {
+		productList = append(productList, &product.LowStockProduct{
+			ProductId: p.ProductId,
+			Sku:       p.Sku,
+			Name:      p.Name,
+			Stock:     p.Stock,
+			Threshold: p.Threshold,
+		})
+	}
+
+	return &product.ListLowStockProductsResponse{
+		Total:    total,
+		Products: productList,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListPriceSchedulesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListPriceSchedulesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPriceSchedulesLogic {
+	return &ListPriceSchedulesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// List price schedules of a product (admin)
+func (l *ListPriceSchedulesLogic) ListPriceSchedules(in *product.ListPriceSchedulesRequest) (*product.ListPriceSchedulesResponse, error) {
+	// 1. Validate product ID
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+
+	// 2. Query schedules
+	schedules, err := l.svcCtx.PriceModel.ListSchedules(l.ctx, in.ProductId, in.IncludeFinished)
+	if err != nil {
+		l.Logger.Errorf("Failed to list price schedules: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	// 3. Convert to response
+	scheduleList := make([]*product.PriceSchedule, 0, len(schedules))
+	for _, s := range schedules {
+		scheduleList = append(scheduleList, &product.PriceSchedule{
+			Id:            s.Id,
+			ProductId:     s.ProductId,
+			Price:         s.Price,
+			OriginalPrice: s.OriginalPrice,
+			StartAt:       s.StartAt,
+			EndAt:         s.EndAt,
+			Status:        int32(s.Status),
+			OperatorId:    s.OperatorId,
+			CreatedAt:     s.CreatedAt,
+			UpdatedAt:     s.UpdatedAt,
+		})
+	}
+
+	return &product.ListPriceSchedulesResponse{
+		Schedules: scheduleList,
+	}, nil
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"letsgo/common/errorx"
 	"letsgo/services/product/rpc/internal/svc"
 	"letsgo/services/product/rpc/product"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
 )
 
 type ListProductsLogic struct {
@@ -41,31 +43,100 @@ func (l *ListProductsLogic) ListProducts(in *product.ListProductsRequest) (*prod
 		pageSize = 100 // Max 100 items per page
 	}
 
-	// 2. Try to get list from cache first
-	categoryVersion := GetCategoryVersion(l.ctx, in.Category, &l.svcCtx.Redis)
+	// 2. Try to get list from cache first.
+	// Pages remember the category version they were built for. An outdated or expired page
+	// is still served while a single request rebuilds it in background, so a version bump
+	// does not send every request of a category to the database at once
+	version := GetCategoryVersion(l.ctx, in.Category, &l.svcCtx.Redis)
+	cacheKey := fmt.Sprintf("product:list:%d:%d:%s:%s:%s", page, pageSize, in.Category, in.SortBy, in.Order)
 
-	cacheKey := fmt.Sprintf("product:list:v%d:%d:%d:%s:%s:%s", categoryVersion, in.Page, in.PageSize, in.Category, in.SortBy, in.Order)
-	cacheData, err := l.svcCtx.Redis.GetCtx(l.ctx, cacheKey)
-	if err == nil && cacheData != "" {
-		var cachedResponse product.ListProductsResponse
-		if err := json.Unmarshal([]byte(cacheData), &cachedResponse); err == nil {
-			l.Logger.Infof("Product list retrieved from cache: Total = %d", cachedResponse.Total)
-			return &cachedResponse, nil
+	if cached, ok := l.getPage(cacheKey, version); ok {
+		if cached.fresh(version) {
+			l.Logger.Infof("Product list retrieved from cache: Total = %d", cached.Data.Total)
+			return cached.Data, nil
 		}
+
+		l.refreshPage(cacheKey, version, page, pageSize, in)
+		l.Logger.Infof("Stale product list retrieved from cache: Total = %d", cached.Data.Total)
+		return cached.Data, nil
 	}
 
-	// 3. Query products from database
-	products, total, err := l.svcCtx.ProductModel.List(l.ctx, page, pageSize, in.Category, in.SortBy, in.Order)
+	// 3. Nothing cached, concurrent misses of the same page share one query
+	val, err := l.svcCtx.CacheFlight.Do(cacheKey, func() (any, error) {
+		ctx, cancel := sharedLoadContext(l.ctx)
+		defer cancel()
+		return l.loadPage(ctx, cacheKey, version, page, pageSize, in)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	loaded := decodeListPage(val.(string))
+	if loaded == nil {
+		return nil, errorx.ErrSystem
+	}
+
+	return loaded.Data, nil
+}
+
+// getPage returns the cached page, fresh or not
+// A stale local copy is skipped, another instance may already have refreshed it in Redis
+func (l *ListProductsLogic) getPage(cacheKey string, version int64) (*listPage, bool) {
+	if data, ok := l.svcCtx.LocalCache.Get(cacheKey); ok {
+		if cached := decodeListPage(data); cached != nil && cached.fresh(version) {
+			return cached, true
+		}
+	}
+
+	data, err := l.svcCtx.Redis.GetCtx(l.ctx, cacheKey)
+	if err != nil || data == "" {
+		return nil, false
+	}
+
+	cached := decodeListPage(data)
+	if cached == nil {
+		return nil, false
+	}
+	l.svcCtx.LocalCache.Set(cacheKey, data)
+
+	return cached, true
+}
+
+// refreshPage rebuilds a stale page in background
+// The Redis lock makes sure only one request across all instances does it
+func (l *ListProductsLogic) refreshPage(cacheKey string, version int64, page, pageSize int32, in *product.ListProductsRequest) {
+	threading.GoSafe(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		lockKey := cacheKey + ":refresh"
+		locked, err := l.svcCtx.Redis.SetnxExCtx(ctx, lockKey, "1", 10)
+		if err != nil || !locked {
+			return
+		}
+		defer l.svcCtx.Redis.DelCtx(ctx, lockKey)
+
+		if _, err := l.loadPage(ctx, cacheKey, version, page, pageSize, in); err != nil {
+			l.Logger.Errorf("Failed to refresh product list: key=%s, err=%v", cacheKey, err)
+		}
+	})
+}
+
+// loadPage queries the page from database and caches it, returns the cached JSON
+func (l *ListProductsLogic) loadPage(ctx context.Context, cacheKey string, version int64, page, pageSize int32, in *product.ListProductsRequest) (string, error) {
+	// 1. Query products from database
+	products, total, err := l.svcCtx.ProductModel.List(ctx, page, pageSize, in.Category, in.SortBy, in.Order)
 	if err != nil {
 		l.Logger.Errorf("Failed to list products: %v", err)
-		return nil, errorx.ErrDatabase
+		return "", errorx.ErrDatabase
 	}
 
-	// 4. Build response
+	// 2. Build response
 	productList := make([]*product.ProductInfo, 0, len(products))
 	for _, p := range products {
 		productList = append(productList, &product.ProductInfo{
 			Id:          p.Id,
+			Sku:         p.Sku,
 			Name:        p.Name,
 			Description: p.Description,
 			Price:       p.Price,
@@ -74,22 +145,59 @@ func (l *ListProductsLogic) ListProducts(in *product.ListProductsRequest) (*prod
 			Images:      p.Images,
 			Attributes:  p.Attributes,
 			Sales:       p.Sales,
+			Status:      int32(p.Status),
+			PublishAt:   p.PublishAt,
 			CreatedAt:   p.CreatedAt,
 			UpdatedAt:   p.UpdatedAt,
+			Version:     p.Version,
+			MaxPerOrder: p.MaxPerOrder,
+			MaxPerUser:  p.MaxPerUser,
+			LimitWindow: p.LimitWindow,
 		})
 	}
 
-	result := &product.ListProductsResponse{
-		Total:    total,
-		Products: productList,
+	// 3. Cache the page, it stays in Redis ListStale seconds after it is no longer fresh
+	expire := jitterExpire(l.svcCtx, l.svcCtx.Config.Cache.ListExpire)
+	JsonResult, err := json.Marshal(&listPage{
+		Version:    version,
+		FreshUntil: time.Now().Unix() + int64(expire),
+		Data: &product.ListProductsResponse{
+			Total:    total,
+			Products: productList,
+		},
+	})
+	if err != nil {
+		l.Logger.Errorf("Failed to marshal product list: %v", err)
+		return "", errorx.ErrSystem
 	}
-	JsonResult, err := json.Marshal(&result)
-	if err == nil {
-		err := l.svcCtx.Redis.SetexCtx(l.ctx, cacheKey, string(JsonResult), l.svcCtx.Config.Cache.ListExpire)
-		if err != nil {
-			l.Logger.Errorf("Failed to cache product list info: %v", err)
-		}
+
+	err = l.svcCtx.Redis.SetexCtx(ctx, cacheKey, string(JsonResult), expire+l.svcCtx.Config.Cache.ListStale)
+	if err != nil {
+		l.Logger.Errorf("Failed to cache product list info: %v", err)
+	}
+	l.svcCtx.LocalCache.Set(cacheKey, string(JsonResult))
+
+	return string(JsonResult), nil
+}
+
+// listPage is a cached product list page with the category version it was built for
+type listPage struct {
+	Version    int64                         `json:"version"`
+	FreshUntil int64                         `json:"fresh_until"`
+	Data       *product.ListProductsResponse `json:"data"`
+}
+
+// fresh reports whether the page can be served without refreshing it
+func (p *listPage) fresh(version int64) bool {
+	return p.Version == version && time.Now().Unix() < p.FreshUntil
+}
+
+// decodeListPage decodes a cached page, nil if it is not valid
+func decodeListPage(data string) *listPage {
+	var cached listPage
+	if err := json.Unmarshal([]byte(data), &cached); err != nil || cached.Data == nil {
+		return nil
 	}
 
-	return result, nil
+	return &cached
 }
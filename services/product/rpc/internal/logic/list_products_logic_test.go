@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"testing"
+	"time"
+
+	"letsgo/services/product/rpc/product"
+)
+
+func TestListProductsServesStalePageWhileRefreshing(t *testing.T) {
+	pt := newProductTest(t)
+	pt.addProduct(1, "Pen", 5)
+	req := &product.ListProductsRequest{Page: 1, PageSize: 10, Category: "office"}
+
+	resp, err := NewListProductsLogic(pt.ctx, pt.svcCtx).ListProducts(req)
+	if err != nil || resp.Total != 1 {
+		t.Fatalf("total = %v, err = %v", resp, err)
+	}
+
+	// A second product makes the cached page outdated
+	pt.addProduct(2, "Ink", 3)
+	InvalidateProductCache(pt.ctx, &pt.svcCtx.Redis, 2, "office")
+
+	resp, err = NewListProductsLogic(pt.ctx, pt.svcCtx).ListProducts(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Total != 1 {
+		t.Fatalf("total = %d, want the stale page", resp.Total)
+	}
+
+	// The page is rebuilt in background
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		resp, err = NewListProductsLogic(pt.ctx, pt.svcCtx).ListProducts(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Total == 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("stale page never refreshed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if lists := pt.products.lists.Load(); lists != 2 {
+		t.Fatalf("database read %d times, want 2", lists)
+	}
+}
@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListWarehousesLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListWarehousesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListWarehousesLogic {
+	return &ListWarehousesLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// List warehouses, the default warehouse first (admin)
+func (l *ListWarehousesLogic) ListWarehouses(in *product.ListWarehousesRequest) (*product.ListWarehousesResponse, error) {
+	warehouses, err := l.svcCtx.WarehouseModel.List(l.ctx)
+	if err != nil {
+		l.Logger.Errorf("Failed to list warehouses: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	warehouseList := make([]*product.Warehouse, 0, len(warehouses))
+	for _, w := range warehouses {
+		warehouseList = append(warehouseList, &product.Warehouse{
+			Id:        w.Id,
+			Code:      w.Code,
+			Name:      w.Name,
+			Region:    w.Region,
+			Priority:  w.Priority,
+			CreatedAt: w.CreatedAt,
+			UpdatedAt: w.UpdatedAt,
+		})
+	}
+
+	return &product.ListWarehousesResponse{
+		Warehouses: warehouseList,
+	}, nil
+}
@@ -0,0 +1,103 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+// Product change event types, also the default topic names
+const (
+	EventProductCreated = "product.created"
+	EventProductUpdated = "product.updated"
+	EventPriceChanged   = "product.price.changed"
+	EventStockChanged   = "product.stock.changed"
+	EventProductDeleted = "product.deleted"
+)
+
+// Price change sources
+const (
+	PriceSourceUpdate   = "update"
+	PriceSourceSchedule = "schedule"
+)
+
+// PublishProductEvent publishes a product change event in background (异步，不影响主流程)
+// Failures are logged only, the database stays the source of truth
+func PublishProductEvent(svcCtx *svc.ServiceContext, topic string, eventType string, productId int64, data interface{}) {
+	event := utils.ProductEvent{
+		EventType: eventType,
+		EventID:   uuid.New().String(),
+		Timestamp: time.Now().Unix(),
+		Data:      data,
+	}
+
+	threading.GoSafe(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		err := svcCtx.KafkaProducer.PublishEvent(ctx, topic, strconv.FormatInt(productId, 10), event)
+		if err != nil {
+			logx.Errorf("Failed to publish %s event: product_id=%d, err=%v", eventType, productId, err)
+		}
+	})
+}
+
+// publishProductChanged publishes product.created or product.updated with the product after the change
+func publishProductChanged(svcCtx *svc.ServiceContext, eventType string, p *model.Product, operatorId int64) {
+	topic := svcCtx.Config.Kafka.Topics.ProductUpdated
+	if eventType == EventProductCreated {
+		topic = svcCtx.Config.Kafka.Topics.ProductCreated
+	}
+
+	PublishProductEvent(svcCtx, topic, eventType, p.Id, utils.ProductData{
+		ProductID:  p.Id,
+		Sku:        p.Sku,
+		Name:       p.Name,
+		Price:      p.Price,
+		Stock:      p.Stock,
+		Category:   p.Category,
+		Images:     p.Images,
+		Status:     p.Status,
+		OperatorID: operatorId,
+		UpdatedAt:  p.UpdatedAt,
+	})
+}
+
+// publishStockChanged publishes product.stock.changed
+func publishStockChanged(svcCtx *svc.ServiceContext, productId, quantity, stock int64, reason, referenceId string) {
+	PublishProductEvent(svcCtx, svcCtx.Config.Kafka.Topics.StockChanged, EventStockChanged, productId, utils.StockChangedData{
+		ProductID:   productId,
+		Quantity:    quantity,
+		Stock:       stock,
+		Reason:      reason,
+		ReferenceID: referenceId,
+	})
+}
+
+// publishProductDeleted publishes product.deleted
+func publishProductDeleted(svcCtx *svc.ServiceContext, productId int64, category string) {
+	PublishProductEvent(svcCtx, svcCtx.Config.Kafka.Topics.ProductDeleted, EventProductDeleted, productId, utils.ProductDeletedData{
+		ProductID: productId,
+		Category:  category,
+	})
+}
+
+// PublishPriceChanged publishes product.price.changed
+func PublishPriceChanged(svcCtx *svc.ServiceContext, productId int64, oldPrice, newPrice float64, source string, scheduleId, operatorId int64) {
+	PublishProductEvent(svcCtx, svcCtx.Config.Kafka.Topics.PriceChanged, EventPriceChanged, productId, utils.PriceChangedData{
+		ProductID:  productId,
+		OldPrice:   oldPrice,
+		NewPrice:   newPrice,
+		Source:     source,
+		ScheduleID: scheduleId,
+		OperatorID: operatorId,
+	})
+}
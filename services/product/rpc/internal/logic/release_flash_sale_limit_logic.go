@@ -0,0 +1,52 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReleaseFlashSaleLimitLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReleaseFlashSaleLimitLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReleaseFlashSaleLimitLogic {
+	return &ReleaseFlashSaleLimitLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Give back the per-user purchase count of a cancelled flash sale order
+func (l *ReleaseFlashSaleLimitLogic) ReleaseFlashSaleLimit(in *product.ReleaseFlashSaleLimitRequest) (*product.ReleaseFlashSaleLimitResponse, error) {
+	if in.SaleId <= 0 || in.UserId <= 0 || in.Quantity <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid flash sale release")
+	}
+
+	result, err := l.svcCtx.Redis.EvalCtx(l.ctx, flashSaleReleaseLimitScript,
+		[]string{flashSaleUsersKey(in.SaleId)},
+		in.UserId,
+		in.Quantity,
+	)
+	if err != nil {
+		l.Logger.Errorf("Failed to release flash sale limit: sale_id=%d, user_id=%d, quantity=%d, err=%v",
+			in.SaleId, in.UserId, in.Quantity, err)
+		return nil, errorx.ErrCache
+	}
+
+	released, _ := result.(int64)
+	if released == 0 {
+		l.Logger.Infof("Flash sale already closed, nothing to release: sale_id=%d, user_id=%d", in.SaleId, in.UserId)
+	}
+
+	return &product.ReleaseFlashSaleLimitResponse{
+		Success: released == 1,
+	}, nil
+}
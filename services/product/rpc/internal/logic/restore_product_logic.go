@@ -0,0 +1,67 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RestoreProductLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRestoreProductLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RestoreProductLogic {
+	return &RestoreProductLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Restore a soft deleted product as unpublished (admin)
+func (l *RestoreProductLogic) RestoreProduct(in *product.RestoreProductRequest) (*product.RestoreProductResponse, error) {
+	// 1. Validate product ID
+	if in.Id <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+
+	// 2. Get product (any status) to check it is deleted
+	existingProduct, err := l.svcCtx.ProductModel.FindOneAnyStatus(l.ctx, in.Id)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrProductNotFound
+		}
+		l.Logger.Errorf("Failed to get product: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	if existingProduct.Status != model.ProductStatusDeleted {
+		return nil, errorx.NewCodeError(3021, "Only deleted products can be restored")
+	}
+
+	// 3. Restore as unpublished, admin publishes it again with SetProductStatus
+	err = l.svcCtx.ProductModel.Restore(l.ctx, in.Id)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrProductNotFound
+		}
+		l.Logger.Errorf("Failed to restore product: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Product restored: product_id=%d", in.Id)
+
+	// 4. Keep cache data consistant (drop the cached "null" of the detail page).
+	InvalidateStockCache(l.ctx, l.svcCtx, []int64{in.Id}, existingProduct.Category)
+
+	return &product.RestoreProductResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,72 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SchedulePriceChangeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSchedulePriceChangeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SchedulePriceChangeLogic {
+	return &SchedulePriceChangeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Schedule a future price change, optionally reverted at end_at (admin)
+func (l *SchedulePriceChangeLogic) SchedulePriceChange(in *product.SchedulePriceChangeRequest) (*product.SchedulePriceChangeResponse, error) {
+	// 1. Validate input parameters
+	now := time.Now().Unix()
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+	if in.Price <= 0 {
+		return nil, errorx.NewCodeError(1001, "Price must be greater than 0")
+	}
+	if in.StartAt <= now {
+		return nil, errorx.NewCodeError(1001, "Start time must be in the future")
+	}
+	if in.EndAt != 0 && in.EndAt <= in.StartAt {
+		return nil, errorx.NewCodeError(1001, "End time must be after start time")
+	}
+
+	// 2. Create pending schedule, the price job applies it at start_at
+	scheduleId, err := l.svcCtx.PriceModel.InsertSchedule(l.ctx, &model.PriceSchedule{
+		ProductId:  in.ProductId,
+		Price:      in.Price,
+		StartAt:    in.StartAt,
+		EndAt:      in.EndAt,
+		OperatorId: in.OperatorId,
+		CreatedAt:  now,
+	})
+	if err != nil {
+		switch err {
+		case model.ErrNotFound:
+			return nil, errorx.ErrProductNotFound
+		case model.ErrScheduleOverlap:
+			return nil, errorx.ErrPriceScheduleOverlap
+		}
+		l.Logger.Errorf("Failed to insert price schedule: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Price change scheduled: schedule_id=%d, product_id=%d, price=%.2f, start_at=%d, end_at=%d, operator_id=%d",
+		scheduleId, in.ProductId, in.Price, in.StartAt, in.EndAt, in.OperatorId)
+
+	return &product.SchedulePriceChangeResponse{
+		ScheduleId: scheduleId,
+	}, nil
+}
@@ -71,6 +71,7 @@ func (l *SearchProductsLogic) SearchProducts(in *product.SearchProductsRequest)
 	for _, p := range products {
 		productList = append(productList, &product.ProductInfo{
 			Id:          p.Id,
+			Sku:         p.Sku,
 			Name:        p.Name,
 			Description: p.Description,
 			Price:       p.Price,
@@ -79,8 +80,14 @@ func (l *SearchProductsLogic) SearchProducts(in *product.SearchProductsRequest)
 			Images:      p.Images,
 			Attributes:  p.Attributes,
 			Sales:       p.Sales,
+			Status:      int32(p.Status),
+			PublishAt:   p.PublishAt,
 			CreatedAt:   p.CreatedAt,
 			UpdatedAt:   p.UpdatedAt,
+			Version:     p.Version,
+			MaxPerOrder: p.MaxPerOrder,
+			MaxPerUser:  p.MaxPerUser,
+			LimitWindow: p.LimitWindow,
 		})
 	}
 
@@ -93,7 +100,7 @@ func (l *SearchProductsLogic) SearchProducts(in *product.SearchProductsRequest)
 
 	JsonResult, err := json.Marshal(result)
 	if err == nil {
-		err := l.svcCtx.Redis.SetexCtx(l.ctx, cacheKey, string(JsonResult), l.svcCtx.Config.Cache.SearchExpire)
+		err := l.svcCtx.Redis.SetexCtx(l.ctx, cacheKey, string(JsonResult), jitterExpire(l.svcCtx, l.svcCtx.Config.Cache.SearchExpire))
 		if err != nil {
 			l.Logger.Errorf("Failed to cache product search info: %v", err)
 		}
@@ -0,0 +1,86 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetBundleItemsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetBundleItemsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetBundleItemsLogic {
+	return &SetBundleItemsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// maxBundleItems limits components per bundle
+const maxBundleItems = 20
+
+// Set the components of a bundle product, no components makes it a standalone product again (admin)
+func (l *SetBundleItemsLogic) SetBundleItems(in *product.SetBundleItemsRequest) (*product.SetBundleItemsResponse, error) {
+	// 1. Validate parameters
+	if in.BundleId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+	if len(in.Items) > maxBundleItems {
+		return nil, errorx.NewCodeError(1001, fmt.Sprintf("At most %d components per bundle", maxBundleItems))
+	}
+
+	items := make([]model.BundleItem, 0, len(in.Items))
+	seen := make(map[int64]bool, len(in.Items))
+	for _, item := range in.Items {
+		if item.ComponentId <= 0 {
+			return nil, errorx.NewCodeError(1001, "Invalid component ID")
+		}
+		if item.ComponentId == in.BundleId {
+			return nil, errorx.NewCodeError(1001, "A bundle cannot contain itself")
+		}
+		if seen[item.ComponentId] {
+			return nil, errorx.NewCodeError(1001, "Duplicate component")
+		}
+		if item.Quantity <= 0 {
+			return nil, errorx.NewCodeError(1001, "Component quantity must be positive")
+		}
+		seen[item.ComponentId] = true
+		items = append(items, model.BundleItem{
+			BundleId:    in.BundleId,
+			ComponentId: item.ComponentId,
+			Quantity:    item.Quantity,
+		})
+	}
+
+	// 2. Replace the components
+	err := l.svcCtx.BundleModel.SetItems(l.ctx, in.BundleId, items)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrProductNotFound
+		}
+		if err == model.ErrBundleHasStock {
+			return nil, errorx.NewCodeError(1001, "Clear the stock of the product before making it a bundle")
+		}
+		if err == model.ErrBundleNesting {
+			return nil, errorx.NewCodeError(1001, "Bundles cannot contain other bundles")
+		}
+		l.Logger.Errorf("Failed to set bundle items: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Bundle items set: bundle_id=%d, components=%d", in.BundleId, len(items))
+
+	return &product.SetBundleItemsResponse{
+		Success: true,
+	}, nil
+}
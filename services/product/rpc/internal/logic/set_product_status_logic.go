@@ -0,0 +1,85 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetProductStatusLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetProductStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetProductStatusLogic {
+	return &SetProductStatusLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Change product status: draft, published, unpublished, optionally scheduled (admin)
+func (l *SetProductStatusLogic) SetProductStatus(in *product.SetProductStatusRequest) (*product.SetProductStatusResponse, error) {
+	// 1. Validate parameters
+	if in.Id <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+
+	status := int64(in.Status)
+	switch status {
+	case model.ProductStatusPublished:
+		if in.PublishAt != 0 {
+			return nil, errorx.NewCodeError(1001, "Publish time can only be set for draft or unpublished products")
+		}
+	case model.ProductStatusUnpublished, model.ProductStatusDraft:
+		if in.PublishAt != 0 && in.PublishAt <= time.Now().Unix() {
+			return nil, errorx.NewCodeError(1001, "Publish time must be in the future")
+		}
+	case model.ProductStatusDeleted:
+		return nil, errorx.NewCodeError(1001, "Use DeleteProduct to delete a product")
+	default:
+		return nil, errorx.NewCodeError(1001, "Invalid product status")
+	}
+
+	// 2. Get product (any status) to know its category
+	existingProduct, err := l.svcCtx.ProductModel.FindOneAnyStatus(l.ctx, in.Id)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrProductNotFound
+		}
+		l.Logger.Errorf("Failed to get product: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	if existingProduct.Status == model.ProductStatusDeleted {
+		return nil, errorx.NewCodeError(3002, "Product is deleted, restore it first")
+	}
+
+	// 3. Update status
+	err = l.svcCtx.ProductModel.UpdateStatus(l.ctx, in.Id, status, in.PublishAt)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrProductNotFound
+		}
+		l.Logger.Errorf("Failed to update product status: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Product status updated: product_id=%d, status=%d -> %d, publish_at=%d",
+		in.Id, existingProduct.Status, status, in.PublishAt)
+
+	// 4. Keep cache data consistant.
+	InvalidateStockCache(l.ctx, l.svcCtx, []int64{in.Id}, existingProduct.Category)
+
+	return &product.SetProductStatusResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetPurchaseLimitsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetPurchaseLimitsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetPurchaseLimitsLogic {
+	return &SetPurchaseLimitsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Set the purchase limits of a product, enforced by cart and order (admin)
+func (l *SetPurchaseLimitsLogic) SetPurchaseLimits(in *product.SetPurchaseLimitsRequest) (*product.SetPurchaseLimitsResponse, error) {
+	// 1. Validate parameters
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+	if in.MaxPerOrder < 0 || in.MaxPerUser < 0 || in.LimitWindow < 0 {
+		return nil, errorx.NewCodeError(1001, "Purchase limits cannot be negative")
+	}
+	if in.LimitWindow > 0 && in.MaxPerUser == 0 {
+		return nil, errorx.NewCodeError(1001, "Limit window needs a per-user limit")
+	}
+
+	// 2. Get product (any status) to know its category
+	existingProduct, err := l.svcCtx.ProductModel.FindOneAnyStatus(l.ctx, in.ProductId)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrProductNotFound
+		}
+		l.Logger.Errorf("Failed to get product: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	// 3. Save limits
+	err = l.svcCtx.ProductModel.SetPurchaseLimits(l.ctx, in.ProductId, in.MaxPerOrder, in.MaxPerUser, in.LimitWindow)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrProductNotFound
+		}
+		l.Logger.Errorf("Failed to set purchase limits: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Purchase limits set: product_id=%d, max_per_order=%d, max_per_user=%d, limit_window=%d, operator_id=%d",
+		in.ProductId, in.MaxPerOrder, in.MaxPerUser, in.LimitWindow, in.OperatorId)
+
+	// 4. Cart and order read the limits from the product cache
+	InvalidateProductCache(l.ctx, &l.svcCtx.Redis, in.ProductId, existingProduct.Category)
+
+	return &product.SetPurchaseLimitsResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetStockThresholdLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetStockThresholdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetStockThresholdLogic {
+	return &SetStockThresholdLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Set the low-stock alert threshold of a product, 0 disables alerts (admin)
+func (l *SetStockThresholdLogic) SetStockThreshold(in *product.SetStockThresholdRequest) (*product.SetStockThresholdResponse, error) {
+	// 1. Validate parameters
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+	if in.Threshold < 0 {
+		return nil, errorx.NewCodeError(1001, "Threshold cannot be negative")
+	}
+
+	// 2. Save threshold, the alert is re-armed and evaluated by the stock alert job
+	err := l.svcCtx.StockAlertModel.SetThreshold(l.ctx, in.ProductId, in.Threshold)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrProductNotFound
+		}
+		l.Logger.Errorf("Failed to set stock threshold: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Stock threshold set: product_id=%d, threshold=%d", in.ProductId, in.Threshold)
+
+	return &product.SetStockThresholdResponse{
+		Success: true,
+	}, nil
+}
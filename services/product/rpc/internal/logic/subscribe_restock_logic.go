@@ -0,0 +1,65 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SubscribeRestockLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSubscribeRestockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubscribeRestockLogic {
+	return &SubscribeRestockLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Subscribe to a back-in-stock notification of an out-of-stock product
+func (l *SubscribeRestockLogic) SubscribeRestock(in *product.SubscribeRestockRequest) (*product.SubscribeRestockResponse, error) {
+	// 1. Validate parameters
+	if in.UserId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	}
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+
+	// 2. Only published products that are out of stock can be subscribed to
+	p, err := l.svcCtx.ProductModel.FindOne(l.ctx, in.ProductId)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrProductNotFound
+		}
+		l.Logger.Errorf("Failed to find product: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+	if p.Status != model.ProductStatusPublished {
+		return nil, errorx.ErrProductNotFound
+	}
+	if p.Stock > 0 {
+		return nil, errorx.ErrProductInStock
+	}
+
+	// 3. Save subscription, subscribing again is a no-op
+	err = l.svcCtx.StockAlertModel.Subscribe(l.ctx, in.ProductId, in.UserId, time.Now().Unix())
+	if err != nil {
+		l.Logger.Errorf("Failed to subscribe to restock: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	return &product.SubscribeRestockResponse{
+		Success: true,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TransferStockLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewTransferStockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TransferStockLogic {
+	return &TransferStockLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Move stock of a product between warehouses (admin)
+func (l *TransferStockLogic) TransferStock(in *product.TransferStockRequest) (*product.TransferStockResponse, error) {
+	// 1. Validate parameters
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+	if in.FromWarehouseId <= 0 || in.ToWarehouseId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid warehouse ID")
+	}
+	if in.FromWarehouseId == in.ToWarehouseId {
+		return nil, errorx.NewCodeError(1001, "Source and target warehouse must differ")
+	}
+	if in.Quantity <= 0 {
+		return nil, errorx.NewCodeError(1001, "Quantity must be positive")
+	}
+
+	// 2. Move stock, the product total does not change so caches stay valid
+	err := l.svcCtx.WarehouseModel.Transfer(l.ctx, in.ProductId, in.FromWarehouseId, in.ToWarehouseId, in.Quantity, model.StockChange{
+		Reason:      model.StockReasonTransfer,
+		ReferenceId: in.ReferenceId,
+		OperatorId:  in.OperatorId,
+	}, time.Now().Unix())
+	if err != nil {
+		switch err {
+		case model.ErrNotFound:
+			return nil, errorx.ErrProductNotFound
+		case model.ErrWarehouseNotFound:
+			return nil, errorx.ErrWarehouseNotFound
+		case model.ErrInsufficientStock:
+			return nil, errorx.ErrProductOutOfStock
+		}
+		l.Logger.Errorf("Failed to transfer stock: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Stock transferred: product_id=%d, from=%d, to=%d, quantity=%d, operator_id=%d",
+		in.ProductId, in.FromWarehouseId, in.ToWarehouseId, in.Quantity, in.OperatorId)
+
+	return &product.TransferStockResponse{
+		Success: true,
+	}, nil
+}
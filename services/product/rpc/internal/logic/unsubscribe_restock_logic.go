@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnsubscribeRestockLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnsubscribeRestockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnsubscribeRestockLogic {
+	return &UnsubscribeRestockLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Cancel a pending back-in-stock subscription
+func (l *UnsubscribeRestockLogic) UnsubscribeRestock(in *product.UnsubscribeRestockRequest) (*product.UnsubscribeRestockResponse, error) {
+	// 1. Validate parameters
+	if in.UserId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid user ID")
+	}
+	if in.ProductId <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid product ID")
+	}
+
+	// 2. Remove the pending subscription
+	err := l.svcCtx.StockAlertModel.Unsubscribe(l.ctx, in.ProductId, in.UserId)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.NewCodeError(1001, "Subscription not found")
+		}
+		l.Logger.Errorf("Failed to unsubscribe from restock: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	return &product.UnsubscribeRestockResponse{
+		Success: true,
+	}, nil
+}
@@ -2,7 +2,6 @@ package logic
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"letsgo/common/errorx"
@@ -33,9 +32,13 @@ func (l *UpdateProductLogic) UpdateProduct(in *product.UpdateProductRequest) (*p
 	if in.Id <= 0 {
 		return nil, errorx.NewCodeError(1001, "Invalid product ID")
 	}
+	if in.Version <= 0 {
+		return nil, errorx.NewCodeError(1001, "Product version is required")
+	}
 
 	// 2. Get existing product to check if it exists
-	existingProduct, err := l.svcCtx.ProductModel.FindOne(l.ctx, in.Id)
+	// Admins can edit drafts and unpublished products too, but not deleted ones
+	existingProduct, err := l.svcCtx.ProductModel.FindOneAnyStatus(l.ctx, in.Id)
 	if err != nil {
 		if err == model.ErrNotFound {
 			return nil, errorx.ErrProductNotFound
@@ -43,6 +46,12 @@ func (l *UpdateProductLogic) UpdateProduct(in *product.UpdateProductRequest) (*p
 		l.Logger.Errorf("Failed to get product: %v", err)
 		return nil, errorx.ErrDatabase
 	}
+	if existingProduct.Status == model.ProductStatusDeleted {
+		return nil, errorx.ErrProductNotFound
+	}
+	if existingProduct.Version != in.Version {
+		return nil, errorx.ErrProductVersionConflict
+	}
 
 	// 3. Update fields (only update non-empty/non-zero values)
 	// Note: stock and sales are managed by dedicated RPCs (UpdateStock, IncrementSales)
@@ -51,7 +60,7 @@ func (l *UpdateProductLogic) UpdateProduct(in *product.UpdateProductRequest) (*p
 		Name:        in.Name,
 		Description: in.Description,
 		Price:       in.Price,
-		Stock:       existingProduct.Stock, // Keep existing stock - use UpdateStock RPC instead
+		Stock:       existingProduct.Stock, // Not written by Update - use UpdateStock RPC instead
 		Category:    in.Category,
 		Images:      in.Images,
 		Attributes:  in.Attributes,
@@ -59,6 +68,7 @@ func (l *UpdateProductLogic) UpdateProduct(in *product.UpdateProductRequest) (*p
 		Status:      existingProduct.Status, // Keep existing status
 		CreatedAt:   existingProduct.CreatedAt,
 		UpdatedAt:   time.Now().Unix(),
+		Version:     in.Version,
 	}
 
 	// Use existing values if new values are empty
@@ -81,43 +91,39 @@ func (l *UpdateProductLogic) UpdateProduct(in *product.UpdateProductRequest) (*p
 		updatedProduct.Attributes = existingProduct.Attributes
 	}
 
-	// 4. Update product in database
-	err = l.svcCtx.ProductModel.Update(l.ctx, updatedProduct)
+	// 4. Update product in database (a price change is recorded in price history)
+	// The version is checked again under the row lock, another edit may have happened since it was read
+	version, err := l.svcCtx.ProductModel.Update(l.ctx, updatedProduct, in.OperatorId)
 	if err != nil {
 		if err == model.ErrNotFound {
 			return nil, errorx.ErrProductNotFound
 		}
+		if err == model.ErrVersionConflict {
+			return nil, errorx.ErrProductVersionConflict
+		}
 		l.Logger.Errorf("Failed to update product: %v", err)
 		return nil, errorx.ErrDatabase
 	}
 
-	l.Logger.Infof("Product updated successfully: product_id=%d", in.Id)
+	l.Logger.Infof("Product updated successfully: product_id=%d, version=%d", in.Id, version)
 
 	// 5. Keep cache data consistant.
-	cacheKey := fmt.Sprintf("product:detail:%d", in.Id)
-	_, err = l.svcCtx.Redis.DelCtx(l.ctx, cacheKey)
-	if err != nil {
-		l.Logger.Errorf("Delete product detail cache failed! err:%s", err)
-	}
-
-	err = IncCategoryVersion(l.ctx, existingProduct.Category, &l.svcCtx.Redis)
-	if err != nil {
-		l.Logger.Errorf("Increse category version failed! err:%s", err)
-	}
-
+	categories := []string{existingProduct.Category}
 	if in.Category != "" && in.Category != existingProduct.Category {
-		err = IncCategoryVersion(l.ctx, in.Category, &l.svcCtx.Redis)
-		if err != nil {
-			l.Logger.Errorf("Failed to increment new category version: %v", err)
-		}
+		categories = append(categories, in.Category)
 	}
-
-	err = IncGlobalVersion(l.ctx, &l.svcCtx.Redis)
-	if err != nil {
-		l.Logger.Errorf("Increse global version failed! err:%s", err)
+	InvalidateProductCache(l.ctx, &l.svcCtx.Redis, in.Id, categories...)
+
+	// 6. Tell downstream consumers
+	updatedProduct.Sku = existingProduct.Sku
+	updatedProduct.Version = version
+	publishProductChanged(l.svcCtx, EventProductUpdated, updatedProduct, in.OperatorId)
+	if updatedProduct.Price != existingProduct.Price {
+		PublishPriceChanged(l.svcCtx, in.Id, existingProduct.Price, updatedProduct.Price, PriceSourceUpdate, 0, in.OperatorId)
 	}
 
 	return &product.UpdateProductResponse{
 		Success: true,
+		Version: version,
 	}, nil
 }
@@ -0,0 +1,42 @@
+package logic
+
+import (
+	"testing"
+
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/product"
+)
+
+func TestUpdateProductVersion(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  int64
+		deleted  bool
+		conflict bool
+		code     int
+	}{
+		{name: "version required", version: 0, code: 1001},
+		{name: "stale version", version: 2, code: 3015},
+		{name: "changed after read", version: 3, conflict: true, code: 3015},
+		{name: "deleted product", version: 3, deleted: true, code: 3000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pt := newProductTest(t)
+			p := pt.addProduct(1, "Pen", 10)
+			p.Version = 3
+			if tt.deleted {
+				p.Status = model.ProductStatusDeleted
+			}
+			pt.products.conflict = tt.conflict
+
+			_, err := NewUpdateProductLogic(pt.ctx, pt.svcCtx).UpdateProduct(&product.UpdateProductRequest{
+				Id:      1,
+				Name:    "Blue pen",
+				Version: tt.version,
+			})
+			assertCode(t, err, tt.code)
+		})
+	}
+}
@@ -2,7 +2,6 @@ package logic
 
 import (
 	"context"
-	"fmt"
 
 	"letsgo/common/errorx"
 	"letsgo/services/product/model"
@@ -26,46 +25,66 @@ func NewUpdateStockLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Updat
 	}
 }
 
-// Update product stock (called by order service)
+// stockChangeReasons are the inventory movement reasons callers of UpdateStock and BatchUpdateStock may give,
+// the other reasons are only written by the product service itself
+var stockChangeReasons = map[string]bool{
+	model.StockReasonOrder:        true,
+	model.StockReasonCancel:       true,
+	model.StockReasonCompensation: true,
+	model.StockReasonAdminAdjust:  true,
+}
+
+// allocations are the warehouse allocation strategies a BatchUpdateStock caller may ask for
+var allocations = map[string]bool{
+	model.AllocationPriority: true,
+	model.AllocationNearest:  true,
+	model.AllocationSplit:    true,
+}
+
+// Update product stock (called by order service and admins)
 // quantity: positive = increase, negative = decrease
 func (l *UpdateStockLogic) UpdateStock(in *product.UpdateStockRequest) (*product.UpdateStockResponse, error) {
 	// 1. Validate parameters
 	if in.ProductId <= 0 {
 		return nil, errorx.NewCodeError(1001, "Invalid product ID")
 	}
+	if !stockChangeReasons[in.Reason] {
+		return nil, errorx.NewCodeError(1001, "Invalid stock change reason")
+	}
 
-	// 2. Update stock atomically
-	newStock, category, err := l.svcCtx.ProductModel.UpdateStock(l.ctx, in.ProductId, in.Quantity)
+	// 2. Update stock atomically and record the movement
+	newStock, category, err := l.svcCtx.ProductModel.UpdateStock(l.ctx, in.ProductId, in.Quantity, model.StockChange{
+		Reason:      in.Reason,
+		ReferenceId: in.ReferenceId,
+		OperatorId:  in.OperatorId,
+		WarehouseId: in.WarehouseId,
+		Allocation:  l.svcCtx.Config.Inventory.Allocation,
+	})
 	if err != nil {
 		if err == model.ErrNotFound {
 			return nil, errorx.ErrProductNotFound
 		}
+		if err == model.ErrWarehouseNotFound {
+			return nil, errorx.ErrWarehouseNotFound
+		}
 		if err == model.ErrInsufficientStock {
 			return nil, errorx.ErrProductOutOfStock
 		}
+		if err == model.ErrBundleStock {
+			return nil, errorx.ErrProductIsBundle
+		}
 		l.Logger.Errorf("Failed to update stock: %v", err)
 		return nil, errorx.ErrDatabase
 	}
 
-	l.Logger.Infof("Stock updated: product_id=%d, quantity=%d, new_stock=%d",
-		in.ProductId, in.Quantity, newStock)
+	l.Logger.Infof("Stock updated: product_id=%d, quantity=%d, new_stock=%d, reason=%s, reference_id=%s",
+		in.ProductId, in.Quantity, newStock, in.Reason, in.ReferenceId)
 
 	// 3. Keep cache data consistant.
-	cacheKey := fmt.Sprintf("product:detail:%d", in.ProductId)
-	_, err = l.svcCtx.Redis.DelCtx(l.ctx, cacheKey)
-	if err != nil {
-		l.Logger.Errorf("Delete product detail cache failed! err:%s", err)
-	}
+	InvalidateStockCache(l.ctx, l.svcCtx, []int64{in.ProductId}, category)
 
-	err = IncCategoryVersion(l.ctx, category, &l.svcCtx.Redis)
-	if err != nil {
-		l.Logger.Errorf("Increase category version failed! err:%s", err)
-	}
-
-	err = IncGlobalVersion(l.ctx, &l.svcCtx.Redis)
-	if err != nil {
-		l.Logger.Errorf("Increase global version failed! err:%s", err)
-	}
+	// 4. Tell downstream consumers
+	publishStockChanged(l.svcCtx, in.ProductId, in.Quantity, newStock, in.Reason, in.ReferenceId)
 
 	return &product.UpdateStockResponse{
 		Success:  true,
@@ -0,0 +1,76 @@
+package logic
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"letsgo/common/errorx"
+	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/svc"
+	"letsgo/services/product/rpc/product"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateWarehouseLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateWarehouseLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateWarehouseLogic {
+	return &UpdateWarehouseLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Update name, region and priority of a warehouse (admin)
+func (l *UpdateWarehouseLogic) UpdateWarehouse(in *product.UpdateWarehouseRequest) (*product.UpdateWarehouseResponse, error) {
+	// 1. Validate parameters
+	if in.Id <= 0 {
+		return nil, errorx.NewCodeError(1001, "Invalid warehouse ID")
+	}
+	if len(in.Name) > 100 {
+		return nil, errorx.NewCodeError(1001, "Warehouse name must be less than 100 characters")
+	}
+	if len(in.Region) > 64 {
+		return nil, errorx.NewCodeError(1001, "Warehouse region must be less than 64 characters")
+	}
+
+	// 2. Get existing warehouse
+	warehouse, err := l.svcCtx.WarehouseModel.FindOne(l.ctx, in.Id)
+	if err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrWarehouseNotFound
+		}
+		l.Logger.Errorf("Failed to get warehouse: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	// 3. Apply changes, priority is always set
+	if name := strings.TrimSpace(in.Name); name != "" {
+		warehouse.Name = name
+	}
+	if region := strings.TrimSpace(in.Region); region != "" {
+		warehouse.Region = region
+	}
+	warehouse.Priority = in.Priority
+	warehouse.UpdatedAt = time.Now().Unix()
+
+	if err := l.svcCtx.WarehouseModel.Update(l.ctx, warehouse); err != nil {
+		if err == model.ErrNotFound {
+			return nil, errorx.ErrWarehouseNotFound
+		}
+		l.Logger.Errorf("Failed to update warehouse: %v", err)
+		return nil, errorx.ErrDatabase
+	}
+
+	l.Logger.Infof("Warehouse updated: id=%d, region=%s, priority=%d", in.Id, warehouse.Region, warehouse.Priority)
+
+	return &product.UpdateWarehouseResponse{
+		Success: true,
+	}, nil
+}
@@ -41,6 +41,12 @@ func (s *ProductServer) GetProduct(ctx context.Context, in *product.GetProductRe
 	return l.GetProduct(in)
 }
 
+// Get multiple products at once, results follow the order of the requested IDs
+func (s *ProductServer) GetProducts(ctx context.Context, in *product.GetProductsRequest) (*product.GetProductsResponse, error) {
+	l := logic.NewGetProductsLogic(ctx, s.svcCtx)
+	return l.GetProducts(in)
+}
+
 // List products with pagination
 func (s *ProductServer) ListProducts(ctx context.Context, in *product.ListProductsRequest) (*product.ListProductsResponse, error) {
 	l := logic.NewListProductsLogic(ctx, s.svcCtx)
@@ -76,3 +82,177 @@ func (s *ProductServer) BatchUpdateStock(ctx context.Context, in *product.BatchU
 	l := logic.NewBatchUpdateStockLogic(ctx, s.svcCtx)
 	return l.BatchUpdateStock(in)
 }
+
+// Soft delete product (admin)
+func (s *ProductServer) DeleteProduct(ctx context.Context, in *product.DeleteProductRequest) (*product.DeleteProductResponse, error) {
+	l := logic.NewDeleteProductLogic(ctx, s.svcCtx)
+	return l.DeleteProduct(in)
+}
+
+// Restore a soft deleted product as unpublished (admin)
+func (s *ProductServer) RestoreProduct(ctx context.Context, in *product.RestoreProductRequest) (*product.RestoreProductResponse, error) {
+	l := logic.NewRestoreProductLogic(ctx, s.svcCtx)
+	return l.RestoreProduct(in)
+}
+
+// Change product status: draft, published, unpublished, optionally scheduled (admin)
+func (s *ProductServer) SetProductStatus(ctx context.Context, in *product.SetProductStatusRequest) (*product.SetProductStatusResponse, error) {
+	l := logic.NewSetProductStatusLogic(ctx, s.svcCtx)
+	return l.SetProductStatus(in)
+}
+
+// Bulk import products from CSV or JSON Lines, upsert by SKU (admin)
+func (s *ProductServer) ImportProducts(ctx context.Context, in *product.ImportProductsRequest) (*product.ImportProductsResponse, error) {
+	l := logic.NewImportProductsLogic(ctx, s.svcCtx)
+	return l.ImportProducts(in)
+}
+
+// Export product catalog as CSV or JSON Lines, streamed in chunks (admin)
+func (s *ProductServer) ExportProducts(in *product.ExportProductsRequest, stream product.Product_ExportProductsServer) error {
+	l := logic.NewExportProductsLogic(stream.Context(), s.svcCtx)
+	return l.ExportProducts(in, stream)
+}
+
+// Schedule a future price change, optionally reverted at end_at (admin)
+func (s *ProductServer) SchedulePriceChange(ctx context.Context, in *product.SchedulePriceChangeRequest) (*product.SchedulePriceChangeResponse, error) {
+	l := logic.NewSchedulePriceChangeLogic(ctx, s.svcCtx)
+	return l.SchedulePriceChange(in)
+}
+
+// Cancel a pending or active price schedule, active ones are reverted (admin)
+func (s *ProductServer) CancelPriceSchedule(ctx context.Context, in *product.CancelPriceScheduleRequest) (*product.CancelPriceScheduleResponse, error) {
+	l := logic.NewCancelPriceScheduleLogic(ctx, s.svcCtx)
+	return l.CancelPriceSchedule(in)
+}
+
+// List price schedules of a product (admin)
+func (s *ProductServer) ListPriceSchedules(ctx context.Context, in *product.ListPriceSchedulesRequest) (*product.ListPriceSchedulesResponse, error) {
+	l := logic.NewListPriceSchedulesLogic(ctx, s.svcCtx)
+	return l.ListPriceSchedules(in)
+}
+
+// Get price change history of a product (admin)
+func (s *ProductServer) GetPriceHistory(ctx context.Context, in *product.GetPriceHistoryRequest) (*product.GetPriceHistoryResponse, error) {
+	l := logic.NewGetPriceHistoryLogic(ctx, s.svcCtx)
+	return l.GetPriceHistory(in)
+}
+
+// Create a flash sale, its quantity is reserved from product stock (admin)
+func (s *ProductServer) CreateFlashSale(ctx context.Context, in *product.CreateFlashSaleRequest) (*product.CreateFlashSaleResponse, error) {
+	l := logic.NewCreateFlashSaleLogic(ctx, s.svcCtx)
+	return l.CreateFlashSale(in)
+}
+
+// Cancel an open flash sale, unsold quantity goes back to product stock (admin)
+func (s *ProductServer) CancelFlashSale(ctx context.Context, in *product.CancelFlashSaleRequest) (*product.CancelFlashSaleResponse, error) {
+	l := logic.NewCancelFlashSaleLogic(ctx, s.svcCtx)
+	return l.CancelFlashSale(in)
+}
+
+// List flash sales (admin)
+func (s *ProductServer) ListFlashSales(ctx context.Context, in *product.ListFlashSalesRequest) (*product.ListFlashSalesResponse, error) {
+	l := logic.NewListFlashSalesLogic(ctx, s.svcCtx)
+	return l.ListFlashSales(in)
+}
+
+// Get flash sale detail with remaining quantity
+func (s *ProductServer) GetFlashSale(ctx context.Context, in *product.GetFlashSaleRequest) (*product.GetFlashSaleResponse, error) {
+	l := logic.NewGetFlashSaleLogic(ctx, s.svcCtx)
+	return l.GetFlashSale(in)
+}
+
+// Buy from a flash sale, stock is deducted in Redis and the order is queued through Kafka
+func (s *ProductServer) FlashSaleBuy(ctx context.Context, in *product.FlashSaleBuyRequest) (*product.FlashSaleBuyResponse, error) {
+	l := logic.NewFlashSaleBuyLogic(ctx, s.svcCtx)
+	return l.FlashSaleBuy(in)
+}
+
+// Give back the per-user purchase count of a cancelled flash sale order
+func (s *ProductServer) ReleaseFlashSaleLimit(ctx context.Context, in *product.ReleaseFlashSaleLimitRequest) (*product.ReleaseFlashSaleLimitResponse, error) {
+	l := logic.NewReleaseFlashSaleLimitLogic(ctx, s.svcCtx)
+	return l.ReleaseFlashSaleLimit(in)
+}
+
+// List inventory movements of a product (admin)
+func (s *ProductServer) ListInventoryMovements(ctx context.Context, in *product.ListInventoryMovementsRequest) (*product.ListInventoryMovementsResponse, error) {
+	l := logic.NewListInventoryMovementsLogic(ctx, s.svcCtx)
+	return l.ListInventoryMovements(in)
+}
+
+// Recompute stock from inventory movements and report products that do not match (admin)
+func (s *ProductServer) CheckInventoryConsistency(ctx context.Context, in *product.CheckInventoryConsistencyRequest) (*product.CheckInventoryConsistencyResponse, error) {
+	l := logic.NewCheckInventoryConsistencyLogic(ctx, s.svcCtx)
+	return l.CheckInventoryConsistency(in)
+}
+
+// Create a warehouse (admin)
+func (s *ProductServer) CreateWarehouse(ctx context.Context, in *product.CreateWarehouseRequest) (*product.CreateWarehouseResponse, error) {
+	l := logic.NewCreateWarehouseLogic(ctx, s.svcCtx)
+	return l.CreateWarehouse(in)
+}
+
+// Update name, region and priority of a warehouse (admin)
+func (s *ProductServer) UpdateWarehouse(ctx context.Context, in *product.UpdateWarehouseRequest) (*product.UpdateWarehouseResponse, error) {
+	l := logic.NewUpdateWarehouseLogic(ctx, s.svcCtx)
+	return l.UpdateWarehouse(in)
+}
+
+// List warehouses, the default warehouse first (admin)
+func (s *ProductServer) ListWarehouses(ctx context.Context, in *product.ListWarehousesRequest) (*product.ListWarehousesResponse, error) {
+	l := logic.NewListWarehousesLogic(ctx, s.svcCtx)
+	return l.ListWarehouses(in)
+}
+
+// Move stock of a product between warehouses (admin)
+func (s *ProductServer) TransferStock(ctx context.Context, in *product.TransferStockRequest) (*product.TransferStockResponse, error) {
+	l := logic.NewTransferStockLogic(ctx, s.svcCtx)
+	return l.TransferStock(in)
+}
+
+// Set the low-stock alert threshold of a product, 0 disables alerts (admin)
+func (s *ProductServer) SetStockThreshold(ctx context.Context, in *product.SetStockThresholdRequest) (*product.SetStockThresholdResponse, error) {
+	l := logic.NewSetStockThresholdLogic(ctx, s.svcCtx)
+	return l.SetStockThreshold(in)
+}
+
+// Set the purchase limits of a product, enforced by cart and order (admin)
+func (s *ProductServer) SetPurchaseLimits(ctx context.Context, in *product.SetPurchaseLimitsRequest) (*product.SetPurchaseLimitsResponse, error) {
+	l := logic.NewSetPurchaseLimitsLogic(ctx, s.svcCtx)
+	return l.SetPurchaseLimits(in)
+}
+
+// List products at or below their low-stock threshold (admin)
+func (s *ProductServer) ListLowStockProducts(ctx context.Context, in *product.ListLowStockProductsRequest) (*product.ListLowStockProductsResponse, error) {
+	l := logic.NewListLowStockProductsLogic(ctx, s.svcCtx)
+	return l.ListLowStockProducts(in)
+}
+
+// Subscribe to a back-in-stock notification of an out-of-stock product
+func (s *ProductServer) SubscribeRestock(ctx context.Context, in *product.SubscribeRestockRequest) (*product.SubscribeRestockResponse, error) {
+	l := logic.NewSubscribeRestockLogic(ctx, s.svcCtx)
+	return l.SubscribeRestock(in)
+}
+
+// Cancel a pending back-in-stock subscription
+func (s *ProductServer) UnsubscribeRestock(ctx context.Context, in *product.UnsubscribeRestockRequest) (*product.UnsubscribeRestockResponse, error) {
+	l := logic.NewUnsubscribeRestockLogic(ctx, s.svcCtx)
+	return l.UnsubscribeRestock(in)
+}
+
+// Get products frequently bought together with a product, topped up with best sellers of its category
+func (s *ProductServer) GetRelatedProducts(ctx context.Context, in *product.GetRelatedProductsRequest) (*product.GetRelatedProductsResponse, error) {
+	l := logic.NewGetRelatedProductsLogic(ctx, s.svcCtx)
+	return l.GetRelatedProducts(in)
+}
+
+// Set the components of a bundle product, no components makes it a standalone product again (admin)
+func (s *ProductServer) SetBundleItems(ctx context.Context, in *product.SetBundleItemsRequest) (*product.SetBundleItemsResponse, error) {
+	l := logic.NewSetBundleItemsLogic(ctx, s.svcCtx)
+	return l.SetBundleItems(in)
+}
+
+// Get the components of a bundle product and how many bundles they can make
+func (s *ProductServer) GetBundle(ctx context.Context, in *product.GetBundleRequest) (*product.GetBundleResponse, error) {
+	l := logic.NewGetBundleLogic(ctx, s.svcCtx)
+	return l.GetBundle(in)
+}
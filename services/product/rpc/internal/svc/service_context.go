@@ -4,16 +4,34 @@ import (
 	"time"
 
 	"letsgo/services/product/model"
+	"letsgo/services/product/rpc/internal/cache"
 	"letsgo/services/product/rpc/internal/config"
+	"letsgo/services/product/rpc/internal/utils"
 
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/mathx"
 	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/core/stores/sqlx"
+	"github.com/zeromicro/go-zero/core/syncx"
 )
 
 type ServiceContext struct {
-	Config       config.Config
-	ProductModel model.ProductModel
-	Redis        redis.Redis
+	Config          config.Config
+	ProductModel    model.ProductModel
+	PriceModel      model.PriceModel
+	FlashSaleModel  model.FlashSaleModel
+	InventoryModel  model.InventoryModel
+	WarehouseModel  model.WarehouseModel
+	StockAlertModel model.StockAlertModel
+	RelatedModel    model.RelatedModel
+	BundleModel     model.BundleModel
+	Redis           redis.Redis
+	KafkaProducer   *utils.KafkaProducer
+
+	// Product read caching
+	LocalCache  *cache.LocalCache  // nil when disabled
+	CacheFlight syncx.SingleFlight // Coalesces concurrent loads of the same cache key
+	CacheExpiry mathx.Unstable     // Jitters cache expire times
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -30,9 +48,27 @@ func NewServiceContext(c config.Config) *ServiceContext {
 
 	rds := redis.MustNewRedis(c.RedisConf[0].RedisConf)
 
+	var localCache *cache.LocalCache
+	if c.Cache.Local.Enabled {
+		localCache, err = cache.NewLocalCache(c.RedisConf[0].RedisConf, c.Cache.Local.Size,
+			time.Duration(c.Cache.Local.Expire)*time.Second)
+		logx.Must(err)
+	}
+
 	return &ServiceContext{
-		Config:       c,
-		ProductModel: model.NewProductModel(conn),
-		Redis:        *rds,
+		Config:          c,
+		ProductModel:    model.NewProductModel(conn),
+		PriceModel:      model.NewPriceModel(conn),
+		FlashSaleModel:  model.NewFlashSaleModel(conn),
+		InventoryModel:  model.NewInventoryModel(conn),
+		WarehouseModel:  model.NewWarehouseModel(conn),
+		StockAlertModel: model.NewStockAlertModel(conn),
+		RelatedModel:    model.NewRelatedModel(conn),
+		BundleModel:     model.NewBundleModel(conn),
+		Redis:           *rds,
+		KafkaProducer:   utils.NewKafkaProducer(c.Kafka.Brokers),
+		LocalCache:      localCache,
+		CacheFlight:     syncx.NewSingleFlight(),
+		CacheExpiry:     mathx.NewUnstable(c.Cache.ExpireJitter),
 	}
 }
@@ -0,0 +1,243 @@
+package utils
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Supported catalog file formats
+const (
+	CatalogFormatCSV   = "csv"
+	CatalogFormatJSONL = "jsonl"
+)
+
+// catalogColumns is the CSV header, shared by import and export
+var catalogColumns = []string{"sku", "name", "description", "price", "stock", "category", "images", "attributes", "status"}
+
+// imageSeparator joins image URLs inside a single CSV cell
+const imageSeparator = "|"
+
+// CatalogRow is a single product row of an import/export file
+type CatalogRow struct {
+	Sku         string   `json:"sku"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Price       float64  `json:"price"`
+	Stock       int64    `json:"stock"`
+	Category    string   `json:"category"`
+	Images      []string `json:"images"`
+	Attributes  string   `json:"attributes"`
+	Status      int64    `json:"status"`
+}
+
+// ParsedRow is a decoded row with its 1-based position in the file (header excluded)
+type ParsedRow struct {
+	Row  int64
+	Data CatalogRow
+	Err  error // Decode error, Data is incomplete if set
+}
+
+// IsCatalogFormat reports whether the format is supported
+func IsCatalogFormat(format string) bool {
+	return format == CatalogFormatCSV || format == CatalogFormatJSONL
+}
+
+// DecodeCatalog decodes all rows of a CSV or JSON Lines file
+// A malformed row does not stop decoding, its error is returned in ParsedRow.Err
+func DecodeCatalog(format string, data []byte) ([]ParsedRow, error) {
+	switch format {
+	case CatalogFormatCSV:
+		return decodeCSV(data)
+	case CatalogFormatJSONL:
+		return decodeJSONL(data)
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+}
+
+func decodeCSV(data []byte) ([]ParsedRow, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1 // Checked per row to report row level errors
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read csv header: %w", err)
+	}
+
+	// Map column name -> index, columns may be in any order
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	for _, required := range []string{"sku", "name", "price", "category"} {
+		if _, ok := index[required]; !ok {
+			return nil, fmt.Errorf("csv header is missing column: %s", required)
+		}
+	}
+
+	var rows []ParsedRow
+	var rowNum int64
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		rowNum++
+		if err != nil {
+			// Malformed quoting etc. cannot be recovered reliably
+			rows = append(rows, ParsedRow{Row: rowNum, Err: err})
+			if _, ok := err.(*csv.ParseError); ok {
+				continue
+			}
+			break
+		}
+
+		rows = append(rows, parseCSVRecord(rowNum, record, index))
+	}
+
+	return rows, nil
+}
+
+func parseCSVRecord(rowNum int64, record []string, index map[string]int) ParsedRow {
+	get := func(column string) string {
+		i, ok := index[column]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	parsed := ParsedRow{Row: rowNum}
+	row := CatalogRow{
+		Sku:         get("sku"),
+		Name:        get("name"),
+		Description: get("description"),
+		Category:    get("category"),
+		Attributes:  get("attributes"),
+	}
+
+	var err error
+	if row.Price, err = strconv.ParseFloat(get("price"), 64); err != nil {
+		parsed.Err = fmt.Errorf("invalid price: %q", get("price"))
+	}
+	if v := get("stock"); v != "" {
+		if row.Stock, err = strconv.ParseInt(v, 10, 64); err != nil {
+			parsed.Err = fmt.Errorf("invalid stock: %q", v)
+		}
+	}
+	if v := get("status"); v != "" {
+		if row.Status, err = strconv.ParseInt(v, 10, 64); err != nil {
+			parsed.Err = fmt.Errorf("invalid status: %q", v)
+		}
+	}
+	if v := get("images"); v != "" {
+		for _, image := range strings.Split(v, imageSeparator) {
+			if image = strings.TrimSpace(image); image != "" {
+				row.Images = append(row.Images, image)
+			}
+		}
+	}
+
+	parsed.Data = row
+	return parsed
+}
+
+func decodeJSONL(data []byte) ([]ParsedRow, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(make([]byte, 64*1024), len(data)+1)
+
+	var rows []ParsedRow
+	var rowNum int64
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		rowNum++
+
+		parsed := ParsedRow{Row: rowNum}
+		if err := json.Unmarshal(line, &parsed.Data); err != nil {
+			parsed.Err = fmt.Errorf("invalid json: %v", err)
+		}
+		rows = append(rows, parsed)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// CatalogEncoder writes catalog rows in CSV or JSON Lines format
+type CatalogEncoder struct {
+	format string
+	buf    bytes.Buffer
+	csv    *csv.Writer
+}
+
+// NewCatalogEncoder creates an encoder, the CSV header is written immediately
+func NewCatalogEncoder(format string) (*CatalogEncoder, error) {
+	if !IsCatalogFormat(format) {
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+
+	e := &CatalogEncoder{format: format}
+	if format == CatalogFormatCSV {
+		e.csv = csv.NewWriter(&e.buf)
+		if err := e.csv.Write(catalogColumns); err != nil {
+			return nil, err
+		}
+	}
+
+	return e, nil
+}
+
+// Write encodes a single row into the internal buffer
+func (e *CatalogEncoder) Write(row CatalogRow) error {
+	if e.format == CatalogFormatJSONL {
+		if row.Images == nil {
+			row.Images = []string{}
+		}
+		data, err := json.Marshal(row)
+		if err != nil {
+			return err
+		}
+		e.buf.Write(data)
+		e.buf.WriteByte('\n')
+		return nil
+	}
+
+	return e.csv.Write([]string{
+		row.Sku,
+		row.Name,
+		row.Description,
+		strconv.FormatFloat(row.Price, 'f', 2, 64),
+		strconv.FormatInt(row.Stock, 10),
+		row.Category,
+		strings.Join(row.Images, imageSeparator),
+		row.Attributes,
+		strconv.FormatInt(row.Status, 10),
+	})
+}
+
+// Flush returns the encoded bytes since the last flush and resets the buffer
+func (e *CatalogEncoder) Flush() ([]byte, error) {
+	if e.csv != nil {
+		e.csv.Flush()
+		if err := e.csv.Error(); err != nil {
+			return nil, err
+		}
+	}
+
+	data := make([]byte, e.buf.Len())
+	copy(data, e.buf.Bytes())
+	e.buf.Reset()
+	return data, nil
+}
@@ -0,0 +1,185 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// KafkaProducer is a helper for publishing events to Kafka
+// Unlike the order and payment services it keeps one writer for its lifetime,
+// flash sale buys are too frequent to dial the brokers for every message
+type KafkaProducer struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaProducer creates a new Kafka producer
+func NewKafkaProducer(brokers []string) *KafkaProducer {
+	return &KafkaProducer{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Balancer:     &kafka.Hash{}, // Same key, same partition
+			RequiredAcks: kafka.RequireOne,
+			Async:        false, // Synchronous to ensure message is sent
+			MaxAttempts:  3,
+			WriteTimeout: 5 * time.Second,
+			BatchTimeout: 10 * time.Millisecond, // Concurrent writes are batched, don't wait the default 1s
+		},
+	}
+}
+
+// PublishEvent publishes an event to Kafka topic
+func (p *KafkaProducer) PublishEvent(ctx context.Context, topic string, key string, value interface{}) error {
+	// Marshal value to JSON
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+
+	// Write message
+	err = p.writer.WriteMessages(ctx, kafka.Message{
+		Topic: topic,
+		Key:   []byte(key),
+		Value: data,
+		Time:  time.Now(),
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to publish event to topic %s: %w", topic, err)
+	}
+
+	return nil
+}
+
+// Close flushes pending messages and closes the writer
+func (p *KafkaProducer) Close() error {
+	return p.writer.Close()
+}
+
+// FlashSaleOrderEvent asks the order service to create the order of a flash sale buy
+// Stock is already deducted, the consumer must not touch product stock
+type FlashSaleOrderEvent struct {
+	EventType string             `json:"event_type"`
+	EventID   string             `json:"event_id"`
+	Timestamp int64              `json:"timestamp"`
+	Data      FlashSaleOrderData `json:"data"`
+}
+
+// FlashSaleOrderData contains the order to create
+type FlashSaleOrderData struct {
+	OrderNo     string  `json:"order_no"`
+	SaleID      int64   `json:"sale_id"`
+	UserID      int64   `json:"user_id"`
+	ProductID   int64   `json:"product_id"`
+	Name        string  `json:"name"`
+	Image       string  `json:"image"`
+	Price       float64 `json:"price"`
+	Quantity    int64   `json:"quantity"`
+	TotalAmount float64 `json:"total_amount"`
+	Address     string  `json:"address"`
+	Phone       string  `json:"phone"`
+	Remark      string  `json:"remark"`
+}
+
+// StockLowEvent is published once when a product's stock drops to its low-stock threshold
+// It is published again only after stock went back above the threshold
+type StockLowEvent struct {
+	EventType string       `json:"event_type"`
+	EventID   string       `json:"event_id"`
+	Timestamp int64        `json:"timestamp"`
+	Data      StockLowData `json:"data"`
+}
+
+// StockLowData contains the product running low
+type StockLowData struct {
+	ProductID int64  `json:"product_id"`
+	Sku       string `json:"sku"`
+	Name      string `json:"name"`
+	Stock     int64  `json:"stock"`
+	Threshold int64  `json:"threshold"`
+}
+
+// BackInStockEvent is published when an out-of-stock product is restocked
+// A product with many subscribers is split into several events
+type BackInStockEvent struct {
+	EventType string          `json:"event_type"`
+	EventID   string          `json:"event_id"`
+	Timestamp int64           `json:"timestamp"`
+	Data      BackInStockData `json:"data"`
+}
+
+// BackInStockData contains the restocked product and the users to notify
+type BackInStockData struct {
+	ProductID int64   `json:"product_id"`
+	UserIDs   []int64 `json:"user_ids"`
+}
+
+// OrderCreatedEvent is published by the order service when an order is created
+// Only the fields used for recommendations are decoded
+type OrderCreatedEvent struct {
+	EventType string           `json:"event_type"`
+	EventID   string           `json:"event_id"`
+	Timestamp int64            `json:"timestamp"`
+	Data      OrderCreatedData `json:"data"`
+}
+
+// OrderCreatedData contains the created order
+type OrderCreatedData struct {
+	OrderID int64  `json:"order_id"`
+	OrderNo string `json:"order_no"`
+	Items   []struct {
+		ProductID int64 `json:"product_id"`
+	} `json:"items"`
+}
+
+// ProductEvent is the envelope of the product change events
+// (product.created, product.updated, product.price.changed, product.stock.changed, product.deleted),
+// keyed by product ID so the events of a product stay in order within a topic
+type ProductEvent struct {
+	EventType string      `json:"event_type"`
+	EventID   string      `json:"event_id"`
+	Timestamp int64       `json:"timestamp"`
+	Data      interface{} `json:"data"` // ProductData, PriceChangedData, StockChangedData or ProductDeletedData
+}
+
+// ProductData is the product after a product.created or product.updated change
+type ProductData struct {
+	ProductID  int64    `json:"product_id"`
+	Sku        string   `json:"sku"`
+	Name       string   `json:"name"`
+	Price      float64  `json:"price"`
+	Stock      int64    `json:"stock"`
+	Category   string   `json:"category"`
+	Images     []string `json:"images"`
+	Status     int64    `json:"status"`
+	OperatorID int64    `json:"operator_id"` // 0 = system
+	UpdatedAt  int64    `json:"updated_at"`
+}
+
+// PriceChangedData is a product.price.changed change
+type PriceChangedData struct {
+	ProductID  int64   `json:"product_id"`
+	OldPrice   float64 `json:"old_price"`
+	NewPrice   float64 `json:"new_price"`
+	Source     string  `json:"source"`      // update = admin edit, schedule = price schedule applied or reverted
+	ScheduleID int64   `json:"schedule_id"` // Price schedule, 0 for admin edits
+	OperatorID int64   `json:"operator_id"` // 0 = system
+}
+
+// StockChangedData is a product.stock.changed change
+type StockChangedData struct {
+	ProductID   int64  `json:"product_id"`
+	Quantity    int64  `json:"quantity"` // Positive = in, negative = out
+	Stock       int64  `json:"stock"`    // Stock after the change
+	Reason      string `json:"reason"`   // Inventory movement reason: order, cancel, compensation, admin_adjust
+	ReferenceID string `json:"reference_id"`
+}
+
+// ProductDeletedData is a product.deleted change (soft delete, the product can be restored)
+type ProductDeletedData struct {
+	ProductID int64  `json:"product_id"`
+	Category  string `json:"category"`
+}
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// Consumers of the product change events decode these payloads, field names must not change
+func TestProductEventJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data interface{}
+		want string
+	}{
+		{
+			name: "product updated",
+			data: ProductData{ProductID: 1, Sku: "PEN-1", Name: "Pen", Price: 2.5, Stock: 10, Category: "office", Images: []string{"a.jpg"}, Status: 1, OperatorID: 7, UpdatedAt: 100},
+			want: `{"product_id":1,"sku":"PEN-1","name":"Pen","price":2.5,"stock":10,"category":"office","images":["a.jpg"],"status":1,"operator_id":7,"updated_at":100}`,
+		},
+		{
+			name: "price changed",
+			data: PriceChangedData{ProductID: 1, OldPrice: 3, NewPrice: 2.5, Source: "schedule", ScheduleID: 5},
+			want: `{"product_id":1,"old_price":3,"new_price":2.5,"source":"schedule","schedule_id":5,"operator_id":0}`,
+		},
+		{
+			name: "stock changed",
+			data: StockChangedData{ProductID: 1, Quantity: -2, Stock: 8, Reason: "order", ReferenceID: "ORD1"},
+			want: `{"product_id":1,"quantity":-2,"stock":8,"reason":"order","reference_id":"ORD1"}`,
+		},
+		{
+			name: "product deleted",
+			data: ProductDeletedData{ProductID: 1, Category: "office"},
+			want: `{"product_id":1,"category":"office"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(ProductEvent{EventType: "product.updated", EventID: "e1", Timestamp: 100, Data: tt.data})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want := `{"event_type":"product.updated","event_id":"e1","timestamp":100,"data":` + tt.want + `}`
+			if string(data) != want {
+				t.Fatalf("event = %s\nwant    %s", data, want)
+			}
+		})
+	}
+}
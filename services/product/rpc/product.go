@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"letsgo/services/product/rpc/internal/config"
+	"letsgo/services/product/rpc/internal/consumer"
+	"letsgo/services/product/rpc/internal/job"
 	"letsgo/services/product/rpc/internal/server"
 	"letsgo/services/product/rpc/internal/svc"
 	"letsgo/services/product/rpc/product"
@@ -24,6 +26,8 @@ func main() {
 	var c config.Config
 	conf.MustLoad(*configFile, &c)
 	ctx := svc.NewServiceContext(c)
+	defer ctx.KafkaProducer.Close()
+	defer ctx.LocalCache.Close()
 
 	s := zrpc.MustNewServer(c.RpcServerConf, func(grpcServer *grpc.Server) {
 		product.RegisterProductServer(grpcServer, server.NewProductServer(ctx))
@@ -34,6 +38,31 @@ func main() {
 	})
 	defer s.Stop()
 
+	// Publish products whose scheduled publish time has passed
+	publishJob := job.NewPublishJob(ctx)
+	publishJob.Start()
+	defer publishJob.Stop()
+
+	// Apply and revert scheduled price changes
+	priceJob := job.NewPriceJob(ctx)
+	priceJob.Start()
+	defer priceJob.Stop()
+
+	// Close ended flash sales and return their unsold stock
+	flashSaleJob := job.NewFlashSaleJob(ctx)
+	flashSaleJob.Start()
+	defer flashSaleJob.Stop()
+
+	// Publish low-stock alerts and back-in-stock notifications
+	stockAlertJob := job.NewStockAlertJob(ctx)
+	stockAlertJob.Start()
+	defer stockAlertJob.Stop()
+
+	// Count products bought together from created orders
+	orderCreatedConsumer := consumer.NewOrderCreatedConsumer(ctx)
+	orderCreatedConsumer.Start()
+	defer orderCreatedConsumer.Stop()
+
 	fmt.Printf("Starting rpc server at %s...\n", c.ListenOn)
 	s.Start()
 }
@@ -28,8 +28,12 @@ type AddProductRequest struct {
 	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
 	Stock         int64                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
 	Category      string                 `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
-	Images        []string               `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`         // Array of image URLs
-	Attributes    string                 `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"` // JSON string of product attributes
+	Images        []string               `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`                             // Array of image URLs
+	Attributes    string                 `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`                     // JSON string of product attributes
+	Status        int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`                            // 0 = published (or draft if publish_at is set), 1:published, 2:unpublished, 4:draft
+	PublishAt     int64                  `protobuf:"varint,9,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`     // Unix timestamp to publish automatically (0 = not scheduled)
+	Sku           string                 `protobuf:"bytes,10,opt,name=sku,proto3" json:"sku,omitempty"`                                  // External SKU code (optional, unique)
+	OperatorId    int64                  `protobuf:"varint,11,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // Admin user ID, recorded in the inventory ledger
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -113,6 +117,34 @@ func (x *AddProductRequest) GetAttributes() string {
 	return ""
 }
 
+func (x *AddProductRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AddProductRequest) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+func (x *AddProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
 type AddProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
@@ -167,6 +199,8 @@ type UpdateProductRequest struct {
 	Category      string   `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
 	Images        []string `protobuf:"bytes,6,rep,name=images,proto3" json:"images,omitempty"`
 	Attributes    string   `protobuf:"bytes,7,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	OperatorId    int64    `protobuf:"varint,8,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // Admin user ID, recorded in price history
+	Version       int64    `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`                         // Version the edit is based on (from ProductInfo), stale versions are rejected
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -250,9 +284,24 @@ func (x *UpdateProductRequest) GetAttributes() string {
 	return ""
 }
 
+func (x *UpdateProductRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type UpdateProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // New version of the product
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -294,6 +343,13 @@ func (x *UpdateProductResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *UpdateProductResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type GetProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -382,6 +438,154 @@ func (x *GetProductResponse) GetProduct() *ProductInfo {
 	return nil
 }
 
+type GetProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"` // At most 100 IDs, duplicates allowed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductsRequest) Reset() {
+	*x = GetProductsRequest{}
+	mi := &file_product_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsRequest) ProtoMessage() {}
+
+func (x *GetProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetProductsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type GetProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*ProductResult       `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // One per requested ID, same order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductsResponse) Reset() {
+	*x = GetProductsResponse{}
+	mi := &file_product_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsResponse) ProtoMessage() {}
+
+func (x *GetProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsResponse.ProtoReflect.Descriptor instead.
+func (*GetProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetProductsResponse) GetResults() []*ProductResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ProductResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Found         bool                   `protobuf:"varint,2,opt,name=found,proto3" json:"found,omitempty"`    // false = product does not exist or is not published
+	Product       *ProductInfo           `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"` // Set only when found
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductResult) Reset() {
+	*x = ProductResult{}
+	mi := &file_product_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductResult) ProtoMessage() {}
+
+func (x *ProductResult) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductResult.ProtoReflect.Descriptor instead.
+func (*ProductResult) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ProductResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductResult) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *ProductResult) GetProduct() *ProductInfo {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
 type ListProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
@@ -395,7 +599,7 @@ type ListProductsRequest struct {
 
 func (x *ListProductsRequest) Reset() {
 	*x = ListProductsRequest{}
-	mi := &file_product_proto_msgTypes[6]
+	mi := &file_product_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -407,7 +611,7 @@ func (x *ListProductsRequest) String() string {
 func (*ListProductsRequest) ProtoMessage() {}
 
 func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[6]
+	mi := &file_product_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -420,7 +624,7 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
 func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{6}
+	return file_product_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListProductsRequest) GetPage() int32 {
@@ -468,7 +672,7 @@ type ListProductsResponse struct {
 
 func (x *ListProductsResponse) Reset() {
 	*x = ListProductsResponse{}
-	mi := &file_product_proto_msgTypes[7]
+	mi := &file_product_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -480,7 +684,7 @@ func (x *ListProductsResponse) String() string {
 func (*ListProductsResponse) ProtoMessage() {}
 
 func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[7]
+	mi := &file_product_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -493,7 +697,7 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
 func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{7}
+	return file_product_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListProductsResponse) GetTotal() int64 {
@@ -521,7 +725,7 @@ type SearchProductsRequest struct {
 
 func (x *SearchProductsRequest) Reset() {
 	*x = SearchProductsRequest{}
-	mi := &file_product_proto_msgTypes[8]
+	mi := &file_product_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -533,7 +737,7 @@ func (x *SearchProductsRequest) String() string {
 func (*SearchProductsRequest) ProtoMessage() {}
 
 func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[8]
+	mi := &file_product_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,7 +750,7 @@ func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
 func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{8}
+	return file_product_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *SearchProductsRequest) GetKeyword() string {
@@ -580,7 +784,7 @@ type SearchProductsResponse struct {
 
 func (x *SearchProductsResponse) Reset() {
 	*x = SearchProductsResponse{}
-	mi := &file_product_proto_msgTypes[9]
+	mi := &file_product_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -592,7 +796,7 @@ func (x *SearchProductsResponse) String() string {
 func (*SearchProductsResponse) ProtoMessage() {}
 
 func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[9]
+	mi := &file_product_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,7 +809,7 @@ func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
 func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{9}
+	return file_product_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SearchProductsResponse) GetTotal() int64 {
@@ -626,14 +830,18 @@ func (x *SearchProductsResponse) GetProducts() []*ProductInfo {
 type UpdateStockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // Positive = increase, Negative = decrease
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                          // Positive = increase, Negative = decrease
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                               // order, cancel, compensation, admin_adjust
+	ReferenceId   string                 `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`  // Order number, stocktake number... (optional)
+	OperatorId    int64                  `protobuf:"varint,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`    // Admin user ID (0 = system)
+	WarehouseId   int64                  `protobuf:"varint,6,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"` // 0 = allocated for deductions, default warehouse for additions
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateStockRequest) Reset() {
 	*x = UpdateStockRequest{}
-	mi := &file_product_proto_msgTypes[10]
+	mi := &file_product_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -645,7 +853,7 @@ func (x *UpdateStockRequest) String() string {
 func (*UpdateStockRequest) ProtoMessage() {}
 
 func (x *UpdateStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[10]
+	mi := &file_product_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -658,7 +866,7 @@ func (x *UpdateStockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStockRequest.ProtoReflect.Descriptor instead.
 func (*UpdateStockRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{10}
+	return file_product_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *UpdateStockRequest) GetProductId() int64 {
@@ -675,6 +883,34 @@ func (x *UpdateStockRequest) GetQuantity() int64 {
 	return 0
 }
 
+func (x *UpdateStockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateStockRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *UpdateStockRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *UpdateStockRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
 type UpdateStockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -685,7 +921,7 @@ type UpdateStockResponse struct {
 
 func (x *UpdateStockResponse) Reset() {
 	*x = UpdateStockResponse{}
-	mi := &file_product_proto_msgTypes[11]
+	mi := &file_product_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -697,7 +933,7 @@ func (x *UpdateStockResponse) String() string {
 func (*UpdateStockResponse) ProtoMessage() {}
 
 func (x *UpdateStockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[11]
+	mi := &file_product_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,7 +946,7 @@ func (x *UpdateStockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateStockResponse.ProtoReflect.Descriptor instead.
 func (*UpdateStockResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{11}
+	return file_product_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *UpdateStockResponse) GetSuccess() bool {
@@ -737,7 +973,7 @@ type CheckStockRequest struct {
 
 func (x *CheckStockRequest) Reset() {
 	*x = CheckStockRequest{}
-	mi := &file_product_proto_msgTypes[12]
+	mi := &file_product_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -749,7 +985,7 @@ func (x *CheckStockRequest) String() string {
 func (*CheckStockRequest) ProtoMessage() {}
 
 func (x *CheckStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[12]
+	mi := &file_product_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -762,7 +998,7 @@ func (x *CheckStockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckStockRequest.ProtoReflect.Descriptor instead.
 func (*CheckStockRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{12}
+	return file_product_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *CheckStockRequest) GetItems() []*StockItem {
@@ -782,7 +1018,7 @@ type CheckStockResponse struct {
 
 func (x *CheckStockResponse) Reset() {
 	*x = CheckStockResponse{}
-	mi := &file_product_proto_msgTypes[13]
+	mi := &file_product_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -794,7 +1030,7 @@ func (x *CheckStockResponse) String() string {
 func (*CheckStockResponse) ProtoMessage() {}
 
 func (x *CheckStockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[13]
+	mi := &file_product_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -807,7 +1043,7 @@ func (x *CheckStockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckStockResponse.ProtoReflect.Descriptor instead.
 func (*CheckStockResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{13}
+	return file_product_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CheckStockResponse) GetAvailable() bool {
@@ -828,14 +1064,15 @@ type StockItem struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	ProductId        int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	RequiredQuantity int64                  `protobuf:"varint,2,opt,name=required_quantity,json=requiredQuantity,proto3" json:"required_quantity,omitempty"` // How many needed
-	AvailableStock   int64                  `protobuf:"varint,3,opt,name=available_stock,json=availableStock,proto3" json:"available_stock,omitempty"`       // How many in stock
+	AvailableStock   int64                  `protobuf:"varint,3,opt,name=available_stock,json=availableStock,proto3" json:"available_stock,omitempty"`       // How many in stock, for a bundle how many its components can make
+	Warehouses       []*WarehouseStock      `protobuf:"bytes,4,rep,name=warehouses,proto3" json:"warehouses,omitempty"`                                      // Stock per warehouse (response only)
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
 func (x *StockItem) Reset() {
 	*x = StockItem{}
-	mi := &file_product_proto_msgTypes[14]
+	mi := &file_product_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -847,7 +1084,7 @@ func (x *StockItem) String() string {
 func (*StockItem) ProtoMessage() {}
 
 func (x *StockItem) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[14]
+	mi := &file_product_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -860,7 +1097,7 @@ func (x *StockItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StockItem.ProtoReflect.Descriptor instead.
 func (*StockItem) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{14}
+	return file_product_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *StockItem) GetProductId() int64 {
@@ -884,30 +1121,38 @@ func (x *StockItem) GetAvailableStock() int64 {
 	return 0
 }
 
-// Increment sales count (after order completion)
-type IncrementSalesRequest struct {
+func (x *StockItem) GetWarehouses() []*WarehouseStock {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+type WarehouseStock struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // Sales quantity to add
+	WarehouseId   int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	WarehouseCode string                 `protobuf:"bytes,2,opt,name=warehouse_code,json=warehouseCode,proto3" json:"warehouse_code,omitempty"`
+	WarehouseName string                 `protobuf:"bytes,3,opt,name=warehouse_name,json=warehouseName,proto3" json:"warehouse_name,omitempty"`
+	Stock         int64                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IncrementSalesRequest) Reset() {
-	*x = IncrementSalesRequest{}
-	mi := &file_product_proto_msgTypes[15]
+func (x *WarehouseStock) Reset() {
+	*x = WarehouseStock{}
+	mi := &file_product_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IncrementSalesRequest) String() string {
+func (x *WarehouseStock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncrementSalesRequest) ProtoMessage() {}
+func (*WarehouseStock) ProtoMessage() {}
 
-func (x *IncrementSalesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[15]
+func (x *WarehouseStock) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -918,48 +1163,4147 @@ func (x *IncrementSalesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncrementSalesRequest.ProtoReflect.Descriptor instead.
-func (*IncrementSalesRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use WarehouseStock.ProtoReflect.Descriptor instead.
+func (*WarehouseStock) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *IncrementSalesRequest) GetProductId() int64 {
+func (x *WarehouseStock) GetWarehouseId() int64 {
 	if x != nil {
-		return x.ProductId
+		return x.WarehouseId
 	}
 	return 0
 }
 
-func (x *IncrementSalesRequest) GetQuantity() int64 {
+func (x *WarehouseStock) GetWarehouseCode() string {
 	if x != nil {
-		return x.Quantity
+		return x.WarehouseCode
 	}
-	return 0
+	return ""
 }
 
-type IncrementSalesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	NewSales      int64                  `protobuf:"varint,2,opt,name=new_sales,json=newSales,proto3" json:"new_sales,omitempty"` // Sales count after update
+func (x *WarehouseStock) GetWarehouseName() string {
+	if x != nil {
+		return x.WarehouseName
+	}
+	return ""
+}
+
+func (x *WarehouseStock) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+// Increment sales count (after order completion)
+type IncrementSalesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // Sales quantity to add
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrementSalesRequest) Reset() {
+	*x = IncrementSalesRequest{}
+	mi := &file_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrementSalesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementSalesRequest) ProtoMessage() {}
+
+func (x *IncrementSalesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementSalesRequest.ProtoReflect.Descriptor instead.
+func (*IncrementSalesRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *IncrementSalesRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *IncrementSalesRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type IncrementSalesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	NewSales      int64                  `protobuf:"varint,2,opt,name=new_sales,json=newSales,proto3" json:"new_sales,omitempty"` // Sales count after update
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IncrementSalesResponse) Reset() {
+	*x = IncrementSalesResponse{}
+	mi := &file_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IncrementSalesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IncrementSalesResponse) ProtoMessage() {}
+
+func (x *IncrementSalesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IncrementSalesResponse.ProtoReflect.Descriptor instead.
+func (*IncrementSalesResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *IncrementSalesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *IncrementSalesResponse) GetNewSales() int64 {
+	if x != nil {
+		return x.NewSales
+	}
+	return 0
+}
+
+// Batch update stock (transactional)
+type BatchUpdateStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*StockUpdateItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`                              // order, cancel, compensation, admin_adjust
+	ReferenceId   string                 `protobuf:"bytes,3,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"` // Order number, stocktake number... (optional)
+	OperatorId    int64                  `protobuf:"varint,4,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`   // Admin user ID (0 = system)
+	Allocation    string                 `protobuf:"bytes,5,opt,name=allocation,proto3" json:"allocation,omitempty"`                      // Warehouse allocation of deductions: priority, nearest, split (empty = service default)
+	Region        string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`                              // Customer region, used by nearest allocation
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateStockRequest) Reset() {
+	*x = BatchUpdateStockRequest{}
+	mi := &file_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateStockRequest) ProtoMessage() {}
+
+func (x *BatchUpdateStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateStockRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateStockRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BatchUpdateStockRequest) GetItems() []*StockUpdateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchUpdateStockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BatchUpdateStockRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *BatchUpdateStockRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *BatchUpdateStockRequest) GetAllocation() string {
+	if x != nil {
+		return x.Allocation
+	}
+	return ""
+}
+
+func (x *BatchUpdateStockRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+type BatchUpdateStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Results       []*StockUpdateResult   `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchUpdateStockResponse) Reset() {
+	*x = BatchUpdateStockResponse{}
+	mi := &file_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchUpdateStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateStockResponse) ProtoMessage() {}
+
+func (x *BatchUpdateStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateStockResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateStockResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BatchUpdateStockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchUpdateStockResponse) GetResults() []*StockUpdateResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type StockUpdateItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // Positive = increase, Negative = decrease
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockUpdateItem) Reset() {
+	*x = StockUpdateItem{}
+	mi := &file_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockUpdateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockUpdateItem) ProtoMessage() {}
+
+func (x *StockUpdateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockUpdateItem.ProtoReflect.Descriptor instead.
+func (*StockUpdateItem) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StockUpdateItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockUpdateItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type StockUpdateResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	NewStock      int64                  `protobuf:"varint,2,opt,name=new_stock,json=newStock,proto3" json:"new_stock,omitempty"` // Stock after update, for a bundle how many its components can still make
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockUpdateResult) Reset() {
+	*x = StockUpdateResult{}
+	mi := &file_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockUpdateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockUpdateResult) ProtoMessage() {}
+
+func (x *StockUpdateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockUpdateResult.ProtoReflect.Descriptor instead.
+func (*StockUpdateResult) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StockUpdateResult) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockUpdateResult) GetNewStock() int64 {
+	if x != nil {
+		return x.NewStock
+	}
+	return 0
+}
+
+// Soft delete product (status -> deleted)
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteProductRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteProductResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Restore soft deleted product (status -> unpublished)
+type RestoreProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductRequest) Reset() {
+	*x = RestoreProductRequest{}
+	mi := &file_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductRequest) ProtoMessage() {}
+
+func (x *RestoreProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProductRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RestoreProductRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductResponse) Reset() {
+	*x = RestoreProductResponse{}
+	mi := &file_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductResponse) ProtoMessage() {}
+
+func (x *RestoreProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductResponse.ProtoReflect.Descriptor instead.
+func (*RestoreProductResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *RestoreProductResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Change product status
+type SetProductStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                        // 1:published, 2:unpublished, 4:draft (use DeleteProduct to delete)
+	PublishAt     int64                  `protobuf:"varint,3,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // Only for draft/unpublished: publish automatically at this Unix timestamp (0 = not scheduled)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductStatusRequest) Reset() {
+	*x = SetProductStatusRequest{}
+	mi := &file_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductStatusRequest) ProtoMessage() {}
+
+func (x *SetProductStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetProductStatusRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetProductStatusRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetProductStatusRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *SetProductStatusRequest) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+type SetProductStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductStatusResponse) Reset() {
+	*x = SetProductStatusResponse{}
+	mi := &file_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductStatusResponse) ProtoMessage() {}
+
+func (x *SetProductStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetProductStatusResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetProductStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Bulk import (upsert by SKU)
+type ImportProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`                            // csv, jsonl
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`                                // File content
+	OperatorId    int64                  `protobuf:"varint,3,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // Admin user ID, recorded in price history
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsRequest) Reset() {
+	*x = ImportProductsRequest{}
+	mi := &file_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsRequest) ProtoMessage() {}
+
+func (x *ImportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ImportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ImportProductsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportProductsRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportProductsRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type ImportProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`     // Rows read from the file
+	Created       int64                  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"` // New products
+	Updated       int64                  `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"` // Existing products updated by SKU
+	Failed        int64                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`   // Rows rejected
+	Errors        []*ImportRowError      `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsResponse) Reset() {
+	*x = ImportProductsResponse{}
+	mi := &file_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsResponse) ProtoMessage() {}
+
+func (x *ImportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsResponse.ProtoReflect.Descriptor instead.
+func (*ImportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImportProductsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int64                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // 1-based data row number (header excluded)
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImportRowError) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Catalog export
+type ExportProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // csv, jsonl
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsRequest) Reset() {
+	*x = ExportProductsRequest{}
+	mi := &file_product_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsRequest) ProtoMessage() {}
+
+func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ExportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExportProductsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportProductsChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"` // Next part of the file
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsChunk) Reset() {
+	*x = ExportProductsChunk{}
+	mi := &file_product_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsChunk) ProtoMessage() {}
+
+func (x *ExportProductsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsChunk.ProtoReflect.Descriptor instead.
+func (*ExportProductsChunk) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExportProductsChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Schedule a price change
+type SchedulePriceChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`                            // Price applied at start_at
+	StartAt       int64                  `protobuf:"varint,3,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`          // Unix timestamp, must be in the future
+	EndAt         int64                  `protobuf:"varint,4,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`                // Unix timestamp to revert the price (0 = permanent change)
+	OperatorId    int64                  `protobuf:"varint,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // Admin user ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeRequest) Reset() {
+	*x = SchedulePriceChangeRequest{}
+	mi := &file_product_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeRequest) ProtoMessage() {}
+
+func (x *SchedulePriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SchedulePriceChangeRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type SchedulePriceChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    int64                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeResponse) Reset() {
+	*x = SchedulePriceChangeResponse{}
+	mi := &file_product_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeResponse) ProtoMessage() {}
+
+func (x *SchedulePriceChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SchedulePriceChangeResponse) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+type CancelPriceScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    int64                  `protobuf:"varint,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // Admin user ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPriceScheduleRequest) Reset() {
+	*x = CancelPriceScheduleRequest{}
+	mi := &file_product_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPriceScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPriceScheduleRequest) ProtoMessage() {}
+
+func (x *CancelPriceScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPriceScheduleRequest.ProtoReflect.Descriptor instead.
+func (*CancelPriceScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CancelPriceScheduleRequest) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *CancelPriceScheduleRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type CancelPriceScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPriceScheduleResponse) Reset() {
+	*x = CancelPriceScheduleResponse{}
+	mi := &file_product_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPriceScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPriceScheduleResponse) ProtoMessage() {}
+
+func (x *CancelPriceScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPriceScheduleResponse.ProtoReflect.Descriptor instead.
+func (*CancelPriceScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CancelPriceScheduleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListPriceSchedulesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	IncludeFinished bool                   `protobuf:"varint,2,opt,name=include_finished,json=includeFinished,proto3" json:"include_finished,omitempty"` // Also return finished and cancelled schedules
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPriceSchedulesRequest) Reset() {
+	*x = ListPriceSchedulesRequest{}
+	mi := &file_product_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceSchedulesRequest) ProtoMessage() {}
+
+func (x *ListPriceSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListPriceSchedulesRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListPriceSchedulesRequest) GetIncludeFinished() bool {
+	if x != nil {
+		return x.IncludeFinished
+	}
+	return false
+}
+
+type ListPriceSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*PriceSchedule       `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceSchedulesResponse) Reset() {
+	*x = ListPriceSchedulesResponse{}
+	mi := &file_product_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceSchedulesResponse) ProtoMessage() {}
+
+func (x *ListPriceSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListPriceSchedulesResponse) GetSchedules() []*PriceSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type PriceSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	OriginalPrice float64                `protobuf:"fixed64,4,opt,name=original_price,json=originalPrice,proto3" json:"original_price,omitempty"` // Price before the schedule started (0 = not started)
+	StartAt       int64                  `protobuf:"varint,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         int64                  `protobuf:"varint,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"` // 0 = permanent change
+	Status        int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`            // 1:pending, 2:active, 3:finished, 4:cancelled
+	OperatorId    int64                  `protobuf:"varint,8,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceSchedule) Reset() {
+	*x = PriceSchedule{}
+	mi := &file_product_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceSchedule) ProtoMessage() {}
+
+func (x *PriceSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceSchedule.ProtoReflect.Descriptor instead.
+func (*PriceSchedule) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PriceSchedule) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetOriginalPrice() float64 {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PriceSchedule) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetPriceHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryRequest) Reset() {
+	*x = GetPriceHistoryRequest{}
+	mi := &file_product_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryRequest) ProtoMessage() {}
+
+func (x *GetPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetPriceHistoryRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *GetPriceHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPriceHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetPriceHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	History       []*PriceHistory        `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceHistoryResponse) Reset() {
+	*x = GetPriceHistoryResponse{}
+	mi := &file_product_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceHistoryResponse) ProtoMessage() {}
+
+func (x *GetPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetPriceHistoryResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetPriceHistoryResponse) GetHistory() []*PriceHistory {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type PriceHistory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	OldPrice      float64                `protobuf:"fixed64,3,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`
+	NewPrice      float64                `protobuf:"fixed64,4,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 0 = system
+	Source        string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`                            // manual, import, schedule_start, schedule_end, schedule_cancel
+	ScheduleId    int64                  `protobuf:"varint,7,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"` // Related price schedule (0 = none)
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceHistory) Reset() {
+	*x = PriceHistory{}
+	mi := &file_product_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistory) ProtoMessage() {}
+
+func (x *PriceHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceHistory.ProtoReflect.Descriptor instead.
+func (*PriceHistory) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PriceHistory) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetOldPrice() float64 {
+	if x != nil {
+		return x.OldPrice
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetNewPrice() float64 {
+	if x != nil {
+		return x.NewPrice
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PriceHistory) GetScheduleId() int64 {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return 0
+}
+
+func (x *PriceHistory) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// Product information model
+type ProductInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Stock         int64                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
+	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	Images        []string               `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
+	Attributes    string                 `protobuf:"bytes,8,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Sales         int64                  `protobuf:"varint,9,opt,name=sales,proto3" json:"sales,omitempty"` // Total sales count
+	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status        int32                  `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`                                // 1:published, 2:unpublished, 3:deleted, 4:draft
+	PublishAt     int64                  `protobuf:"varint,13,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`         // Scheduled publish time (0 = not scheduled)
+	Sku           string                 `protobuf:"bytes,14,opt,name=sku,proto3" json:"sku,omitempty"`                                       // External SKU code
+	Version       int64                  `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`                              // Pass to UpdateProduct, changes with every edit
+	MaxPerOrder   int64                  `protobuf:"varint,16,opt,name=max_per_order,json=maxPerOrder,proto3" json:"max_per_order,omitempty"` // Max units in one order (0 = unlimited)
+	MaxPerUser    int64                  `protobuf:"varint,17,opt,name=max_per_user,json=maxPerUser,proto3" json:"max_per_user,omitempty"`    // Max units one user can order within limit_window (0 = unlimited)
+	LimitWindow   int64                  `protobuf:"varint,18,opt,name=limit_window,json=limitWindow,proto3" json:"limit_window,omitempty"`   // Seconds counted for max_per_user (0 = all time)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductInfo) Reset() {
+	*x = ProductInfo{}
+	mi := &file_product_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductInfo) ProtoMessage() {}
+
+func (x *ProductInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductInfo.ProtoReflect.Descriptor instead.
+func (*ProductInfo) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ProductInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ProductInfo) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductInfo) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *ProductInfo) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ProductInfo) GetImages() []string {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *ProductInfo) GetAttributes() string {
+	if x != nil {
+		return x.Attributes
+	}
+	return ""
+}
+
+func (x *ProductInfo) GetSales() int64 {
+	if x != nil {
+		return x.Sales
+	}
+	return 0
+}
+
+func (x *ProductInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ProductInfo) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *ProductInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ProductInfo) GetPublishAt() int64 {
+	if x != nil {
+		return x.PublishAt
+	}
+	return 0
+}
+
+func (x *ProductInfo) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ProductInfo) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ProductInfo) GetMaxPerOrder() int64 {
+	if x != nil {
+		return x.MaxPerOrder
+	}
+	return 0
+}
+
+func (x *ProductInfo) GetMaxPerUser() int64 {
+	if x != nil {
+		return x.MaxPerUser
+	}
+	return 0
+}
+
+func (x *ProductInfo) GetLimitWindow() int64 {
+	if x != nil {
+		return x.LimitWindow
+	}
+	return 0
+}
+
+type CreateFlashSaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`                                    // Flash sale price
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                               // Units reserved from product stock
+	PerUserLimit  int64                  `protobuf:"varint,4,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"` // Max units per user (0 = unlimited)
+	StartAt       int64                  `protobuf:"varint,5,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`                  // Unix timestamp, must be in the future
+	EndAt         int64                  `protobuf:"varint,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`                        // Unix timestamp, unsold units are returned after it
+	OperatorId    int64                  `protobuf:"varint,7,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`         // Admin user ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFlashSaleRequest) Reset() {
+	*x = CreateFlashSaleRequest{}
+	mi := &file_product_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFlashSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFlashSaleRequest) ProtoMessage() {}
+
+func (x *CreateFlashSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFlashSaleRequest.ProtoReflect.Descriptor instead.
+func (*CreateFlashSaleRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateFlashSaleRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CreateFlashSaleRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateFlashSaleRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateFlashSaleRequest) GetPerUserLimit() int64 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *CreateFlashSaleRequest) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *CreateFlashSaleRequest) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *CreateFlashSaleRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type CreateFlashSaleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SaleId        int64                  `protobuf:"varint,1,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFlashSaleResponse) Reset() {
+	*x = CreateFlashSaleResponse{}
+	mi := &file_product_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFlashSaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFlashSaleResponse) ProtoMessage() {}
+
+func (x *CreateFlashSaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFlashSaleResponse.ProtoReflect.Descriptor instead.
+func (*CreateFlashSaleResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateFlashSaleResponse) GetSaleId() int64 {
+	if x != nil {
+		return x.SaleId
+	}
+	return 0
+}
+
+type CancelFlashSaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SaleId        int64                  `protobuf:"varint,1,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	OperatorId    int64                  `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // Admin user ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFlashSaleRequest) Reset() {
+	*x = CancelFlashSaleRequest{}
+	mi := &file_product_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFlashSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFlashSaleRequest) ProtoMessage() {}
+
+func (x *CancelFlashSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFlashSaleRequest.ProtoReflect.Descriptor instead.
+func (*CancelFlashSaleRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CancelFlashSaleRequest) GetSaleId() int64 {
+	if x != nil {
+		return x.SaleId
+	}
+	return 0
+}
+
+func (x *CancelFlashSaleRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type CancelFlashSaleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Returned      int64                  `protobuf:"varint,2,opt,name=returned,proto3" json:"returned,omitempty"` // Unsold units returned to product stock
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFlashSaleResponse) Reset() {
+	*x = CancelFlashSaleResponse{}
+	mi := &file_product_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFlashSaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFlashSaleResponse) ProtoMessage() {}
+
+func (x *CancelFlashSaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFlashSaleResponse.ProtoReflect.Descriptor instead.
+func (*CancelFlashSaleResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CancelFlashSaleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CancelFlashSaleResponse) GetReturned() int64 {
+	if x != nil {
+		return x.Returned
+	}
+	return 0
+}
+
+type ListFlashSalesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // 0 = all products
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                        // 0 = all statuses
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlashSalesRequest) Reset() {
+	*x = ListFlashSalesRequest{}
+	mi := &file_product_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlashSalesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlashSalesRequest) ProtoMessage() {}
+
+func (x *ListFlashSalesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlashSalesRequest.ProtoReflect.Descriptor instead.
+func (*ListFlashSalesRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListFlashSalesRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListFlashSalesRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListFlashSalesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFlashSalesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListFlashSalesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Sales         []*FlashSale           `protobuf:"bytes,2,rep,name=sales,proto3" json:"sales,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlashSalesResponse) Reset() {
+	*x = ListFlashSalesResponse{}
+	mi := &file_product_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlashSalesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlashSalesResponse) ProtoMessage() {}
+
+func (x *ListFlashSalesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlashSalesResponse.ProtoReflect.Descriptor instead.
+func (*ListFlashSalesResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListFlashSalesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListFlashSalesResponse) GetSales() []*FlashSale {
+	if x != nil {
+		return x.Sales
+	}
+	return nil
+}
+
+type GetFlashSaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SaleId        int64                  `protobuf:"varint,1,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFlashSaleRequest) Reset() {
+	*x = GetFlashSaleRequest{}
+	mi := &file_product_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFlashSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlashSaleRequest) ProtoMessage() {}
+
+func (x *GetFlashSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlashSaleRequest.ProtoReflect.Descriptor instead.
+func (*GetFlashSaleRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetFlashSaleRequest) GetSaleId() int64 {
+	if x != nil {
+		return x.SaleId
+	}
+	return 0
+}
+
+type GetFlashSaleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sale          *FlashSale             `protobuf:"bytes,1,opt,name=sale,proto3" json:"sale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFlashSaleResponse) Reset() {
+	*x = GetFlashSaleResponse{}
+	mi := &file_product_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFlashSaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFlashSaleResponse) ProtoMessage() {}
+
+func (x *GetFlashSaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFlashSaleResponse.ProtoReflect.Descriptor instead.
+func (*GetFlashSaleResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetFlashSaleResponse) GetSale() *FlashSale {
+	if x != nil {
+		return x.Sale
+	}
+	return nil
+}
+
+type FlashSale struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity      int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Sold          int64                  `protobuf:"varint,5,opt,name=sold,proto3" json:"sold,omitempty"`                                       // Settled when the sale closes
+	Remaining     int64                  `protobuf:"varint,6,opt,name=remaining,proto3" json:"remaining,omitempty"`                             // Units left in Redis while the sale is open
+	PerUserLimit  int64                  `protobuf:"varint,7,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"` // 0 = unlimited
+	StartAt       int64                  `protobuf:"varint,8,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`
+	EndAt         int64                  `protobuf:"varint,9,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	Status        int32                  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"` // 1:pending, 2:active, 3:finished, 4:cancelled
+	OperatorId    int64                  `protobuf:"varint,11,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlashSale) Reset() {
+	*x = FlashSale{}
+	mi := &file_product_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlashSale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlashSale) ProtoMessage() {}
+
+func (x *FlashSale) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlashSale.ProtoReflect.Descriptor instead.
+func (*FlashSale) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *FlashSale) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FlashSale) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *FlashSale) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *FlashSale) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *FlashSale) GetSold() int64 {
+	if x != nil {
+		return x.Sold
+	}
+	return 0
+}
+
+func (x *FlashSale) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *FlashSale) GetPerUserLimit() int64 {
+	if x != nil {
+		return x.PerUserLimit
+	}
+	return 0
+}
+
+func (x *FlashSale) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *FlashSale) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *FlashSale) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *FlashSale) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *FlashSale) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *FlashSale) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type FlashSaleBuyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SaleId        int64                  `protobuf:"varint,1,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Address       string                 `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"` // Shipping address
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`     // Contact phone
+	Remark        string                 `protobuf:"bytes,6,opt,name=remark,proto3" json:"remark,omitempty"`   // Order remark
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlashSaleBuyRequest) Reset() {
+	*x = FlashSaleBuyRequest{}
+	mi := &file_product_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlashSaleBuyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlashSaleBuyRequest) ProtoMessage() {}
+
+func (x *FlashSaleBuyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlashSaleBuyRequest.ProtoReflect.Descriptor instead.
+func (*FlashSaleBuyRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *FlashSaleBuyRequest) GetSaleId() int64 {
+	if x != nil {
+		return x.SaleId
+	}
+	return 0
+}
+
+func (x *FlashSaleBuyRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *FlashSaleBuyRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *FlashSaleBuyRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *FlashSaleBuyRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *FlashSaleBuyRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type FlashSaleBuyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"` // Order number, the order is created asynchronously
+	TotalAmount   float64                `protobuf:"fixed64,2,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlashSaleBuyResponse) Reset() {
+	*x = FlashSaleBuyResponse{}
+	mi := &file_product_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlashSaleBuyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlashSaleBuyResponse) ProtoMessage() {}
+
+func (x *FlashSaleBuyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlashSaleBuyResponse.ProtoReflect.Descriptor instead.
+func (*FlashSaleBuyResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *FlashSaleBuyResponse) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *FlashSaleBuyResponse) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+type ReleaseFlashSaleLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SaleId        int64                  `protobuf:"varint,1,opt,name=sale_id,json=saleId,proto3" json:"sale_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseFlashSaleLimitRequest) Reset() {
+	*x = ReleaseFlashSaleLimitRequest{}
+	mi := &file_product_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseFlashSaleLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseFlashSaleLimitRequest) ProtoMessage() {}
+
+func (x *ReleaseFlashSaleLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseFlashSaleLimitRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseFlashSaleLimitRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ReleaseFlashSaleLimitRequest) GetSaleId() int64 {
+	if x != nil {
+		return x.SaleId
+	}
+	return 0
+}
+
+func (x *ReleaseFlashSaleLimitRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReleaseFlashSaleLimitRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ReleaseFlashSaleLimitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // False if the sale is already closed, its counters are gone
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseFlashSaleLimitResponse) Reset() {
+	*x = ReleaseFlashSaleLimitResponse{}
+	mi := &file_product_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseFlashSaleLimitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseFlashSaleLimitResponse) ProtoMessage() {}
+
+func (x *ReleaseFlashSaleLimitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseFlashSaleLimitResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseFlashSaleLimitResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ReleaseFlashSaleLimitResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListInventoryMovementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // Empty = all reasons
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInventoryMovementsRequest) Reset() {
+	*x = ListInventoryMovementsRequest{}
+	mi := &file_product_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInventoryMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInventoryMovementsRequest) ProtoMessage() {}
+
+func (x *ListInventoryMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInventoryMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListInventoryMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListInventoryMovementsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListInventoryMovementsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ListInventoryMovementsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListInventoryMovementsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListInventoryMovementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Movements     []*InventoryMovement   `protobuf:"bytes,2,rep,name=movements,proto3" json:"movements,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInventoryMovementsResponse) Reset() {
+	*x = ListInventoryMovementsResponse{}
+	mi := &file_product_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInventoryMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInventoryMovementsResponse) ProtoMessage() {}
+
+func (x *ListInventoryMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInventoryMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListInventoryMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListInventoryMovementsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListInventoryMovementsResponse) GetMovements() []*InventoryMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+type InventoryMovement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                         // Positive = in, negative = out
+	StockAfter    int64                  `protobuf:"varint,4,opt,name=stock_after,json=stockAfter,proto3" json:"stock_after,omitempty"`   // Product stock after the change
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                              // initial, import, order, cancel, compensation, admin_adjust, flash_sale, transfer
+	ReferenceId   string                 `protobuf:"bytes,6,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"` // Order number, flash sale ID... (empty = none)
+	OperatorId    int64                  `protobuf:"varint,7,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`   // 0 = system
+	CreatedAt     int64                  `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	WarehouseId   int64                  `protobuf:"varint,9,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"` // Warehouse whose stock changed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryMovement) Reset() {
+	*x = InventoryMovement{}
+	mi := &file_product_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryMovement) ProtoMessage() {}
+
+func (x *InventoryMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryMovement.ProtoReflect.Descriptor instead.
+func (*InventoryMovement) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *InventoryMovement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InventoryMovement) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *InventoryMovement) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InventoryMovement) GetStockAfter() int64 {
+	if x != nil {
+		return x.StockAfter
+	}
+	return 0
+}
+
+func (x *InventoryMovement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *InventoryMovement) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *InventoryMovement) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *InventoryMovement) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *InventoryMovement) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+type CheckInventoryConsistencyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // 0 = all products
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                          // Max discrepancies returned (default 100)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInventoryConsistencyRequest) Reset() {
+	*x = CheckInventoryConsistencyRequest{}
+	mi := &file_product_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInventoryConsistencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInventoryConsistencyRequest) ProtoMessage() {}
+
+func (x *CheckInventoryConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInventoryConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*CheckInventoryConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CheckInventoryConsistencyRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CheckInventoryConsistencyRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CheckInventoryConsistencyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Consistent    bool                   `protobuf:"varint,1,opt,name=consistent,proto3" json:"consistent,omitempty"` // No discrepancies found
+	Discrepancies []*StockDiscrepancy    `protobuf:"bytes,2,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckInventoryConsistencyResponse) Reset() {
+	*x = CheckInventoryConsistencyResponse{}
+	mi := &file_product_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckInventoryConsistencyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckInventoryConsistencyResponse) ProtoMessage() {}
+
+func (x *CheckInventoryConsistencyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckInventoryConsistencyResponse.ProtoReflect.Descriptor instead.
+func (*CheckInventoryConsistencyResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CheckInventoryConsistencyResponse) GetConsistent() bool {
+	if x != nil {
+		return x.Consistent
+	}
+	return false
+}
+
+func (x *CheckInventoryConsistencyResponse) GetDiscrepancies() []*StockDiscrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+type StockDiscrepancy struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Stock          int64                  `protobuf:"varint,2,opt,name=stock,proto3" json:"stock,omitempty"`                                         // products.stock
+	LedgerStock    int64                  `protobuf:"varint,3,opt,name=ledger_stock,json=ledgerStock,proto3" json:"ledger_stock,omitempty"`          // Sum of inventory movements
+	Difference     int64                  `protobuf:"varint,4,opt,name=difference,proto3" json:"difference,omitempty"`                               // stock - ledger_stock
+	WarehouseStock int64                  `protobuf:"varint,5,opt,name=warehouse_stock,json=warehouseStock,proto3" json:"warehouse_stock,omitempty"` // Sum of per-warehouse stock
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StockDiscrepancy) Reset() {
+	*x = StockDiscrepancy{}
+	mi := &file_product_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockDiscrepancy) ProtoMessage() {}
+
+func (x *StockDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockDiscrepancy.ProtoReflect.Descriptor instead.
+func (*StockDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *StockDiscrepancy) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockDiscrepancy) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *StockDiscrepancy) GetLedgerStock() int64 {
+	if x != nil {
+		return x.LedgerStock
+	}
+	return 0
+}
+
+func (x *StockDiscrepancy) GetDifference() int64 {
+	if x != nil {
+		return x.Difference
+	}
+	return 0
+}
+
+func (x *StockDiscrepancy) GetWarehouseStock() int64 {
+	if x != nil {
+		return x.WarehouseStock
+	}
+	return 0
+}
+
+type CreateWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"` // Unique short code
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`      // Matched against the customer region by nearest allocation
+	Priority      int64                  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"` // Lower ships first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseRequest) Reset() {
+	*x = CreateWarehouseRequest{}
+	mi := &file_product_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseRequest) ProtoMessage() {}
+
+func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CreateWarehouseRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type CreateWarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseResponse) Reset() {
+	*x = CreateWarehouseResponse{}
+	mi := &file_product_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseResponse) ProtoMessage() {}
+
+func (x *CreateWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CreateWarehouseResponse) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+type UpdateWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`     // Empty = no change
+	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"` // Empty = no change
+	Priority      int64                  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWarehouseRequest) Reset() {
+	*x = UpdateWarehouseRequest{}
+	mi := &file_product_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWarehouseRequest) ProtoMessage() {}
+
+func (x *UpdateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UpdateWarehouseRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateWarehouseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+type UpdateWarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWarehouseResponse) Reset() {
+	*x = UpdateWarehouseResponse{}
+	mi := &file_product_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWarehouseResponse) ProtoMessage() {}
+
+func (x *UpdateWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpdateWarehouseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListWarehousesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesRequest) Reset() {
+	*x = ListWarehousesRequest{}
+	mi := &file_product_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesRequest) ProtoMessage() {}
+
+func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesRequest.ProtoReflect.Descriptor instead.
+func (*ListWarehousesRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{70}
+}
+
+type ListWarehousesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouses    []*Warehouse           `protobuf:"bytes,1,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesResponse) Reset() {
+	*x = ListWarehousesResponse{}
+	mi := &file_product_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesResponse) ProtoMessage() {}
+
+func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesResponse.ProtoReflect.Descriptor instead.
+func (*ListWarehousesResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ListWarehousesResponse) GetWarehouses() []*Warehouse {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+type Warehouse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Region        string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Priority      int64                  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Warehouse) Reset() {
+	*x = Warehouse{}
+	mi := &file_product_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warehouse) ProtoMessage() {}
+
+func (x *Warehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warehouse.ProtoReflect.Descriptor instead.
+func (*Warehouse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Warehouse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Warehouse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Warehouse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Warehouse) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Warehouse) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Warehouse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Warehouse) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type TransferStockRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	FromWarehouseId int64                  `protobuf:"varint,2,opt,name=from_warehouse_id,json=fromWarehouseId,proto3" json:"from_warehouse_id,omitempty"`
+	ToWarehouseId   int64                  `protobuf:"varint,3,opt,name=to_warehouse_id,json=toWarehouseId,proto3" json:"to_warehouse_id,omitempty"`
+	Quantity        int64                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ReferenceId     string                 `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"` // Transfer note number (optional)
+	OperatorId      int64                  `protobuf:"varint,6,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`   // Admin user ID
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransferStockRequest) Reset() {
+	*x = TransferStockRequest{}
+	mi := &file_product_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockRequest) ProtoMessage() {}
+
+func (x *TransferStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockRequest.ProtoReflect.Descriptor instead.
+func (*TransferStockRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *TransferStockRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetFromWarehouseId() int64 {
+	if x != nil {
+		return x.FromWarehouseId
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetToWarehouseId() int64 {
+	if x != nil {
+		return x.ToWarehouseId
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type TransferStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferStockResponse) Reset() {
+	*x = TransferStockResponse{}
+	mi := &file_product_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockResponse) ProtoMessage() {}
+
+func (x *TransferStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockResponse.ProtoReflect.Descriptor instead.
+func (*TransferStockResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *TransferStockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetStockThresholdRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Threshold     int64                  `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"` // Alert when stock drops to this level, 0 = no alerts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStockThresholdRequest) Reset() {
+	*x = SetStockThresholdRequest{}
+	mi := &file_product_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStockThresholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStockThresholdRequest) ProtoMessage() {}
+
+func (x *SetStockThresholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStockThresholdRequest.ProtoReflect.Descriptor instead.
+func (*SetStockThresholdRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SetStockThresholdRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetStockThresholdRequest) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type SetStockThresholdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetStockThresholdResponse) Reset() {
+	*x = SetStockThresholdResponse{}
+	mi := &file_product_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStockThresholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStockThresholdResponse) ProtoMessage() {}
+
+func (x *SetStockThresholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStockThresholdResponse.ProtoReflect.Descriptor instead.
+func (*SetStockThresholdResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SetStockThresholdResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetPurchaseLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MaxPerOrder   int64                  `protobuf:"varint,2,opt,name=max_per_order,json=maxPerOrder,proto3" json:"max_per_order,omitempty"` // Max units in one order, 0 = unlimited
+	MaxPerUser    int64                  `protobuf:"varint,3,opt,name=max_per_user,json=maxPerUser,proto3" json:"max_per_user,omitempty"`    // Max units one user can order within limit_window, 0 = unlimited
+	LimitWindow   int64                  `protobuf:"varint,4,opt,name=limit_window,json=limitWindow,proto3" json:"limit_window,omitempty"`   // Seconds counted for max_per_user, 0 = all time
+	OperatorId    int64                  `protobuf:"varint,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`      // Admin user ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPurchaseLimitsRequest) Reset() {
+	*x = SetPurchaseLimitsRequest{}
+	mi := &file_product_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPurchaseLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPurchaseLimitsRequest) ProtoMessage() {}
+
+func (x *SetPurchaseLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPurchaseLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetPurchaseLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *SetPurchaseLimitsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetPurchaseLimitsRequest) GetMaxPerOrder() int64 {
+	if x != nil {
+		return x.MaxPerOrder
+	}
+	return 0
+}
+
+func (x *SetPurchaseLimitsRequest) GetMaxPerUser() int64 {
+	if x != nil {
+		return x.MaxPerUser
+	}
+	return 0
+}
+
+func (x *SetPurchaseLimitsRequest) GetLimitWindow() int64 {
+	if x != nil {
+		return x.LimitWindow
+	}
+	return 0
+}
+
+func (x *SetPurchaseLimitsRequest) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+type SetPurchaseLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPurchaseLimitsResponse) Reset() {
+	*x = SetPurchaseLimitsResponse{}
+	mi := &file_product_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPurchaseLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPurchaseLimitsResponse) ProtoMessage() {}
+
+func (x *SetPurchaseLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPurchaseLimitsResponse.ProtoReflect.Descriptor instead.
+func (*SetPurchaseLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SetPurchaseLimitsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListLowStockProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockProductsRequest) Reset() {
+	*x = ListLowStockProductsRequest{}
+	mi := &file_product_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockProductsRequest) ProtoMessage() {}
+
+func (x *ListLowStockProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListLowStockProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListLowStockProductsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLowStockProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListLowStockProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Products      []*LowStockProduct     `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"` // Lowest stock first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockProductsResponse) Reset() {
+	*x = ListLowStockProductsResponse{}
+	mi := &file_product_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockProductsResponse) ProtoMessage() {}
+
+func (x *ListLowStockProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListLowStockProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListLowStockProductsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListLowStockProductsResponse) GetProducts() []*LowStockProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type LowStockProduct struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Stock         int64                  `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	Threshold     int64                  `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LowStockProduct) Reset() {
+	*x = LowStockProduct{}
+	mi := &file_product_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LowStockProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowStockProduct) ProtoMessage() {}
+
+func (x *LowStockProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowStockProduct.ProtoReflect.Descriptor instead.
+func (*LowStockProduct) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *LowStockProduct) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *LowStockProduct) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *LowStockProduct) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LowStockProduct) GetStock() int64 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *LowStockProduct) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type SubscribeRestockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRestockRequest) Reset() {
+	*x = SubscribeRestockRequest{}
+	mi := &file_product_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRestockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRestockRequest) ProtoMessage() {}
+
+func (x *SubscribeRestockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRestockRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRestockRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SubscribeRestockRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubscribeRestockRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type SubscribeRestockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeRestockResponse) Reset() {
+	*x = SubscribeRestockResponse{}
+	mi := &file_product_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeRestockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRestockResponse) ProtoMessage() {}
+
+func (x *SubscribeRestockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRestockResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeRestockResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SubscribeRestockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UnsubscribeRestockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeRestockRequest) Reset() {
+	*x = UnsubscribeRestockRequest{}
+	mi := &file_product_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeRestockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeRestockRequest) ProtoMessage() {}
+
+func (x *UnsubscribeRestockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeRestockRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeRestockRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *UnsubscribeRestockRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UnsubscribeRestockRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type UnsubscribeRestockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsubscribeRestockResponse) Reset() {
+	*x = UnsubscribeRestockResponse{}
+	mi := &file_product_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsubscribeRestockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeRestockResponse) ProtoMessage() {}
+
+func (x *UnsubscribeRestockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeRestockResponse.ProtoReflect.Descriptor instead.
+func (*UnsubscribeRestockResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *UnsubscribeRestockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetRelatedProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Default 10, max 20
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IncrementSalesResponse) Reset() {
-	*x = IncrementSalesResponse{}
-	mi := &file_product_proto_msgTypes[16]
+func (x *GetRelatedProductsRequest) Reset() {
+	*x = GetRelatedProductsRequest{}
+	mi := &file_product_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IncrementSalesResponse) String() string {
+func (x *GetRelatedProductsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IncrementSalesResponse) ProtoMessage() {}
+func (*GetRelatedProductsRequest) ProtoMessage() {}
 
-func (x *IncrementSalesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[16]
+func (x *GetRelatedProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,48 +5314,48 @@ func (x *IncrementSalesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IncrementSalesResponse.ProtoReflect.Descriptor instead.
-func (*IncrementSalesResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use GetRelatedProductsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelatedProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *IncrementSalesResponse) GetSuccess() bool {
+func (x *GetRelatedProductsRequest) GetProductId() int64 {
 	if x != nil {
-		return x.Success
+		return x.ProductId
 	}
-	return false
+	return 0
 }
 
-func (x *IncrementSalesResponse) GetNewSales() int64 {
+func (x *GetRelatedProductsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.NewSales
+		return x.Limit
 	}
 	return 0
 }
 
-// Batch update stock (transactional)
-type BatchUpdateStockRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*StockUpdateItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetRelatedProductsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Products       []*ProductInfo         `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	BoughtTogether int32                  `protobuf:"varint,2,opt,name=bought_together,json=boughtTogether,proto3" json:"bought_together,omitempty"` // The first bought_together products come from orders, the rest are category best sellers
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *BatchUpdateStockRequest) Reset() {
-	*x = BatchUpdateStockRequest{}
-	mi := &file_product_proto_msgTypes[17]
+func (x *GetRelatedProductsResponse) Reset() {
+	*x = GetRelatedProductsResponse{}
+	mi := &file_product_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchUpdateStockRequest) String() string {
+func (x *GetRelatedProductsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchUpdateStockRequest) ProtoMessage() {}
+func (*GetRelatedProductsResponse) ProtoMessage() {}
 
-func (x *BatchUpdateStockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[17]
+func (x *GetRelatedProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1022,41 +5366,48 @@ func (x *BatchUpdateStockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchUpdateStockRequest.ProtoReflect.Descriptor instead.
-func (*BatchUpdateStockRequest) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetRelatedProductsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelatedProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *BatchUpdateStockRequest) GetItems() []*StockUpdateItem {
+func (x *GetRelatedProductsResponse) GetProducts() []*ProductInfo {
 	if x != nil {
-		return x.Items
+		return x.Products
 	}
 	return nil
 }
 
-type BatchUpdateStockResponse struct {
+func (x *GetRelatedProductsResponse) GetBoughtTogether() int32 {
+	if x != nil {
+		return x.BoughtTogether
+	}
+	return 0
+}
+
+type BundleItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Results       []*StockUpdateResult   `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	ComponentId   int64                  `protobuf:"varint,1,opt,name=component_id,json=componentId,proto3" json:"component_id,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // Units of the component in one bundle
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BatchUpdateStockResponse) Reset() {
-	*x = BatchUpdateStockResponse{}
-	mi := &file_product_proto_msgTypes[18]
+func (x *BundleItem) Reset() {
+	*x = BundleItem{}
+	mi := &file_product_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BatchUpdateStockResponse) String() string {
+func (x *BundleItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchUpdateStockResponse) ProtoMessage() {}
+func (*BundleItem) ProtoMessage() {}
 
-func (x *BatchUpdateStockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[18]
+func (x *BundleItem) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,48 +5418,48 @@ func (x *BatchUpdateStockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchUpdateStockResponse.ProtoReflect.Descriptor instead.
-func (*BatchUpdateStockResponse) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use BundleItem.ProtoReflect.Descriptor instead.
+func (*BundleItem) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *BatchUpdateStockResponse) GetSuccess() bool {
+func (x *BundleItem) GetComponentId() int64 {
 	if x != nil {
-		return x.Success
+		return x.ComponentId
 	}
-	return false
+	return 0
 }
 
-func (x *BatchUpdateStockResponse) GetResults() []*StockUpdateResult {
+func (x *BundleItem) GetQuantity() int64 {
 	if x != nil {
-		return x.Results
+		return x.Quantity
 	}
-	return nil
+	return 0
 }
 
-type StockUpdateItem struct {
+type SetBundleItemsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // Positive = increase, Negative = decrease
+	BundleId      int64                  `protobuf:"varint,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
+	Items         []*BundleItem          `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"` // Empty = standalone product again
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StockUpdateItem) Reset() {
-	*x = StockUpdateItem{}
-	mi := &file_product_proto_msgTypes[19]
+func (x *SetBundleItemsRequest) Reset() {
+	*x = SetBundleItemsRequest{}
+	mi := &file_product_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StockUpdateItem) String() string {
+func (x *SetBundleItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StockUpdateItem) ProtoMessage() {}
+func (*SetBundleItemsRequest) ProtoMessage() {}
 
-func (x *StockUpdateItem) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[19]
+func (x *SetBundleItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1119,48 +5470,47 @@ func (x *StockUpdateItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StockUpdateItem.ProtoReflect.Descriptor instead.
-func (*StockUpdateItem) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SetBundleItemsRequest.ProtoReflect.Descriptor instead.
+func (*SetBundleItemsRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *StockUpdateItem) GetProductId() int64 {
+func (x *SetBundleItemsRequest) GetBundleId() int64 {
 	if x != nil {
-		return x.ProductId
+		return x.BundleId
 	}
 	return 0
 }
 
-func (x *StockUpdateItem) GetQuantity() int64 {
+func (x *SetBundleItemsRequest) GetItems() []*BundleItem {
 	if x != nil {
-		return x.Quantity
+		return x.Items
 	}
-	return 0
+	return nil
 }
 
-type StockUpdateResult struct {
+type SetBundleItemsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	NewStock      int64                  `protobuf:"varint,2,opt,name=new_stock,json=newStock,proto3" json:"new_stock,omitempty"` // Stock after update
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StockUpdateResult) Reset() {
-	*x = StockUpdateResult{}
-	mi := &file_product_proto_msgTypes[20]
+func (x *SetBundleItemsResponse) Reset() {
+	*x = SetBundleItemsResponse{}
+	mi := &file_product_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StockUpdateResult) String() string {
+func (x *SetBundleItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StockUpdateResult) ProtoMessage() {}
+func (*SetBundleItemsResponse) ProtoMessage() {}
 
-func (x *StockUpdateResult) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[20]
+func (x *SetBundleItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1171,58 +5521,40 @@ func (x *StockUpdateResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StockUpdateResult.ProtoReflect.Descriptor instead.
-func (*StockUpdateResult) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *StockUpdateResult) GetProductId() int64 {
-	if x != nil {
-		return x.ProductId
-	}
-	return 0
+// Deprecated: Use SetBundleItemsResponse.ProtoReflect.Descriptor instead.
+func (*SetBundleItemsResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *StockUpdateResult) GetNewStock() int64 {
+func (x *SetBundleItemsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.NewStock
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-// Product information model
-type ProductInfo struct {
+type GetBundleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Stock         int64                  `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
-	Category      string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
-	Images        []string               `protobuf:"bytes,7,rep,name=images,proto3" json:"images,omitempty"`
-	Attributes    string                 `protobuf:"bytes,8,opt,name=attributes,proto3" json:"attributes,omitempty"`
-	Sales         int64                  `protobuf:"varint,9,opt,name=sales,proto3" json:"sales,omitempty"` // Total sales count
-	CreatedAt     int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     int64                  `protobuf:"varint,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	BundleId      int64                  `protobuf:"varint,1,opt,name=bundle_id,json=bundleId,proto3" json:"bundle_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductInfo) Reset() {
-	*x = ProductInfo{}
-	mi := &file_product_proto_msgTypes[21]
+func (x *GetBundleRequest) Reset() {
+	*x = GetBundleRequest{}
+	mi := &file_product_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductInfo) String() string {
+func (x *GetBundleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProductInfo) ProtoMessage() {}
+func (*GetBundleRequest) ProtoMessage() {}
 
-func (x *ProductInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_product_proto_msgTypes[21]
+func (x *GetBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1233,84 +5565,126 @@ func (x *ProductInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProductInfo.ProtoReflect.Descriptor instead.
-func (*ProductInfo) Descriptor() ([]byte, []int) {
-	return file_product_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetBundleRequest.ProtoReflect.Descriptor instead.
+func (*GetBundleRequest) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *ProductInfo) GetId() int64 {
+func (x *GetBundleRequest) GetBundleId() int64 {
 	if x != nil {
-		return x.Id
+		return x.BundleId
 	}
 	return 0
 }
 
-func (x *ProductInfo) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+type GetBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bundle        *ProductInfo           `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	Components    []*BundleComponent     `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"`
+	Available     int64                  `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"` // Bundles the component stock can make
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProductInfo) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *GetBundleResponse) Reset() {
+	*x = GetBundleResponse{}
+	mi := &file_product_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProductInfo) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
+func (x *GetBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ProductInfo) GetStock() int64 {
+func (*GetBundleResponse) ProtoMessage() {}
+
+func (x *GetBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[92]
 	if x != nil {
-		return x.Stock
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ProductInfo) GetCategory() string {
+// Deprecated: Use GetBundleResponse.ProtoReflect.Descriptor instead.
+func (*GetBundleResponse) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetBundleResponse) GetBundle() *ProductInfo {
 	if x != nil {
-		return x.Category
+		return x.Bundle
 	}
-	return ""
+	return nil
 }
 
-func (x *ProductInfo) GetImages() []string {
+func (x *GetBundleResponse) GetComponents() []*BundleComponent {
 	if x != nil {
-		return x.Images
+		return x.Components
 	}
 	return nil
 }
 
-func (x *ProductInfo) GetAttributes() string {
+func (x *GetBundleResponse) GetAvailable() int64 {
 	if x != nil {
-		return x.Attributes
+		return x.Available
 	}
-	return ""
+	return 0
 }
 
-func (x *ProductInfo) GetSales() int64 {
+type BundleComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *ProductInfo           `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // Units of the component in one bundle
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleComponent) Reset() {
+	*x = BundleComponent{}
+	mi := &file_product_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleComponent) ProtoMessage() {}
+
+func (x *BundleComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_product_proto_msgTypes[93]
 	if x != nil {
-		return x.Sales
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ProductInfo) GetCreatedAt() int64 {
+// Deprecated: Use BundleComponent.ProtoReflect.Descriptor instead.
+func (*BundleComponent) Descriptor() ([]byte, []int) {
+	return file_product_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *BundleComponent) GetProduct() *ProductInfo {
 	if x != nil {
-		return x.CreatedAt
+		return x.Product
 	}
-	return 0
+	return nil
 }
 
-func (x *ProductInfo) GetUpdatedAt() int64 {
+func (x *BundleComponent) GetQuantity() int64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Quantity
 	}
 	return 0
 }
@@ -1319,7 +5693,7 @@ var File_product_proto protoreflect.FileDescriptor
 
 const file_product_proto_rawDesc = "" +
 	"\n" +
-	"\rproduct.proto\x12\aproduct\"\xc9\x01\n" +
+	"\rproduct.proto\x12\aproduct\"\xb3\x02\n" +
 	"\x11AddProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x14\n" +
@@ -1329,10 +5703,17 @@ const file_product_proto_rawDesc = "" +
 	"\x06images\x18\x06 \x03(\tR\x06images\x12\x1e\n" +
 	"\n" +
 	"attributes\x18\a \x01(\tR\n" +
-	"attributes\"3\n" +
+	"attributes\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\t \x01(\x03R\tpublishAt\x12\x10\n" +
+	"\x03sku\x18\n" +
+	" \x01(\tR\x03sku\x12\x1f\n" +
+	"\voperator_id\x18\v \x01(\x03R\n" +
+	"operatorId\"3\n" +
 	"\x12AddProductResponse\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x01 \x01(\x03R\tproductId\"\xc6\x01\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\"\x81\x02\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1342,13 +5723,25 @@ const file_product_proto_rawDesc = "" +
 	"\x06images\x18\x06 \x03(\tR\x06images\x12\x1e\n" +
 	"\n" +
 	"attributes\x18\a \x01(\tR\n" +
-	"attributes\"1\n" +
+	"attributes\x12\x1f\n" +
+	"\voperator_id\x18\b \x01(\x03R\n" +
+	"operatorId\x12\x18\n" +
+	"\aversion\x18\t \x01(\x03R\aversion\"K\n" +
 	"\x15UpdateProductResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"#\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"#\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"D\n" +
 	"\x12GetProductResponse\x12.\n" +
-	"\aproduct\x18\x01 \x01(\v2\x14.product.ProductInfoR\aproduct\"\x91\x01\n" +
+	"\aproduct\x18\x01 \x01(\v2\x14.product.ProductInfoR\aproduct\"&\n" +
+	"\x12GetProductsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"G\n" +
+	"\x13GetProductsResponse\x120\n" +
+	"\aresults\x18\x01 \x03(\v2\x16.product.ProductResultR\aresults\"e\n" +
+	"\rProductResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05found\x18\x02 \x01(\bR\x05found\x12.\n" +
+	"\aproduct\x18\x03 \x01(\v2\x14.product.ProductInfoR\aproduct\"\x91\x01\n" +
 	"\x13ListProductsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1a\n" +
@@ -1364,11 +5757,16 @@ const file_product_proto_rawDesc = "" +
 	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"`\n" +
 	"\x16SearchProductsResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x03R\x05total\x120\n" +
-	"\bproducts\x18\x02 \x03(\v2\x14.product.ProductInfoR\bproducts\"O\n" +
+	"\bproducts\x18\x02 \x03(\v2\x14.product.ProductInfoR\bproducts\"\xce\x01\n" +
 	"\x12UpdateStockRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x03R\bquantity\"L\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12!\n" +
+	"\freference_id\x18\x04 \x01(\tR\vreferenceId\x12\x1f\n" +
+	"\voperator_id\x18\x05 \x01(\x03R\n" +
+	"operatorId\x12!\n" +
+	"\fwarehouse_id\x18\x06 \x01(\x03R\vwarehouseId\"L\n" +
 	"\x13UpdateStockResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
 	"\tnew_stock\x18\x02 \x01(\x03R\bnewStock\"=\n" +
@@ -1376,21 +5774,37 @@ const file_product_proto_rawDesc = "" +
 	"\x05items\x18\x01 \x03(\v2\x12.product.StockItemR\x05items\"\\\n" +
 	"\x12CheckStockResponse\x12\x1c\n" +
 	"\tavailable\x18\x01 \x01(\bR\tavailable\x12(\n" +
-	"\x05items\x18\x02 \x03(\v2\x12.product.StockItemR\x05items\"\x80\x01\n" +
+	"\x05items\x18\x02 \x03(\v2\x12.product.StockItemR\x05items\"\xb9\x01\n" +
 	"\tStockItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03R\tproductId\x12+\n" +
 	"\x11required_quantity\x18\x02 \x01(\x03R\x10requiredQuantity\x12'\n" +
-	"\x0favailable_stock\x18\x03 \x01(\x03R\x0eavailableStock\"R\n" +
+	"\x0favailable_stock\x18\x03 \x01(\x03R\x0eavailableStock\x127\n" +
+	"\n" +
+	"warehouses\x18\x04 \x03(\v2\x17.product.WarehouseStockR\n" +
+	"warehouses\"\x97\x01\n" +
+	"\x0eWarehouseStock\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\x12%\n" +
+	"\x0ewarehouse_code\x18\x02 \x01(\tR\rwarehouseCode\x12%\n" +
+	"\x0ewarehouse_name\x18\x03 \x01(\tR\rwarehouseName\x12\x14\n" +
+	"\x05stock\x18\x04 \x01(\x03R\x05stock\"R\n" +
 	"\x15IncrementSalesRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x03R\bquantity\"O\n" +
 	"\x16IncrementSalesResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
-	"\tnew_sales\x18\x02 \x01(\x03R\bnewSales\"I\n" +
+	"\tnew_sales\x18\x02 \x01(\x03R\bnewSales\"\xdd\x01\n" +
 	"\x17BatchUpdateStockRequest\x12.\n" +
-	"\x05items\x18\x01 \x03(\v2\x18.product.StockUpdateItemR\x05items\"j\n" +
+	"\x05items\x18\x01 \x03(\v2\x18.product.StockUpdateItemR\x05items\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12!\n" +
+	"\freference_id\x18\x03 \x01(\tR\vreferenceId\x12\x1f\n" +
+	"\voperator_id\x18\x04 \x01(\x03R\n" +
+	"operatorId\x12\x1e\n" +
+	"\n" +
+	"allocation\x18\x05 \x01(\tR\n" +
+	"allocation\x12\x16\n" +
+	"\x06region\x18\x06 \x01(\tR\x06region\"j\n" +
 	"\x18BatchUpdateStockResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x124\n" +
 	"\aresults\x18\x02 \x03(\v2\x1a.product.StockUpdateResultR\aresults\"L\n" +
@@ -1401,7 +5815,102 @@ const file_product_proto_rawDesc = "" +
 	"\x11StockUpdateResult\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1b\n" +
-	"\tnew_stock\x18\x02 \x01(\x03R\bnewStock\"\xa7\x02\n" +
+	"\tnew_stock\x18\x02 \x01(\x03R\bnewStock\"&\n" +
+	"\x14DeleteProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"1\n" +
+	"\x15DeleteProductResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"'\n" +
+	"\x15RestoreProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
+	"\x16RestoreProductResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"`\n" +
+	"\x17SetProductStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x03 \x01(\x03R\tpublishAt\"4\n" +
+	"\x18SetProductStatusResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"d\n" +
+	"\x15ImportProductsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x1f\n" +
+	"\voperator_id\x18\x03 \x01(\x03R\n" +
+	"operatorId\"\xab\x01\n" +
+	"\x16ImportProductsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x18\n" +
+	"\acreated\x18\x02 \x01(\x03R\acreated\x12\x18\n" +
+	"\aupdated\x18\x03 \x01(\x03R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x03R\x06failed\x12/\n" +
+	"\x06errors\x18\x05 \x03(\v2\x17.product.ImportRowErrorR\x06errors\"N\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x03R\x03row\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"/\n" +
+	"\x15ExportProductsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\")\n" +
+	"\x13ExportProductsChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xa4\x01\n" +
+	"\x1aSchedulePriceChangeRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x19\n" +
+	"\bstart_at\x18\x03 \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x04 \x01(\x03R\x05endAt\x12\x1f\n" +
+	"\voperator_id\x18\x05 \x01(\x03R\n" +
+	"operatorId\">\n" +
+	"\x1bSchedulePriceChangeResponse\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\x03R\n" +
+	"scheduleId\"^\n" +
+	"\x1aCancelPriceScheduleRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\x03R\n" +
+	"scheduleId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\"7\n" +
+	"\x1bCancelPriceScheduleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"e\n" +
+	"\x19ListPriceSchedulesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12)\n" +
+	"\x10include_finished\x18\x02 \x01(\bR\x0fincludeFinished\"R\n" +
+	"\x1aListPriceSchedulesResponse\x124\n" +
+	"\tschedules\x18\x01 \x03(\v2\x16.product.PriceScheduleR\tschedules\"\xa4\x02\n" +
+	"\rPriceSchedule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12%\n" +
+	"\x0eoriginal_price\x18\x04 \x01(\x01R\roriginalPrice\x12\x19\n" +
+	"\bstart_at\x18\x05 \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x06 \x01(\x03R\x05endAt\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\x12\x1f\n" +
+	"\voperator_id\x18\b \x01(\x03R\n" +
+	"operatorId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\"h\n" +
+	"\x16GetPriceHistoryRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"`\n" +
+	"\x17GetPriceHistoryResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12/\n" +
+	"\ahistory\x18\x02 \x03(\v2\x15.product.PriceHistoryR\ahistory\"\xf0\x01\n" +
+	"\fPriceHistory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1b\n" +
+	"\told_price\x18\x03 \x01(\x01R\boldPrice\x12\x1b\n" +
+	"\tnew_price\x18\x04 \x01(\x01R\bnewPrice\x12\x1f\n" +
+	"\voperator_id\x18\x05 \x01(\x03R\n" +
+	"operatorId\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\x12\x1f\n" +
+	"\vschedule_id\x18\a \x01(\x03R\n" +
+	"scheduleId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\"\xf3\x03\n" +
 	"\vProductInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -1418,20 +5927,276 @@ const file_product_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\x03R\tupdatedAt2\xc4\x05\n" +
+	"updated_at\x18\v \x01(\x03R\tupdatedAt\x12\x16\n" +
+	"\x06status\x18\f \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\r \x01(\x03R\tpublishAt\x12\x10\n" +
+	"\x03sku\x18\x0e \x01(\tR\x03sku\x12\x18\n" +
+	"\aversion\x18\x0f \x01(\x03R\aversion\x12\"\n" +
+	"\rmax_per_order\x18\x10 \x01(\x03R\vmaxPerOrder\x12 \n" +
+	"\fmax_per_user\x18\x11 \x01(\x03R\n" +
+	"maxPerUser\x12!\n" +
+	"\flimit_window\x18\x12 \x01(\x03R\vlimitWindow\"\xe2\x01\n" +
+	"\x16CreateFlashSaleRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12$\n" +
+	"\x0eper_user_limit\x18\x04 \x01(\x03R\fperUserLimit\x12\x19\n" +
+	"\bstart_at\x18\x05 \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x06 \x01(\x03R\x05endAt\x12\x1f\n" +
+	"\voperator_id\x18\a \x01(\x03R\n" +
+	"operatorId\"2\n" +
+	"\x17CreateFlashSaleResponse\x12\x17\n" +
+	"\asale_id\x18\x01 \x01(\x03R\x06saleId\"R\n" +
+	"\x16CancelFlashSaleRequest\x12\x17\n" +
+	"\asale_id\x18\x01 \x01(\x03R\x06saleId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x03R\n" +
+	"operatorId\"O\n" +
+	"\x17CancelFlashSaleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1a\n" +
+	"\breturned\x18\x02 \x01(\x03R\breturned\"\x7f\n" +
+	"\x15ListFlashSalesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"X\n" +
+	"\x16ListFlashSalesResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12(\n" +
+	"\x05sales\x18\x02 \x03(\v2\x12.product.FlashSaleR\x05sales\".\n" +
+	"\x13GetFlashSaleRequest\x12\x17\n" +
+	"\asale_id\x18\x01 \x01(\x03R\x06saleId\">\n" +
+	"\x14GetFlashSaleResponse\x12&\n" +
+	"\x04sale\x18\x01 \x01(\v2\x12.product.FlashSaleR\x04sale\"\xed\x02\n" +
+	"\tFlashSale\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x12\n" +
+	"\x04sold\x18\x05 \x01(\x03R\x04sold\x12\x1c\n" +
+	"\tremaining\x18\x06 \x01(\x03R\tremaining\x12$\n" +
+	"\x0eper_user_limit\x18\a \x01(\x03R\fperUserLimit\x12\x19\n" +
+	"\bstart_at\x18\b \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\t \x01(\x03R\x05endAt\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\x05R\x06status\x12\x1f\n" +
+	"\voperator_id\x18\v \x01(\x03R\n" +
+	"operatorId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\x03R\tupdatedAt\"\xab\x01\n" +
+	"\x13FlashSaleBuyRequest\x12\x17\n" +
+	"\asale_id\x18\x01 \x01(\x03R\x06saleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12\x18\n" +
+	"\aaddress\x18\x04 \x01(\tR\aaddress\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x16\n" +
+	"\x06remark\x18\x06 \x01(\tR\x06remark\"T\n" +
+	"\x14FlashSaleBuyResponse\x12\x19\n" +
+	"\border_no\x18\x01 \x01(\tR\aorderNo\x12!\n" +
+	"\ftotal_amount\x18\x02 \x01(\x01R\vtotalAmount\"l\n" +
+	"\x1cReleaseFlashSaleLimitRequest\x12\x17\n" +
+	"\asale_id\x18\x01 \x01(\x03R\x06saleId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\"9\n" +
+	"\x1dReleaseFlashSaleLimitResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x87\x01\n" +
+	"\x1dListInventoryMovementsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"p\n" +
+	"\x1eListInventoryMovementsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x128\n" +
+	"\tmovements\x18\x02 \x03(\v2\x1a.product.InventoryMovementR\tmovements\"\x9d\x02\n" +
+	"\x11InventoryMovement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x03R\bquantity\x12\x1f\n" +
+	"\vstock_after\x18\x04 \x01(\x03R\n" +
+	"stockAfter\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12!\n" +
+	"\freference_id\x18\x06 \x01(\tR\vreferenceId\x12\x1f\n" +
+	"\voperator_id\x18\a \x01(\x03R\n" +
+	"operatorId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\x03R\tcreatedAt\x12!\n" +
+	"\fwarehouse_id\x18\t \x01(\x03R\vwarehouseId\"W\n" +
+	" CheckInventoryConsistencyRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"\x84\x01\n" +
+	"!CheckInventoryConsistencyResponse\x12\x1e\n" +
+	"\n" +
+	"consistent\x18\x01 \x01(\bR\n" +
+	"consistent\x12?\n" +
+	"\rdiscrepancies\x18\x02 \x03(\v2\x19.product.StockDiscrepancyR\rdiscrepancies\"\xb3\x01\n" +
+	"\x10StockDiscrepancy\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x14\n" +
+	"\x05stock\x18\x02 \x01(\x03R\x05stock\x12!\n" +
+	"\fledger_stock\x18\x03 \x01(\x03R\vledgerStock\x12\x1e\n" +
+	"\n" +
+	"difference\x18\x04 \x01(\x03R\n" +
+	"difference\x12'\n" +
+	"\x0fwarehouse_stock\x18\x05 \x01(\x03R\x0ewarehouseStock\"t\n" +
+	"\x16CreateWarehouseRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x03R\bpriority\"<\n" +
+	"\x17CreateWarehouseResponse\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\"p\n" +
+	"\x16UpdateWarehouseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x03R\bpriority\"3\n" +
+	"\x17UpdateWarehouseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x17\n" +
+	"\x15ListWarehousesRequest\"L\n" +
+	"\x16ListWarehousesResponse\x122\n" +
+	"\n" +
+	"warehouses\x18\x01 \x03(\v2\x12.product.WarehouseR\n" +
+	"warehouses\"\xb5\x01\n" +
+	"\tWarehouse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\x03R\bpriority\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAt\"\xe9\x01\n" +
+	"\x14TransferStockRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12*\n" +
+	"\x11from_warehouse_id\x18\x02 \x01(\x03R\x0ffromWarehouseId\x12&\n" +
+	"\x0fto_warehouse_id\x18\x03 \x01(\x03R\rtoWarehouseId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12!\n" +
+	"\freference_id\x18\x05 \x01(\tR\vreferenceId\x12\x1f\n" +
+	"\voperator_id\x18\x06 \x01(\x03R\n" +
+	"operatorId\"1\n" +
+	"\x15TransferStockResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"W\n" +
+	"\x18SetStockThresholdRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\x03R\tthreshold\"5\n" +
+	"\x19SetStockThresholdResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc3\x01\n" +
+	"\x18SetPurchaseLimitsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\"\n" +
+	"\rmax_per_order\x18\x02 \x01(\x03R\vmaxPerOrder\x12 \n" +
+	"\fmax_per_user\x18\x03 \x01(\x03R\n" +
+	"maxPerUser\x12!\n" +
+	"\flimit_window\x18\x04 \x01(\x03R\vlimitWindow\x12\x1f\n" +
+	"\voperator_id\x18\x05 \x01(\x03R\n" +
+	"operatorId\"5\n" +
+	"\x19SetPurchaseLimitsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"N\n" +
+	"\x1bListLowStockProductsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"j\n" +
+	"\x1cListLowStockProductsResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x124\n" +
+	"\bproducts\x18\x02 \x03(\v2\x18.product.LowStockProductR\bproducts\"\x8a\x01\n" +
+	"\x0fLowStockProduct\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05stock\x18\x04 \x01(\x03R\x05stock\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x03R\tthreshold\"Q\n" +
+	"\x17SubscribeRestockRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\"4\n" +
+	"\x18SubscribeRestockResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"S\n" +
+	"\x19UnsubscribeRestockRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\"6\n" +
+	"\x1aUnsubscribeRestockResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"P\n" +
+	"\x19GetRelatedProductsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"w\n" +
+	"\x1aGetRelatedProductsResponse\x120\n" +
+	"\bproducts\x18\x01 \x03(\v2\x14.product.ProductInfoR\bproducts\x12'\n" +
+	"\x0fbought_together\x18\x02 \x01(\x05R\x0eboughtTogether\"K\n" +
+	"\n" +
+	"BundleItem\x12!\n" +
+	"\fcomponent_id\x18\x01 \x01(\x03R\vcomponentId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"_\n" +
+	"\x15SetBundleItemsRequest\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\x03R\bbundleId\x12)\n" +
+	"\x05items\x18\x02 \x03(\v2\x13.product.BundleItemR\x05items\"2\n" +
+	"\x16SetBundleItemsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"/\n" +
+	"\x10GetBundleRequest\x12\x1b\n" +
+	"\tbundle_id\x18\x01 \x01(\x03R\bbundleId\"\x99\x01\n" +
+	"\x11GetBundleResponse\x12,\n" +
+	"\x06bundle\x18\x01 \x01(\v2\x14.product.ProductInfoR\x06bundle\x128\n" +
+	"\n" +
+	"components\x18\x02 \x03(\v2\x18.product.BundleComponentR\n" +
+	"components\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\x03R\tavailable\"]\n" +
+	"\x0fBundleComponent\x12.\n" +
+	"\aproduct\x18\x01 \x01(\v2\x14.product.ProductInfoR\aproduct\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity2\xa2\x1a\n" +
 	"\aProduct\x12E\n" +
 	"\n" +
 	"AddProduct\x12\x1a.product.AddProductRequest\x1a\x1b.product.AddProductResponse\x12N\n" +
 	"\rUpdateProduct\x12\x1d.product.UpdateProductRequest\x1a\x1e.product.UpdateProductResponse\x12E\n" +
 	"\n" +
-	"GetProduct\x12\x1a.product.GetProductRequest\x1a\x1b.product.GetProductResponse\x12K\n" +
+	"GetProduct\x12\x1a.product.GetProductRequest\x1a\x1b.product.GetProductResponse\x12H\n" +
+	"\vGetProducts\x12\x1b.product.GetProductsRequest\x1a\x1c.product.GetProductsResponse\x12K\n" +
 	"\fListProducts\x12\x1c.product.ListProductsRequest\x1a\x1d.product.ListProductsResponse\x12Q\n" +
 	"\x0eSearchProducts\x12\x1e.product.SearchProductsRequest\x1a\x1f.product.SearchProductsResponse\x12H\n" +
 	"\vUpdateStock\x12\x1b.product.UpdateStockRequest\x1a\x1c.product.UpdateStockResponse\x12E\n" +
 	"\n" +
 	"CheckStock\x12\x1a.product.CheckStockRequest\x1a\x1b.product.CheckStockResponse\x12Q\n" +
 	"\x0eIncrementSales\x12\x1e.product.IncrementSalesRequest\x1a\x1f.product.IncrementSalesResponse\x12W\n" +
-	"\x10BatchUpdateStock\x12 .product.BatchUpdateStockRequest\x1a!.product.BatchUpdateStockResponseB\vZ\t./productb\x06proto3"
+	"\x10BatchUpdateStock\x12 .product.BatchUpdateStockRequest\x1a!.product.BatchUpdateStockResponse\x12N\n" +
+	"\rDeleteProduct\x12\x1d.product.DeleteProductRequest\x1a\x1e.product.DeleteProductResponse\x12Q\n" +
+	"\x0eRestoreProduct\x12\x1e.product.RestoreProductRequest\x1a\x1f.product.RestoreProductResponse\x12W\n" +
+	"\x10SetProductStatus\x12 .product.SetProductStatusRequest\x1a!.product.SetProductStatusResponse\x12Q\n" +
+	"\x0eImportProducts\x12\x1e.product.ImportProductsRequest\x1a\x1f.product.ImportProductsResponse\x12P\n" +
+	"\x0eExportProducts\x12\x1e.product.ExportProductsRequest\x1a\x1c.product.ExportProductsChunk0\x01\x12`\n" +
+	"\x13SchedulePriceChange\x12#.product.SchedulePriceChangeRequest\x1a$.product.SchedulePriceChangeResponse\x12`\n" +
+	"\x13CancelPriceSchedule\x12#.product.CancelPriceScheduleRequest\x1a$.product.CancelPriceScheduleResponse\x12]\n" +
+	"\x12ListPriceSchedules\x12\".product.ListPriceSchedulesRequest\x1a#.product.ListPriceSchedulesResponse\x12T\n" +
+	"\x0fGetPriceHistory\x12\x1f.product.GetPriceHistoryRequest\x1a .product.GetPriceHistoryResponse\x12T\n" +
+	"\x0fCreateFlashSale\x12\x1f.product.CreateFlashSaleRequest\x1a .product.CreateFlashSaleResponse\x12T\n" +
+	"\x0fCancelFlashSale\x12\x1f.product.CancelFlashSaleRequest\x1a .product.CancelFlashSaleResponse\x12Q\n" +
+	"\x0eListFlashSales\x12\x1e.product.ListFlashSalesRequest\x1a\x1f.product.ListFlashSalesResponse\x12K\n" +
+	"\fGetFlashSale\x12\x1c.product.GetFlashSaleRequest\x1a\x1d.product.GetFlashSaleResponse\x12K\n" +
+	"\fFlashSaleBuy\x12\x1c.product.FlashSaleBuyRequest\x1a\x1d.product.FlashSaleBuyResponse\x12f\n" +
+	"\x15ReleaseFlashSaleLimit\x12%.product.ReleaseFlashSaleLimitRequest\x1a&.product.ReleaseFlashSaleLimitResponse\x12i\n" +
+	"\x16ListInventoryMovements\x12&.product.ListInventoryMovementsRequest\x1a'.product.ListInventoryMovementsResponse\x12r\n" +
+	"\x19CheckInventoryConsistency\x12).product.CheckInventoryConsistencyRequest\x1a*.product.CheckInventoryConsistencyResponse\x12T\n" +
+	"\x0fCreateWarehouse\x12\x1f.product.CreateWarehouseRequest\x1a .product.CreateWarehouseResponse\x12T\n" +
+	"\x0fUpdateWarehouse\x12\x1f.product.UpdateWarehouseRequest\x1a .product.UpdateWarehouseResponse\x12Q\n" +
+	"\x0eListWarehouses\x12\x1e.product.ListWarehousesRequest\x1a\x1f.product.ListWarehousesResponse\x12N\n" +
+	"\rTransferStock\x12\x1d.product.TransferStockRequest\x1a\x1e.product.TransferStockResponse\x12Z\n" +
+	"\x11SetStockThreshold\x12!.product.SetStockThresholdRequest\x1a\".product.SetStockThresholdResponse\x12Z\n" +
+	"\x11SetPurchaseLimits\x12!.product.SetPurchaseLimitsRequest\x1a\".product.SetPurchaseLimitsResponse\x12c\n" +
+	"\x14ListLowStockProducts\x12$.product.ListLowStockProductsRequest\x1a%.product.ListLowStockProductsResponse\x12W\n" +
+	"\x10SubscribeRestock\x12 .product.SubscribeRestockRequest\x1a!.product.SubscribeRestockResponse\x12]\n" +
+	"\x12UnsubscribeRestock\x12\".product.UnsubscribeRestockRequest\x1a#.product.UnsubscribeRestockResponse\x12]\n" +
+	"\x12GetRelatedProducts\x12\".product.GetRelatedProductsRequest\x1a#.product.GetRelatedProductsResponse\x12Q\n" +
+	"\x0eSetBundleItems\x12\x1e.product.SetBundleItemsRequest\x1a\x1f.product.SetBundleItemsResponse\x12B\n" +
+	"\tGetBundle\x12\x19.product.GetBundleRequest\x1a\x1a.product.GetBundleResponseB\vZ\t./productb\x06proto3"
 
 var (
 	file_product_proto_rawDescOnce sync.Once
@@ -1445,62 +6210,211 @@ func file_product_proto_rawDescGZIP() []byte {
 	return file_product_proto_rawDescData
 }
 
-var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_product_proto_msgTypes = make([]protoimpl.MessageInfo, 94)
 var file_product_proto_goTypes = []any{
-	(*AddProductRequest)(nil),        // 0: product.AddProductRequest
-	(*AddProductResponse)(nil),       // 1: product.AddProductResponse
-	(*UpdateProductRequest)(nil),     // 2: product.UpdateProductRequest
-	(*UpdateProductResponse)(nil),    // 3: product.UpdateProductResponse
-	(*GetProductRequest)(nil),        // 4: product.GetProductRequest
-	(*GetProductResponse)(nil),       // 5: product.GetProductResponse
-	(*ListProductsRequest)(nil),      // 6: product.ListProductsRequest
-	(*ListProductsResponse)(nil),     // 7: product.ListProductsResponse
-	(*SearchProductsRequest)(nil),    // 8: product.SearchProductsRequest
-	(*SearchProductsResponse)(nil),   // 9: product.SearchProductsResponse
-	(*UpdateStockRequest)(nil),       // 10: product.UpdateStockRequest
-	(*UpdateStockResponse)(nil),      // 11: product.UpdateStockResponse
-	(*CheckStockRequest)(nil),        // 12: product.CheckStockRequest
-	(*CheckStockResponse)(nil),       // 13: product.CheckStockResponse
-	(*StockItem)(nil),                // 14: product.StockItem
-	(*IncrementSalesRequest)(nil),    // 15: product.IncrementSalesRequest
-	(*IncrementSalesResponse)(nil),   // 16: product.IncrementSalesResponse
-	(*BatchUpdateStockRequest)(nil),  // 17: product.BatchUpdateStockRequest
-	(*BatchUpdateStockResponse)(nil), // 18: product.BatchUpdateStockResponse
-	(*StockUpdateItem)(nil),          // 19: product.StockUpdateItem
-	(*StockUpdateResult)(nil),        // 20: product.StockUpdateResult
-	(*ProductInfo)(nil),              // 21: product.ProductInfo
+	(*AddProductRequest)(nil),                 // 0: product.AddProductRequest
+	(*AddProductResponse)(nil),                // 1: product.AddProductResponse
+	(*UpdateProductRequest)(nil),              // 2: product.UpdateProductRequest
+	(*UpdateProductResponse)(nil),             // 3: product.UpdateProductResponse
+	(*GetProductRequest)(nil),                 // 4: product.GetProductRequest
+	(*GetProductResponse)(nil),                // 5: product.GetProductResponse
+	(*GetProductsRequest)(nil),                // 6: product.GetProductsRequest
+	(*GetProductsResponse)(nil),               // 7: product.GetProductsResponse
+	(*ProductResult)(nil),                     // 8: product.ProductResult
+	(*ListProductsRequest)(nil),               // 9: product.ListProductsRequest
+	(*ListProductsResponse)(nil),              // 10: product.ListProductsResponse
+	(*SearchProductsRequest)(nil),             // 11: product.SearchProductsRequest
+	(*SearchProductsResponse)(nil),            // 12: product.SearchProductsResponse
+	(*UpdateStockRequest)(nil),                // 13: product.UpdateStockRequest
+	(*UpdateStockResponse)(nil),               // 14: product.UpdateStockResponse
+	(*CheckStockRequest)(nil),                 // 15: product.CheckStockRequest
+	(*CheckStockResponse)(nil),                // 16: product.CheckStockResponse
+	(*StockItem)(nil),                         // 17: product.StockItem
+	(*WarehouseStock)(nil),                    // 18: product.WarehouseStock
+	(*IncrementSalesRequest)(nil),             // 19: product.IncrementSalesRequest
+	(*IncrementSalesResponse)(nil),            // 20: product.IncrementSalesResponse
+	(*BatchUpdateStockRequest)(nil),           // 21: product.BatchUpdateStockRequest
+	(*BatchUpdateStockResponse)(nil),          // 22: product.BatchUpdateStockResponse
+	(*StockUpdateItem)(nil),                   // 23: product.StockUpdateItem
+	(*StockUpdateResult)(nil),                 // 24: product.StockUpdateResult
+	(*DeleteProductRequest)(nil),              // 25: product.DeleteProductRequest
+	(*DeleteProductResponse)(nil),             // 26: product.DeleteProductResponse
+	(*RestoreProductRequest)(nil),             // 27: product.RestoreProductRequest
+	(*RestoreProductResponse)(nil),            // 28: product.RestoreProductResponse
+	(*SetProductStatusRequest)(nil),           // 29: product.SetProductStatusRequest
+	(*SetProductStatusResponse)(nil),          // 30: product.SetProductStatusResponse
+	(*ImportProductsRequest)(nil),             // 31: product.ImportProductsRequest
+	(*ImportProductsResponse)(nil),            // 32: product.ImportProductsResponse
+	(*ImportRowError)(nil),                    // 33: product.ImportRowError
+	(*ExportProductsRequest)(nil),             // 34: product.ExportProductsRequest
+	(*ExportProductsChunk)(nil),               // 35: product.ExportProductsChunk
+	(*SchedulePriceChangeRequest)(nil),        // 36: product.SchedulePriceChangeRequest
+	(*SchedulePriceChangeResponse)(nil),       // 37: product.SchedulePriceChangeResponse
+	(*CancelPriceScheduleRequest)(nil),        // 38: product.CancelPriceScheduleRequest
+	(*CancelPriceScheduleResponse)(nil),       // 39: product.CancelPriceScheduleResponse
+	(*ListPriceSchedulesRequest)(nil),         // 40: product.ListPriceSchedulesRequest
+	(*ListPriceSchedulesResponse)(nil),        // 41: product.ListPriceSchedulesResponse
+	(*PriceSchedule)(nil),                     // 42: product.PriceSchedule
+	(*GetPriceHistoryRequest)(nil),            // 43: product.GetPriceHistoryRequest
+	(*GetPriceHistoryResponse)(nil),           // 44: product.GetPriceHistoryResponse
+	(*PriceHistory)(nil),                      // 45: product.PriceHistory
+	(*ProductInfo)(nil),                       // 46: product.ProductInfo
+	(*CreateFlashSaleRequest)(nil),            // 47: product.CreateFlashSaleRequest
+	(*CreateFlashSaleResponse)(nil),           // 48: product.CreateFlashSaleResponse
+	(*CancelFlashSaleRequest)(nil),            // 49: product.CancelFlashSaleRequest
+	(*CancelFlashSaleResponse)(nil),           // 50: product.CancelFlashSaleResponse
+	(*ListFlashSalesRequest)(nil),             // 51: product.ListFlashSalesRequest
+	(*ListFlashSalesResponse)(nil),            // 52: product.ListFlashSalesResponse
+	(*GetFlashSaleRequest)(nil),               // 53: product.GetFlashSaleRequest
+	(*GetFlashSaleResponse)(nil),              // 54: product.GetFlashSaleResponse
+	(*FlashSale)(nil),                         // 55: product.FlashSale
+	(*FlashSaleBuyRequest)(nil),               // 56: product.FlashSaleBuyRequest
+	(*FlashSaleBuyResponse)(nil),              // 57: product.FlashSaleBuyResponse
+	(*ReleaseFlashSaleLimitRequest)(nil),      // 58: product.ReleaseFlashSaleLimitRequest
+	(*ReleaseFlashSaleLimitResponse)(nil),     // 59: product.ReleaseFlashSaleLimitResponse
+	(*ListInventoryMovementsRequest)(nil),     // 60: product.ListInventoryMovementsRequest
+	(*ListInventoryMovementsResponse)(nil),    // 61: product.ListInventoryMovementsResponse
+	(*InventoryMovement)(nil),                 // 62: product.InventoryMovement
+	(*CheckInventoryConsistencyRequest)(nil),  // 63: product.CheckInventoryConsistencyRequest
+	(*CheckInventoryConsistencyResponse)(nil), // 64: product.CheckInventoryConsistencyResponse
+	(*StockDiscrepancy)(nil),                  // 65: product.StockDiscrepancy
+	(*CreateWarehouseRequest)(nil),            // 66: product.CreateWarehouseRequest
+	(*CreateWarehouseResponse)(nil),           // 67: product.CreateWarehouseResponse
+	(*UpdateWarehouseRequest)(nil),            // 68: product.UpdateWarehouseRequest
+	(*UpdateWarehouseResponse)(nil),           // 69: product.UpdateWarehouseResponse
+	(*ListWarehousesRequest)(nil),             // 70: product.ListWarehousesRequest
+	(*ListWarehousesResponse)(nil),            // 71: product.ListWarehousesResponse
+	(*Warehouse)(nil),                         // 72: product.Warehouse
+	(*TransferStockRequest)(nil),              // 73: product.TransferStockRequest
+	(*TransferStockResponse)(nil),             // 74: product.TransferStockResponse
+	(*SetStockThresholdRequest)(nil),          // 75: product.SetStockThresholdRequest
+	(*SetStockThresholdResponse)(nil),         // 76: product.SetStockThresholdResponse
+	(*SetPurchaseLimitsRequest)(nil),          // 77: product.SetPurchaseLimitsRequest
+	(*SetPurchaseLimitsResponse)(nil),         // 78: product.SetPurchaseLimitsResponse
+	(*ListLowStockProductsRequest)(nil),       // 79: product.ListLowStockProductsRequest
+	(*ListLowStockProductsResponse)(nil),      // 80: product.ListLowStockProductsResponse
+	(*LowStockProduct)(nil),                   // 81: product.LowStockProduct
+	(*SubscribeRestockRequest)(nil),           // 82: product.SubscribeRestockRequest
+	(*SubscribeRestockResponse)(nil),          // 83: product.SubscribeRestockResponse
+	(*UnsubscribeRestockRequest)(nil),         // 84: product.UnsubscribeRestockRequest
+	(*UnsubscribeRestockResponse)(nil),        // 85: product.UnsubscribeRestockResponse
+	(*GetRelatedProductsRequest)(nil),         // 86: product.GetRelatedProductsRequest
+	(*GetRelatedProductsResponse)(nil),        // 87: product.GetRelatedProductsResponse
+	(*BundleItem)(nil),                        // 88: product.BundleItem
+	(*SetBundleItemsRequest)(nil),             // 89: product.SetBundleItemsRequest
+	(*SetBundleItemsResponse)(nil),            // 90: product.SetBundleItemsResponse
+	(*GetBundleRequest)(nil),                  // 91: product.GetBundleRequest
+	(*GetBundleResponse)(nil),                 // 92: product.GetBundleResponse
+	(*BundleComponent)(nil),                   // 93: product.BundleComponent
 }
 var file_product_proto_depIdxs = []int32{
-	21, // 0: product.GetProductResponse.product:type_name -> product.ProductInfo
-	21, // 1: product.ListProductsResponse.products:type_name -> product.ProductInfo
-	21, // 2: product.SearchProductsResponse.products:type_name -> product.ProductInfo
-	14, // 3: product.CheckStockRequest.items:type_name -> product.StockItem
-	14, // 4: product.CheckStockResponse.items:type_name -> product.StockItem
-	19, // 5: product.BatchUpdateStockRequest.items:type_name -> product.StockUpdateItem
-	20, // 6: product.BatchUpdateStockResponse.results:type_name -> product.StockUpdateResult
-	0,  // 7: product.Product.AddProduct:input_type -> product.AddProductRequest
-	2,  // 8: product.Product.UpdateProduct:input_type -> product.UpdateProductRequest
-	4,  // 9: product.Product.GetProduct:input_type -> product.GetProductRequest
-	6,  // 10: product.Product.ListProducts:input_type -> product.ListProductsRequest
-	8,  // 11: product.Product.SearchProducts:input_type -> product.SearchProductsRequest
-	10, // 12: product.Product.UpdateStock:input_type -> product.UpdateStockRequest
-	12, // 13: product.Product.CheckStock:input_type -> product.CheckStockRequest
-	15, // 14: product.Product.IncrementSales:input_type -> product.IncrementSalesRequest
-	17, // 15: product.Product.BatchUpdateStock:input_type -> product.BatchUpdateStockRequest
-	1,  // 16: product.Product.AddProduct:output_type -> product.AddProductResponse
-	3,  // 17: product.Product.UpdateProduct:output_type -> product.UpdateProductResponse
-	5,  // 18: product.Product.GetProduct:output_type -> product.GetProductResponse
-	7,  // 19: product.Product.ListProducts:output_type -> product.ListProductsResponse
-	9,  // 20: product.Product.SearchProducts:output_type -> product.SearchProductsResponse
-	11, // 21: product.Product.UpdateStock:output_type -> product.UpdateStockResponse
-	13, // 22: product.Product.CheckStock:output_type -> product.CheckStockResponse
-	16, // 23: product.Product.IncrementSales:output_type -> product.IncrementSalesResponse
-	18, // 24: product.Product.BatchUpdateStock:output_type -> product.BatchUpdateStockResponse
-	16, // [16:25] is the sub-list for method output_type
-	7,  // [7:16] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	46, // 0: product.GetProductResponse.product:type_name -> product.ProductInfo
+	8,  // 1: product.GetProductsResponse.results:type_name -> product.ProductResult
+	46, // 2: product.ProductResult.product:type_name -> product.ProductInfo
+	46, // 3: product.ListProductsResponse.products:type_name -> product.ProductInfo
+	46, // 4: product.SearchProductsResponse.products:type_name -> product.ProductInfo
+	17, // 5: product.CheckStockRequest.items:type_name -> product.StockItem
+	17, // 6: product.CheckStockResponse.items:type_name -> product.StockItem
+	18, // 7: product.StockItem.warehouses:type_name -> product.WarehouseStock
+	23, // 8: product.BatchUpdateStockRequest.items:type_name -> product.StockUpdateItem
+	24, // 9: product.BatchUpdateStockResponse.results:type_name -> product.StockUpdateResult
+	33, // 10: product.ImportProductsResponse.errors:type_name -> product.ImportRowError
+	42, // 11: product.ListPriceSchedulesResponse.schedules:type_name -> product.PriceSchedule
+	45, // 12: product.GetPriceHistoryResponse.history:type_name -> product.PriceHistory
+	55, // 13: product.ListFlashSalesResponse.sales:type_name -> product.FlashSale
+	55, // 14: product.GetFlashSaleResponse.sale:type_name -> product.FlashSale
+	62, // 15: product.ListInventoryMovementsResponse.movements:type_name -> product.InventoryMovement
+	65, // 16: product.CheckInventoryConsistencyResponse.discrepancies:type_name -> product.StockDiscrepancy
+	72, // 17: product.ListWarehousesResponse.warehouses:type_name -> product.Warehouse
+	81, // 18: product.ListLowStockProductsResponse.products:type_name -> product.LowStockProduct
+	46, // 19: product.GetRelatedProductsResponse.products:type_name -> product.ProductInfo
+	88, // 20: product.SetBundleItemsRequest.items:type_name -> product.BundleItem
+	46, // 21: product.GetBundleResponse.bundle:type_name -> product.ProductInfo
+	93, // 22: product.GetBundleResponse.components:type_name -> product.BundleComponent
+	46, // 23: product.BundleComponent.product:type_name -> product.ProductInfo
+	0,  // 24: product.Product.AddProduct:input_type -> product.AddProductRequest
+	2,  // 25: product.Product.UpdateProduct:input_type -> product.UpdateProductRequest
+	4,  // 26: product.Product.GetProduct:input_type -> product.GetProductRequest
+	6,  // 27: product.Product.GetProducts:input_type -> product.GetProductsRequest
+	9,  // 28: product.Product.ListProducts:input_type -> product.ListProductsRequest
+	11, // 29: product.Product.SearchProducts:input_type -> product.SearchProductsRequest
+	13, // 30: product.Product.UpdateStock:input_type -> product.UpdateStockRequest
+	15, // 31: product.Product.CheckStock:input_type -> product.CheckStockRequest
+	19, // 32: product.Product.IncrementSales:input_type -> product.IncrementSalesRequest
+	21, // 33: product.Product.BatchUpdateStock:input_type -> product.BatchUpdateStockRequest
+	25, // 34: product.Product.DeleteProduct:input_type -> product.DeleteProductRequest
+	27, // 35: product.Product.RestoreProduct:input_type -> product.RestoreProductRequest
+	29, // 36: product.Product.SetProductStatus:input_type -> product.SetProductStatusRequest
+	31, // 37: product.Product.ImportProducts:input_type -> product.ImportProductsRequest
+	34, // 38: product.Product.ExportProducts:input_type -> product.ExportProductsRequest
+	36, // 39: product.Product.SchedulePriceChange:input_type -> product.SchedulePriceChangeRequest
+	38, // 40: product.Product.CancelPriceSchedule:input_type -> product.CancelPriceScheduleRequest
+	40, // 41: product.Product.ListPriceSchedules:input_type -> product.ListPriceSchedulesRequest
+	43, // 42: product.Product.GetPriceHistory:input_type -> product.GetPriceHistoryRequest
+	47, // 43: product.Product.CreateFlashSale:input_type -> product.CreateFlashSaleRequest
+	49, // 44: product.Product.CancelFlashSale:input_type -> product.CancelFlashSaleRequest
+	51, // 45: product.Product.ListFlashSales:input_type -> product.ListFlashSalesRequest
+	53, // 46: product.Product.GetFlashSale:input_type -> product.GetFlashSaleRequest
+	56, // 47: product.Product.FlashSaleBuy:input_type -> product.FlashSaleBuyRequest
+	58, // 48: product.Product.ReleaseFlashSaleLimit:input_type -> product.ReleaseFlashSaleLimitRequest
+	60, // 49: product.Product.ListInventoryMovements:input_type -> product.ListInventoryMovementsRequest
+	63, // 50: product.Product.CheckInventoryConsistency:input_type -> product.CheckInventoryConsistencyRequest
+	66, // 51: product.Product.CreateWarehouse:input_type -> product.CreateWarehouseRequest
+	68, // 52: product.Product.UpdateWarehouse:input_type -> product.UpdateWarehouseRequest
+	70, // 53: product.Product.ListWarehouses:input_type -> product.ListWarehousesRequest
+	73, // 54: product.Product.TransferStock:input_type -> product.TransferStockRequest
+	75, // 55: product.Product.SetStockThreshold:input_type -> product.SetStockThresholdRequest
+	77, // 56: product.Product.SetPurchaseLimits:input_type -> product.SetPurchaseLimitsRequest
+	79, // 57: product.Product.ListLowStockProducts:input_type -> product.ListLowStockProductsRequest
+	82, // 58: product.Product.SubscribeRestock:input_type -> product.SubscribeRestockRequest
+	84, // 59: product.Product.UnsubscribeRestock:input_type -> product.UnsubscribeRestockRequest
+	86, // 60: product.Product.GetRelatedProducts:input_type -> product.GetRelatedProductsRequest
+	89, // 61: product.Product.SetBundleItems:input_type -> product.SetBundleItemsRequest
+	91, // 62: product.Product.GetBundle:input_type -> product.GetBundleRequest
+	1,  // 63: product.Product.AddProduct:output_type -> product.AddProductResponse
+	3,  // 64: product.Product.UpdateProduct:output_type -> product.UpdateProductResponse
+	5,  // 65: product.Product.GetProduct:output_type -> product.GetProductResponse
+	7,  // 66: product.Product.GetProducts:output_type -> product.GetProductsResponse
+	10, // 67: product.Product.ListProducts:output_type -> product.ListProductsResponse
+	12, // 68: product.Product.SearchProducts:output_type -> product.SearchProductsResponse
+	14, // 69: product.Product.UpdateStock:output_type -> product.UpdateStockResponse
+	16, // 70: product.Product.CheckStock:output_type -> product.CheckStockResponse
+	20, // 71: product.Product.IncrementSales:output_type -> product.IncrementSalesResponse
+	22, // 72: product.Product.BatchUpdateStock:output_type -> product.BatchUpdateStockResponse
+	26, // 73: product.Product.DeleteProduct:output_type -> product.DeleteProductResponse
+	28, // 74: product.Product.RestoreProduct:output_type -> product.RestoreProductResponse
+	30, // 75: product.Product.SetProductStatus:output_type -> product.SetProductStatusResponse
+	32, // 76: product.Product.ImportProducts:output_type -> product.ImportProductsResponse
+	35, // 77: product.Product.ExportProducts:output_type -> product.ExportProductsChunk
+	37, // 78: product.Product.SchedulePriceChange:output_type -> product.SchedulePriceChangeResponse
+	39, // 79: product.Product.CancelPriceSchedule:output_type -> product.CancelPriceScheduleResponse
+	41, // 80: product.Product.ListPriceSchedules:output_type -> product.ListPriceSchedulesResponse
+	44, // 81: product.Product.GetPriceHistory:output_type -> product.GetPriceHistoryResponse
+	48, // 82: product.Product.CreateFlashSale:output_type -> product.CreateFlashSaleResponse
+	50, // 83: product.Product.CancelFlashSale:output_type -> product.CancelFlashSaleResponse
+	52, // 84: product.Product.ListFlashSales:output_type -> product.ListFlashSalesResponse
+	54, // 85: product.Product.GetFlashSale:output_type -> product.GetFlashSaleResponse
+	57, // 86: product.Product.FlashSaleBuy:output_type -> product.FlashSaleBuyResponse
+	59, // 87: product.Product.ReleaseFlashSaleLimit:output_type -> product.ReleaseFlashSaleLimitResponse
+	61, // 88: product.Product.ListInventoryMovements:output_type -> product.ListInventoryMovementsResponse
+	64, // 89: product.Product.CheckInventoryConsistency:output_type -> product.CheckInventoryConsistencyResponse
+	67, // 90: product.Product.CreateWarehouse:output_type -> product.CreateWarehouseResponse
+	69, // 91: product.Product.UpdateWarehouse:output_type -> product.UpdateWarehouseResponse
+	71, // 92: product.Product.ListWarehouses:output_type -> product.ListWarehousesResponse
+	74, // 93: product.Product.TransferStock:output_type -> product.TransferStockResponse
+	76, // 94: product.Product.SetStockThreshold:output_type -> product.SetStockThresholdResponse
+	78, // 95: product.Product.SetPurchaseLimits:output_type -> product.SetPurchaseLimitsResponse
+	80, // 96: product.Product.ListLowStockProducts:output_type -> product.ListLowStockProductsResponse
+	83, // 97: product.Product.SubscribeRestock:output_type -> product.SubscribeRestockResponse
+	85, // 98: product.Product.UnsubscribeRestock:output_type -> product.UnsubscribeRestockResponse
+	87, // 99: product.Product.GetRelatedProducts:output_type -> product.GetRelatedProductsResponse
+	90, // 100: product.Product.SetBundleItems:output_type -> product.SetBundleItemsResponse
+	92, // 101: product.Product.GetBundle:output_type -> product.GetBundleResponse
+	63, // [63:102] is the sub-list for method output_type
+	24, // [24:63] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_product_proto_init() }
@@ -1514,7 +6428,7 @@ func file_product_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_proto_rawDesc), len(file_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   94,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
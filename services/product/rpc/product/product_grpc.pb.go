@@ -19,15 +19,45 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Product_AddProduct_FullMethodName       = "/product.Product/AddProduct"
-	Product_UpdateProduct_FullMethodName    = "/product.Product/UpdateProduct"
-	Product_GetProduct_FullMethodName       = "/product.Product/GetProduct"
-	Product_ListProducts_FullMethodName     = "/product.Product/ListProducts"
-	Product_SearchProducts_FullMethodName   = "/product.Product/SearchProducts"
-	Product_UpdateStock_FullMethodName      = "/product.Product/UpdateStock"
-	Product_CheckStock_FullMethodName       = "/product.Product/CheckStock"
-	Product_IncrementSales_FullMethodName   = "/product.Product/IncrementSales"
-	Product_BatchUpdateStock_FullMethodName = "/product.Product/BatchUpdateStock"
+	Product_AddProduct_FullMethodName                = "/product.Product/AddProduct"
+	Product_UpdateProduct_FullMethodName             = "/product.Product/UpdateProduct"
+	Product_GetProduct_FullMethodName                = "/product.Product/GetProduct"
+	Product_GetProducts_FullMethodName               = "/product.Product/GetProducts"
+	Product_ListProducts_FullMethodName              = "/product.Product/ListProducts"
+	Product_SearchProducts_FullMethodName            = "/product.Product/SearchProducts"
+	Product_UpdateStock_FullMethodName               = "/product.Product/UpdateStock"
+	Product_CheckStock_FullMethodName                = "/product.Product/CheckStock"
+	Product_IncrementSales_FullMethodName            = "/product.Product/IncrementSales"
+	Product_BatchUpdateStock_FullMethodName          = "/product.Product/BatchUpdateStock"
+	Product_DeleteProduct_FullMethodName             = "/product.Product/DeleteProduct"
+	Product_RestoreProduct_FullMethodName            = "/product.Product/RestoreProduct"
+	Product_SetProductStatus_FullMethodName          = "/product.Product/SetProductStatus"
+	Product_ImportProducts_FullMethodName            = "/product.Product/ImportProducts"
+	Product_ExportProducts_FullMethodName            = "/product.Product/ExportProducts"
+	Product_SchedulePriceChange_FullMethodName       = "/product.Product/SchedulePriceChange"
+	Product_CancelPriceSchedule_FullMethodName       = "/product.Product/CancelPriceSchedule"
+	Product_ListPriceSchedules_FullMethodName        = "/product.Product/ListPriceSchedules"
+	Product_GetPriceHistory_FullMethodName           = "/product.Product/GetPriceHistory"
+	Product_CreateFlashSale_FullMethodName           = "/product.Product/CreateFlashSale"
+	Product_CancelFlashSale_FullMethodName           = "/product.Product/CancelFlashSale"
+	Product_ListFlashSales_FullMethodName            = "/product.Product/ListFlashSales"
+	Product_GetFlashSale_FullMethodName              = "/product.Product/GetFlashSale"
+	Product_FlashSaleBuy_FullMethodName              = "/product.Product/FlashSaleBuy"
+	Product_ReleaseFlashSaleLimit_FullMethodName     = "/product.Product/ReleaseFlashSaleLimit"
+	Product_ListInventoryMovements_FullMethodName    = "/product.Product/ListInventoryMovements"
+	Product_CheckInventoryConsistency_FullMethodName = "/product.Product/CheckInventoryConsistency"
+	Product_CreateWarehouse_FullMethodName           = "/product.Product/CreateWarehouse"
+	Product_UpdateWarehouse_FullMethodName           = "/product.Product/UpdateWarehouse"
+	Product_ListWarehouses_FullMethodName            = "/product.Product/ListWarehouses"
+	Product_TransferStock_FullMethodName             = "/product.Product/TransferStock"
+	Product_SetStockThreshold_FullMethodName         = "/product.Product/SetStockThreshold"
+	Product_SetPurchaseLimits_FullMethodName         = "/product.Product/SetPurchaseLimits"
+	Product_ListLowStockProducts_FullMethodName      = "/product.Product/ListLowStockProducts"
+	Product_SubscribeRestock_FullMethodName          = "/product.Product/SubscribeRestock"
+	Product_UnsubscribeRestock_FullMethodName        = "/product.Product/UnsubscribeRestock"
+	Product_GetRelatedProducts_FullMethodName        = "/product.Product/GetRelatedProducts"
+	Product_SetBundleItems_FullMethodName            = "/product.Product/SetBundleItems"
+	Product_GetBundle_FullMethodName                 = "/product.Product/GetBundle"
 )
 
 // ProductClient is the client API for Product service.
@@ -40,6 +70,8 @@ type ProductClient interface {
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
 	// Get product detail
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error)
+	// Get multiple products at once, results follow the order of the requested IDs
+	GetProducts(ctx context.Context, in *GetProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error)
 	// List products with pagination
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
 	// Search products by keyword
@@ -52,6 +84,64 @@ type ProductClient interface {
 	IncrementSales(ctx context.Context, in *IncrementSalesRequest, opts ...grpc.CallOption) (*IncrementSalesResponse, error)
 	// Batch update stock for multiple products (transactional)
 	BatchUpdateStock(ctx context.Context, in *BatchUpdateStockRequest, opts ...grpc.CallOption) (*BatchUpdateStockResponse, error)
+	// Soft delete product (admin)
+	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	// Restore a soft deleted product as unpublished (admin)
+	RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductResponse, error)
+	// Change product status: draft, published, unpublished, optionally scheduled (admin)
+	SetProductStatus(ctx context.Context, in *SetProductStatusRequest, opts ...grpc.CallOption) (*SetProductStatusResponse, error)
+	// Bulk import products from CSV or JSON Lines, upsert by SKU (admin)
+	ImportProducts(ctx context.Context, in *ImportProductsRequest, opts ...grpc.CallOption) (*ImportProductsResponse, error)
+	// Export product catalog as CSV or JSON Lines, streamed in chunks (admin)
+	ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductsChunk], error)
+	// Schedule a future price change, optionally reverted at end_at (admin)
+	SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*SchedulePriceChangeResponse, error)
+	// Cancel a pending or active price schedule, active ones are reverted (admin)
+	CancelPriceSchedule(ctx context.Context, in *CancelPriceScheduleRequest, opts ...grpc.CallOption) (*CancelPriceScheduleResponse, error)
+	// List price schedules of a product (admin)
+	ListPriceSchedules(ctx context.Context, in *ListPriceSchedulesRequest, opts ...grpc.CallOption) (*ListPriceSchedulesResponse, error)
+	// Get price change history of a product (admin)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	// Create a flash sale, its quantity is reserved from product stock (admin)
+	CreateFlashSale(ctx context.Context, in *CreateFlashSaleRequest, opts ...grpc.CallOption) (*CreateFlashSaleResponse, error)
+	// Cancel an open flash sale, unsold quantity goes back to product stock (admin)
+	CancelFlashSale(ctx context.Context, in *CancelFlashSaleRequest, opts ...grpc.CallOption) (*CancelFlashSaleResponse, error)
+	// List flash sales (admin)
+	ListFlashSales(ctx context.Context, in *ListFlashSalesRequest, opts ...grpc.CallOption) (*ListFlashSalesResponse, error)
+	// Get flash sale detail with remaining quantity
+	GetFlashSale(ctx context.Context, in *GetFlashSaleRequest, opts ...grpc.CallOption) (*GetFlashSaleResponse, error)
+	// Buy from a flash sale, stock is deducted in Redis and the order is queued through Kafka
+	FlashSaleBuy(ctx context.Context, in *FlashSaleBuyRequest, opts ...grpc.CallOption) (*FlashSaleBuyResponse, error)
+	// Give back the per-user purchase count of a cancelled flash sale order
+	ReleaseFlashSaleLimit(ctx context.Context, in *ReleaseFlashSaleLimitRequest, opts ...grpc.CallOption) (*ReleaseFlashSaleLimitResponse, error)
+	// List inventory movements of a product (admin)
+	ListInventoryMovements(ctx context.Context, in *ListInventoryMovementsRequest, opts ...grpc.CallOption) (*ListInventoryMovementsResponse, error)
+	// Recompute stock from inventory movements and report products that do not match (admin)
+	CheckInventoryConsistency(ctx context.Context, in *CheckInventoryConsistencyRequest, opts ...grpc.CallOption) (*CheckInventoryConsistencyResponse, error)
+	// Create a warehouse (admin)
+	CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*CreateWarehouseResponse, error)
+	// Update name, region and priority of a warehouse (admin)
+	UpdateWarehouse(ctx context.Context, in *UpdateWarehouseRequest, opts ...grpc.CallOption) (*UpdateWarehouseResponse, error)
+	// List warehouses, the default warehouse first (admin)
+	ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...grpc.CallOption) (*ListWarehousesResponse, error)
+	// Move stock of a product between warehouses (admin)
+	TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error)
+	// Set the low-stock alert threshold of a product, 0 disables alerts (admin)
+	SetStockThreshold(ctx context.Context, in *SetStockThresholdRequest, opts ...grpc.CallOption) (*SetStockThresholdResponse, error)
+	// Set the purchase limits of a product, enforced by cart and order (admin)
+	SetPurchaseLimits(ctx context.Context, in *SetPurchaseLimitsRequest, opts ...grpc.CallOption) (*SetPurchaseLimitsResponse, error)
+	// List products at or below their low-stock threshold (admin)
+	ListLowStockProducts(ctx context.Context, in *ListLowStockProductsRequest, opts ...grpc.CallOption) (*ListLowStockProductsResponse, error)
+	// Subscribe to a back-in-stock notification of an out-of-stock product
+	SubscribeRestock(ctx context.Context, in *SubscribeRestockRequest, opts ...grpc.CallOption) (*SubscribeRestockResponse, error)
+	// Cancel a pending back-in-stock subscription
+	UnsubscribeRestock(ctx context.Context, in *UnsubscribeRestockRequest, opts ...grpc.CallOption) (*UnsubscribeRestockResponse, error)
+	// Get products frequently bought together with a product, topped up with best sellers of its category
+	GetRelatedProducts(ctx context.Context, in *GetRelatedProductsRequest, opts ...grpc.CallOption) (*GetRelatedProductsResponse, error)
+	// Set the components of a bundle product, no components makes it a standalone product again (admin)
+	SetBundleItems(ctx context.Context, in *SetBundleItemsRequest, opts ...grpc.CallOption) (*SetBundleItemsResponse, error)
+	// Get the components of a bundle product and how many bundles they can make
+	GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*GetBundleResponse, error)
 }
 
 type productClient struct {
@@ -92,6 +182,16 @@ func (c *productClient) GetProduct(ctx context.Context, in *GetProductRequest, o
 	return out, nil
 }
 
+func (c *productClient) GetProducts(ctx context.Context, in *GetProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductsResponse)
+	err := c.cc.Invoke(ctx, Product_GetProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProductsResponse)
@@ -152,6 +252,305 @@ func (c *productClient) BatchUpdateStock(ctx context.Context, in *BatchUpdateSto
 	return out, nil
 }
 
+func (c *productClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductResponse)
+	err := c.cc.Invoke(ctx, Product_DeleteProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreProductResponse)
+	err := c.cc.Invoke(ctx, Product_RestoreProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) SetProductStatus(ctx context.Context, in *SetProductStatusRequest, opts ...grpc.CallOption) (*SetProductStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProductStatusResponse)
+	err := c.cc.Invoke(ctx, Product_SetProductStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ImportProducts(ctx context.Context, in *ImportProductsRequest, opts ...grpc.CallOption) (*ImportProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportProductsResponse)
+	err := c.cc.Invoke(ctx, Product_ImportProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductsChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Product_ServiceDesc.Streams[0], Product_ExportProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportProductsRequest, ExportProductsChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Product_ExportProductsClient = grpc.ServerStreamingClient[ExportProductsChunk]
+
+func (c *productClient) SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*SchedulePriceChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchedulePriceChangeResponse)
+	err := c.cc.Invoke(ctx, Product_SchedulePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CancelPriceSchedule(ctx context.Context, in *CancelPriceScheduleRequest, opts ...grpc.CallOption) (*CancelPriceScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelPriceScheduleResponse)
+	err := c.cc.Invoke(ctx, Product_CancelPriceSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ListPriceSchedules(ctx context.Context, in *ListPriceSchedulesRequest, opts ...grpc.CallOption) (*ListPriceSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPriceSchedulesResponse)
+	err := c.cc.Invoke(ctx, Product_ListPriceSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Product_GetPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CreateFlashSale(ctx context.Context, in *CreateFlashSaleRequest, opts ...grpc.CallOption) (*CreateFlashSaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateFlashSaleResponse)
+	err := c.cc.Invoke(ctx, Product_CreateFlashSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CancelFlashSale(ctx context.Context, in *CancelFlashSaleRequest, opts ...grpc.CallOption) (*CancelFlashSaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelFlashSaleResponse)
+	err := c.cc.Invoke(ctx, Product_CancelFlashSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ListFlashSales(ctx context.Context, in *ListFlashSalesRequest, opts ...grpc.CallOption) (*ListFlashSalesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlashSalesResponse)
+	err := c.cc.Invoke(ctx, Product_ListFlashSales_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetFlashSale(ctx context.Context, in *GetFlashSaleRequest, opts ...grpc.CallOption) (*GetFlashSaleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFlashSaleResponse)
+	err := c.cc.Invoke(ctx, Product_GetFlashSale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) FlashSaleBuy(ctx context.Context, in *FlashSaleBuyRequest, opts ...grpc.CallOption) (*FlashSaleBuyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlashSaleBuyResponse)
+	err := c.cc.Invoke(ctx, Product_FlashSaleBuy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ReleaseFlashSaleLimit(ctx context.Context, in *ReleaseFlashSaleLimitRequest, opts ...grpc.CallOption) (*ReleaseFlashSaleLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseFlashSaleLimitResponse)
+	err := c.cc.Invoke(ctx, Product_ReleaseFlashSaleLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ListInventoryMovements(ctx context.Context, in *ListInventoryMovementsRequest, opts ...grpc.CallOption) (*ListInventoryMovementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInventoryMovementsResponse)
+	err := c.cc.Invoke(ctx, Product_ListInventoryMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CheckInventoryConsistency(ctx context.Context, in *CheckInventoryConsistencyRequest, opts ...grpc.CallOption) (*CheckInventoryConsistencyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckInventoryConsistencyResponse)
+	err := c.cc.Invoke(ctx, Product_CheckInventoryConsistency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*CreateWarehouseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWarehouseResponse)
+	err := c.cc.Invoke(ctx, Product_CreateWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) UpdateWarehouse(ctx context.Context, in *UpdateWarehouseRequest, opts ...grpc.CallOption) (*UpdateWarehouseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateWarehouseResponse)
+	err := c.cc.Invoke(ctx, Product_UpdateWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...grpc.CallOption) (*ListWarehousesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWarehousesResponse)
+	err := c.cc.Invoke(ctx, Product_ListWarehouses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferStockResponse)
+	err := c.cc.Invoke(ctx, Product_TransferStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) SetStockThreshold(ctx context.Context, in *SetStockThresholdRequest, opts ...grpc.CallOption) (*SetStockThresholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetStockThresholdResponse)
+	err := c.cc.Invoke(ctx, Product_SetStockThreshold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) SetPurchaseLimits(ctx context.Context, in *SetPurchaseLimitsRequest, opts ...grpc.CallOption) (*SetPurchaseLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetPurchaseLimitsResponse)
+	err := c.cc.Invoke(ctx, Product_SetPurchaseLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) ListLowStockProducts(ctx context.Context, in *ListLowStockProductsRequest, opts ...grpc.CallOption) (*ListLowStockProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLowStockProductsResponse)
+	err := c.cc.Invoke(ctx, Product_ListLowStockProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) SubscribeRestock(ctx context.Context, in *SubscribeRestockRequest, opts ...grpc.CallOption) (*SubscribeRestockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeRestockResponse)
+	err := c.cc.Invoke(ctx, Product_SubscribeRestock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) UnsubscribeRestock(ctx context.Context, in *UnsubscribeRestockRequest, opts ...grpc.CallOption) (*UnsubscribeRestockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnsubscribeRestockResponse)
+	err := c.cc.Invoke(ctx, Product_UnsubscribeRestock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetRelatedProducts(ctx context.Context, in *GetRelatedProductsRequest, opts ...grpc.CallOption) (*GetRelatedProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRelatedProductsResponse)
+	err := c.cc.Invoke(ctx, Product_GetRelatedProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) SetBundleItems(ctx context.Context, in *SetBundleItemsRequest, opts ...grpc.CallOption) (*SetBundleItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBundleItemsResponse)
+	err := c.cc.Invoke(ctx, Product_SetBundleItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productClient) GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*GetBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBundleResponse)
+	err := c.cc.Invoke(ctx, Product_GetBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServer is the server API for Product service.
 // All implementations must embed UnimplementedProductServer
 // for forward compatibility.
@@ -162,6 +561,8 @@ type ProductServer interface {
 	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
 	// Get product detail
 	GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error)
+	// Get multiple products at once, results follow the order of the requested IDs
+	GetProducts(context.Context, *GetProductsRequest) (*GetProductsResponse, error)
 	// List products with pagination
 	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
 	// Search products by keyword
@@ -174,6 +575,64 @@ type ProductServer interface {
 	IncrementSales(context.Context, *IncrementSalesRequest) (*IncrementSalesResponse, error)
 	// Batch update stock for multiple products (transactional)
 	BatchUpdateStock(context.Context, *BatchUpdateStockRequest) (*BatchUpdateStockResponse, error)
+	// Soft delete product (admin)
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	// Restore a soft deleted product as unpublished (admin)
+	RestoreProduct(context.Context, *RestoreProductRequest) (*RestoreProductResponse, error)
+	// Change product status: draft, published, unpublished, optionally scheduled (admin)
+	SetProductStatus(context.Context, *SetProductStatusRequest) (*SetProductStatusResponse, error)
+	// Bulk import products from CSV or JSON Lines, upsert by SKU (admin)
+	ImportProducts(context.Context, *ImportProductsRequest) (*ImportProductsResponse, error)
+	// Export product catalog as CSV or JSON Lines, streamed in chunks (admin)
+	ExportProducts(*ExportProductsRequest, grpc.ServerStreamingServer[ExportProductsChunk]) error
+	// Schedule a future price change, optionally reverted at end_at (admin)
+	SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*SchedulePriceChangeResponse, error)
+	// Cancel a pending or active price schedule, active ones are reverted (admin)
+	CancelPriceSchedule(context.Context, *CancelPriceScheduleRequest) (*CancelPriceScheduleResponse, error)
+	// List price schedules of a product (admin)
+	ListPriceSchedules(context.Context, *ListPriceSchedulesRequest) (*ListPriceSchedulesResponse, error)
+	// Get price change history of a product (admin)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	// Create a flash sale, its quantity is reserved from product stock (admin)
+	CreateFlashSale(context.Context, *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error)
+	// Cancel an open flash sale, unsold quantity goes back to product stock (admin)
+	CancelFlashSale(context.Context, *CancelFlashSaleRequest) (*CancelFlashSaleResponse, error)
+	// List flash sales (admin)
+	ListFlashSales(context.Context, *ListFlashSalesRequest) (*ListFlashSalesResponse, error)
+	// Get flash sale detail with remaining quantity
+	GetFlashSale(context.Context, *GetFlashSaleRequest) (*GetFlashSaleResponse, error)
+	// Buy from a flash sale, stock is deducted in Redis and the order is queued through Kafka
+	FlashSaleBuy(context.Context, *FlashSaleBuyRequest) (*FlashSaleBuyResponse, error)
+	// Give back the per-user purchase count of a cancelled flash sale order
+	ReleaseFlashSaleLimit(context.Context, *ReleaseFlashSaleLimitRequest) (*ReleaseFlashSaleLimitResponse, error)
+	// List inventory movements of a product (admin)
+	ListInventoryMovements(context.Context, *ListInventoryMovementsRequest) (*ListInventoryMovementsResponse, error)
+	// Recompute stock from inventory movements and report products that do not match (admin)
+	CheckInventoryConsistency(context.Context, *CheckInventoryConsistencyRequest) (*CheckInventoryConsistencyResponse, error)
+	// Create a warehouse (admin)
+	CreateWarehouse(context.Context, *CreateWarehouseRequest) (*CreateWarehouseResponse, error)
+	// Update name, region and priority of a warehouse (admin)
+	UpdateWarehouse(context.Context, *UpdateWarehouseRequest) (*UpdateWarehouseResponse, error)
+	// List warehouses, the default warehouse first (admin)
+	ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error)
+	// Move stock of a product between warehouses (admin)
+	TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error)
+	// Set the low-stock alert threshold of a product, 0 disables alerts (admin)
+	SetStockThreshold(context.Context, *SetStockThresholdRequest) (*SetStockThresholdResponse, error)
+	// Set the purchase limits of a product, enforced by cart and order (admin)
+	SetPurchaseLimits(context.Context, *SetPurchaseLimitsRequest) (*SetPurchaseLimitsResponse, error)
+	// List products at or below their low-stock threshold (admin)
+	ListLowStockProducts(context.Context, *ListLowStockProductsRequest) (*ListLowStockProductsResponse, error)
+	// Subscribe to a back-in-stock notification of an out-of-stock product
+	SubscribeRestock(context.Context, *SubscribeRestockRequest) (*SubscribeRestockResponse, error)
+	// Cancel a pending back-in-stock subscription
+	UnsubscribeRestock(context.Context, *UnsubscribeRestockRequest) (*UnsubscribeRestockResponse, error)
+	// Get products frequently bought together with a product, topped up with best sellers of its category
+	GetRelatedProducts(context.Context, *GetRelatedProductsRequest) (*GetRelatedProductsResponse, error)
+	// Set the components of a bundle product, no components makes it a standalone product again (admin)
+	SetBundleItems(context.Context, *SetBundleItemsRequest) (*SetBundleItemsResponse, error)
+	// Get the components of a bundle product and how many bundles they can make
+	GetBundle(context.Context, *GetBundleRequest) (*GetBundleResponse, error)
 	mustEmbedUnimplementedProductServer()
 }
 
@@ -193,6 +652,9 @@ func (UnimplementedProductServer) UpdateProduct(context.Context, *UpdateProductR
 func (UnimplementedProductServer) GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetProduct not implemented")
 }
+func (UnimplementedProductServer) GetProducts(context.Context, *GetProductsRequest) (*GetProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProducts not implemented")
+}
 func (UnimplementedProductServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListProducts not implemented")
 }
@@ -211,6 +673,93 @@ func (UnimplementedProductServer) IncrementSales(context.Context, *IncrementSale
 func (UnimplementedProductServer) BatchUpdateStock(context.Context, *BatchUpdateStockRequest) (*BatchUpdateStockResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method BatchUpdateStock not implemented")
 }
+func (UnimplementedProductServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedProductServer) RestoreProduct(context.Context, *RestoreProductRequest) (*RestoreProductResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreProduct not implemented")
+}
+func (UnimplementedProductServer) SetProductStatus(context.Context, *SetProductStatusRequest) (*SetProductStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetProductStatus not implemented")
+}
+func (UnimplementedProductServer) ImportProducts(context.Context, *ImportProductsRequest) (*ImportProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportProducts not implemented")
+}
+func (UnimplementedProductServer) ExportProducts(*ExportProductsRequest, grpc.ServerStreamingServer[ExportProductsChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportProducts not implemented")
+}
+func (UnimplementedProductServer) SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*SchedulePriceChangeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SchedulePriceChange not implemented")
+}
+func (UnimplementedProductServer) CancelPriceSchedule(context.Context, *CancelPriceScheduleRequest) (*CancelPriceScheduleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelPriceSchedule not implemented")
+}
+func (UnimplementedProductServer) ListPriceSchedules(context.Context, *ListPriceSchedulesRequest) (*ListPriceSchedulesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPriceSchedules not implemented")
+}
+func (UnimplementedProductServer) GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPriceHistory not implemented")
+}
+func (UnimplementedProductServer) CreateFlashSale(context.Context, *CreateFlashSaleRequest) (*CreateFlashSaleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateFlashSale not implemented")
+}
+func (UnimplementedProductServer) CancelFlashSale(context.Context, *CancelFlashSaleRequest) (*CancelFlashSaleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelFlashSale not implemented")
+}
+func (UnimplementedProductServer) ListFlashSales(context.Context, *ListFlashSalesRequest) (*ListFlashSalesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFlashSales not implemented")
+}
+func (UnimplementedProductServer) GetFlashSale(context.Context, *GetFlashSaleRequest) (*GetFlashSaleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFlashSale not implemented")
+}
+func (UnimplementedProductServer) FlashSaleBuy(context.Context, *FlashSaleBuyRequest) (*FlashSaleBuyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FlashSaleBuy not implemented")
+}
+func (UnimplementedProductServer) ReleaseFlashSaleLimit(context.Context, *ReleaseFlashSaleLimitRequest) (*ReleaseFlashSaleLimitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseFlashSaleLimit not implemented")
+}
+func (UnimplementedProductServer) ListInventoryMovements(context.Context, *ListInventoryMovementsRequest) (*ListInventoryMovementsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListInventoryMovements not implemented")
+}
+func (UnimplementedProductServer) CheckInventoryConsistency(context.Context, *CheckInventoryConsistencyRequest) (*CheckInventoryConsistencyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckInventoryConsistency not implemented")
+}
+func (UnimplementedProductServer) CreateWarehouse(context.Context, *CreateWarehouseRequest) (*CreateWarehouseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateWarehouse not implemented")
+}
+func (UnimplementedProductServer) UpdateWarehouse(context.Context, *UpdateWarehouseRequest) (*UpdateWarehouseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateWarehouse not implemented")
+}
+func (UnimplementedProductServer) ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListWarehouses not implemented")
+}
+func (UnimplementedProductServer) TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TransferStock not implemented")
+}
+func (UnimplementedProductServer) SetStockThreshold(context.Context, *SetStockThresholdRequest) (*SetStockThresholdResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetStockThreshold not implemented")
+}
+func (UnimplementedProductServer) SetPurchaseLimits(context.Context, *SetPurchaseLimitsRequest) (*SetPurchaseLimitsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetPurchaseLimits not implemented")
+}
+func (UnimplementedProductServer) ListLowStockProducts(context.Context, *ListLowStockProductsRequest) (*ListLowStockProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLowStockProducts not implemented")
+}
+func (UnimplementedProductServer) SubscribeRestock(context.Context, *SubscribeRestockRequest) (*SubscribeRestockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubscribeRestock not implemented")
+}
+func (UnimplementedProductServer) UnsubscribeRestock(context.Context, *UnsubscribeRestockRequest) (*UnsubscribeRestockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnsubscribeRestock not implemented")
+}
+func (UnimplementedProductServer) GetRelatedProducts(context.Context, *GetRelatedProductsRequest) (*GetRelatedProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetRelatedProducts not implemented")
+}
+func (UnimplementedProductServer) SetBundleItems(context.Context, *SetBundleItemsRequest) (*SetBundleItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetBundleItems not implemented")
+}
+func (UnimplementedProductServer) GetBundle(context.Context, *GetBundleRequest) (*GetBundleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBundle not implemented")
+}
 func (UnimplementedProductServer) mustEmbedUnimplementedProductServer() {}
 func (UnimplementedProductServer) testEmbeddedByValue()                 {}
 
@@ -286,35 +835,53 @@ func _Product_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProductsRequest)
+func _Product_GetProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).ListProducts(ctx, in)
+		return srv.(ProductServer).GetProducts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_ListProducts_FullMethodName,
+		FullMethod: Product_GetProducts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServer).ListProducts(ctx, req.(*ListProductsRequest))
+		return srv.(ProductServer).GetProducts(ctx, req.(*GetProductsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Product_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SearchProductsRequest)
+func _Product_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServer).SearchProducts(ctx, in)
+		return srv.(ProductServer).ListProducts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Product_SearchProducts_FullMethodName,
+		FullMethod: Product_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SearchProducts_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(ProductServer).SearchProducts(ctx, req.(*SearchProductsRequest))
@@ -394,6 +961,521 @@ func _Product_BatchUpdateStock_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Product_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).RestoreProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_RestoreProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).RestoreProduct(ctx, req.(*RestoreProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_SetProductStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SetProductStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SetProductStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).SetProductStatus(ctx, req.(*SetProductStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ImportProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ImportProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ImportProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ImportProducts(ctx, req.(*ImportProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ExportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServer).ExportProducts(m, &grpc.GenericServerStream[ExportProductsRequest, ExportProductsChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Product_ExportProductsServer = grpc.ServerStreamingServer[ExportProductsChunk]
+
+func _Product_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SchedulePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SchedulePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).SchedulePriceChange(ctx, req.(*SchedulePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CancelPriceSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPriceScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CancelPriceSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CancelPriceSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CancelPriceSchedule(ctx, req.(*CancelPriceScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ListPriceSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ListPriceSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ListPriceSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ListPriceSchedules(ctx, req.(*ListPriceSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CreateFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFlashSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CreateFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CreateFlashSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CreateFlashSale(ctx, req.(*CreateFlashSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CancelFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFlashSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CancelFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CancelFlashSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CancelFlashSale(ctx, req.(*CancelFlashSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ListFlashSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlashSalesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ListFlashSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ListFlashSales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ListFlashSales(ctx, req.(*ListFlashSalesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlashSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetFlashSale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetFlashSale(ctx, req.(*GetFlashSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_FlashSaleBuy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlashSaleBuyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).FlashSaleBuy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_FlashSaleBuy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).FlashSaleBuy(ctx, req.(*FlashSaleBuyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ReleaseFlashSaleLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseFlashSaleLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ReleaseFlashSaleLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ReleaseFlashSaleLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ReleaseFlashSaleLimit(ctx, req.(*ReleaseFlashSaleLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ListInventoryMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInventoryMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ListInventoryMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ListInventoryMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ListInventoryMovements(ctx, req.(*ListInventoryMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CheckInventoryConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInventoryConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CheckInventoryConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CheckInventoryConsistency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CheckInventoryConsistency(ctx, req.(*CheckInventoryConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_CreateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).CreateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_CreateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).CreateWarehouse(ctx, req.(*CreateWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_UpdateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).UpdateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_UpdateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).UpdateWarehouse(ctx, req.(*UpdateWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ListWarehouses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWarehousesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ListWarehouses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ListWarehouses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ListWarehouses(ctx, req.(*ListWarehousesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_TransferStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).TransferStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_TransferStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).TransferStock(ctx, req.(*TransferStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_SetStockThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStockThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SetStockThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SetStockThreshold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).SetStockThreshold(ctx, req.(*SetStockThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_SetPurchaseLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPurchaseLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SetPurchaseLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SetPurchaseLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).SetPurchaseLimits(ctx, req.(*SetPurchaseLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_ListLowStockProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLowStockProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).ListLowStockProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_ListLowStockProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).ListLowStockProducts(ctx, req.(*ListLowStockProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_SubscribeRestock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeRestockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SubscribeRestock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SubscribeRestock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).SubscribeRestock(ctx, req.(*SubscribeRestockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_UnsubscribeRestock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeRestockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).UnsubscribeRestock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_UnsubscribeRestock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).UnsubscribeRestock(ctx, req.(*UnsubscribeRestockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetRelatedProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRelatedProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetRelatedProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetRelatedProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetRelatedProducts(ctx, req.(*GetRelatedProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_SetBundleItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBundleItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).SetBundleItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_SetBundleItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).SetBundleItems(ctx, req.(*SetBundleItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Product_GetBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServer).GetBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Product_GetBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServer).GetBundle(ctx, req.(*GetBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Product_ServiceDesc is the grpc.ServiceDesc for Product service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -413,6 +1495,10 @@ var Product_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProduct",
 			Handler:    _Product_GetProduct_Handler,
 		},
+		{
+			MethodName: "GetProducts",
+			Handler:    _Product_GetProducts_Handler,
+		},
 		{
 			MethodName: "ListProducts",
 			Handler:    _Product_ListProducts_Handler,
@@ -437,7 +1523,125 @@ var Product_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchUpdateStock",
 			Handler:    _Product_BatchUpdateStock_Handler,
 		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _Product_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "RestoreProduct",
+			Handler:    _Product_RestoreProduct_Handler,
+		},
+		{
+			MethodName: "SetProductStatus",
+			Handler:    _Product_SetProductStatus_Handler,
+		},
+		{
+			MethodName: "ImportProducts",
+			Handler:    _Product_ImportProducts_Handler,
+		},
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _Product_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "CancelPriceSchedule",
+			Handler:    _Product_CancelPriceSchedule_Handler,
+		},
+		{
+			MethodName: "ListPriceSchedules",
+			Handler:    _Product_ListPriceSchedules_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _Product_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "CreateFlashSale",
+			Handler:    _Product_CreateFlashSale_Handler,
+		},
+		{
+			MethodName: "CancelFlashSale",
+			Handler:    _Product_CancelFlashSale_Handler,
+		},
+		{
+			MethodName: "ListFlashSales",
+			Handler:    _Product_ListFlashSales_Handler,
+		},
+		{
+			MethodName: "GetFlashSale",
+			Handler:    _Product_GetFlashSale_Handler,
+		},
+		{
+			MethodName: "FlashSaleBuy",
+			Handler:    _Product_FlashSaleBuy_Handler,
+		},
+		{
+			MethodName: "ReleaseFlashSaleLimit",
+			Handler:    _Product_ReleaseFlashSaleLimit_Handler,
+		},
+		{
+			MethodName: "ListInventoryMovements",
+			Handler:    _Product_ListInventoryMovements_Handler,
+		},
+		{
+			MethodName: "CheckInventoryConsistency",
+			Handler:    _Product_CheckInventoryConsistency_Handler,
+		},
+		{
+			MethodName: "CreateWarehouse",
+			Handler:    _Product_CreateWarehouse_Handler,
+		},
+		{
+			MethodName: "UpdateWarehouse",
+			Handler:    _Product_UpdateWarehouse_Handler,
+		},
+		{
+			MethodName: "ListWarehouses",
+			Handler:    _Product_ListWarehouses_Handler,
+		},
+		{
+			MethodName: "TransferStock",
+			Handler:    _Product_TransferStock_Handler,
+		},
+		{
+			MethodName: "SetStockThreshold",
+			Handler:    _Product_SetStockThreshold_Handler,
+		},
+		{
+			MethodName: "SetPurchaseLimits",
+			Handler:    _Product_SetPurchaseLimits_Handler,
+		},
+		{
+			MethodName: "ListLowStockProducts",
+			Handler:    _Product_ListLowStockProducts_Handler,
+		},
+		{
+			MethodName: "SubscribeRestock",
+			Handler:    _Product_SubscribeRestock_Handler,
+		},
+		{
+			MethodName: "UnsubscribeRestock",
+			Handler:    _Product_UnsubscribeRestock_Handler,
+		},
+		{
+			MethodName: "GetRelatedProducts",
+			Handler:    _Product_GetRelatedProducts_Handler,
+		},
+		{
+			MethodName: "SetBundleItems",
+			Handler:    _Product_SetBundleItems_Handler,
+		},
+		{
+			MethodName: "GetBundle",
+			Handler:    _Product_GetBundle_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportProducts",
+			Handler:       _Product_ExportProducts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "product.proto",
 }
@@ -14,28 +14,100 @@ import (
 )
 
 type (
-	AddProductRequest        = product.AddProductRequest
-	AddProductResponse       = product.AddProductResponse
-	BatchUpdateStockRequest  = product.BatchUpdateStockRequest
-	BatchUpdateStockResponse = product.BatchUpdateStockResponse
-	CheckStockRequest        = product.CheckStockRequest
-	CheckStockResponse       = product.CheckStockResponse
-	GetProductRequest        = product.GetProductRequest
-	GetProductResponse       = product.GetProductResponse
-	IncrementSalesRequest    = product.IncrementSalesRequest
-	IncrementSalesResponse   = product.IncrementSalesResponse
-	ListProductsRequest      = product.ListProductsRequest
-	ListProductsResponse     = product.ListProductsResponse
-	ProductInfo              = product.ProductInfo
-	SearchProductsRequest    = product.SearchProductsRequest
-	SearchProductsResponse   = product.SearchProductsResponse
-	StockItem                = product.StockItem
-	StockUpdateItem          = product.StockUpdateItem
-	StockUpdateResult        = product.StockUpdateResult
-	UpdateProductRequest     = product.UpdateProductRequest
-	UpdateProductResponse    = product.UpdateProductResponse
-	UpdateStockRequest       = product.UpdateStockRequest
-	UpdateStockResponse      = product.UpdateStockResponse
+	AddProductRequest                 = product.AddProductRequest
+	AddProductResponse                = product.AddProductResponse
+	BatchUpdateStockRequest           = product.BatchUpdateStockRequest
+	BatchUpdateStockResponse          = product.BatchUpdateStockResponse
+	BundleComponent                   = product.BundleComponent
+	BundleItem                        = product.BundleItem
+	CancelFlashSaleRequest            = product.CancelFlashSaleRequest
+	CancelFlashSaleResponse           = product.CancelFlashSaleResponse
+	CancelPriceScheduleRequest        = product.CancelPriceScheduleRequest
+	CancelPriceScheduleResponse       = product.CancelPriceScheduleResponse
+	CheckInventoryConsistencyRequest  = product.CheckInventoryConsistencyRequest
+	CheckInventoryConsistencyResponse = product.CheckInventoryConsistencyResponse
+	CheckStockRequest                 = product.CheckStockRequest
+	CheckStockResponse                = product.CheckStockResponse
+	CreateFlashSaleRequest            = product.CreateFlashSaleRequest
+	CreateFlashSaleResponse           = product.CreateFlashSaleResponse
+	CreateWarehouseRequest            = product.CreateWarehouseRequest
+	CreateWarehouseResponse           = product.CreateWarehouseResponse
+	DeleteProductRequest              = product.DeleteProductRequest
+	DeleteProductResponse             = product.DeleteProductResponse
+	ExportProductsChunk               = product.ExportProductsChunk
+	ExportProductsRequest             = product.ExportProductsRequest
+	FlashSale                         = product.FlashSale
+	FlashSaleBuyRequest               = product.FlashSaleBuyRequest
+	FlashSaleBuyResponse              = product.FlashSaleBuyResponse
+	GetBundleRequest                  = product.GetBundleRequest
+	GetBundleResponse                 = product.GetBundleResponse
+	GetFlashSaleRequest               = product.GetFlashSaleRequest
+	GetFlashSaleResponse              = product.GetFlashSaleResponse
+	GetPriceHistoryRequest            = product.GetPriceHistoryRequest
+	GetPriceHistoryResponse           = product.GetPriceHistoryResponse
+	GetProductRequest                 = product.GetProductRequest
+	GetProductResponse                = product.GetProductResponse
+	GetProductsRequest                = product.GetProductsRequest
+	GetProductsResponse               = product.GetProductsResponse
+	GetRelatedProductsRequest         = product.GetRelatedProductsRequest
+	GetRelatedProductsResponse        = product.GetRelatedProductsResponse
+	ImportProductsRequest             = product.ImportProductsRequest
+	ImportProductsResponse            = product.ImportProductsResponse
+	ImportRowError                    = product.ImportRowError
+	IncrementSalesRequest             = product.IncrementSalesRequest
+	IncrementSalesResponse            = product.IncrementSalesResponse
+	InventoryMovement                 = product.InventoryMovement
+	ListFlashSalesRequest             = product.ListFlashSalesRequest
+	ListFlashSalesResponse            = product.ListFlashSalesResponse
+	ListInventoryMovementsRequest     = product.ListInventoryMovementsRequest
+	ListInventoryMovementsResponse    = product.ListInventoryMovementsResponse
+	ListLowStockProductsRequest       = product.ListLowStockProductsRequest
+	ListLowStockProductsResponse      = product.ListLowStockProductsResponse
+	ListPriceSchedulesRequest         = product.ListPriceSchedulesRequest
+	ListPriceSchedulesResponse        = product.ListPriceSchedulesResponse
+	ListProductsRequest               = product.ListProductsRequest
+	ListProductsResponse              = product.ListProductsResponse
+	ListWarehousesRequest             = product.ListWarehousesRequest
+	ListWarehousesResponse            = product.ListWarehousesResponse
+	LowStockProduct                   = product.LowStockProduct
+	PriceHistory                      = product.PriceHistory
+	PriceSchedule                     = product.PriceSchedule
+	ProductInfo                       = product.ProductInfo
+	ProductResult                     = product.ProductResult
+	ReleaseFlashSaleLimitRequest      = product.ReleaseFlashSaleLimitRequest
+	ReleaseFlashSaleLimitResponse     = product.ReleaseFlashSaleLimitResponse
+	RestoreProductRequest             = product.RestoreProductRequest
+	RestoreProductResponse            = product.RestoreProductResponse
+	SchedulePriceChangeRequest        = product.SchedulePriceChangeRequest
+	SchedulePriceChangeResponse       = product.SchedulePriceChangeResponse
+	SearchProductsRequest             = product.SearchProductsRequest
+	SearchProductsResponse            = product.SearchProductsResponse
+	SetBundleItemsRequest             = product.SetBundleItemsRequest
+	SetBundleItemsResponse            = product.SetBundleItemsResponse
+	SetProductStatusRequest           = product.SetProductStatusRequest
+	SetProductStatusResponse          = product.SetProductStatusResponse
+	SetPurchaseLimitsRequest          = product.SetPurchaseLimitsRequest
+	SetPurchaseLimitsResponse         = product.SetPurchaseLimitsResponse
+	SetStockThresholdRequest          = product.SetStockThresholdRequest
+	SetStockThresholdResponse         = product.SetStockThresholdResponse
+	StockDiscrepancy                  = product.StockDiscrepancy
+	StockItem                         = product.StockItem
+	StockUpdateItem                   = product.StockUpdateItem
+	StockUpdateResult                 = product.StockUpdateResult
+	SubscribeRestockRequest           = product.SubscribeRestockRequest
+	SubscribeRestockResponse          = product.SubscribeRestockResponse
+	TransferStockRequest              = product.TransferStockRequest
+	TransferStockResponse             = product.TransferStockResponse
+	UnsubscribeRestockRequest         = product.UnsubscribeRestockRequest
+	UnsubscribeRestockResponse        = product.UnsubscribeRestockResponse
+	UpdateProductRequest              = product.UpdateProductRequest
+	UpdateProductResponse             = product.UpdateProductResponse
+	UpdateStockRequest                = product.UpdateStockRequest
+	UpdateStockResponse               = product.UpdateStockResponse
+	UpdateWarehouseRequest            = product.UpdateWarehouseRequest
+	UpdateWarehouseResponse           = product.UpdateWarehouseResponse
+	Warehouse                         = product.Warehouse
+	WarehouseStock                    = product.WarehouseStock
 
 	Product interface {
 		// Add new product (admin)
@@ -44,6 +116,8 @@ type (
 		UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
 		// Get product detail
 		GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error)
+		// Get multiple products at once, results follow the order of the requested IDs
+		GetProducts(ctx context.Context, in *GetProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error)
 		// List products with pagination
 		ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
 		// Search products by keyword
@@ -56,6 +130,64 @@ type (
 		IncrementSales(ctx context.Context, in *IncrementSalesRequest, opts ...grpc.CallOption) (*IncrementSalesResponse, error)
 		// Batch update stock for multiple products (transactional)
 		BatchUpdateStock(ctx context.Context, in *BatchUpdateStockRequest, opts ...grpc.CallOption) (*BatchUpdateStockResponse, error)
+		// Soft delete product (admin)
+		DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+		// Restore a soft deleted product as unpublished (admin)
+		RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductResponse, error)
+		// Change product status: draft, published, unpublished, optionally scheduled (admin)
+		SetProductStatus(ctx context.Context, in *SetProductStatusRequest, opts ...grpc.CallOption) (*SetProductStatusResponse, error)
+		// Bulk import products from CSV or JSON Lines, upsert by SKU (admin)
+		ImportProducts(ctx context.Context, in *ImportProductsRequest, opts ...grpc.CallOption) (*ImportProductsResponse, error)
+		// Export product catalog as CSV or JSON Lines, streamed in chunks (admin)
+		ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (product.Product_ExportProductsClient, error)
+		// Schedule a future price change, optionally reverted at end_at (admin)
+		SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*SchedulePriceChangeResponse, error)
+		// Cancel a pending or active price schedule, active ones are reverted (admin)
+		CancelPriceSchedule(ctx context.Context, in *CancelPriceScheduleRequest, opts ...grpc.CallOption) (*CancelPriceScheduleResponse, error)
+		// List price schedules of a product (admin)
+		ListPriceSchedules(ctx context.Context, in *ListPriceSchedulesRequest, opts ...grpc.CallOption) (*ListPriceSchedulesResponse, error)
+		// Get price change history of a product (admin)
+		GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+		// Create a flash sale, its quantity is reserved from product stock (admin)
+		CreateFlashSale(ctx context.Context, in *CreateFlashSaleRequest, opts ...grpc.CallOption) (*CreateFlashSaleResponse, error)
+		// Cancel an open flash sale, unsold quantity goes back to product stock (admin)
+		CancelFlashSale(ctx context.Context, in *CancelFlashSaleRequest, opts ...grpc.CallOption) (*CancelFlashSaleResponse, error)
+		// List flash sales (admin)
+		ListFlashSales(ctx context.Context, in *ListFlashSalesRequest, opts ...grpc.CallOption) (*ListFlashSalesResponse, error)
+		// Get flash sale detail with remaining quantity
+		GetFlashSale(ctx context.Context, in *GetFlashSaleRequest, opts ...grpc.CallOption) (*GetFlashSaleResponse, error)
+		// Buy from a flash sale, stock is deducted in Redis and the order is queued through Kafka
+		FlashSaleBuy(ctx context.Context, in *FlashSaleBuyRequest, opts ...grpc.CallOption) (*FlashSaleBuyResponse, error)
+		// Give back the per-user purchase count of a cancelled flash sale order
+		ReleaseFlashSaleLimit(ctx context.Context, in *ReleaseFlashSaleLimitRequest, opts ...grpc.CallOption) (*ReleaseFlashSaleLimitResponse, error)
+		// List inventory movements of a product (admin)
+		ListInventoryMovements(ctx context.Context, in *ListInventoryMovementsRequest, opts ...grpc.CallOption) (*ListInventoryMovementsResponse, error)
+		// Recompute stock from inventory movements and report products that do not match (admin)
+		CheckInventoryConsistency(ctx context.Context, in *CheckInventoryConsistencyRequest, opts ...grpc.CallOption) (*CheckInventoryConsistencyResponse, error)
+		// Create a warehouse (admin)
+		CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*CreateWarehouseResponse, error)
+		// Update name, region and priority of a warehouse (admin)
+		UpdateWarehouse(ctx context.Context, in *UpdateWarehouseRequest, opts ...grpc.CallOption) (*UpdateWarehouseResponse, error)
+		// List warehouses, the default warehouse first (admin)
+		ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...grpc.CallOption) (*ListWarehousesResponse, error)
+		// Move stock of a product between warehouses (admin)
+		TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error)
+		// Set the low-stock alert threshold of a product, 0 disables alerts (admin)
+		SetStockThreshold(ctx context.Context, in *SetStockThresholdRequest, opts ...grpc.CallOption) (*SetStockThresholdResponse, error)
+		// Set the purchase limits of a product, enforced by cart and order (admin)
+		SetPurchaseLimits(ctx context.Context, in *SetPurchaseLimitsRequest, opts ...grpc.CallOption) (*SetPurchaseLimitsResponse, error)
+		// List products at or below their low-stock threshold (admin)
+		ListLowStockProducts(ctx context.Context, in *ListLowStockProductsRequest, opts ...grpc.CallOption) (*ListLowStockProductsResponse, error)
+		// Subscribe to a back-in-stock notification of an out-of-stock product
+		SubscribeRestock(ctx context.Context, in *SubscribeRestockRequest, opts ...grpc.CallOption) (*SubscribeRestockResponse, error)
+		// Cancel a pending back-in-stock subscription
+		UnsubscribeRestock(ctx context.Context, in *UnsubscribeRestockRequest, opts ...grpc.CallOption) (*UnsubscribeRestockResponse, error)
+		// Get products frequently bought together with a product, topped up with best sellers of its category
+		GetRelatedProducts(ctx context.Context, in *GetRelatedProductsRequest, opts ...grpc.CallOption) (*GetRelatedProductsResponse, error)
+		// Set the components of a bundle product, no components makes it a standalone product again (admin)
+		SetBundleItems(ctx context.Context, in *SetBundleItemsRequest, opts ...grpc.CallOption) (*SetBundleItemsResponse, error)
+		// Get the components of a bundle product and how many bundles they can make
+		GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*GetBundleResponse, error)
 	}
 
 	defaultProduct struct {
@@ -87,6 +219,12 @@ func (m *defaultProduct) GetProduct(ctx context.Context, in *GetProductRequest,
 	return client.GetProduct(ctx, in, opts...)
 }
 
+// Get multiple products at once, results follow the order of the requested IDs
+func (m *defaultProduct) GetProducts(ctx context.Context, in *GetProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.GetProducts(ctx, in, opts...)
+}
+
 // List products with pagination
 func (m *defaultProduct) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
 	client := product.NewProductClient(m.cli.Conn())
@@ -122,3 +260,177 @@ func (m *defaultProduct) BatchUpdateStock(ctx context.Context, in *BatchUpdateSt
 	client := product.NewProductClient(m.cli.Conn())
 	return client.BatchUpdateStock(ctx, in, opts...)
 }
+
+// Soft delete product (admin)
+func (m *defaultProduct) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.DeleteProduct(ctx, in, opts...)
+}
+
+// Restore a soft deleted product as unpublished (admin)
+func (m *defaultProduct) RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.RestoreProduct(ctx, in, opts...)
+}
+
+// Change product status: draft, published, unpublished, optionally scheduled (admin)
+func (m *defaultProduct) SetProductStatus(ctx context.Context, in *SetProductStatusRequest, opts ...grpc.CallOption) (*SetProductStatusResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.SetProductStatus(ctx, in, opts...)
+}
+
+// Bulk import products from CSV or JSON Lines, upsert by SKU (admin)
+func (m *defaultProduct) ImportProducts(ctx context.Context, in *ImportProductsRequest, opts ...grpc.CallOption) (*ImportProductsResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.ImportProducts(ctx, in, opts...)
+}
+
+// Export product catalog as CSV or JSON Lines, streamed in chunks (admin)
+func (m *defaultProduct) ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (product.Product_ExportProductsClient, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.ExportProducts(ctx, in, opts...)
+}
+
+// Schedule a future price change, optionally reverted at end_at (admin)
+func (m *defaultProduct) SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*SchedulePriceChangeResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.SchedulePriceChange(ctx, in, opts...)
+}
+
+// Cancel a pending or active price schedule, active ones are reverted (admin)
+func (m *defaultProduct) CancelPriceSchedule(ctx context.Context, in *CancelPriceScheduleRequest, opts ...grpc.CallOption) (*CancelPriceScheduleResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.CancelPriceSchedule(ctx, in, opts...)
+}
+
+// List price schedules of a product (admin)
+func (m *defaultProduct) ListPriceSchedules(ctx context.Context, in *ListPriceSchedulesRequest, opts ...grpc.CallOption) (*ListPriceSchedulesResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.ListPriceSchedules(ctx, in, opts...)
+}
+
+// Get price change history of a product (admin)
+func (m *defaultProduct) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.GetPriceHistory(ctx, in, opts...)
+}
+
+// Create a flash sale, its quantity is reserved from product stock (admin)
+func (m *defaultProduct) CreateFlashSale(ctx context.Context, in *CreateFlashSaleRequest, opts ...grpc.CallOption) (*CreateFlashSaleResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.CreateFlashSale(ctx, in, opts...)
+}
+
+// Cancel an open flash sale, unsold quantity goes back to product stock (admin)
+func (m *defaultProduct) CancelFlashSale(ctx context.Context, in *CancelFlashSaleRequest, opts ...grpc.CallOption) (*CancelFlashSaleResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.CancelFlashSale(ctx, in, opts...)
+}
+
+// List flash sales (admin)
+func (m *defaultProduct) ListFlashSales(ctx context.Context, in *ListFlashSalesRequest, opts ...grpc.CallOption) (*ListFlashSalesResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.ListFlashSales(ctx, in, opts...)
+}
+
+// Get flash sale detail with remaining quantity
+func (m *defaultProduct) GetFlashSale(ctx context.Context, in *GetFlashSaleRequest, opts ...grpc.CallOption) (*GetFlashSaleResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.GetFlashSale(ctx, in, opts...)
+}
+
+// Buy from a flash sale, stock is deducted in Redis and the order is queued through Kafka
+func (m *defaultProduct) FlashSaleBuy(ctx context.Context, in *FlashSaleBuyRequest, opts ...grpc.CallOption) (*FlashSaleBuyResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.FlashSaleBuy(ctx, in, opts...)
+}
+
+// Give back the per-user purchase count of a cancelled flash sale order
+func (m *defaultProduct) ReleaseFlashSaleLimit(ctx context.Context, in *ReleaseFlashSaleLimitRequest, opts ...grpc.CallOption) (*ReleaseFlashSaleLimitResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.ReleaseFlashSaleLimit(ctx, in, opts...)
+}
+
+// List inventory movements of a product (admin)
+func (m *defaultProduct) ListInventoryMovements(ctx context.Context, in *ListInventoryMovementsRequest, opts ...grpc.CallOption) (*ListInventoryMovementsResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.ListInventoryMovements(ctx, in, opts...)
+}
+
+// Recompute stock from inventory movements and report products that do not match (admin)
+func (m *defaultProduct) CheckInventoryConsistency(ctx context.Context, in *CheckInventoryConsistencyRequest, opts ...grpc.CallOption) (*CheckInventoryConsistencyResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.CheckInventoryConsistency(ctx, in, opts...)
+}
+
+// Create a warehouse (admin)
+func (m *defaultProduct) CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*CreateWarehouseResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.CreateWarehouse(ctx, in, opts...)
+}
+
+// Update name, region and priority of a warehouse (admin)
+func (m *defaultProduct) UpdateWarehouse(ctx context.Context, in *UpdateWarehouseRequest, opts ...grpc.CallOption) (*UpdateWarehouseResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.UpdateWarehouse(ctx, in, opts...)
+}
+
+// List warehouses, the default warehouse first (admin)
+func (m *defaultProduct) ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...grpc.CallOption) (*ListWarehousesResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.ListWarehouses(ctx, in, opts...)
+}
+
+// Move stock of a product between warehouses (admin)
+func (m *defaultProduct) TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.TransferStock(ctx, in, opts...)
+}
+
+// Set the low-stock alert threshold of a product, 0 disables alerts (admin)
+func (m *defaultProduct) SetStockThreshold(ctx context.Context, in *SetStockThresholdRequest, opts ...grpc.CallOption) (*SetStockThresholdResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.SetStockThreshold(ctx, in, opts...)
+}
+
+// Set the purchase limits of a product, enforced by cart and order (admin)
+func (m *defaultProduct) SetPurchaseLimits(ctx context.Context, in *SetPurchaseLimitsRequest, opts ...grpc.CallOption) (*SetPurchaseLimitsResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.SetPurchaseLimits(ctx, in, opts...)
+}
+
+// List products at or below their low-stock threshold (admin)
+func (m *defaultProduct) ListLowStockProducts(ctx context.Context, in *ListLowStockProductsRequest, opts ...grpc.CallOption) (*ListLowStockProductsResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.ListLowStockProducts(ctx, in, opts...)
+}
+
+// Subscribe to a back-in-stock notification of an out-of-stock product
+func (m *defaultProduct) SubscribeRestock(ctx context.Context, in *SubscribeRestockRequest, opts ...grpc.CallOption) (*SubscribeRestockResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.SubscribeRestock(ctx, in, opts...)
+}
+
+// Cancel a pending back-in-stock subscription
+func (m *defaultProduct) UnsubscribeRestock(ctx context.Context, in *UnsubscribeRestockRequest, opts ...grpc.CallOption) (*UnsubscribeRestockResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.UnsubscribeRestock(ctx, in, opts...)
+}
+
+// Get products frequently bought together with a product, topped up with best sellers of its category
+func (m *defaultProduct) GetRelatedProducts(ctx context.Context, in *GetRelatedProductsRequest, opts ...grpc.CallOption) (*GetRelatedProductsResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.GetRelatedProducts(ctx, in, opts...)
+}
+
+// Set the components of a bundle product, no components makes it a standalone product again (admin)
+func (m *defaultProduct) SetBundleItems(ctx context.Context, in *SetBundleItemsRequest, opts ...grpc.CallOption) (*SetBundleItemsResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.SetBundleItems(ctx, in, opts...)
+}
+
+// Get the components of a bundle product and how many bundles they can make
+func (m *defaultProduct) GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*GetBundleResponse, error) {
+	client := product.NewProductClient(m.cli.Conn())
+	return client.GetBundle(ctx, in, opts...)
+}
@@ -158,13 +158,15 @@ if [ -n "$NEW_PRODUCT_ID" ]; then
     VERIFY_NEW_RESULT=$(curl -s "${BASE_URL}/api/v1/product/detail/${NEW_PRODUCT_ID}")
     print_result "Verify New Product" "$VERIFY_NEW_RESULT"
 
-    # 10. Test Update Product
+    # 10. Test Update Product (the current version is required)
     echo -e "${BLUE}10. Testing Update Product (Admin)...${NC}"
+    PRODUCT_VERSION=$(echo $VERIFY_NEW_RESULT | grep -o '"version":[0-9]*' | cut -d':' -f2)
     UPDATE_RESULT=$(curl -s -X PUT ${BASE_URL}/api/v1/product/update \
       -H "Authorization: Bearer $TOKEN" \
       -H "Content-Type: application/json" \
       -d "{
         \"id\": ${NEW_PRODUCT_ID},
+        \"version\": ${PRODUCT_VERSION},
         \"name\": \"Updated Test Product ${TIMESTAMP}\",
         \"description\": \"This product has been updated by the testing script\",
         \"price\": 149.99,
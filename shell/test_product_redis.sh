@@ -183,11 +183,13 @@ echo -e "${BLUE}Test 7: Update product (should invalidate caches)${NC}"
 echo -e "  - Delete product:detail:${PRODUCT_ID}"
 echo -e "  - Increment product:CategoryVersion:Electronics"
 echo -e "  - Increment product:GlobalVersion"
+PRODUCT_VERSION=$(curl -s "${BASE_URL}/api/v1/product/detail/${PRODUCT_ID}" | grep -o '"version":[0-9]*' | cut -d':' -f2)
 UPDATE_RESULT=$(curl -s -X PUT ${BASE_URL}/api/v1/product/update \
   -H "Authorization: Bearer $TOKEN" \
   -H "Content-Type: application/json" \
   -d "{
     \"id\": ${PRODUCT_ID},
+    \"version\": ${PRODUCT_VERSION},
     \"name\": \"UPDATED Redis Cache Test Product ${TIMESTAMP}\",
     \"description\": \"Updated product to test cache invalidation\",
     \"price\": 199.99
@@ -231,11 +233,13 @@ echo -e "${BLUE}Test 11: Change product category (Electronics -> Books)${NC}"
 echo -e "  - Should increment Electronics version"
 echo -e "  - Should increment Books version"
 echo -e "  - Should increment Global version"
+PRODUCT_VERSION=$(echo $UPDATE_RESULT | grep -o '"version":[0-9]*' | cut -d':' -f2)
 UPDATE_CAT=$(curl -s -X PUT ${BASE_URL}/api/v1/product/update \
   -H "Authorization: Bearer $TOKEN" \
   -H "Content-Type: application/json" \
   -d "{
     \"id\": ${PRODUCT_ID},
+    \"version\": ${PRODUCT_VERSION},
     \"category\": \"Books\"
   }")
 print_result "Update Category (Electronics -> Books)" "$UPDATE_CAT"